@@ -0,0 +1,258 @@
+package api
+
+import (
+	"api-server/orm"
+	pb "api-server/proto_gen"
+	"api-server/queue"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/EnclaveRunner/shareddeps/auth"
+	"github.com/EnclaveRunner/shareddeps/utils"
+	"github.com/rs/zerolog/log"
+)
+
+// PostV1TaskBatch implements [StrictServerInterface].
+func (server *Server) PostV1TaskBatch(
+	ctx context.Context,
+	request PostV1TaskBatchRequestObject,
+) (PostV1TaskBatchResponseObject, error) {
+	requested := request.Body.Tasks
+	if len(requested) == 0 {
+		return PostV1TaskBatch400JSONResponse{
+			GenericBadRequestJSONResponse{
+				Error: "Batch must contain at least one task",
+			},
+		}, nil
+	}
+
+	if len(requested) > server.maxBatchSize {
+		return PostV1TaskBatch413JSONResponse{
+			GenericTooLargeJSONResponse{
+				Error: fmt.Sprintf(
+					"Batch exceeds the maximum size of %d tasks",
+					server.maxBatchSize,
+				),
+			},
+		}, nil
+	}
+
+	batch := orm.TaskBatch{
+		SubmittedBy: auth.GetAuthenticatedUser(ctx),
+		Total:       len(requested),
+	}
+
+	err := server.db.CreateTaskBatch(ctx, &batch)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create task batch")
+
+		return PostV1TaskBatch500Response{}, nil
+	}
+
+	items := make([]TaskBatchItem, len(requested))
+	enqueueRequests := make([]queue.EnqueueRequest, 0, len(requested))
+	// Positions of the enqueue requests in the batch
+	positions := make([]int, 0, len(requested))
+	resolveArtifact := cachedArtifactResolver(server.resolveArtifact)
+	for i := range requested {
+		items[i].Index = i
+
+		task, taskOptions, err := server.prepareTask(
+			ctx,
+			&requested[i],
+			resolveArtifact,
+		)
+		if err != nil {
+			items[i].Error = utils.Ptr(batchItemError(err, batch.ID.String(), i))
+
+			continue
+		}
+
+		enqueueRequests = append(enqueueRequests, queue.EnqueueRequest{
+			Task: task,
+			Metadata: queue.TaskMetadata{
+				SubmittedBy: batch.SubmittedBy,
+				BatchID:     batch.ID.String(),
+				GroupID:     groupOf(&requested[i]),
+			},
+			Options: taskOptions,
+		})
+		positions = append(positions, i)
+	}
+
+	submitted := 0
+	results := server.queueClient.EnqueueTasks(ctx, enqueueRequests)
+	for j, result := range results {
+		item := &items[positions[j]]
+		if result.Err != nil {
+			log.Error().
+				Err(result.Err).
+				Str("batch", batch.ID.String()).
+				Int("index", item.Index).
+				Msg("Failed to enqueue task of batch")
+
+			item.Error = utils.Ptr("Failed to enqueue task")
+
+			continue
+		}
+
+		submitted++
+		item.Id = &result.Info.ID
+	}
+
+	err = server.db.SetTaskBatchSubmitted(ctx, batch.ID, submitted)
+	if err != nil {
+		// The tasks are enqueued, only the progress of the batch is affected
+		log.Error().
+			Err(err).
+			Str("batch", batch.ID.String()).
+			Msg("Failed to store number of submitted tasks of batch")
+	}
+
+	return PostV1TaskBatch201JSONResponse{
+		Id:        batch.ID,
+		Submitted: submitted,
+		Failed:    len(requested) - submitted,
+		Items:     items,
+	}, nil
+}
+
+// GetV1TaskBatchId implements [StrictServerInterface].
+func (server *Server) GetV1TaskBatchId(
+	ctx context.Context,
+	request GetV1TaskBatchIdRequestObject,
+) (GetV1TaskBatchIdResponseObject, error) {
+	batch, err := server.db.GetTaskBatch(ctx, request.Id)
+	if err != nil {
+		var errNotFound *orm.NotFoundError
+		if errors.As(err, &errNotFound) {
+			return GetV1TaskBatchId404JSONResponse{
+				GenericNotFoundJSONResponse{Error: err.Error()},
+			}, nil
+		}
+
+		log.Error().
+			Err(err).
+			Str("id", request.Id.String()).
+			Msg("Failed to retrieve task batch")
+
+		return GetV1TaskBatchId500Response{}, nil
+	}
+
+	owner, err := server.visibleOwner(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to determine visible tasks")
+
+		return GetV1TaskBatchId500Response{}, nil
+	}
+
+	if owner != nil && batch.SubmittedBy != *owner {
+		// Batches of other users are not disclosed
+		return GetV1TaskBatchId404JSONResponse{
+			GenericNotFoundJSONResponse{
+				Error: (&orm.NotFoundError{
+					Search: "Task batch " + request.Id.String(),
+				}).Error(),
+			},
+		}, nil
+	}
+
+	states, err := server.db.CountTaskStatesOfBatch(ctx, batch.ID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id.String()).
+			Msg("Failed to count task states of batch")
+
+		return GetV1TaskBatchId500Response{}, nil
+	}
+
+	return GetV1TaskBatchId200JSONResponse(batchProgress(batch, states)), nil
+}
+
+// batchProgress aggregates the task states of a batch.
+func batchProgress(batch *orm.TaskBatch, states map[string]int) TaskBatch {
+	progress := TaskBatch{
+		Id:          batch.ID,
+		SubmittedBy: batch.SubmittedBy,
+		CreatedAt:   batch.CreatedAt,
+		Total:       batch.Total,
+		Submitted:   batch.Submitted,
+		States:      states,
+	}
+
+	for state, count := range states {
+		if queue.IsFinalState(state) {
+			progress.Finished += count
+		}
+	}
+
+	return progress
+}
+
+// batchItemError returns the error reported for a task of a batch which could
+// not be prepared. Errors not caused by the request are logged and hidden.
+func batchItemError(err error, batchID string, index int) string {
+	var errRequest *taskRequestError
+	if errors.As(err, &errRequest) {
+		return errRequest.message
+	}
+
+	if errors.Is(err, ErrQueueForbidden) {
+		return err.Error()
+	}
+
+	if field, ok := paramsFieldError(err); ok {
+		return field.Error
+	}
+
+	log.Error().
+		Err(err).
+		Str("batch", batchID).
+		Int("index", index).
+		Msg("Failed to prepare task of batch")
+
+	return "Internal error"
+}
+
+// cachedArtifactResolver wraps an artifactResolver so that every distinct
+// artifact is only resolved once. The result is not safe for concurrent use.
+func cachedArtifactResolver(resolve artifactResolver) artifactResolver {
+	type outcome struct {
+		versionHash string
+		found       bool
+		err         error
+	}
+	outcomes := map[string]outcome{}
+
+	return func(
+		ctx context.Context,
+		artifact *pb.ArtifactIdentifier,
+	) (string, bool, error) {
+		key := artifactKey(artifact)
+		if cached, ok := outcomes[key]; ok {
+			return cached.versionHash, cached.found, cached.err
+		}
+
+		versionHash, found, err := resolve(ctx, artifact)
+		outcomes[key] = outcome{versionHash, found, err}
+
+		return versionHash, found, err
+	}
+}
+
+// artifactKey identifies an artifact by its package and its tag or hash.
+func artifactKey(artifact *pb.ArtifactIdentifier) string {
+	key := artifact.GetPackage().GetNamespace() + ":" +
+		artifact.GetPackage().GetName()
+
+	switch identifier := artifact.GetIdentifier().(type) {
+	case *pb.ArtifactIdentifier_Tag:
+		return key + "@" + identifier.Tag
+	case *pb.ArtifactIdentifier_VersionHash:
+		return key + "@hash:" + identifier.VersionHash
+	default:
+		return key
+	}
+}
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"api-server/orm"
+	"api-server/queue"
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog/log"
+)
+
+// GetV1TaskIdCallback implements [StrictServerInterface].
+func (server *Server) GetV1TaskIdCallback(
+	ctx context.Context,
+	request GetV1TaskIdCallbackRequestObject,
+) (GetV1TaskIdCallbackResponseObject, error) {
+	visible, err := server.taskVisible(ctx, request.Id)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to retrieve task")
+
+		return GetV1TaskIdCallback500Response{}, nil
+	}
+
+	if !visible {
+		return GetV1TaskIdCallback404JSONResponse{GenericNotFoundJSONResponse{
+			Error: (&queue.TaskNotFoundError{Id: request.Id}).Error(),
+		}}, nil
+	}
+
+	callback, err := server.db.GetCallbackOfTask(ctx, request.Id)
+	if err != nil {
+		var errNotFound *orm.NotFoundError
+		if errors.As(err, &errNotFound) {
+			return GetV1TaskIdCallback404JSONResponse{
+				GenericNotFoundJSONResponse{
+					Error: "No callback registered for task " + request.Id,
+				},
+			}, nil
+		}
+
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to retrieve task callback")
+
+		return GetV1TaskIdCallback500Response{}, nil
+	}
+
+	deliveries, err := server.db.GetCallbackDeliveriesOfTask(ctx, request.Id)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to retrieve callback deliveries")
+
+		return GetV1TaskIdCallback500Response{}, nil
+	}
+
+	return GetV1TaskIdCallback200JSONResponse(
+		callbackToTaskCallback(callback, deliveries),
+	), nil
+}
+
+func callbackToTaskCallback(
+	callback *orm.Callback,
+	deliveries []orm.CallbackDelivery,
+) TaskCallback {
+	response := TaskCallback{
+		Url:        callback.URL,
+		Status:     TaskCallbackStatus(callback.Status),
+		Attempts:   callback.Attempts,
+		Round:      callback.Round,
+		Deliveries: make([]CallbackDelivery, len(deliveries)),
+	}
+
+	if callback.Status == orm.CallbackStatusPending {
+		response.NextAttemptAt = &callback.NextAttemptAt
+	}
+
+	for i, delivery := range deliveries {
+		response.Deliveries[i] = CallbackDelivery{
+			Round:     delivery.Round,
+			Attempt:   delivery.Attempt,
+			Timestamp: delivery.Timestamp,
+			Success:   delivery.Success,
+		}
+
+		if delivery.StatusCode != 0 {
+			response.Deliveries[i].StatusCode = &delivery.StatusCode
+		}
+
+		if delivery.Error != "" {
+			response.Deliveries[i].Error = &delivery.Error
+		}
+	}
+
+	return response
+}
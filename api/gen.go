@@ -21,6 +21,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/oapi-codegen/runtime"
 	strictgin "github.com/oapi-codegen/runtime/strictmiddleware/gin"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
@@ -38,6 +39,76 @@ const (
 	PUT      RBACPolicyMethod = "PUT"
 )
 
+// Defines values for RetryTasksRequestState.
+const (
+	Archived RetryTasksRequestState = "archived"
+	Retry    RetryTasksRequestState = "retry"
+)
+
+// Defines values for TaskCallbackStatus.
+const (
+	TaskCallbackStatusDelivered TaskCallbackStatus = "delivered"
+	TaskCallbackStatusFailed    TaskCallbackStatus = "failed"
+	TaskCallbackStatusPending   TaskCallbackStatus = "pending"
+)
+
+// Defines values for TaskMapState.
+const (
+	TaskMapStateCompleted TaskMapState = "completed"
+	TaskMapStateFailed    TaskMapState = "failed"
+	TaskMapStateRunning   TaskMapState = "running"
+)
+
+// Defines values for TaskMapElementState.
+const (
+	TaskMapElementStateCompleted TaskMapElementState = "completed"
+	TaskMapElementStateEnqueued  TaskMapElementState = "enqueued"
+	TaskMapElementStateFailed    TaskMapElementState = "failed"
+	TaskMapElementStateSkipped   TaskMapElementState = "skipped"
+	TaskMapElementStateWaiting   TaskMapElementState = "waiting"
+)
+
+// Defines values for WorkflowState.
+const (
+	WorkflowStateCompleted WorkflowState = "completed"
+	WorkflowStateFailed    WorkflowState = "failed"
+	WorkflowStateRunning   WorkflowState = "running"
+)
+
+// Defines values for WorkflowNodeState.
+const (
+	Completed WorkflowNodeState = "completed"
+	Enqueued  WorkflowNodeState = "enqueued"
+	Failed    WorkflowNodeState = "failed"
+	Skipped   WorkflowNodeState = "skipped"
+	Waiting   WorkflowNodeState = "waiting"
+)
+
+// Defines values for GetV1TaskIdLogsParamsOrder.
+const (
+	Asc  GetV1TaskIdLogsParamsOrder = "asc"
+	Desc GetV1TaskIdLogsParamsOrder = "desc"
+)
+
+// Defines values for GetV1TaskIdLogsExportParamsFormat.
+const (
+	Ndjson GetV1TaskIdLogsExportParamsFormat = "ndjson"
+	Text   GetV1TaskIdLogsExportParamsFormat = "text"
+)
+
+// Defines values for GetV1TaskIdResultParamsFormat.
+const (
+	Json  GetV1TaskIdResultParamsFormat = "json"
+	Proto GetV1TaskIdResultParamsFormat = "proto"
+	Raw   GetV1TaskIdResultParamsFormat = "raw"
+)
+
+// AppendTaskLogsRequest defines model for AppendTaskLogsRequest.
+type AppendTaskLogsRequest struct {
+	// Logs Log entries to store.
+	Logs []TaskLogEntry `json:"logs"`
+}
+
 // Artifact Metadata of an artifact version.
 type Artifact struct {
 	// CreatedAt Creation timestamp of the artifact.
@@ -59,20 +130,112 @@ type Artifact struct {
 	VersionHash string `json:"versionHash"`
 }
 
+// CallbackDelivery defines model for CallbackDelivery.
+type CallbackDelivery struct {
+	// Attempt Number of the delivery attempt within its round, starting at 1.
+	Attempt int `json:"attempt"`
+
+	// Error Reason the delivery attempt failed.
+	Error *string `json:"error,omitempty"`
+
+	// Round Delivery round the attempt belongs to, starting at 1.
+	Round int `json:"round"`
+
+	// StatusCode HTTP status code returned by the callback endpoint, if a response was received.
+	StatusCode *int `json:"statusCode,omitempty"`
+
+	// Success Whether the callback endpoint acknowledged the delivery.
+	Success bool `json:"success"`
+
+	// Timestamp Time of the delivery attempt.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// CancelTaskGroupResponse defines model for CancelTaskGroupResponse.
+type CancelTaskGroupResponse struct {
+	// Count Number of canceled tasks.
+	Count int `json:"count"`
+
+	// Ids Unique identifiers of the canceled tasks.
+	Ids []string `json:"ids"`
+}
+
+// CreateScheduleRequest defines model for CreateScheduleRequest.
+type CreateScheduleRequest struct {
+	// Cron Standard five field cron expression (minute hour day-of-month month day-of-week) or a descriptor such as @daily or @every 90m.
+	Cron string `json:"cron"`
+
+	// Name Unique name of the schedule.
+	Name string `json:"name"`
+
+	// Paused Create the schedule in paused state.
+	Paused *bool             `json:"paused,omitempty"`
+	Task   CreateTaskRequest `json:"task"`
+
+	// Timezone IANA timezone the cron expression is evaluated in.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// CreateTaskBatchRequest defines model for CreateTaskBatchRequest.
+type CreateTaskBatchRequest struct {
+	// Tasks Tasks to create.
+	Tasks []CreateTaskRequest `json:"tasks"`
+}
+
+// CreateTaskBatchResponse defines model for CreateTaskBatchResponse.
+type CreateTaskBatchResponse struct {
+	// Failed Number of tasks that could not be enqueued.
+	Failed int `json:"failed"`
+
+	// Id Unique identifier of the batch.
+	Id openapi_types.UUID `json:"id"`
+
+	// Items Outcome of every task, in the order of the request.
+	Items []TaskBatchItem `json:"items"`
+
+	// Submitted Number of tasks that were enqueued.
+	Submitted int `json:"submitted"`
+}
+
+// CreateTaskMapRequest defines model for CreateTaskMapRequest.
+type CreateTaskMapRequest struct {
+	// Concurrency Maximum number of tasks of the map in flight at once. Defaults to the number of list elements.
+	Concurrency *int `json:"concurrency,omitempty"`
+
+	// Parameter Index of the list parameter of the task to map over.
+	Parameter *int              `json:"parameter,omitempty"`
+	Task      CreateTaskRequest `json:"task"`
+}
+
 // CreateTaskRequest defines model for CreateTaskRequest.
 type CreateTaskRequest struct {
 	// Args Argument list used to invoke the task.
 	Args *[]string `json:"args,omitempty"`
 
-	// Callback Callback URL invoked on task completion.
+	// Callback Callback URL invoked on task completion. Once the task reached a final state, a JSON object with the fields id, state, result_payload, last_error and completed_at is POSTed to this URL. result_payload holds the base64 encoded result as served by the raw format of the task result endpoint. If a callback secret is configured, the request carries an X-Enclave-Signature header containing "sha256=" followed by the hex encoded HMAC-SHA256 of "<X-Enclave-Timestamp>.<body>". Callbacks are only sent to public addresses and to the hosts and networks allowed by callback.allowed_targets; URLs of other private, loopback or link-local targets are rejected, as are deliveries to host names resolving to them.
 	Callback *string `json:"callback,omitempty"`
 
+	// Deadline Time (RFC3339) after which the task is no longer processed. Attempts still running at the deadline are canceled. Must lie in the future and not further ahead than the configured maximum deadline. Not supported for schedules.
+	Deadline *time.Time `json:"deadline,omitempty"`
+
 	// Env Environment variables supplied to the task.
 	Env *[]EnvironmentVariable `json:"env,omitempty"`
 
-	// Params Parameters passed to the task.
+	// Group Client chosen identifier of a group of related tasks, e.g. a parameter sweep. The aggregate status of a group can be retrieved and all of its tasks can be canceled at once.
+	Group *string `json:"group,omitempty"`
+
+	// Params Parameters passed to the task. Plain JSON values are passed as bool, s64 (integers given by YAML), f64, string, list, record or, for null, as none option. Values of other WIT types are given as object naming the type, e.g. {"type": "u8", "value": 7}. Integers and floats are given as number, 64 bit integers also as decimal string. A char is given as single character or its code point, list and tuple as array, option as its value (none if missing or null), result as {"ok": value} or {"err": value}, record as object of its fields, variant as {"case": name, "value": value}, enum as the name of its case and flags as array of the names of all set flags. Typed values may nest plain and typed values. Values are returned in the same encoding.
 	Params *[]interface{} `json:"params,omitempty"`
 
+	// ProcessAt Time (RFC3339) at which the task should be processed. The task stays in state scheduled until then. Must not lie in the past or further ahead than the configured maximum scheduling horizon. Mutually exclusive with processIn.
+	ProcessAt *time.Time `json:"processAt,omitempty"`
+
+	// ProcessIn Delay (e.g. "90m") after which the task should be processed. Must not be negative or exceed the configured maximum scheduling horizon. Mutually exclusive with processAt.
+	ProcessIn *string `json:"processIn,omitempty"`
+
+	// Queue Name of the queue the task is submitted to, defaults to "default". Queues with a higher configured weight are processed first. Queues other than the default queue may only be used by members of the user group "queue_<name>".
+	Queue *string `json:"queue,omitempty"`
+
 	// Retention Duration to retain the task after completion.
 	Retention *string `json:"retention,omitempty"`
 
@@ -81,6 +244,28 @@ type CreateTaskRequest struct {
 
 	// Source Task source in the form namespace:name/interface/function@<hash|tag>.
 	Source string `json:"source"`
+
+	// Timeout Maximum duration (e.g. "10m") a single attempt of the task may run before it is canceled and counted as failed. Defaults to the configured default timeout and must not exceed the configured maximum timeout.
+	Timeout *string `json:"timeout,omitempty"`
+}
+
+// CreateWorkflowNode defines model for CreateWorkflowNode.
+type CreateWorkflowNode struct {
+	// DependsOn Names of the nodes that have to complete before this node is enqueued. Their results are passed to the task of this node as leading parameters in this order.
+	DependsOn *[]string `json:"dependsOn,omitempty"`
+
+	// Name Name of the node, unique within the workflow.
+	Name string            `json:"name"`
+	Task CreateTaskRequest `json:"task"`
+}
+
+// CreateWorkflowRequest defines model for CreateWorkflowRequest.
+type CreateWorkflowRequest struct {
+	// Name Name of the workflow.
+	Name string `json:"name"`
+
+	// Nodes Nodes of the workflow.
+	Nodes []CreateWorkflowNode `json:"nodes"`
 }
 
 // EnvironmentVariable defines model for EnvironmentVariable.
@@ -124,6 +309,22 @@ type PatchMe struct {
 	Roles *[]string `json:"roles,omitempty"`
 }
 
+// PatchSchedule defines model for PatchSchedule.
+type PatchSchedule struct {
+	// Cron New cron expression of the schedule.
+	Cron *string `json:"cron,omitempty"`
+
+	// Name New unique name of the schedule.
+	Name *string `json:"name,omitempty"`
+
+	// Paused Pause or resume the schedule.
+	Paused *bool              `json:"paused,omitempty"`
+	Task   *CreateTaskRequest `json:"task,omitempty"`
+
+	// Timezone New IANA timezone the cron expression is evaluated in.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
 // PatchUser defines model for PatchUser.
 type PatchUser struct {
 	// DisplayName The display name for the user.
@@ -160,6 +361,57 @@ type PutUserRequest struct {
 	Roles *[]string `json:"roles,omitempty"`
 }
 
+// QueueDailyStats defines model for QueueDailyStats.
+type QueueDailyStats struct {
+	// Date Day the statistics were taken on.
+	Date openapi_types.Date `json:"date"`
+
+	// Failed Number of tasks that failed on this day.
+	Failed int `json:"failed"`
+
+	// Processed Number of tasks processed on this day, including failed tasks.
+	Processed int `json:"processed"`
+}
+
+// QueueStats defines model for QueueStats.
+type QueueStats struct {
+	// Failed Number of tasks that failed today.
+	Failed int `json:"failed"`
+
+	// FailedTotal Number of tasks that failed since the queue was created.
+	FailedTotal int `json:"failedTotal"`
+
+	// History Daily statistics, newest first. Only returned for a single queue.
+	History *[]QueueDailyStats `json:"history,omitempty"`
+
+	// Latency Time the oldest pending task is waiting for (e.g. "1m30s").
+	Latency string `json:"latency"`
+
+	// MemoryUsage Approximate number of bytes the queue and its tasks use in Redis.
+	MemoryUsage int64 `json:"memoryUsage"`
+
+	// Name Name of the queue.
+	Name string `json:"name"`
+
+	// Paused Whether processing of the tasks of the queue is paused.
+	Paused bool `json:"paused"`
+
+	// Processed Number of tasks processed today, including failed tasks.
+	Processed int `json:"processed"`
+
+	// ProcessedTotal Number of tasks processed since the queue was created.
+	ProcessedTotal int `json:"processedTotal"`
+
+	// Size Number of tasks in the queue, excluding completed tasks.
+	Size int `json:"size"`
+
+	// States Number of tasks of the queue per state.
+	States map[string]int `json:"states"`
+
+	// Timestamp Time the statistics were taken.
+	Timestamp time.Time `json:"timestamp"`
+}
+
 // RBACPolicy defines model for RBACPolicy.
 type RBACPolicy struct {
 	// Method The allowed HTTP method (e.g., "GET", "POST", "*").
@@ -184,6 +436,30 @@ type ResourceGroupResource struct {
 	Name string `json:"name"`
 }
 
+// RetryTasksRequest defines model for RetryTasksRequest.
+type RetryTasksRequest struct {
+	// ResetRetries Reset the retry counter of the retried tasks. Like for a single task, every retried task is replaced by a copy with a new id.
+	ResetRetries *bool `json:"resetRetries,omitempty"`
+
+	// Source Only retry tasks whose source starts with this value, e.g. a namespace or a full namespace:name/interface/function@<hash|tag> reference.
+	Source *string `json:"source,omitempty"`
+
+	// State State of the tasks to retry.
+	State RetryTasksRequestState `json:"state"`
+}
+
+// RetryTasksRequestState State of the tasks to retry.
+type RetryTasksRequestState string
+
+// RetryTasksResponse defines model for RetryTasksResponse.
+type RetryTasksResponse struct {
+	// Count Number of retried tasks.
+	Count int `json:"count"`
+
+	// Ids Unique identifiers of the retried tasks.
+	Ids []string `json:"ids"`
+}
+
 // RoleResource defines model for RoleResource.
 type RoleResource struct {
 	// Name The role name.
@@ -193,6 +469,49 @@ type RoleResource struct {
 	Users []string `json:"users"`
 }
 
+// Schedule defines model for Schedule.
+type Schedule struct {
+	// CreatedAt Time the schedule was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedBy User that created the schedule.
+	CreatedBy string `json:"createdBy"`
+
+	// Cron Cron expression of the schedule.
+	Cron string `json:"cron"`
+
+	// Id Unique identifier of the schedule.
+	Id openapi_types.UUID `json:"id"`
+
+	// Name Unique name of the schedule.
+	Name string `json:"name"`
+
+	// NextRunAt Time of the next run. Absent while the schedule is paused.
+	NextRunAt *time.Time `json:"nextRunAt,omitempty"`
+
+	// Paused Whether the schedule is paused.
+	Paused bool              `json:"paused"`
+	Task   CreateTaskRequest `json:"task"`
+
+	// Timezone IANA timezone the cron expression is evaluated in.
+	Timezone string `json:"timezone"`
+
+	// UpdatedAt Time the schedule was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ScheduleRun defines model for ScheduleRun.
+type ScheduleRun struct {
+	// Error Reason the task of the run could not be enqueued.
+	Error *string `json:"error,omitempty"`
+
+	// ScheduledAt Time the run was due.
+	ScheduledAt time.Time `json:"scheduledAt"`
+
+	// TaskId Unique identifier of the task spawned by the run.
+	TaskId *string `json:"taskId,omitempty"`
+}
+
 // Task defines model for Task.
 type Task struct {
 	// Args Argument list used to invoke the task.
@@ -201,15 +520,30 @@ type Task struct {
 	// Callback Callback URL invoked on task completion.
 	Callback *string `json:"callback,omitempty"`
 
+	// Deadline Time after which the task is no longer processed.
+	Deadline *time.Time `json:"deadline,omitempty"`
+
 	// Env Environment variables supplied to the task.
 	Env *[]EnvironmentVariable `json:"env,omitempty"`
 
+	// Group Group the task was submitted to.
+	Group *string `json:"group,omitempty"`
+
 	// Id Unique identifier for the task.
 	Id string `json:"id"`
 
-	// Params Parameters passed to the task.
+	// Params Parameters passed to the task. Plain JSON values are passed as bool, s64 (integers given by YAML), f64, string, list, record or, for null, as none option. Values of other WIT types are given as object naming the type, e.g. {"type": "u8", "value": 7}. Integers and floats are given as number, 64 bit integers also as decimal string. A char is given as single character or its code point, list and tuple as array, option as its value (none if missing or null), result as {"ok": value} or {"err": value}, record as object of its fields, variant as {"case": name, "value": value}, enum as the name of its case and flags as array of the names of all set flags. Typed values may nest plain and typed values. Values are returned in the same encoding.
 	Params *[]interface{} `json:"params,omitempty"`
 
+	// Queue Name of the queue the task was submitted to.
+	Queue *string `json:"queue,omitempty"`
+
+	// RequeuedAs Id of the copy that replaced this task after it was retried with reset retries. Tasks replaced by a copy are not counted in the progress of their batch or group.
+	RequeuedAs *string `json:"requeuedAs,omitempty"`
+
+	// RequeuedFrom Id of the task this task replaces after it was retried with reset retries.
+	RequeuedFrom *string `json:"requeuedFrom,omitempty"`
+
 	// Retention Duration to retain the task after completion.
 	Retention *string `json:"retention,omitempty"`
 
@@ -219,6 +553,105 @@ type Task struct {
 	// Source Task source in the form namespace:name/interface/function@<hash|tag>.
 	Source string     `json:"source"`
 	Status TaskStatus `json:"status"`
+
+	// SubmittedBy Username of the user that submitted the task.
+	SubmittedBy *string `json:"submittedBy,omitempty"`
+
+	// Timeout Maximum duration a single attempt of the task may run.
+	Timeout *string `json:"timeout,omitempty"`
+
+	// VersionHash Version hash of the artifact the task runs. Tags are resolved when the task is submitted, so that retries run the same code even if the tag is moved.
+	VersionHash *string `json:"versionHash,omitempty"`
+}
+
+// TaskBatch defines model for TaskBatch.
+type TaskBatch struct {
+	// CreatedAt Time the batch was submitted.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Finished Number of tasks of the batch that reached a final state.
+	Finished int `json:"finished"`
+
+	// Id Unique identifier of the batch.
+	Id openapi_types.UUID `json:"id"`
+
+	// States Number of tasks of the batch per state.
+	States map[string]int `json:"states"`
+
+	// Submitted Number of tasks of the batch that were enqueued.
+	Submitted int `json:"submitted"`
+
+	// SubmittedBy User that submitted the batch.
+	SubmittedBy string `json:"submittedBy"`
+
+	// Total Number of tasks in the request of the batch.
+	Total int `json:"total"`
+}
+
+// TaskBatchItem defines model for TaskBatchItem.
+type TaskBatchItem struct {
+	// Error Reason the task could not be created.
+	Error *string `json:"error,omitempty"`
+
+	// Id Unique identifier of the created task.
+	Id *string `json:"id,omitempty"`
+
+	// Index Position of the task in the request.
+	Index int `json:"index"`
+}
+
+// TaskCallback defines model for TaskCallback.
+type TaskCallback struct {
+	// Attempts Number of delivery attempts made so far in the current round.
+	Attempts int `json:"attempts"`
+
+	// Deliveries Delivery attempts of all rounds ordered from oldest to newest.
+	Deliveries []CallbackDelivery `json:"deliveries"`
+
+	// NextAttemptAt Earliest time of the next delivery attempt while the callback is pending.
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
+
+	// Round Current delivery round, starting at 1. Every retry of the task starts a new round with a fresh number of attempts.
+	Round int `json:"round"`
+
+	// Status Delivery state of the callback.
+	Status TaskCallbackStatus `json:"status"`
+
+	// Url Callback URL invoked on task completion.
+	Url string `json:"url"`
+}
+
+// TaskCallbackStatus Delivery state of the callback.
+type TaskCallbackStatus string
+
+// TaskGroup defines model for TaskGroup.
+type TaskGroup struct {
+	// Failed A page of the tasks of the group that reached a final state other than completed, i.e. that were archived after failing or being canceled, expired, or whose function returned an error. Their total number is given by the states.
+	Failed []TaskGroupMember `json:"failed"`
+
+	// Finished Number of tasks of the group that reached a final state.
+	Finished int `json:"finished"`
+
+	// Id Identifier of the group.
+	Id string `json:"id"`
+
+	// Progress Share of the tasks of the group that reached a final state.
+	Progress float64 `json:"progress"`
+
+	// States Number of tasks of the group per state.
+	States map[string]int `json:"states"`
+
+	// Total Number of tasks in the group.
+	Total int `json:"total"`
+}
+
+// TaskGroupMember defines model for TaskGroupMember.
+type TaskGroupMember struct {
+	// Id Unique identifier of the task.
+	Id string `json:"id"`
+
+	// LastError Last error reported by the task.
+	LastError *string `json:"lastError,omitempty"`
 }
 
 // TaskLog defines model for TaskLog.
@@ -236,6 +669,99 @@ type TaskLog struct {
 	Timestamp time.Time `json:"timestamp"`
 }
 
+// TaskLogEntry defines model for TaskLogEntry.
+type TaskLogEntry struct {
+	// Issuer Component that issued the log entry.
+	Issuer string `json:"issuer"`
+
+	// Level Log level (e.g., debug, info, warn, error, fatal).
+	Level string `json:"level"`
+
+	// Message Log message content.
+	Message string `json:"message"`
+
+	// Timestamp Time the log entry was created. Defaults to the time it is stored.
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// TaskMap defines model for TaskMap.
+type TaskMap struct {
+	// Concurrency Maximum number of tasks of the map in flight at once.
+	Concurrency int `json:"concurrency"`
+
+	// CreatedAt Time the task map was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedBy Username of the user that created the task map.
+	CreatedBy string `json:"createdBy"`
+
+	// Elements Tasks of the map in the order of the list elements.
+	Elements []TaskMapElement `json:"elements"`
+
+	// Error Reason the task map failed.
+	Error *string `json:"error,omitempty"`
+
+	// Id Unique identifier of the task map.
+	Id openapi_types.UUID `json:"id"`
+
+	// Parameter Index of the parameter the map runs over.
+	Parameter int `json:"parameter"`
+
+	// Result Results of all tasks in the order of the list elements, once completed.
+	Result *[]interface{} `json:"result,omitempty"`
+
+	// Source Task source in the form namespace:name/interface/function@<hash|tag>.
+	Source string `json:"source"`
+
+	// State State of the task map.
+	State TaskMapState `json:"state"`
+
+	// Total Number of list elements.
+	Total int `json:"total"`
+
+	// UpdatedAt Time the task map was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// TaskMapState State of the task map.
+type TaskMapState string
+
+// TaskMapElement defines model for TaskMapElement.
+type TaskMapElement struct {
+	// Error Reason the task processing the element failed.
+	Error *string `json:"error,omitempty"`
+
+	// Index Index of the list element.
+	Index int `json:"index"`
+
+	// State State of the task processing the element.
+	State TaskMapElementState `json:"state"`
+
+	// TaskId Unique identifier of the task processing the element.
+	TaskId *string `json:"taskId,omitempty"`
+}
+
+// TaskMapElementState State of the task processing the element.
+type TaskMapElementState string
+
+// TaskResult defines model for TaskResult.
+type TaskResult struct {
+	// Value Value returned by the function, encoded like task parameters.
+	Value interface{} `json:"value"`
+}
+
+// TaskRetry defines model for TaskRetry.
+type TaskRetry struct {
+	// ResetRetries Whether the retry counter was reset. The task was replaced by a copy then, see requeuedAs of the task.
+	ResetRetries bool `json:"resetRetries"`
+
+	// Timestamp Time the task was retried.
+	Timestamp time.Time `json:"timestamp"`
+
+	// TriggeredBy Username of the user that retried the task.
+	TriggeredBy string `json:"triggeredBy"`
+}
+
 // TaskStatus defines model for TaskStatus.
 type TaskStatus struct {
 	// CompletedAt Time the task finished processing.
@@ -250,16 +776,28 @@ type TaskStatus struct {
 	// NextProcessAt Time the task is scheduled for processing next.
 	NextProcessAt *time.Time `json:"next_process_at,omitempty"`
 
-	// ResultPayload Result payload of the task.
-	ResultPayload *string `json:"result_payload,omitempty"`
+	// Result Value returned by the function, encoded like task parameters. Absent if the task has no result yet or its result is not a serialized WIT value, see the task result endpoint for the raw result.
+	Result interface{} `json:"result,omitempty"`
 
 	// Retries Current retry count.
 	Retries int `json:"retries"`
 
-	// State Current status of the task.
+	// State Current status of the task. Tasks that vanished from the queue before reaching a final state are reported as expired, completed tasks whose function returned the err case of a WIT result as failed.
 	State string `json:"state"`
 }
 
+// TaskTransition defines model for TaskTransition.
+type TaskTransition struct {
+	// From State of the task before the transition. Absent for the initial state.
+	From *string `json:"from,omitempty"`
+
+	// Timestamp Time the transition was observed.
+	Timestamp time.Time `json:"timestamp"`
+
+	// To State of the task after the transition.
+	To string `json:"to"`
+}
+
 // UploadArtifactResponse defines model for UploadArtifactResponse.
 type UploadArtifactResponse struct {
 	// VersionHash Created version hash.
@@ -278,6 +816,57 @@ type UserResponse struct {
 	Roles *[]string `json:"roles,omitempty"`
 }
 
+// Workflow defines model for Workflow.
+type Workflow struct {
+	// CreatedAt Time the workflow was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedBy Username of the user that created the workflow.
+	CreatedBy string `json:"createdBy"`
+
+	// Id Unique identifier of the workflow.
+	Id openapi_types.UUID `json:"id"`
+
+	// Name Name of the workflow.
+	Name string `json:"name"`
+
+	// Nodes Nodes of the workflow ordered by name.
+	Nodes []WorkflowNode `json:"nodes"`
+
+	// State State of the workflow. A workflow is completed once all of its nodes completed and failed once no node can make progress anymore but some did not complete.
+	State WorkflowState `json:"state"`
+
+	// UpdatedAt Time the workflow was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// WorkflowState State of the workflow. A workflow is completed once all of its nodes completed and failed once no node can make progress anymore but some did not complete.
+type WorkflowState string
+
+// WorkflowNode defines model for WorkflowNode.
+type WorkflowNode struct {
+	// DependsOn Names of the nodes whose results are passed to this node.
+	DependsOn []string `json:"dependsOn"`
+
+	// Error Reason the node failed.
+	Error *string `json:"error,omitempty"`
+
+	// Name Name of the node.
+	Name string `json:"name"`
+
+	// Source Task source in the form namespace:name/interface/function@<hash|tag>.
+	Source string `json:"source"`
+
+	// State State of the node.
+	State WorkflowNodeState `json:"state"`
+
+	// TaskId Unique identifier of the task enqueued for the node.
+	TaskId *string `json:"taskId,omitempty"`
+}
+
+// WorkflowNodeState State of the node.
+type WorkflowNodeState string
+
 // FieldError defines model for FieldError.
 type FieldError struct {
 	Errors *[]ErrField `json:"errors,omitempty"`
@@ -319,6 +908,12 @@ type GetV1ArtifactNamespaceNameParams struct {
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// GetV1QueueNameParams defines parameters for GetV1QueueName.
+type GetV1QueueNameParams struct {
+	// Days Number of days of history to return, including today.
+	Days *int `form:"days,omitempty" json:"days,omitempty"`
+}
+
 // GetV1RbacPolicyParams defines parameters for GetV1RbacPolicy.
 type GetV1RbacPolicyParams struct {
 	// Limit Maximum number of policies to return.
@@ -361,46 +956,175 @@ type GetV1RbacRoleParams struct {
 	Role *string `form:"role,omitempty" json:"role,omitempty"`
 }
 
-// GetV1TaskParams defines parameters for GetV1Task.
-type GetV1TaskParams struct {
-	// Limit Maximum number of tasks to return.
+// GetV1ScheduleParams defines parameters for GetV1Schedule.
+type GetV1ScheduleParams struct {
+	// Limit Maximum number of schedules to return.
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
 	// Offset Offset for pagination.
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
-
-	// State Filter tasks by state (e.g., ACTIVE).
-	State *string `form:"state,omitempty" json:"state,omitempty"`
 }
 
-// GetV1TaskIdLogsParams defines parameters for GetV1TaskIdLogs.
-type GetV1TaskIdLogsParams struct {
-	// Level Filter logs by level.
-	Level *string `form:"level,omitempty" json:"level,omitempty"`
-
-	// Issuer Filter logs by issuer.
-	Issuer *string `form:"issuer,omitempty" json:"issuer,omitempty"`
-
-	// TimeRangeFrom Start of the time range filter. Must be used with time-range-to and be more recent.
-	TimeRangeFrom *time.Time `form:"time-range-from,omitempty" json:"time-range-from,omitempty"`
+// GetV1ScheduleIdHistoryParams defines parameters for GetV1ScheduleIdHistory.
+type GetV1ScheduleIdHistoryParams struct {
+	// Limit Maximum number of runs to return.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-	// TimeRangeTo End of the time range filter. Must be used with time-range-from and be less recent.
-	TimeRangeTo *time.Time `form:"time-range-to,omitempty" json:"time-range-to,omitempty"`
+	// Offset Offset for pagination.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
-// GetV1UserParams defines parameters for GetV1User.
-type GetV1UserParams struct {
-	// Limit Maximum number of users to return.
+// GetV1TaskParams defines parameters for GetV1Task.
+type GetV1TaskParams struct {
+	// Limit Maximum number of tasks to return.
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-	// Offset Offset into the user list.
+	// Offset Number of tasks to skip (after the cursor, if given).
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 
-	// Name Filter by exact username.
-	Name *string `form:"name,omitempty" json:"name,omitempty"`
+	// Cursor Opaque cursor from the X-Next-Cursor header of the previous page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 
-	// DisplayName Filter by display name.
-	DisplayName *string `form:"display-name,omitempty" json:"display-name,omitempty"`
+	// State Filter tasks by state (e.g., ACTIVE). The state of the task history is used, which is eventually consistent with the queue like the listed state.
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+
+	// Source Filter tasks by function identifier (e.g. `namespace:package/interface/function@tag` or `...@hash:<hash>`).
+	Source *string `form:"source,omitempty" json:"source,omitempty"`
+
+	// Namespace Filter tasks by artifact namespace.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// Package Filter tasks by artifact package name.
+	Package *string `form:"package,omitempty" json:"package,omitempty"`
+
+	// Interface Filter tasks by interface of the called function.
+	Interface *string `form:"interface,omitempty" json:"interface,omitempty"`
+
+	// Function Filter tasks by name of the called function.
+	Function *string `form:"function,omitempty" json:"function,omitempty"`
+
+	// Tag Filter tasks by the artifact tag they were submitted with.
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Hash Filter tasks by the artifact version hash they were submitted with.
+	Hash *string `form:"hash,omitempty" json:"hash,omitempty"`
+
+	// SubmittedBy Filter tasks by the username of the submitting user.
+	SubmittedBy *string `form:"submitted-by,omitempty" json:"submitted-by,omitempty"`
+
+	// Group Filter tasks by the group they were submitted to.
+	Group *string `form:"group,omitempty" json:"group,omitempty"`
+
+	// CreatedAfter Only return tasks created at or after this time.
+	CreatedAfter *time.Time `form:"created-after,omitempty" json:"created-after,omitempty"`
+
+	// CreatedBefore Only return tasks created at or before this time.
+	CreatedBefore *time.Time `form:"created-before,omitempty" json:"created-before,omitempty"`
+
+	// CompletedAfter Only return tasks completed at or after this time.
+	CompletedAfter *time.Time `form:"completed-after,omitempty" json:"completed-after,omitempty"`
+
+	// CompletedBefore Only return tasks completed at or before this time.
+	CompletedBefore *time.Time `form:"completed-before,omitempty" json:"completed-before,omitempty"`
+}
+
+// PostV1TaskParams defines parameters for PostV1Task.
+type PostV1TaskParams struct {
+	// IdempotencyKey Client chosen key identifying the submission. Retrying a request with the same key and body returns the original task instead of submitting it again.
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// GetV1TaskGroupIdParams defines parameters for GetV1TaskGroupId.
+type GetV1TaskGroupIdParams struct {
+	// Limit Maximum number of failed tasks to return.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor from the X-Next-Cursor header of the previous page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetV1TaskIdLogsParams defines parameters for GetV1TaskIdLogs.
+type GetV1TaskIdLogsParams struct {
+	// Limit Maximum number of logs to return.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of logs to skip.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Level Filter logs by level.
+	Level *string `form:"level,omitempty" json:"level,omitempty"`
+
+	// Issuer Filter logs by issuer.
+	Issuer *string `form:"issuer,omitempty" json:"issuer,omitempty"`
+
+	// Since Only return logs written at or after this time.
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only return logs written at or before this time.
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Q Only return logs whose message contains this text, ignoring case.
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Order Order of the logs by time, oldest (asc) or newest (desc) first.
+	Order *GetV1TaskIdLogsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+}
+
+// GetV1TaskIdLogsParamsOrder defines parameters for GetV1TaskIdLogs.
+type GetV1TaskIdLogsParamsOrder string
+
+// GetV1TaskIdLogsExportParams defines parameters for GetV1TaskIdLogsExport.
+type GetV1TaskIdLogsExportParams struct {
+	// Format Format of the exported logs.
+	Format *GetV1TaskIdLogsExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetV1TaskIdLogsExportParamsFormat defines parameters for GetV1TaskIdLogsExport.
+type GetV1TaskIdLogsExportParamsFormat string
+
+// GetV1TaskIdLogsStreamParams defines parameters for GetV1TaskIdLogsStream.
+type GetV1TaskIdLogsStreamParams struct {
+	// LastEventID ID of the last received log event. Only logs following it are sent.
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// GetV1TaskIdResultParams defines parameters for GetV1TaskIdResult.
+type GetV1TaskIdResultParams struct {
+	// Format Encoding of the returned result.
+	Format *GetV1TaskIdResultParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetV1TaskIdResultParamsFormat defines parameters for GetV1TaskIdResult.
+type GetV1TaskIdResultParamsFormat string
+
+// PostV1TaskIdRetryParams defines parameters for PostV1TaskIdRetry.
+type PostV1TaskIdRetryParams struct {
+	// ResetRetries Reset the retry counter of the task so that it gets its full number of retries again. The task is replaced by a copy with a new id, which is returned and references the retried task in requeuedFrom. The retried task is kept in the history and references its copy in requeuedAs.
+	ResetRetries *bool `form:"reset-retries,omitempty" json:"reset-retries,omitempty"`
+}
+
+// GetV1UserParams defines parameters for GetV1User.
+type GetV1UserParams struct {
+	// Limit Maximum number of users to return.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Offset into the user list.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Name Filter by exact username.
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// DisplayName Filter by display name.
+	DisplayName *string `form:"display-name,omitempty" json:"display-name,omitempty"`
+}
+
+// GetV1WorkflowParams defines parameters for GetV1Workflow.
+type GetV1WorkflowParams struct {
+	// Limit Maximum number of workflows to return.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Offset for pagination.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
 // PatchV1ArtifactNamespaceNameHashHashJSONRequestBody defines body for PatchV1ArtifactNamespaceNameHashHash for application/json ContentType.
@@ -421,9 +1145,27 @@ type PutV1RbacResourceGroupResourceGroupJSONRequestBody = PutResourceGroupReques
 // PutV1RbacRoleRoleJSONRequestBody defines body for PutV1RbacRoleRole for application/json ContentType.
 type PutV1RbacRoleRoleJSONRequestBody = PutRoleRequest
 
+// PostV1ScheduleJSONRequestBody defines body for PostV1Schedule for application/json ContentType.
+type PostV1ScheduleJSONRequestBody = CreateScheduleRequest
+
+// PatchV1ScheduleIdJSONRequestBody defines body for PatchV1ScheduleId for application/json ContentType.
+type PatchV1ScheduleIdJSONRequestBody = PatchSchedule
+
 // PostV1TaskJSONRequestBody defines body for PostV1Task for application/json ContentType.
 type PostV1TaskJSONRequestBody = CreateTaskRequest
 
+// PostV1TaskBatchJSONRequestBody defines body for PostV1TaskBatch for application/json ContentType.
+type PostV1TaskBatchJSONRequestBody = CreateTaskBatchRequest
+
+// PostV1TaskMapJSONRequestBody defines body for PostV1TaskMap for application/json ContentType.
+type PostV1TaskMapJSONRequestBody = CreateTaskMapRequest
+
+// PostV1TaskRetryJSONRequestBody defines body for PostV1TaskRetry for application/json ContentType.
+type PostV1TaskRetryJSONRequestBody = RetryTasksRequest
+
+// PostV1TaskIdLogsJSONRequestBody defines body for PostV1TaskIdLogs for application/json ContentType.
+type PostV1TaskIdLogsJSONRequestBody = AppendTaskLogsRequest
+
 // PatchV1UserMeJSONRequestBody defines body for PatchV1UserMe for application/json ContentType.
 type PatchV1UserMeJSONRequestBody = PatchMe
 
@@ -433,6 +1175,9 @@ type PatchV1UserUsernameJSONRequestBody = PatchUser
 // PutV1UserUsernameJSONRequestBody defines body for PutV1UserUsername for application/json ContentType.
 type PutV1UserUsernameJSONRequestBody = PutUserRequest
 
+// PostV1WorkflowJSONRequestBody defines body for PostV1Workflow for application/json ContentType.
+type PostV1WorkflowJSONRequestBody = CreateWorkflowRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// List Artifact Namespaces
@@ -471,6 +1216,18 @@ type ServerInterface interface {
 	// Patch Artifact Metadata by Tag
 	// (PATCH /v1/artifact/{namespace}/{name}/tag/{tag})
 	PatchV1ArtifactNamespaceNameTagTag(c *gin.Context, namespace string, name string, tag string)
+	// List Queues
+	// (GET /v1/queue)
+	GetV1Queue(c *gin.Context)
+	// Get Queue
+	// (GET /v1/queue/{name})
+	GetV1QueueName(c *gin.Context, name string, params GetV1QueueNameParams)
+	// Pause Queue
+	// (POST /v1/queue/{name}/pause)
+	PostV1QueueNamePause(c *gin.Context, name string)
+	// Resume Queue
+	// (POST /v1/queue/{name}/resume)
+	PostV1QueueNameResume(c *gin.Context, name string)
 	// Delete RBAC Policy
 	// (DELETE /v1/rbac/policy)
 	DeleteV1RbacPolicy(c *gin.Context)
@@ -510,18 +1267,93 @@ type ServerInterface interface {
 	// Create or Replace Role
 	// (PUT /v1/rbac/role/{role})
 	PutV1RbacRoleRole(c *gin.Context, role string)
+	// List Schedules
+	// (GET /v1/schedule)
+	GetV1Schedule(c *gin.Context, params GetV1ScheduleParams)
+	// Create Schedule
+	// (POST /v1/schedule)
+	PostV1Schedule(c *gin.Context)
+	// Delete Schedule
+	// (DELETE /v1/schedule/{id})
+	DeleteV1ScheduleId(c *gin.Context, id openapi_types.UUID)
+	// Get Schedule
+	// (GET /v1/schedule/{id})
+	GetV1ScheduleId(c *gin.Context, id openapi_types.UUID)
+	// Update Schedule
+	// (PATCH /v1/schedule/{id})
+	PatchV1ScheduleId(c *gin.Context, id openapi_types.UUID)
+	// Get Schedule History
+	// (GET /v1/schedule/{id}/history)
+	GetV1ScheduleIdHistory(c *gin.Context, id openapi_types.UUID, params GetV1ScheduleIdHistoryParams)
+	// Pause Schedule
+	// (POST /v1/schedule/{id}/pause)
+	PostV1ScheduleIdPause(c *gin.Context, id openapi_types.UUID)
+	// Resume Schedule
+	// (POST /v1/schedule/{id}/resume)
+	PostV1ScheduleIdResume(c *gin.Context, id openapi_types.UUID)
 	// List Tasks
 	// (GET /v1/task)
 	GetV1Task(c *gin.Context, params GetV1TaskParams)
 	// Create Task
 	// (POST /v1/task)
-	PostV1Task(c *gin.Context)
+	PostV1Task(c *gin.Context, params PostV1TaskParams)
+	// Get Task Group
+	// (GET /v1/task-group/{id})
+	GetV1TaskGroupId(c *gin.Context, id string, params GetV1TaskGroupIdParams)
+	// Cancel Task Group
+	// (POST /v1/task-group/{id}/cancel)
+	PostV1TaskGroupIdCancel(c *gin.Context, id string)
+	// Create Task Batch
+	// (POST /v1/task/batch)
+	PostV1TaskBatch(c *gin.Context)
+	// Get Task Batch
+	// (GET /v1/task/batch/{id})
+	GetV1TaskBatchId(c *gin.Context, id openapi_types.UUID)
+	// Create Task Map
+	// (POST /v1/task/map)
+	PostV1TaskMap(c *gin.Context)
+	// Get Task Map
+	// (GET /v1/task/map/{id})
+	GetV1TaskMapId(c *gin.Context, id openapi_types.UUID)
+	// Retry Tasks
+	// (POST /v1/task/retry)
+	PostV1TaskRetry(c *gin.Context)
+	// Delete Task
+	// (DELETE /v1/task/{id})
+	DeleteV1TaskId(c *gin.Context, id string)
 	// Get Task
 	// (GET /v1/task/{id})
 	GetV1TaskId(c *gin.Context, id string)
+	// Get Task Callback
+	// (GET /v1/task/{id}/callback)
+	GetV1TaskIdCallback(c *gin.Context, id string)
+	// Cancel Task
+	// (POST /v1/task/{id}/cancel)
+	PostV1TaskIdCancel(c *gin.Context, id string)
 	// Get Task Logs
 	// (GET /v1/task/{id}/logs)
 	GetV1TaskIdLogs(c *gin.Context, id string, params GetV1TaskIdLogsParams)
+	// Append Task Logs
+	// (POST /v1/task/{id}/logs)
+	PostV1TaskIdLogs(c *gin.Context, id string)
+	// Export Task Logs
+	// (GET /v1/task/{id}/logs/export)
+	GetV1TaskIdLogsExport(c *gin.Context, id string, params GetV1TaskIdLogsExportParams)
+	// Stream Task Logs
+	// (GET /v1/task/{id}/logs/stream)
+	GetV1TaskIdLogsStream(c *gin.Context, id string, params GetV1TaskIdLogsStreamParams)
+	// Get Task Result
+	// (GET /v1/task/{id}/result)
+	GetV1TaskIdResult(c *gin.Context, id string, params GetV1TaskIdResultParams)
+	// Get Task Retries
+	// (GET /v1/task/{id}/retries)
+	GetV1TaskIdRetries(c *gin.Context, id string)
+	// Retry Task
+	// (POST /v1/task/{id}/retry)
+	PostV1TaskIdRetry(c *gin.Context, id string, params PostV1TaskIdRetryParams)
+	// Get Task State Transitions
+	// (GET /v1/task/{id}/transitions)
+	GetV1TaskIdTransitions(c *gin.Context, id string)
 	// List Users
 	// (GET /v1/user)
 	GetV1User(c *gin.Context, params GetV1UserParams)
@@ -549,6 +1381,15 @@ type ServerInterface interface {
 	// Create User
 	// (PUT /v1/user/{username})
 	PutV1UserUsername(c *gin.Context, username string)
+	// List Workflows
+	// (GET /v1/workflow)
+	GetV1Workflow(c *gin.Context, params GetV1WorkflowParams)
+	// Create Workflow
+	// (POST /v1/workflow)
+	PostV1Workflow(c *gin.Context)
+	// Get Workflow
+	// (GET /v1/workflow/{id})
+	GetV1WorkflowId(c *gin.Context, id openapi_types.UUID)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -1082,6 +1923,110 @@ func (siw *ServerInterfaceWrapper) PatchV1ArtifactNamespaceNameTagTag(c *gin.Con
 	siw.Handler.PatchV1ArtifactNamespaceNameTagTag(c, namespace, name, tag)
 }
 
+// GetV1Queue operation middleware
+func (siw *ServerInterfaceWrapper) GetV1Queue(c *gin.Context) {
+
+	c.Set(BasicAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV1Queue(c)
+}
+
+// GetV1QueueName operation middleware
+func (siw *ServerInterfaceWrapper) GetV1QueueName(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BasicAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1QueueNameParams
+
+	// ------------- Optional query parameter "days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "days", c.Request.URL.Query(), &params.Days)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter days: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV1QueueName(c, name, params)
+}
+
+// PostV1QueueNamePause operation middleware
+func (siw *ServerInterfaceWrapper) PostV1QueueNamePause(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BasicAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostV1QueueNamePause(c, name)
+}
+
+// PostV1QueueNameResume operation middleware
+func (siw *ServerInterfaceWrapper) PostV1QueueNameResume(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BasicAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostV1QueueNameResume(c, name)
+}
+
 // DeleteV1RbacPolicy operation middleware
 func (siw *ServerInterfaceWrapper) DeleteV1RbacPolicy(c *gin.Context) {
 
@@ -1468,15 +2413,15 @@ func (siw *ServerInterfaceWrapper) PutV1RbacRoleRole(c *gin.Context) {
 	siw.Handler.PutV1RbacRoleRole(c, role)
 }
 
-// GetV1Task operation middleware
-func (siw *ServerInterfaceWrapper) GetV1Task(c *gin.Context) {
+// GetV1Schedule operation middleware
+func (siw *ServerInterfaceWrapper) GetV1Schedule(c *gin.Context) {
 
 	var err error
 
 	c.Set(BasicAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetV1TaskParams
+	var params GetV1ScheduleParams
 
 	// ------------- Optional query parameter "limit" -------------
 
@@ -1494,14 +2439,6 @@ func (siw *ServerInterfaceWrapper) GetV1Task(c *gin.Context) {
 		return
 	}
 
-	// ------------- Optional query parameter "state" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "state", c.Request.URL.Query(), &params.State)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter state: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1509,11 +2446,11 @@ func (siw *ServerInterfaceWrapper) GetV1Task(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetV1Task(c, params)
+	siw.Handler.GetV1Schedule(c, params)
 }
 
-// PostV1Task operation middleware
-func (siw *ServerInterfaceWrapper) PostV1Task(c *gin.Context) {
+// PostV1Schedule operation middleware
+func (siw *ServerInterfaceWrapper) PostV1Schedule(c *gin.Context) {
 
 	c.Set(BasicAuthScopes, []string{})
 
@@ -1524,16 +2461,16 @@ func (siw *ServerInterfaceWrapper) PostV1Task(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostV1Task(c)
+	siw.Handler.PostV1Schedule(c)
 }
 
-// GetV1TaskId operation middleware
-func (siw *ServerInterfaceWrapper) GetV1TaskId(c *gin.Context) {
+// DeleteV1ScheduleId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV1ScheduleId(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id string
+	var id openapi_types.UUID
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -1550,16 +2487,16 @@ func (siw *ServerInterfaceWrapper) GetV1TaskId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetV1TaskId(c, id)
+	siw.Handler.DeleteV1ScheduleId(c, id)
 }
 
-// GetV1TaskIdLogs operation middleware
-func (siw *ServerInterfaceWrapper) GetV1TaskIdLogs(c *gin.Context) {
+// GetV1ScheduleId operation middleware
+func (siw *ServerInterfaceWrapper) GetV1ScheduleId(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id string
+	var id openapi_types.UUID
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -1569,41 +2506,32 @@ func (siw *ServerInterfaceWrapper) GetV1TaskIdLogs(c *gin.Context) {
 
 	c.Set(BasicAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetV1TaskIdLogsParams
-
-	// ------------- Optional query parameter "level" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "level", c.Request.URL.Query(), &params.Level)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter level: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// ------------- Optional query parameter "issuer" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "issuer", c.Request.URL.Query(), &params.Issuer)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter issuer: %w", err), http.StatusBadRequest)
-		return
-	}
+	siw.Handler.GetV1ScheduleId(c, id)
+}
 
-	// ------------- Optional query parameter "time-range-from" -------------
+// PatchV1ScheduleId operation middleware
+func (siw *ServerInterfaceWrapper) PatchV1ScheduleId(c *gin.Context) {
 
-	err = runtime.BindQueryParameter("form", true, false, "time-range-from", c.Request.URL.Query(), &params.TimeRangeFrom)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter time-range-from: %w", err), http.StatusBadRequest)
-		return
-	}
+	var err error
 
-	// ------------- Optional query parameter "time-range-to" -------------
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "time-range-to", c.Request.URL.Query(), &params.TimeRangeTo)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter time-range-to: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(BasicAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1611,18 +2539,27 @@ func (siw *ServerInterfaceWrapper) GetV1TaskIdLogs(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetV1TaskIdLogs(c, id, params)
+	siw.Handler.PatchV1ScheduleId(c, id)
 }
 
-// GetV1User operation middleware
-func (siw *ServerInterfaceWrapper) GetV1User(c *gin.Context) {
+// GetV1ScheduleIdHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetV1ScheduleIdHistory(c *gin.Context) {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	c.Set(BasicAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetV1UserParams
+	var params GetV1ScheduleIdHistoryParams
 
 	// ------------- Optional query parameter "limit" -------------
 
@@ -1640,22 +2577,32 @@ func (siw *ServerInterfaceWrapper) GetV1User(c *gin.Context) {
 		return
 	}
 
-	// ------------- Optional query parameter "name" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// ------------- Optional query parameter "display-name" -------------
+	siw.Handler.GetV1ScheduleIdHistory(c, id, params)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "display-name", c.Request.URL.Query(), &params.DisplayName)
+// PostV1ScheduleIdPause operation middleware
+func (siw *ServerInterfaceWrapper) PostV1ScheduleIdPause(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter display-name: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(BasicAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1663,13 +2610,24 @@ func (siw *ServerInterfaceWrapper) GetV1User(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetV1User(c, params)
+	siw.Handler.PostV1ScheduleIdPause(c, id)
 }
 
-// DeleteV1UserMe operation middleware
-func (siw *ServerInterfaceWrapper) DeleteV1UserMe(c *gin.Context) {
+// PostV1ScheduleIdResume operation middleware
+func (siw *ServerInterfaceWrapper) PostV1ScheduleIdResume(c *gin.Context) {
 
-	c.Set(BasicAuthScopes, []string{})
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BasicAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1678,14 +2636,155 @@ func (siw *ServerInterfaceWrapper) DeleteV1UserMe(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteV1UserMe(c)
+	siw.Handler.PostV1ScheduleIdResume(c, id)
 }
 
-// GetV1UserMe operation middleware
-func (siw *ServerInterfaceWrapper) GetV1UserMe(c *gin.Context) {
+// GetV1Task operation middleware
+func (siw *ServerInterfaceWrapper) GetV1Task(c *gin.Context) {
+
+	var err error
 
 	c.Set(BasicAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1TaskParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", c.Request.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter state: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "source" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "source", c.Request.URL.Query(), &params.Source)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter source: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", c.Request.URL.Query(), &params.Namespace)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "package" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "package", c.Request.URL.Query(), &params.Package)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter package: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "interface" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "interface", c.Request.URL.Query(), &params.Interface)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter interface: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "function" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "function", c.Request.URL.Query(), &params.Function)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter function: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", c.Request.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "hash" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "hash", c.Request.URL.Query(), &params.Hash)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter hash: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "submitted-by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "submitted-by", c.Request.URL.Query(), &params.SubmittedBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter submitted-by: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "group" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group", c.Request.URL.Query(), &params.Group)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter group: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "created-after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created-after", c.Request.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter created-after: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "created-before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created-before", c.Request.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter created-before: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "completed-after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "completed-after", c.Request.URL.Query(), &params.CompletedAfter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter completed-after: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "completed-before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "completed-before", c.Request.URL.Query(), &params.CompletedBefore)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter completed-before: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1693,14 +2792,40 @@ func (siw *ServerInterfaceWrapper) GetV1UserMe(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetV1UserMe(c)
+	siw.Handler.GetV1Task(c, params)
 }
 
-// PatchV1UserMe operation middleware
-func (siw *ServerInterfaceWrapper) PatchV1UserMe(c *gin.Context) {
+// PostV1Task operation middleware
+func (siw *ServerInterfaceWrapper) PostV1Task(c *gin.Context) {
+
+	var err error
 
 	c.Set(BasicAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostV1TaskParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1708,25 +2833,44 @@ func (siw *ServerInterfaceWrapper) PatchV1UserMe(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PatchV1UserMe(c)
+	siw.Handler.PostV1Task(c, params)
 }
 
-// DeleteV1UserUsername operation middleware
-func (siw *ServerInterfaceWrapper) DeleteV1UserUsername(c *gin.Context) {
+// GetV1TaskGroupId operation middleware
+func (siw *ServerInterfaceWrapper) GetV1TaskGroupId(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "username" -------------
-	var username string
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
 	c.Set(BasicAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1TaskGroupIdParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1734,20 +2878,20 @@ func (siw *ServerInterfaceWrapper) DeleteV1UserUsername(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteV1UserUsername(c, username)
+	siw.Handler.GetV1TaskGroupId(c, id, params)
 }
 
-// GetV1UserUsername operation middleware
-func (siw *ServerInterfaceWrapper) GetV1UserUsername(c *gin.Context) {
+// PostV1TaskGroupIdCancel operation middleware
+func (siw *ServerInterfaceWrapper) PostV1TaskGroupIdCancel(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "username" -------------
-	var username string
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1760,20 +2904,35 @@ func (siw *ServerInterfaceWrapper) GetV1UserUsername(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetV1UserUsername(c, username)
+	siw.Handler.PostV1TaskGroupIdCancel(c, id)
 }
 
-// HeadV1UserUsername operation middleware
-func (siw *ServerInterfaceWrapper) HeadV1UserUsername(c *gin.Context) {
+// PostV1TaskBatch operation middleware
+func (siw *ServerInterfaceWrapper) PostV1TaskBatch(c *gin.Context) {
+
+	c.Set(BasicAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostV1TaskBatch(c)
+}
+
+// GetV1TaskBatchId operation middleware
+func (siw *ServerInterfaceWrapper) GetV1TaskBatchId(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "username" -------------
-	var username string
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1786,20 +2945,35 @@ func (siw *ServerInterfaceWrapper) HeadV1UserUsername(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.HeadV1UserUsername(c, username)
+	siw.Handler.GetV1TaskBatchId(c, id)
 }
 
-// PatchV1UserUsername operation middleware
-func (siw *ServerInterfaceWrapper) PatchV1UserUsername(c *gin.Context) {
+// PostV1TaskMap operation middleware
+func (siw *ServerInterfaceWrapper) PostV1TaskMap(c *gin.Context) {
+
+	c.Set(BasicAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostV1TaskMap(c)
+}
+
+// GetV1TaskMapId operation middleware
+func (siw *ServerInterfaceWrapper) GetV1TaskMapId(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "username" -------------
-	var username string
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1812,20 +2986,35 @@ func (siw *ServerInterfaceWrapper) PatchV1UserUsername(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PatchV1UserUsername(c, username)
+	siw.Handler.GetV1TaskMapId(c, id)
 }
 
-// PutV1UserUsername operation middleware
-func (siw *ServerInterfaceWrapper) PutV1UserUsername(c *gin.Context) {
+// PostV1TaskRetry operation middleware
+func (siw *ServerInterfaceWrapper) PostV1TaskRetry(c *gin.Context) {
+
+	c.Set(BasicAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostV1TaskRetry(c)
+}
+
+// DeleteV1TaskId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV1TaskId(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "username" -------------
-	var username string
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1838,2519 +3027,5243 @@ func (siw *ServerInterfaceWrapper) PutV1UserUsername(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PutV1UserUsername(c, username)
+	siw.Handler.DeleteV1TaskId(c, id)
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
-}
+// GetV1TaskId operation middleware
+func (siw *ServerInterfaceWrapper) GetV1TaskId(c *gin.Context) {
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
+	var err error
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
-		}
-	}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	router.GET(options.BaseURL+"/v1/artifact", wrapper.GetV1Artifact)
-	router.POST(options.BaseURL+"/v1/artifact/raw/:namespace/:name", wrapper.PostV1ArtifactRawNamespaceName)
-	router.GET(options.BaseURL+"/v1/artifact/raw/:namespace/:name/hash/:hash", wrapper.GetV1ArtifactRawNamespaceNameHashHash)
-	router.GET(options.BaseURL+"/v1/artifact/raw/:namespace/:name/tag/:tag", wrapper.GetV1ArtifactRawNamespaceNameTagTag)
-	router.GET(options.BaseURL+"/v1/artifact/:namespace", wrapper.GetV1ArtifactNamespace)
-	router.GET(options.BaseURL+"/v1/artifact/:namespace/:name", wrapper.GetV1ArtifactNamespaceName)
-	router.DELETE(options.BaseURL+"/v1/artifact/:namespace/:name/hash/:hash", wrapper.DeleteV1ArtifactNamespaceNameHashHash)
-	router.GET(options.BaseURL+"/v1/artifact/:namespace/:name/hash/:hash", wrapper.GetV1ArtifactNamespaceNameHashHash)
-	router.PATCH(options.BaseURL+"/v1/artifact/:namespace/:name/hash/:hash", wrapper.PatchV1ArtifactNamespaceNameHashHash)
-	router.DELETE(options.BaseURL+"/v1/artifact/:namespace/:name/tag/:tag", wrapper.DeleteV1ArtifactNamespaceNameTagTag)
-	router.GET(options.BaseURL+"/v1/artifact/:namespace/:name/tag/:tag", wrapper.GetV1ArtifactNamespaceNameTagTag)
-	router.PATCH(options.BaseURL+"/v1/artifact/:namespace/:name/tag/:tag", wrapper.PatchV1ArtifactNamespaceNameTagTag)
-	router.DELETE(options.BaseURL+"/v1/rbac/policy", wrapper.DeleteV1RbacPolicy)
-	router.GET(options.BaseURL+"/v1/rbac/policy", wrapper.GetV1RbacPolicy)
-	router.PUT(options.BaseURL+"/v1/rbac/policy", wrapper.PutV1RbacPolicy)
-	router.GET(options.BaseURL+"/v1/rbac/resource-group", wrapper.GetV1RbacResourceGroup)
-	router.DELETE(options.BaseURL+"/v1/rbac/resource-group/:resourceGroup", wrapper.DeleteV1RbacResourceGroupResourceGroup)
-	router.GET(options.BaseURL+"/v1/rbac/resource-group/:resourceGroup", wrapper.GetV1RbacResourceGroupResourceGroup)
-	router.HEAD(options.BaseURL+"/v1/rbac/resource-group/:resourceGroup", wrapper.HeadV1RbacResourceGroupResourceGroup)
-	router.PUT(options.BaseURL+"/v1/rbac/resource-group/:resourceGroup", wrapper.PutV1RbacResourceGroupResourceGroup)
-	router.GET(options.BaseURL+"/v1/rbac/role", wrapper.GetV1RbacRole)
-	router.DELETE(options.BaseURL+"/v1/rbac/role/:role", wrapper.DeleteV1RbacRoleRole)
-	router.GET(options.BaseURL+"/v1/rbac/role/:role", wrapper.GetV1RbacRoleRole)
-	router.HEAD(options.BaseURL+"/v1/rbac/role/:role", wrapper.HeadV1RbacRoleRole)
-	router.PUT(options.BaseURL+"/v1/rbac/role/:role", wrapper.PutV1RbacRoleRole)
-	router.GET(options.BaseURL+"/v1/task", wrapper.GetV1Task)
-	router.POST(options.BaseURL+"/v1/task", wrapper.PostV1Task)
-	router.GET(options.BaseURL+"/v1/task/:id", wrapper.GetV1TaskId)
-	router.GET(options.BaseURL+"/v1/task/:id/logs", wrapper.GetV1TaskIdLogs)
-	router.GET(options.BaseURL+"/v1/user", wrapper.GetV1User)
-	router.DELETE(options.BaseURL+"/v1/user/me", wrapper.DeleteV1UserMe)
-	router.GET(options.BaseURL+"/v1/user/me", wrapper.GetV1UserMe)
-	router.PATCH(options.BaseURL+"/v1/user/me", wrapper.PatchV1UserMe)
-	router.DELETE(options.BaseURL+"/v1/user/:username", wrapper.DeleteV1UserUsername)
-	router.GET(options.BaseURL+"/v1/user/:username", wrapper.GetV1UserUsername)
-	router.HEAD(options.BaseURL+"/v1/user/:username", wrapper.HeadV1UserUsername)
-	router.PATCH(options.BaseURL+"/v1/user/:username", wrapper.PatchV1UserUsername)
-	router.PUT(options.BaseURL+"/v1/user/:username", wrapper.PutV1UserUsername)
-}
+	c.Set(BasicAuthScopes, []string{})
 
-type FieldErrorJSONResponse struct {
-	Errors *[]ErrField `json:"errors,omitempty"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV1TaskId(c, id)
 }
 
-type GenericBadRequestJSONResponse ErrGeneric
+// GetV1TaskIdCallback operation middleware
+func (siw *ServerInterfaceWrapper) GetV1TaskIdCallback(c *gin.Context) {
 
-type GenericForbiddenResponse struct {
-}
+	var err error
 
-type GenericInternalServerErrorResponse struct {
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type GenericNotFoundJSONResponse ErrGeneric
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GenericTooLargeJSONResponse ErrGeneric
+	c.Set(BasicAuthScopes, []string{})
 
-type GenericUnauthenticatedResponse struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetV1ArtifactRequestObject struct {
-	Params GetV1ArtifactParams
+	siw.Handler.GetV1TaskIdCallback(c, id)
 }
 
-type GetV1ArtifactResponseObject interface {
-	VisitGetV1ArtifactResponse(w http.ResponseWriter) error
-}
+// PostV1TaskIdCancel operation middleware
+func (siw *ServerInterfaceWrapper) PostV1TaskIdCancel(c *gin.Context) {
 
-type GetV1Artifact200JSONResponse []Artifact
+	var err error
 
-func (response GetV1Artifact200JSONResponse) VisitGetV1ArtifactResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetV1Artifact400JSONResponse struct{ GenericBadRequestJSONResponse }
+	c.Set(BasicAuthScopes, []string{})
 
-func (response GetV1Artifact400JSONResponse) VisitGetV1ArtifactResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.PostV1TaskIdCancel(c, id)
 }
 
-type GetV1Artifact401Response = GenericUnauthenticatedResponse
+// GetV1TaskIdLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetV1TaskIdLogs(c *gin.Context) {
 
-func (response GetV1Artifact401Response) VisitGetV1ArtifactResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	var err error
 
-type GetV1Artifact403Response = GenericForbiddenResponse
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response GetV1Artifact403Response) VisitGetV1ArtifactResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetV1Artifact413JSONResponse struct{ GenericTooLargeJSONResponse }
+	c.Set(BasicAuthScopes, []string{})
 
-func (response GetV1Artifact413JSONResponse) VisitGetV1ArtifactResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(413)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1TaskIdLogsParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type GetV1Artifact500Response = GenericInternalServerErrorResponse
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetV1Artifact500Response) VisitGetV1ArtifactResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	// ------------- Optional query parameter "offset" -------------
 
-type PostV1ArtifactRawNamespaceNameRequestObject struct {
-	Namespace string `json:"namespace"`
-	Name      string `json:"name"`
-	Body      io.Reader
-}
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostV1ArtifactRawNamespaceNameResponseObject interface {
-	VisitPostV1ArtifactRawNamespaceNameResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "level" -------------
 
-type PostV1ArtifactRawNamespaceName201JSONResponse UploadArtifactResponse
+	err = runtime.BindQueryParameter("form", true, false, "level", c.Request.URL.Query(), &params.Level)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter level: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostV1ArtifactRawNamespaceName201JSONResponse) VisitPostV1ArtifactRawNamespaceNameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Optional query parameter "issuer" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "issuer", c.Request.URL.Query(), &params.Issuer)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter issuer: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostV1ArtifactRawNamespaceName400JSONResponse struct{ GenericBadRequestJSONResponse }
+	// ------------- Optional query parameter "since" -------------
 
-func (response PostV1ArtifactRawNamespaceName400JSONResponse) VisitPostV1ArtifactRawNamespaceNameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindQueryParameter("form", true, false, "since", c.Request.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter since: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "until" -------------
 
-type PostV1ArtifactRawNamespaceName401Response = GenericUnauthenticatedResponse
+	err = runtime.BindQueryParameter("form", true, false, "until", c.Request.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter until: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostV1ArtifactRawNamespaceName401Response) VisitPostV1ArtifactRawNamespaceNameResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	// ------------- Optional query parameter "q" -------------
 
-type PostV1ArtifactRawNamespaceName403Response = GenericForbiddenResponse
+	err = runtime.BindQueryParameter("form", true, false, "q", c.Request.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostV1ArtifactRawNamespaceName403Response) VisitPostV1ArtifactRawNamespaceNameResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
-}
+	// ------------- Optional query parameter "order" -------------
 
-type PostV1ArtifactRawNamespaceName409JSONResponse ErrGeneric
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostV1ArtifactRawNamespaceName409JSONResponse) VisitPostV1ArtifactRawNamespaceNameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.GetV1TaskIdLogs(c, id, params)
 }
 
-type PostV1ArtifactRawNamespaceName413JSONResponse struct{ GenericTooLargeJSONResponse }
+// PostV1TaskIdLogs operation middleware
+func (siw *ServerInterfaceWrapper) PostV1TaskIdLogs(c *gin.Context) {
 
-func (response PostV1ArtifactRawNamespaceName413JSONResponse) VisitPostV1ArtifactRawNamespaceNameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(413)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type PostV1ArtifactRawNamespaceName500Response = GenericInternalServerErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostV1ArtifactRawNamespaceName500Response) VisitPostV1ArtifactRawNamespaceNameResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	c.Set(BasicAuthScopes, []string{})
 
-type GetV1ArtifactRawNamespaceNameHashHashRequestObject struct {
-	Namespace string `json:"namespace"`
-	Name      string `json:"name"`
-	Hash      string `json:"hash"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetV1ArtifactRawNamespaceNameHashHashResponseObject interface {
-	VisitGetV1ArtifactRawNamespaceNameHashHashResponse(w http.ResponseWriter) error
+	siw.Handler.PostV1TaskIdLogs(c, id)
 }
 
-type GetV1ArtifactRawNamespaceNameHashHash200ApplicationoctetStreamResponse struct {
-	Body          io.Reader
-	ContentLength int64
-}
+// GetV1TaskIdLogsExport operation middleware
+func (siw *ServerInterfaceWrapper) GetV1TaskIdLogsExport(c *gin.Context) {
 
-func (response GetV1ArtifactRawNamespaceNameHashHash200ApplicationoctetStreamResponse) VisitGetV1ArtifactRawNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/octet-stream")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
-	}
-	w.WriteHeader(200)
+	var err error
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
-	}
-	_, err := io.Copy(w, response.Body)
-	return err
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type GetV1ArtifactRawNamespaceNameHashHash400JSONResponse struct{ GenericBadRequestJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetV1ArtifactRawNamespaceNameHashHash400JSONResponse) VisitGetV1ArtifactRawNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	c.Set(BasicAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1TaskIdLogsExportParams
 
-type GetV1ArtifactRawNamespaceNameHashHash401Response = GenericUnauthenticatedResponse
+	// ------------- Optional query parameter "format" -------------
 
-func (response GetV1ArtifactRawNamespaceNameHashHash401Response) VisitGetV1ArtifactRawNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetV1ArtifactRawNamespaceNameHashHash403Response = GenericForbiddenResponse
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-func (response GetV1ArtifactRawNamespaceNameHashHash403Response) VisitGetV1ArtifactRawNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
+	siw.Handler.GetV1TaskIdLogsExport(c, id, params)
 }
 
-type GetV1ArtifactRawNamespaceNameHashHash404JSONResponse struct{ GenericNotFoundJSONResponse }
-
-func (response GetV1ArtifactRawNamespaceNameHashHash404JSONResponse) VisitGetV1ArtifactRawNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetV1TaskIdLogsStream operation middleware
+func (siw *ServerInterfaceWrapper) GetV1TaskIdLogsStream(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetV1ArtifactRawNamespaceNameHashHash413JSONResponse struct{ GenericTooLargeJSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response GetV1ArtifactRawNamespaceNameHashHash413JSONResponse) VisitGetV1ArtifactRawNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(413)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	c.Set(BasicAuthScopes, []string{})
 
-type GetV1ArtifactRawNamespaceNameHashHash500Response = GenericInternalServerErrorResponse
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1TaskIdLogsStreamParams
 
-func (response GetV1ArtifactRawNamespaceNameHashHash500Response) VisitGetV1ArtifactRawNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	headers := c.Request.Header
 
-type GetV1ArtifactRawNamespaceNameTagTagRequestObject struct {
-	Namespace string `json:"namespace"`
-	Name      string `json:"name"`
-	Tag       string `json:"tag"`
-}
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Last-Event-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
 
-type GetV1ArtifactRawNamespaceNameTagTagResponseObject interface {
-	VisitGetV1ArtifactRawNamespaceNameTagTagResponse(w http.ResponseWriter) error
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Last-Event-ID: %w", err), http.StatusBadRequest)
+			return
+		}
 
-type GetV1ArtifactRawNamespaceNameTagTag200ApplicationoctetStreamResponse struct {
-	Body          io.Reader
-	ContentLength int64
-}
+		params.LastEventID = &LastEventID
 
-func (response GetV1ArtifactRawNamespaceNameTagTag200ApplicationoctetStreamResponse) VisitGetV1ArtifactRawNamespaceNameTagTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/octet-stream")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
 	}
-	w.WriteHeader(200)
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	_, err := io.Copy(w, response.Body)
-	return err
+
+	siw.Handler.GetV1TaskIdLogsStream(c, id, params)
 }
 
-type GetV1ArtifactRawNamespaceNameTagTag400JSONResponse struct{ GenericBadRequestJSONResponse }
+// GetV1TaskIdResult operation middleware
+func (siw *ServerInterfaceWrapper) GetV1TaskIdResult(c *gin.Context) {
 
-func (response GetV1ArtifactRawNamespaceNameTagTag400JSONResponse) VisitGetV1ArtifactRawNamespaceNameTagTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type GetV1ArtifactRawNamespaceNameTagTag401Response = GenericUnauthenticatedResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetV1ArtifactRawNamespaceNameTagTag401Response) VisitGetV1ArtifactRawNamespaceNameTagTagResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	c.Set(BasicAuthScopes, []string{})
 
-type GetV1ArtifactRawNamespaceNameTagTag403Response = GenericForbiddenResponse
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1TaskIdResultParams
 
-func (response GetV1ArtifactRawNamespaceNameTagTag403Response) VisitGetV1ArtifactRawNamespaceNameTagTagResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
-}
+	// ------------- Optional query parameter "format" -------------
 
-type GetV1ArtifactRawNamespaceNameTagTag404JSONResponse struct{ GenericNotFoundJSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetV1ArtifactRawNamespaceNameTagTag404JSONResponse) VisitGetV1ArtifactRawNamespaceNameTagTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.GetV1TaskIdResult(c, id, params)
 }
 
-type GetV1ArtifactRawNamespaceNameTagTag413JSONResponse struct{ GenericTooLargeJSONResponse }
+// GetV1TaskIdRetries operation middleware
+func (siw *ServerInterfaceWrapper) GetV1TaskIdRetries(c *gin.Context) {
 
-func (response GetV1ArtifactRawNamespaceNameTagTag413JSONResponse) VisitGetV1ArtifactRawNamespaceNameTagTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(413)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type GetV1ArtifactRawNamespaceNameTagTag500Response = GenericInternalServerErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetV1ArtifactRawNamespaceNameTagTag500Response) VisitGetV1ArtifactRawNamespaceNameTagTagResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	c.Set(BasicAuthScopes, []string{})
 
-type GetV1ArtifactNamespaceRequestObject struct {
-	Namespace string `json:"namespace"`
-	Params    GetV1ArtifactNamespaceParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetV1ArtifactNamespaceResponseObject interface {
-	VisitGetV1ArtifactNamespaceResponse(w http.ResponseWriter) error
+	siw.Handler.GetV1TaskIdRetries(c, id)
 }
 
-type GetV1ArtifactNamespace200JSONResponse []Artifact
+// PostV1TaskIdRetry operation middleware
+func (siw *ServerInterfaceWrapper) PostV1TaskIdRetry(c *gin.Context) {
 
-func (response GetV1ArtifactNamespace200JSONResponse) VisitGetV1ArtifactNamespaceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type GetV1ArtifactNamespace400JSONResponse struct{ GenericBadRequestJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetV1ArtifactNamespace400JSONResponse) VisitGetV1ArtifactNamespaceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	c.Set(BasicAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostV1TaskIdRetryParams
 
-type GetV1ArtifactNamespace401Response = GenericUnauthenticatedResponse
+	// ------------- Optional query parameter "reset-retries" -------------
 
-func (response GetV1ArtifactNamespace401Response) VisitGetV1ArtifactNamespaceResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "reset-retries", c.Request.URL.Query(), &params.ResetRetries)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter reset-retries: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetV1ArtifactNamespace403Response = GenericForbiddenResponse
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-func (response GetV1ArtifactNamespace403Response) VisitGetV1ArtifactNamespaceResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
+	siw.Handler.PostV1TaskIdRetry(c, id, params)
 }
 
-type GetV1ArtifactNamespace413JSONResponse struct{ GenericTooLargeJSONResponse }
+// GetV1TaskIdTransitions operation middleware
+func (siw *ServerInterfaceWrapper) GetV1TaskIdTransitions(c *gin.Context) {
 
-func (response GetV1ArtifactNamespace413JSONResponse) VisitGetV1ArtifactNamespaceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(413)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type GetV1ArtifactNamespace500Response = GenericInternalServerErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetV1ArtifactNamespace500Response) VisitGetV1ArtifactNamespaceResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	c.Set(BasicAuthScopes, []string{})
 
-type GetV1ArtifactNamespaceNameRequestObject struct {
-	Namespace string `json:"namespace"`
-	Name      string `json:"name"`
-	Params    GetV1ArtifactNamespaceNameParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetV1ArtifactNamespaceNameResponseObject interface {
-	VisitGetV1ArtifactNamespaceNameResponse(w http.ResponseWriter) error
+	siw.Handler.GetV1TaskIdTransitions(c, id)
 }
 
-type GetV1ArtifactNamespaceName200JSONResponse []Artifact
-
-func (response GetV1ArtifactNamespaceName200JSONResponse) VisitGetV1ArtifactNamespaceNameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetV1User operation middleware
+func (siw *ServerInterfaceWrapper) GetV1User(c *gin.Context) {
 
-type GetV1ArtifactNamespaceName400JSONResponse struct{ GenericBadRequestJSONResponse }
+	var err error
 
-func (response GetV1ArtifactNamespaceName400JSONResponse) VisitGetV1ArtifactNamespaceNameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	c.Set(BasicAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1UserParams
 
-type GetV1ArtifactNamespaceName401Response = GenericUnauthenticatedResponse
+	// ------------- Optional query parameter "limit" -------------
 
-func (response GetV1ArtifactNamespaceName401Response) VisitGetV1ArtifactNamespaceNameResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetV1ArtifactNamespaceName403Response = GenericForbiddenResponse
+	// ------------- Optional query parameter "offset" -------------
 
-func (response GetV1ArtifactNamespaceName403Response) VisitGetV1ArtifactNamespaceNameResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetV1ArtifactNamespaceName413JSONResponse struct{ GenericTooLargeJSONResponse }
+	// ------------- Optional query parameter "name" -------------
 
-func (response GetV1ArtifactNamespaceName413JSONResponse) VisitGetV1ArtifactNamespaceNameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(413)
+	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "display-name" -------------
 
-type GetV1ArtifactNamespaceName500Response = GenericInternalServerErrorResponse
+	err = runtime.BindQueryParameter("form", true, false, "display-name", c.Request.URL.Query(), &params.DisplayName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter display-name: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetV1ArtifactNamespaceName500Response) VisitGetV1ArtifactNamespaceNameResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type DeleteV1ArtifactNamespaceNameHashHashRequestObject struct {
-	Namespace string `json:"namespace"`
-	Name      string `json:"name"`
-	Hash      string `json:"hash"`
+	siw.Handler.GetV1User(c, params)
 }
 
-type DeleteV1ArtifactNamespaceNameHashHashResponseObject interface {
-	VisitDeleteV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error
-}
+// DeleteV1UserMe operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV1UserMe(c *gin.Context) {
 
-type DeleteV1ArtifactNamespaceNameHashHash200JSONResponse Artifact
+	c.Set(BasicAuthScopes, []string{})
 
-func (response DeleteV1ArtifactNamespaceNameHashHash200JSONResponse) VisitDeleteV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.DeleteV1UserMe(c)
 }
 
-type DeleteV1ArtifactNamespaceNameHashHash400JSONResponse struct{ GenericBadRequestJSONResponse }
-
-func (response DeleteV1ArtifactNamespaceNameHashHash400JSONResponse) VisitDeleteV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetV1UserMe operation middleware
+func (siw *ServerInterfaceWrapper) GetV1UserMe(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	c.Set(BasicAuthScopes, []string{})
 
-type DeleteV1ArtifactNamespaceNameHashHash401Response = GenericUnauthenticatedResponse
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-func (response DeleteV1ArtifactNamespaceNameHashHash401Response) VisitDeleteV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	siw.Handler.GetV1UserMe(c)
 }
 
-type DeleteV1ArtifactNamespaceNameHashHash403Response = GenericForbiddenResponse
-
-func (response DeleteV1ArtifactNamespaceNameHashHash403Response) VisitDeleteV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
-}
+// PatchV1UserMe operation middleware
+func (siw *ServerInterfaceWrapper) PatchV1UserMe(c *gin.Context) {
 
-type DeleteV1ArtifactNamespaceNameHashHash404JSONResponse struct{ GenericNotFoundJSONResponse }
+	c.Set(BasicAuthScopes, []string{})
 
-func (response DeleteV1ArtifactNamespaceNameHashHash404JSONResponse) VisitDeleteV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.PatchV1UserMe(c)
 }
 
-type DeleteV1ArtifactNamespaceNameHashHash413JSONResponse struct{ GenericTooLargeJSONResponse }
+// DeleteV1UserUsername operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV1UserUsername(c *gin.Context) {
 
-func (response DeleteV1ArtifactNamespaceNameHashHash413JSONResponse) VisitDeleteV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(413)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "username" -------------
+	var username string
 
-type DeleteV1ArtifactNamespaceNameHashHash500Response = GenericInternalServerErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response DeleteV1ArtifactNamespaceNameHashHash500Response) VisitDeleteV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	c.Set(BasicAuthScopes, []string{})
 
-type GetV1ArtifactNamespaceNameHashHashRequestObject struct {
-	Namespace string `json:"namespace"`
-	Name      string `json:"name"`
-	Hash      string `json:"hash"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetV1ArtifactNamespaceNameHashHashResponseObject interface {
-	VisitGetV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error
+	siw.Handler.DeleteV1UserUsername(c, username)
 }
 
-type GetV1ArtifactNamespaceNameHashHash200JSONResponse Artifact
+// GetV1UserUsername operation middleware
+func (siw *ServerInterfaceWrapper) GetV1UserUsername(c *gin.Context) {
 
-func (response GetV1ArtifactNamespaceNameHashHash200JSONResponse) VisitGetV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "username" -------------
+	var username string
 
-type GetV1ArtifactNamespaceNameHashHash400JSONResponse struct{ GenericBadRequestJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetV1ArtifactNamespaceNameHashHash400JSONResponse) VisitGetV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	c.Set(BasicAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV1UserUsername(c, username)
 }
 
-type GetV1ArtifactNamespaceNameHashHash401Response = GenericUnauthenticatedResponse
+// HeadV1UserUsername operation middleware
+func (siw *ServerInterfaceWrapper) HeadV1UserUsername(c *gin.Context) {
 
-func (response GetV1ArtifactNamespaceNameHashHash401Response) VisitGetV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	var err error
 
-type GetV1ArtifactNamespaceNameHashHash403Response = GenericForbiddenResponse
+	// ------------- Path parameter "username" -------------
+	var username string
 
-func (response GetV1ArtifactNamespaceNameHashHash403Response) VisitGetV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetV1ArtifactNamespaceNameHashHash404JSONResponse struct{ GenericNotFoundJSONResponse }
+	c.Set(BasicAuthScopes, []string{})
 
-func (response GetV1ArtifactNamespaceNameHashHash404JSONResponse) VisitGetV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.HeadV1UserUsername(c, username)
 }
 
-type GetV1ArtifactNamespaceNameHashHash413JSONResponse struct{ GenericTooLargeJSONResponse }
+// PatchV1UserUsername operation middleware
+func (siw *ServerInterfaceWrapper) PatchV1UserUsername(c *gin.Context) {
 
-func (response GetV1ArtifactNamespaceNameHashHash413JSONResponse) VisitGetV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(413)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "username" -------------
+	var username string
 
-type GetV1ArtifactNamespaceNameHashHash500Response = GenericInternalServerErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetV1ArtifactNamespaceNameHashHash500Response) VisitGetV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	c.Set(BasicAuthScopes, []string{})
 
-type PatchV1ArtifactNamespaceNameHashHashRequestObject struct {
-	Namespace string `json:"namespace"`
-	Name      string `json:"name"`
-	Hash      string `json:"hash"`
-	Body      *PatchV1ArtifactNamespaceNameHashHashJSONRequestBody
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchV1UserUsername(c, username)
 }
 
-type PatchV1ArtifactNamespaceNameHashHashResponseObject interface {
-	VisitPatchV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error
+// PutV1UserUsername operation middleware
+func (siw *ServerInterfaceWrapper) PutV1UserUsername(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BasicAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutV1UserUsername(c, username)
 }
 
-type PatchV1ArtifactNamespaceNameHashHash200JSONResponse Artifact
+// GetV1Workflow operation middleware
+func (siw *ServerInterfaceWrapper) GetV1Workflow(c *gin.Context) {
 
-func (response PatchV1ArtifactNamespaceNameHashHash200JSONResponse) VisitPatchV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
+	c.Set(BasicAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1WorkflowParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV1Workflow(c, params)
 }
 
-type PatchV1ArtifactNamespaceNameHashHash400JSONResponse struct{ GenericBadRequestJSONResponse }
+// PostV1Workflow operation middleware
+func (siw *ServerInterfaceWrapper) PostV1Workflow(c *gin.Context) {
 
-func (response PatchV1ArtifactNamespaceNameHashHash400JSONResponse) VisitPatchV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	c.Set(BasicAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostV1Workflow(c)
 }
 
-type PatchV1ArtifactNamespaceNameHashHash401Response = GenericUnauthenticatedResponse
+// GetV1WorkflowId operation middleware
+func (siw *ServerInterfaceWrapper) GetV1WorkflowId(c *gin.Context) {
 
-func (response PatchV1ArtifactNamespaceNameHashHash401Response) VisitPatchV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BasicAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV1WorkflowId(c, id)
 }
 
-type PatchV1ArtifactNamespaceNameHashHash403Response = GenericForbiddenResponse
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
 
-func (response PatchV1ArtifactNamespaceNameHashHash403Response) VisitPatchV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
 }
 
-type PatchV1ArtifactNamespaceNameHashHash404JSONResponse struct{ GenericNotFoundJSONResponse }
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
 
-func (response PatchV1ArtifactNamespaceNameHashHash404JSONResponse) VisitPatchV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	router.GET(options.BaseURL+"/v1/artifact", wrapper.GetV1Artifact)
+	router.POST(options.BaseURL+"/v1/artifact/raw/:namespace/:name", wrapper.PostV1ArtifactRawNamespaceName)
+	router.GET(options.BaseURL+"/v1/artifact/raw/:namespace/:name/hash/:hash", wrapper.GetV1ArtifactRawNamespaceNameHashHash)
+	router.GET(options.BaseURL+"/v1/artifact/raw/:namespace/:name/tag/:tag", wrapper.GetV1ArtifactRawNamespaceNameTagTag)
+	router.GET(options.BaseURL+"/v1/artifact/:namespace", wrapper.GetV1ArtifactNamespace)
+	router.GET(options.BaseURL+"/v1/artifact/:namespace/:name", wrapper.GetV1ArtifactNamespaceName)
+	router.DELETE(options.BaseURL+"/v1/artifact/:namespace/:name/hash/:hash", wrapper.DeleteV1ArtifactNamespaceNameHashHash)
+	router.GET(options.BaseURL+"/v1/artifact/:namespace/:name/hash/:hash", wrapper.GetV1ArtifactNamespaceNameHashHash)
+	router.PATCH(options.BaseURL+"/v1/artifact/:namespace/:name/hash/:hash", wrapper.PatchV1ArtifactNamespaceNameHashHash)
+	router.DELETE(options.BaseURL+"/v1/artifact/:namespace/:name/tag/:tag", wrapper.DeleteV1ArtifactNamespaceNameTagTag)
+	router.GET(options.BaseURL+"/v1/artifact/:namespace/:name/tag/:tag", wrapper.GetV1ArtifactNamespaceNameTagTag)
+	router.PATCH(options.BaseURL+"/v1/artifact/:namespace/:name/tag/:tag", wrapper.PatchV1ArtifactNamespaceNameTagTag)
+	router.GET(options.BaseURL+"/v1/queue", wrapper.GetV1Queue)
+	router.GET(options.BaseURL+"/v1/queue/:name", wrapper.GetV1QueueName)
+	router.POST(options.BaseURL+"/v1/queue/:name/pause", wrapper.PostV1QueueNamePause)
+	router.POST(options.BaseURL+"/v1/queue/:name/resume", wrapper.PostV1QueueNameResume)
+	router.DELETE(options.BaseURL+"/v1/rbac/policy", wrapper.DeleteV1RbacPolicy)
+	router.GET(options.BaseURL+"/v1/rbac/policy", wrapper.GetV1RbacPolicy)
+	router.PUT(options.BaseURL+"/v1/rbac/policy", wrapper.PutV1RbacPolicy)
+	router.GET(options.BaseURL+"/v1/rbac/resource-group", wrapper.GetV1RbacResourceGroup)
+	router.DELETE(options.BaseURL+"/v1/rbac/resource-group/:resourceGroup", wrapper.DeleteV1RbacResourceGroupResourceGroup)
+	router.GET(options.BaseURL+"/v1/rbac/resource-group/:resourceGroup", wrapper.GetV1RbacResourceGroupResourceGroup)
+	router.HEAD(options.BaseURL+"/v1/rbac/resource-group/:resourceGroup", wrapper.HeadV1RbacResourceGroupResourceGroup)
+	router.PUT(options.BaseURL+"/v1/rbac/resource-group/:resourceGroup", wrapper.PutV1RbacResourceGroupResourceGroup)
+	router.GET(options.BaseURL+"/v1/rbac/role", wrapper.GetV1RbacRole)
+	router.DELETE(options.BaseURL+"/v1/rbac/role/:role", wrapper.DeleteV1RbacRoleRole)
+	router.GET(options.BaseURL+"/v1/rbac/role/:role", wrapper.GetV1RbacRoleRole)
+	router.HEAD(options.BaseURL+"/v1/rbac/role/:role", wrapper.HeadV1RbacRoleRole)
+	router.PUT(options.BaseURL+"/v1/rbac/role/:role", wrapper.PutV1RbacRoleRole)
+	router.GET(options.BaseURL+"/v1/schedule", wrapper.GetV1Schedule)
+	router.POST(options.BaseURL+"/v1/schedule", wrapper.PostV1Schedule)
+	router.DELETE(options.BaseURL+"/v1/schedule/:id", wrapper.DeleteV1ScheduleId)
+	router.GET(options.BaseURL+"/v1/schedule/:id", wrapper.GetV1ScheduleId)
+	router.PATCH(options.BaseURL+"/v1/schedule/:id", wrapper.PatchV1ScheduleId)
+	router.GET(options.BaseURL+"/v1/schedule/:id/history", wrapper.GetV1ScheduleIdHistory)
+	router.POST(options.BaseURL+"/v1/schedule/:id/pause", wrapper.PostV1ScheduleIdPause)
+	router.POST(options.BaseURL+"/v1/schedule/:id/resume", wrapper.PostV1ScheduleIdResume)
+	router.GET(options.BaseURL+"/v1/task", wrapper.GetV1Task)
+	router.POST(options.BaseURL+"/v1/task", wrapper.PostV1Task)
+	router.GET(options.BaseURL+"/v1/task-group/:id", wrapper.GetV1TaskGroupId)
+	router.POST(options.BaseURL+"/v1/task-group/:id/cancel", wrapper.PostV1TaskGroupIdCancel)
+	router.POST(options.BaseURL+"/v1/task/batch", wrapper.PostV1TaskBatch)
+	router.GET(options.BaseURL+"/v1/task/batch/:id", wrapper.GetV1TaskBatchId)
+	router.POST(options.BaseURL+"/v1/task/map", wrapper.PostV1TaskMap)
+	router.GET(options.BaseURL+"/v1/task/map/:id", wrapper.GetV1TaskMapId)
+	router.POST(options.BaseURL+"/v1/task/retry", wrapper.PostV1TaskRetry)
+	router.DELETE(options.BaseURL+"/v1/task/:id", wrapper.DeleteV1TaskId)
+	router.GET(options.BaseURL+"/v1/task/:id", wrapper.GetV1TaskId)
+	router.GET(options.BaseURL+"/v1/task/:id/callback", wrapper.GetV1TaskIdCallback)
+	router.POST(options.BaseURL+"/v1/task/:id/cancel", wrapper.PostV1TaskIdCancel)
+	router.GET(options.BaseURL+"/v1/task/:id/logs", wrapper.GetV1TaskIdLogs)
+	router.POST(options.BaseURL+"/v1/task/:id/logs", wrapper.PostV1TaskIdLogs)
+	router.GET(options.BaseURL+"/v1/task/:id/logs/export", wrapper.GetV1TaskIdLogsExport)
+	router.GET(options.BaseURL+"/v1/task/:id/logs/stream", wrapper.GetV1TaskIdLogsStream)
+	router.GET(options.BaseURL+"/v1/task/:id/result", wrapper.GetV1TaskIdResult)
+	router.GET(options.BaseURL+"/v1/task/:id/retries", wrapper.GetV1TaskIdRetries)
+	router.POST(options.BaseURL+"/v1/task/:id/retry", wrapper.PostV1TaskIdRetry)
+	router.GET(options.BaseURL+"/v1/task/:id/transitions", wrapper.GetV1TaskIdTransitions)
+	router.GET(options.BaseURL+"/v1/user", wrapper.GetV1User)
+	router.DELETE(options.BaseURL+"/v1/user/me", wrapper.DeleteV1UserMe)
+	router.GET(options.BaseURL+"/v1/user/me", wrapper.GetV1UserMe)
+	router.PATCH(options.BaseURL+"/v1/user/me", wrapper.PatchV1UserMe)
+	router.DELETE(options.BaseURL+"/v1/user/:username", wrapper.DeleteV1UserUsername)
+	router.GET(options.BaseURL+"/v1/user/:username", wrapper.GetV1UserUsername)
+	router.HEAD(options.BaseURL+"/v1/user/:username", wrapper.HeadV1UserUsername)
+	router.PATCH(options.BaseURL+"/v1/user/:username", wrapper.PatchV1UserUsername)
+	router.PUT(options.BaseURL+"/v1/user/:username", wrapper.PutV1UserUsername)
+	router.GET(options.BaseURL+"/v1/workflow", wrapper.GetV1Workflow)
+	router.POST(options.BaseURL+"/v1/workflow", wrapper.PostV1Workflow)
+	router.GET(options.BaseURL+"/v1/workflow/:id", wrapper.GetV1WorkflowId)
 }
 
-type PatchV1ArtifactNamespaceNameHashHash413JSONResponse struct{ GenericTooLargeJSONResponse }
+type FieldErrorJSONResponse struct {
+	Errors *[]ErrField `json:"errors,omitempty"`
+}
 
-func (response PatchV1ArtifactNamespaceNameHashHash413JSONResponse) VisitPatchV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(413)
+type GenericBadRequestJSONResponse ErrGeneric
 
-	return json.NewEncoder(w).Encode(response)
+type GenericForbiddenResponse struct {
 }
 
-type PatchV1ArtifactNamespaceNameHashHash500Response = GenericInternalServerErrorResponse
+type GenericInternalServerErrorResponse struct {
+}
 
-func (response PatchV1ArtifactNamespaceNameHashHash500Response) VisitPatchV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
+type GenericNotFoundJSONResponse ErrGeneric
+
+type GenericTooLargeJSONResponse ErrGeneric
+
+type GenericUnauthenticatedResponse struct {
 }
 
-type DeleteV1ArtifactNamespaceNameTagTagRequestObject struct {
-	Namespace string `json:"namespace"`
-	Name      string `json:"name"`
-	Tag       string `json:"tag"`
+type GetV1ArtifactRequestObject struct {
+	Params GetV1ArtifactParams
 }
 
-type DeleteV1ArtifactNamespaceNameTagTagResponseObject interface {
-	VisitDeleteV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error
+type GetV1ArtifactResponseObject interface {
+	VisitGetV1ArtifactResponse(w http.ResponseWriter) error
 }
 
-type DeleteV1ArtifactNamespaceNameTagTag200JSONResponse Artifact
+type GetV1Artifact200JSONResponse []Artifact
 
-func (response DeleteV1ArtifactNamespaceNameTagTag200JSONResponse) VisitDeleteV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+func (response GetV1Artifact200JSONResponse) VisitGetV1ArtifactResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteV1ArtifactNamespaceNameTagTag400JSONResponse struct{ GenericBadRequestJSONResponse }
+type GetV1Artifact400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response DeleteV1ArtifactNamespaceNameTagTag400JSONResponse) VisitDeleteV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+func (response GetV1Artifact400JSONResponse) VisitGetV1ArtifactResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteV1ArtifactNamespaceNameTagTag401Response = GenericUnauthenticatedResponse
+type GetV1Artifact401Response = GenericUnauthenticatedResponse
 
-func (response DeleteV1ArtifactNamespaceNameTagTag401Response) VisitDeleteV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+func (response GetV1Artifact401Response) VisitGetV1ArtifactResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type DeleteV1ArtifactNamespaceNameTagTag403Response = GenericForbiddenResponse
+type GetV1Artifact403Response = GenericForbiddenResponse
 
-func (response DeleteV1ArtifactNamespaceNameTagTag403Response) VisitDeleteV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+func (response GetV1Artifact403Response) VisitGetV1ArtifactResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type DeleteV1ArtifactNamespaceNameTagTag404JSONResponse struct{ GenericNotFoundJSONResponse }
-
-func (response DeleteV1ArtifactNamespaceNameTagTag404JSONResponse) VisitDeleteV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type DeleteV1ArtifactNamespaceNameTagTag413JSONResponse struct{ GenericTooLargeJSONResponse }
+type GetV1Artifact413JSONResponse struct{ GenericTooLargeJSONResponse }
 
-func (response DeleteV1ArtifactNamespaceNameTagTag413JSONResponse) VisitDeleteV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+func (response GetV1Artifact413JSONResponse) VisitGetV1ArtifactResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteV1ArtifactNamespaceNameTagTag500Response = GenericInternalServerErrorResponse
+type GetV1Artifact500Response = GenericInternalServerErrorResponse
 
-func (response DeleteV1ArtifactNamespaceNameTagTag500Response) VisitDeleteV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+func (response GetV1Artifact500Response) VisitGetV1ArtifactResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type GetV1ArtifactNamespaceNameTagTagRequestObject struct {
+type PostV1ArtifactRawNamespaceNameRequestObject struct {
 	Namespace string `json:"namespace"`
 	Name      string `json:"name"`
-	Tag       string `json:"tag"`
+	Body      io.Reader
 }
 
-type GetV1ArtifactNamespaceNameTagTagResponseObject interface {
-	VisitGetV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error
+type PostV1ArtifactRawNamespaceNameResponseObject interface {
+	VisitPostV1ArtifactRawNamespaceNameResponse(w http.ResponseWriter) error
 }
 
-type GetV1ArtifactNamespaceNameTagTag200JSONResponse Artifact
+type PostV1ArtifactRawNamespaceName201JSONResponse UploadArtifactResponse
 
-func (response GetV1ArtifactNamespaceNameTagTag200JSONResponse) VisitGetV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+func (response PostV1ArtifactRawNamespaceName201JSONResponse) VisitPostV1ArtifactRawNamespaceNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1ArtifactNamespaceNameTagTag400JSONResponse struct{ GenericBadRequestJSONResponse }
+type PostV1ArtifactRawNamespaceName400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response GetV1ArtifactNamespaceNameTagTag400JSONResponse) VisitGetV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+func (response PostV1ArtifactRawNamespaceName400JSONResponse) VisitPostV1ArtifactRawNamespaceNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1ArtifactNamespaceNameTagTag401Response = GenericUnauthenticatedResponse
+type PostV1ArtifactRawNamespaceName401Response = GenericUnauthenticatedResponse
 
-func (response GetV1ArtifactNamespaceNameTagTag401Response) VisitGetV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+func (response PostV1ArtifactRawNamespaceName401Response) VisitPostV1ArtifactRawNamespaceNameResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetV1ArtifactNamespaceNameTagTag403Response = GenericForbiddenResponse
+type PostV1ArtifactRawNamespaceName403Response = GenericForbiddenResponse
 
-func (response GetV1ArtifactNamespaceNameTagTag403Response) VisitGetV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+func (response PostV1ArtifactRawNamespaceName403Response) VisitPostV1ArtifactRawNamespaceNameResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type GetV1ArtifactNamespaceNameTagTag404JSONResponse struct{ GenericNotFoundJSONResponse }
+type PostV1ArtifactRawNamespaceName409JSONResponse ErrGeneric
 
-func (response GetV1ArtifactNamespaceNameTagTag404JSONResponse) VisitGetV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+func (response PostV1ArtifactRawNamespaceName409JSONResponse) VisitPostV1ArtifactRawNamespaceNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1ArtifactNamespaceNameTagTag413JSONResponse struct{ GenericTooLargeJSONResponse }
+type PostV1ArtifactRawNamespaceName413JSONResponse struct{ GenericTooLargeJSONResponse }
 
-func (response GetV1ArtifactNamespaceNameTagTag413JSONResponse) VisitGetV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+func (response PostV1ArtifactRawNamespaceName413JSONResponse) VisitPostV1ArtifactRawNamespaceNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1ArtifactNamespaceNameTagTag500Response = GenericInternalServerErrorResponse
+type PostV1ArtifactRawNamespaceName500Response = GenericInternalServerErrorResponse
 
-func (response GetV1ArtifactNamespaceNameTagTag500Response) VisitGetV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+func (response PostV1ArtifactRawNamespaceName500Response) VisitPostV1ArtifactRawNamespaceNameResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type PatchV1ArtifactNamespaceNameTagTagRequestObject struct {
+type GetV1ArtifactRawNamespaceNameHashHashRequestObject struct {
 	Namespace string `json:"namespace"`
 	Name      string `json:"name"`
-	Tag       string `json:"tag"`
-	Body      *PatchV1ArtifactNamespaceNameTagTagJSONRequestBody
+	Hash      string `json:"hash"`
 }
 
-type PatchV1ArtifactNamespaceNameTagTagResponseObject interface {
-	VisitPatchV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error
+type GetV1ArtifactRawNamespaceNameHashHashResponseObject interface {
+	VisitGetV1ArtifactRawNamespaceNameHashHashResponse(w http.ResponseWriter) error
 }
 
-type PatchV1ArtifactNamespaceNameTagTag200JSONResponse Artifact
+type GetV1ArtifactRawNamespaceNameHashHash200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
 
-func (response PatchV1ArtifactNamespaceNameTagTag200JSONResponse) VisitPatchV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response GetV1ArtifactRawNamespaceNameHashHash200ApplicationoctetStreamResponse) VisitGetV1ArtifactRawNamespaceNameHashHashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type PatchV1ArtifactNamespaceNameTagTag400JSONResponse struct{ GenericBadRequestJSONResponse }
+type GetV1ArtifactRawNamespaceNameHashHash400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response PatchV1ArtifactNamespaceNameTagTag400JSONResponse) VisitPatchV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactRawNamespaceNameHashHash400JSONResponse) VisitGetV1ArtifactRawNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchV1ArtifactNamespaceNameTagTag401Response = GenericUnauthenticatedResponse
+type GetV1ArtifactRawNamespaceNameHashHash401Response = GenericUnauthenticatedResponse
 
-func (response PatchV1ArtifactNamespaceNameTagTag401Response) VisitPatchV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactRawNamespaceNameHashHash401Response) VisitGetV1ArtifactRawNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type PatchV1ArtifactNamespaceNameTagTag403Response = GenericForbiddenResponse
+type GetV1ArtifactRawNamespaceNameHashHash403Response = GenericForbiddenResponse
 
-func (response PatchV1ArtifactNamespaceNameTagTag403Response) VisitPatchV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactRawNamespaceNameHashHash403Response) VisitGetV1ArtifactRawNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type PatchV1ArtifactNamespaceNameTagTag404JSONResponse struct{ GenericNotFoundJSONResponse }
+type GetV1ArtifactRawNamespaceNameHashHash404JSONResponse struct{ GenericNotFoundJSONResponse }
 
-func (response PatchV1ArtifactNamespaceNameTagTag404JSONResponse) VisitPatchV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactRawNamespaceNameHashHash404JSONResponse) VisitGetV1ArtifactRawNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchV1ArtifactNamespaceNameTagTag413JSONResponse struct{ GenericTooLargeJSONResponse }
+type GetV1ArtifactRawNamespaceNameHashHash413JSONResponse struct{ GenericTooLargeJSONResponse }
 
-func (response PatchV1ArtifactNamespaceNameTagTag413JSONResponse) VisitPatchV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactRawNamespaceNameHashHash413JSONResponse) VisitGetV1ArtifactRawNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchV1ArtifactNamespaceNameTagTag500Response = GenericInternalServerErrorResponse
+type GetV1ArtifactRawNamespaceNameHashHash500Response = GenericInternalServerErrorResponse
 
-func (response PatchV1ArtifactNamespaceNameTagTag500Response) VisitPatchV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactRawNamespaceNameHashHash500Response) VisitGetV1ArtifactRawNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type DeleteV1RbacPolicyRequestObject struct {
-	Body *DeleteV1RbacPolicyJSONRequestBody
+type GetV1ArtifactRawNamespaceNameTagTagRequestObject struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Tag       string `json:"tag"`
 }
 
-type DeleteV1RbacPolicyResponseObject interface {
-	VisitDeleteV1RbacPolicyResponse(w http.ResponseWriter) error
+type GetV1ArtifactRawNamespaceNameTagTagResponseObject interface {
+	VisitGetV1ArtifactRawNamespaceNameTagTagResponse(w http.ResponseWriter) error
 }
 
-type DeleteV1RbacPolicy200Response struct {
+type GetV1ArtifactRawNamespaceNameTagTag200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
 }
 
-func (response DeleteV1RbacPolicy200Response) VisitDeleteV1RbacPolicyResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactRawNamespaceNameTagTag200ApplicationoctetStreamResponse) VisitGetV1ArtifactRawNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
 	w.WriteHeader(200)
-	return nil
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type DeleteV1RbacPolicy400JSONResponse struct{ GenericBadRequestJSONResponse }
+type GetV1ArtifactRawNamespaceNameTagTag400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response DeleteV1RbacPolicy400JSONResponse) VisitDeleteV1RbacPolicyResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactRawNamespaceNameTagTag400JSONResponse) VisitGetV1ArtifactRawNamespaceNameTagTagResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteV1RbacPolicy401Response = GenericUnauthenticatedResponse
+type GetV1ArtifactRawNamespaceNameTagTag401Response = GenericUnauthenticatedResponse
 
-func (response DeleteV1RbacPolicy401Response) VisitDeleteV1RbacPolicyResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactRawNamespaceNameTagTag401Response) VisitGetV1ArtifactRawNamespaceNameTagTagResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type DeleteV1RbacPolicy403Response = GenericForbiddenResponse
+type GetV1ArtifactRawNamespaceNameTagTag403Response = GenericForbiddenResponse
 
-func (response DeleteV1RbacPolicy403Response) VisitDeleteV1RbacPolicyResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactRawNamespaceNameTagTag403Response) VisitGetV1ArtifactRawNamespaceNameTagTagResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type DeleteV1RbacPolicy409JSONResponse ErrGeneric
+type GetV1ArtifactRawNamespaceNameTagTag404JSONResponse struct{ GenericNotFoundJSONResponse }
 
-func (response DeleteV1RbacPolicy409JSONResponse) VisitDeleteV1RbacPolicyResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactRawNamespaceNameTagTag404JSONResponse) VisitGetV1ArtifactRawNamespaceNameTagTagResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteV1RbacPolicy413JSONResponse struct{ GenericTooLargeJSONResponse }
+type GetV1ArtifactRawNamespaceNameTagTag413JSONResponse struct{ GenericTooLargeJSONResponse }
 
-func (response DeleteV1RbacPolicy413JSONResponse) VisitDeleteV1RbacPolicyResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactRawNamespaceNameTagTag413JSONResponse) VisitGetV1ArtifactRawNamespaceNameTagTagResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteV1RbacPolicy500Response = GenericInternalServerErrorResponse
+type GetV1ArtifactRawNamespaceNameTagTag500Response = GenericInternalServerErrorResponse
 
-func (response DeleteV1RbacPolicy500Response) VisitDeleteV1RbacPolicyResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactRawNamespaceNameTagTag500Response) VisitGetV1ArtifactRawNamespaceNameTagTagResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type GetV1RbacPolicyRequestObject struct {
-	Params GetV1RbacPolicyParams
+type GetV1ArtifactNamespaceRequestObject struct {
+	Namespace string `json:"namespace"`
+	Params    GetV1ArtifactNamespaceParams
 }
 
-type GetV1RbacPolicyResponseObject interface {
-	VisitGetV1RbacPolicyResponse(w http.ResponseWriter) error
+type GetV1ArtifactNamespaceResponseObject interface {
+	VisitGetV1ArtifactNamespaceResponse(w http.ResponseWriter) error
 }
 
-type GetV1RbacPolicy200JSONResponse []RBACPolicy
+type GetV1ArtifactNamespace200JSONResponse []Artifact
 
-func (response GetV1RbacPolicy200JSONResponse) VisitGetV1RbacPolicyResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespace200JSONResponse) VisitGetV1ArtifactNamespaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1RbacPolicy401Response = GenericUnauthenticatedResponse
+type GetV1ArtifactNamespace400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response GetV1RbacPolicy401Response) VisitGetV1RbacPolicyResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespace400JSONResponse) VisitGetV1ArtifactNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1ArtifactNamespace401Response = GenericUnauthenticatedResponse
+
+func (response GetV1ArtifactNamespace401Response) VisitGetV1ArtifactNamespaceResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetV1RbacPolicy403Response = GenericForbiddenResponse
+type GetV1ArtifactNamespace403Response = GenericForbiddenResponse
 
-func (response GetV1RbacPolicy403Response) VisitGetV1RbacPolicyResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespace403Response) VisitGetV1ArtifactNamespaceResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type GetV1RbacPolicy413JSONResponse struct{ GenericTooLargeJSONResponse }
+type GetV1ArtifactNamespace413JSONResponse struct{ GenericTooLargeJSONResponse }
 
-func (response GetV1RbacPolicy413JSONResponse) VisitGetV1RbacPolicyResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespace413JSONResponse) VisitGetV1ArtifactNamespaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1RbacPolicy500Response = GenericInternalServerErrorResponse
+type GetV1ArtifactNamespace500Response = GenericInternalServerErrorResponse
 
-func (response GetV1RbacPolicy500Response) VisitGetV1RbacPolicyResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespace500Response) VisitGetV1ArtifactNamespaceResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type PutV1RbacPolicyRequestObject struct {
-	Body *PutV1RbacPolicyJSONRequestBody
+type GetV1ArtifactNamespaceNameRequestObject struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Params    GetV1ArtifactNamespaceNameParams
 }
 
-type PutV1RbacPolicyResponseObject interface {
-	VisitPutV1RbacPolicyResponse(w http.ResponseWriter) error
+type GetV1ArtifactNamespaceNameResponseObject interface {
+	VisitGetV1ArtifactNamespaceNameResponse(w http.ResponseWriter) error
 }
 
-type PutV1RbacPolicy201Response struct {
-}
+type GetV1ArtifactNamespaceName200JSONResponse []Artifact
 
-func (response PutV1RbacPolicy201Response) VisitPutV1RbacPolicyResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
+func (response GetV1ArtifactNamespaceName200JSONResponse) VisitGetV1ArtifactNamespaceNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PutV1RbacPolicy400JSONResponse struct{ GenericBadRequestJSONResponse }
+type GetV1ArtifactNamespaceName400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response PutV1RbacPolicy400JSONResponse) VisitPutV1RbacPolicyResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespaceName400JSONResponse) VisitGetV1ArtifactNamespaceNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutV1RbacPolicy401Response = GenericUnauthenticatedResponse
+type GetV1ArtifactNamespaceName401Response = GenericUnauthenticatedResponse
 
-func (response PutV1RbacPolicy401Response) VisitPutV1RbacPolicyResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespaceName401Response) VisitGetV1ArtifactNamespaceNameResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type PutV1RbacPolicy403Response = GenericForbiddenResponse
+type GetV1ArtifactNamespaceName403Response = GenericForbiddenResponse
 
-func (response PutV1RbacPolicy403Response) VisitPutV1RbacPolicyResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespaceName403Response) VisitGetV1ArtifactNamespaceNameResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type PutV1RbacPolicy404JSONResponse struct{ FieldErrorJSONResponse }
-
-func (response PutV1RbacPolicy404JSONResponse) VisitPutV1RbacPolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type PutV1RbacPolicy413JSONResponse struct{ GenericTooLargeJSONResponse }
+type GetV1ArtifactNamespaceName413JSONResponse struct{ GenericTooLargeJSONResponse }
 
-func (response PutV1RbacPolicy413JSONResponse) VisitPutV1RbacPolicyResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespaceName413JSONResponse) VisitGetV1ArtifactNamespaceNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutV1RbacPolicy500Response = GenericInternalServerErrorResponse
+type GetV1ArtifactNamespaceName500Response = GenericInternalServerErrorResponse
 
-func (response PutV1RbacPolicy500Response) VisitPutV1RbacPolicyResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespaceName500Response) VisitGetV1ArtifactNamespaceNameResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type GetV1RbacResourceGroupRequestObject struct {
-	Params GetV1RbacResourceGroupParams
+type DeleteV1ArtifactNamespaceNameHashHashRequestObject struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Hash      string `json:"hash"`
 }
 
-type GetV1RbacResourceGroupResponseObject interface {
-	VisitGetV1RbacResourceGroupResponse(w http.ResponseWriter) error
+type DeleteV1ArtifactNamespaceNameHashHashResponseObject interface {
+	VisitDeleteV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error
 }
 
-type GetV1RbacResourceGroup200JSONResponse []ResourceGroupResource
+type DeleteV1ArtifactNamespaceNameHashHash200JSONResponse Artifact
 
-func (response GetV1RbacResourceGroup200JSONResponse) VisitGetV1RbacResourceGroupResponse(w http.ResponseWriter) error {
+func (response DeleteV1ArtifactNamespaceNameHashHash200JSONResponse) VisitDeleteV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1RbacResourceGroup401Response = GenericUnauthenticatedResponse
+type DeleteV1ArtifactNamespaceNameHashHash400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response GetV1RbacResourceGroup401Response) VisitGetV1RbacResourceGroupResponse(w http.ResponseWriter) error {
+func (response DeleteV1ArtifactNamespaceNameHashHash400JSONResponse) VisitDeleteV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1ArtifactNamespaceNameHashHash401Response = GenericUnauthenticatedResponse
+
+func (response DeleteV1ArtifactNamespaceNameHashHash401Response) VisitDeleteV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetV1RbacResourceGroup403Response = GenericForbiddenResponse
+type DeleteV1ArtifactNamespaceNameHashHash403Response = GenericForbiddenResponse
 
-func (response GetV1RbacResourceGroup403Response) VisitGetV1RbacResourceGroupResponse(w http.ResponseWriter) error {
+func (response DeleteV1ArtifactNamespaceNameHashHash403Response) VisitDeleteV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type GetV1RbacResourceGroup413JSONResponse struct{ GenericTooLargeJSONResponse }
+type DeleteV1ArtifactNamespaceNameHashHash404JSONResponse struct{ GenericNotFoundJSONResponse }
 
-func (response GetV1RbacResourceGroup413JSONResponse) VisitGetV1RbacResourceGroupResponse(w http.ResponseWriter) error {
+func (response DeleteV1ArtifactNamespaceNameHashHash404JSONResponse) VisitDeleteV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1ArtifactNamespaceNameHashHash413JSONResponse struct{ GenericTooLargeJSONResponse }
+
+func (response DeleteV1ArtifactNamespaceNameHashHash413JSONResponse) VisitDeleteV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1RbacResourceGroup500Response = GenericInternalServerErrorResponse
+type DeleteV1ArtifactNamespaceNameHashHash500Response = GenericInternalServerErrorResponse
 
-func (response GetV1RbacResourceGroup500Response) VisitGetV1RbacResourceGroupResponse(w http.ResponseWriter) error {
+func (response DeleteV1ArtifactNamespaceNameHashHash500Response) VisitDeleteV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type DeleteV1RbacResourceGroupResourceGroupRequestObject struct {
-	ResourceGroup string `json:"resourceGroup"`
+type GetV1ArtifactNamespaceNameHashHashRequestObject struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Hash      string `json:"hash"`
 }
 
-type DeleteV1RbacResourceGroupResourceGroupResponseObject interface {
-	VisitDeleteV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error
+type GetV1ArtifactNamespaceNameHashHashResponseObject interface {
+	VisitGetV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error
 }
 
-type DeleteV1RbacResourceGroupResourceGroup200JSONResponse ResourceGroupResource
+type GetV1ArtifactNamespaceNameHashHash200JSONResponse Artifact
 
-func (response DeleteV1RbacResourceGroupResourceGroup200JSONResponse) VisitDeleteV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespaceNameHashHash200JSONResponse) VisitGetV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteV1RbacResourceGroupResourceGroup400JSONResponse struct{ GenericBadRequestJSONResponse }
+type GetV1ArtifactNamespaceNameHashHash400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response DeleteV1RbacResourceGroupResourceGroup400JSONResponse) VisitDeleteV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespaceNameHashHash400JSONResponse) VisitGetV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteV1RbacResourceGroupResourceGroup401Response = GenericUnauthenticatedResponse
+type GetV1ArtifactNamespaceNameHashHash401Response = GenericUnauthenticatedResponse
 
-func (response DeleteV1RbacResourceGroupResourceGroup401Response) VisitDeleteV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespaceNameHashHash401Response) VisitGetV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type DeleteV1RbacResourceGroupResourceGroup403Response = GenericForbiddenResponse
+type GetV1ArtifactNamespaceNameHashHash403Response = GenericForbiddenResponse
 
-func (response DeleteV1RbacResourceGroupResourceGroup403Response) VisitDeleteV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespaceNameHashHash403Response) VisitGetV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type DeleteV1RbacResourceGroupResourceGroup404JSONResponse struct{ GenericNotFoundJSONResponse }
+type GetV1ArtifactNamespaceNameHashHash404JSONResponse struct{ GenericNotFoundJSONResponse }
 
-func (response DeleteV1RbacResourceGroupResourceGroup404JSONResponse) VisitDeleteV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespaceNameHashHash404JSONResponse) VisitGetV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteV1RbacResourceGroupResourceGroup413JSONResponse struct{ GenericTooLargeJSONResponse }
+type GetV1ArtifactNamespaceNameHashHash413JSONResponse struct{ GenericTooLargeJSONResponse }
 
-func (response DeleteV1RbacResourceGroupResourceGroup413JSONResponse) VisitDeleteV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespaceNameHashHash413JSONResponse) VisitGetV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteV1RbacResourceGroupResourceGroup500Response = GenericInternalServerErrorResponse
+type GetV1ArtifactNamespaceNameHashHash500Response = GenericInternalServerErrorResponse
 
-func (response DeleteV1RbacResourceGroupResourceGroup500Response) VisitDeleteV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespaceNameHashHash500Response) VisitGetV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type GetV1RbacResourceGroupResourceGroupRequestObject struct {
-	ResourceGroup string `json:"resourceGroup"`
+type PatchV1ArtifactNamespaceNameHashHashRequestObject struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Hash      string `json:"hash"`
+	Body      *PatchV1ArtifactNamespaceNameHashHashJSONRequestBody
 }
 
-type GetV1RbacResourceGroupResourceGroupResponseObject interface {
-	VisitGetV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error
+type PatchV1ArtifactNamespaceNameHashHashResponseObject interface {
+	VisitPatchV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error
 }
 
-type GetV1RbacResourceGroupResourceGroup200JSONResponse ResourceGroupResource
+type PatchV1ArtifactNamespaceNameHashHash200JSONResponse Artifact
 
-func (response GetV1RbacResourceGroupResourceGroup200JSONResponse) VisitGetV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response PatchV1ArtifactNamespaceNameHashHash200JSONResponse) VisitPatchV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1RbacResourceGroupResourceGroup400JSONResponse struct{ GenericBadRequestJSONResponse }
+type PatchV1ArtifactNamespaceNameHashHash400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response GetV1RbacResourceGroupResourceGroup400JSONResponse) VisitGetV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response PatchV1ArtifactNamespaceNameHashHash400JSONResponse) VisitPatchV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1RbacResourceGroupResourceGroup401Response = GenericUnauthenticatedResponse
+type PatchV1ArtifactNamespaceNameHashHash401Response = GenericUnauthenticatedResponse
 
-func (response GetV1RbacResourceGroupResourceGroup401Response) VisitGetV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response PatchV1ArtifactNamespaceNameHashHash401Response) VisitPatchV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetV1RbacResourceGroupResourceGroup403Response = GenericForbiddenResponse
+type PatchV1ArtifactNamespaceNameHashHash403Response = GenericForbiddenResponse
 
-func (response GetV1RbacResourceGroupResourceGroup403Response) VisitGetV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response PatchV1ArtifactNamespaceNameHashHash403Response) VisitPatchV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type GetV1RbacResourceGroupResourceGroup404JSONResponse struct{ GenericNotFoundJSONResponse }
+type PatchV1ArtifactNamespaceNameHashHash404JSONResponse struct{ GenericNotFoundJSONResponse }
 
-func (response GetV1RbacResourceGroupResourceGroup404JSONResponse) VisitGetV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response PatchV1ArtifactNamespaceNameHashHash404JSONResponse) VisitPatchV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1RbacResourceGroupResourceGroup413JSONResponse struct{ GenericTooLargeJSONResponse }
+type PatchV1ArtifactNamespaceNameHashHash413JSONResponse struct{ GenericTooLargeJSONResponse }
 
-func (response GetV1RbacResourceGroupResourceGroup413JSONResponse) VisitGetV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response PatchV1ArtifactNamespaceNameHashHash413JSONResponse) VisitPatchV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1RbacResourceGroupResourceGroup500Response = GenericInternalServerErrorResponse
+type PatchV1ArtifactNamespaceNameHashHash500Response = GenericInternalServerErrorResponse
 
-func (response GetV1RbacResourceGroupResourceGroup500Response) VisitGetV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response PatchV1ArtifactNamespaceNameHashHash500Response) VisitPatchV1ArtifactNamespaceNameHashHashResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type HeadV1RbacResourceGroupResourceGroupRequestObject struct {
-	ResourceGroup string `json:"resourceGroup"`
+type DeleteV1ArtifactNamespaceNameTagTagRequestObject struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Tag       string `json:"tag"`
 }
 
-type HeadV1RbacResourceGroupResourceGroupResponseObject interface {
-	VisitHeadV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error
+type DeleteV1ArtifactNamespaceNameTagTagResponseObject interface {
+	VisitDeleteV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error
 }
 
-type HeadV1RbacResourceGroupResourceGroup200Response struct {
-}
+type DeleteV1ArtifactNamespaceNameTagTag200JSONResponse Artifact
 
-func (response HeadV1RbacResourceGroupResourceGroup200Response) VisitHeadV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response DeleteV1ArtifactNamespaceNameTagTag200JSONResponse) VisitDeleteV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
-}
 
-type HeadV1RbacResourceGroupResourceGroup400Response struct {
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response HeadV1RbacResourceGroupResourceGroup400Response) VisitHeadV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+type DeleteV1ArtifactNamespaceNameTagTag400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response DeleteV1ArtifactNamespaceNameTagTag400JSONResponse) VisitDeleteV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
-	return nil
-}
 
-type HeadV1RbacResourceGroupResourceGroup401Response struct {
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response HeadV1RbacResourceGroupResourceGroup401Response) VisitHeadV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+type DeleteV1ArtifactNamespaceNameTagTag401Response = GenericUnauthenticatedResponse
+
+func (response DeleteV1ArtifactNamespaceNameTagTag401Response) VisitDeleteV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type HeadV1RbacResourceGroupResourceGroup403Response struct {
-}
+type DeleteV1ArtifactNamespaceNameTagTag403Response = GenericForbiddenResponse
 
-func (response HeadV1RbacResourceGroupResourceGroup403Response) VisitHeadV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response DeleteV1ArtifactNamespaceNameTagTag403Response) VisitDeleteV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type HeadV1RbacResourceGroupResourceGroup404Response struct {
-}
+type DeleteV1ArtifactNamespaceNameTagTag404JSONResponse struct{ GenericNotFoundJSONResponse }
 
-func (response HeadV1RbacResourceGroupResourceGroup404Response) VisitHeadV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response DeleteV1ArtifactNamespaceNameTagTag404JSONResponse) VisitDeleteV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type HeadV1RbacResourceGroupResourceGroup413JSONResponse struct{ GenericTooLargeJSONResponse }
+type DeleteV1ArtifactNamespaceNameTagTag413JSONResponse struct{ GenericTooLargeJSONResponse }
 
-func (response HeadV1RbacResourceGroupResourceGroup413JSONResponse) VisitHeadV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response DeleteV1ArtifactNamespaceNameTagTag413JSONResponse) VisitDeleteV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type HeadV1RbacResourceGroupResourceGroup500Response struct {
-}
+type DeleteV1ArtifactNamespaceNameTagTag500Response = GenericInternalServerErrorResponse
 
-func (response HeadV1RbacResourceGroupResourceGroup500Response) VisitHeadV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response DeleteV1ArtifactNamespaceNameTagTag500Response) VisitDeleteV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type PutV1RbacResourceGroupResourceGroupRequestObject struct {
-	ResourceGroup string `json:"resourceGroup"`
-	Body          *PutV1RbacResourceGroupResourceGroupJSONRequestBody
+type GetV1ArtifactNamespaceNameTagTagRequestObject struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Tag       string `json:"tag"`
 }
 
-type PutV1RbacResourceGroupResourceGroupResponseObject interface {
-	VisitPutV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error
+type GetV1ArtifactNamespaceNameTagTagResponseObject interface {
+	VisitGetV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error
 }
 
-type PutV1RbacResourceGroupResourceGroup201JSONResponse ResourceGroupResource
+type GetV1ArtifactNamespaceNameTagTag200JSONResponse Artifact
 
-func (response PutV1RbacResourceGroupResourceGroup201JSONResponse) VisitPutV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespaceNameTagTag200JSONResponse) VisitGetV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutV1RbacResourceGroupResourceGroup400JSONResponse struct{ GenericBadRequestJSONResponse }
+type GetV1ArtifactNamespaceNameTagTag400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response PutV1RbacResourceGroupResourceGroup400JSONResponse) VisitPutV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespaceNameTagTag400JSONResponse) VisitGetV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutV1RbacResourceGroupResourceGroup401Response = GenericUnauthenticatedResponse
+type GetV1ArtifactNamespaceNameTagTag401Response = GenericUnauthenticatedResponse
 
-func (response PutV1RbacResourceGroupResourceGroup401Response) VisitPutV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespaceNameTagTag401Response) VisitGetV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type PutV1RbacResourceGroupResourceGroup403Response = GenericForbiddenResponse
+type GetV1ArtifactNamespaceNameTagTag403Response = GenericForbiddenResponse
 
-func (response PutV1RbacResourceGroupResourceGroup403Response) VisitPutV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespaceNameTagTag403Response) VisitGetV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type PutV1RbacResourceGroupResourceGroup409JSONResponse ErrGeneric
+type GetV1ArtifactNamespaceNameTagTag404JSONResponse struct{ GenericNotFoundJSONResponse }
 
-func (response PutV1RbacResourceGroupResourceGroup409JSONResponse) VisitPutV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespaceNameTagTag404JSONResponse) VisitGetV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutV1RbacResourceGroupResourceGroup413JSONResponse struct{ GenericTooLargeJSONResponse }
+type GetV1ArtifactNamespaceNameTagTag413JSONResponse struct{ GenericTooLargeJSONResponse }
 
-func (response PutV1RbacResourceGroupResourceGroup413JSONResponse) VisitPutV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespaceNameTagTag413JSONResponse) VisitGetV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutV1RbacResourceGroupResourceGroup500Response = GenericInternalServerErrorResponse
+type GetV1ArtifactNamespaceNameTagTag500Response = GenericInternalServerErrorResponse
 
-func (response PutV1RbacResourceGroupResourceGroup500Response) VisitPutV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+func (response GetV1ArtifactNamespaceNameTagTag500Response) VisitGetV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type GetV1RbacRoleRequestObject struct {
-	Params GetV1RbacRoleParams
+type PatchV1ArtifactNamespaceNameTagTagRequestObject struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Tag       string `json:"tag"`
+	Body      *PatchV1ArtifactNamespaceNameTagTagJSONRequestBody
 }
 
-type GetV1RbacRoleResponseObject interface {
-	VisitGetV1RbacRoleResponse(w http.ResponseWriter) error
+type PatchV1ArtifactNamespaceNameTagTagResponseObject interface {
+	VisitPatchV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error
 }
 
-type GetV1RbacRole200JSONResponse []RoleResource
+type PatchV1ArtifactNamespaceNameTagTag200JSONResponse Artifact
 
-func (response GetV1RbacRole200JSONResponse) VisitGetV1RbacRoleResponse(w http.ResponseWriter) error {
+func (response PatchV1ArtifactNamespaceNameTagTag200JSONResponse) VisitPatchV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1RbacRole401Response = GenericUnauthenticatedResponse
+type PatchV1ArtifactNamespaceNameTagTag400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response GetV1RbacRole401Response) VisitGetV1RbacRoleResponse(w http.ResponseWriter) error {
+func (response PatchV1ArtifactNamespaceNameTagTag400JSONResponse) VisitPatchV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV1ArtifactNamespaceNameTagTag401Response = GenericUnauthenticatedResponse
+
+func (response PatchV1ArtifactNamespaceNameTagTag401Response) VisitPatchV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetV1RbacRole403Response = GenericForbiddenResponse
+type PatchV1ArtifactNamespaceNameTagTag403Response = GenericForbiddenResponse
 
-func (response GetV1RbacRole403Response) VisitGetV1RbacRoleResponse(w http.ResponseWriter) error {
+func (response PatchV1ArtifactNamespaceNameTagTag403Response) VisitPatchV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type GetV1RbacRole413JSONResponse struct{ GenericTooLargeJSONResponse }
+type PatchV1ArtifactNamespaceNameTagTag404JSONResponse struct{ GenericNotFoundJSONResponse }
 
-func (response GetV1RbacRole413JSONResponse) VisitGetV1RbacRoleResponse(w http.ResponseWriter) error {
+func (response PatchV1ArtifactNamespaceNameTagTag404JSONResponse) VisitPatchV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV1ArtifactNamespaceNameTagTag413JSONResponse struct{ GenericTooLargeJSONResponse }
+
+func (response PatchV1ArtifactNamespaceNameTagTag413JSONResponse) VisitPatchV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1RbacRole500Response = GenericInternalServerErrorResponse
+type PatchV1ArtifactNamespaceNameTagTag500Response = GenericInternalServerErrorResponse
 
-func (response GetV1RbacRole500Response) VisitGetV1RbacRoleResponse(w http.ResponseWriter) error {
+func (response PatchV1ArtifactNamespaceNameTagTag500Response) VisitPatchV1ArtifactNamespaceNameTagTagResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type DeleteV1RbacRoleRoleRequestObject struct {
-	Role string `json:"role"`
+type GetV1QueueRequestObject struct {
 }
 
-type DeleteV1RbacRoleRoleResponseObject interface {
-	VisitDeleteV1RbacRoleRoleResponse(w http.ResponseWriter) error
+type GetV1QueueResponseObject interface {
+	VisitGetV1QueueResponse(w http.ResponseWriter) error
 }
 
-type DeleteV1RbacRoleRole200JSONResponse RoleResource
+type GetV1Queue200JSONResponse []QueueStats
 
-func (response DeleteV1RbacRoleRole200JSONResponse) VisitDeleteV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response GetV1Queue200JSONResponse) VisitGetV1QueueResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteV1RbacRoleRole400JSONResponse struct{ GenericBadRequestJSONResponse }
+type GetV1Queue400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response DeleteV1RbacRoleRole400JSONResponse) VisitDeleteV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response GetV1Queue400JSONResponse) VisitGetV1QueueResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteV1RbacRoleRole401Response = GenericUnauthenticatedResponse
+type GetV1Queue401Response = GenericUnauthenticatedResponse
 
-func (response DeleteV1RbacRoleRole401Response) VisitDeleteV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response GetV1Queue401Response) VisitGetV1QueueResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type DeleteV1RbacRoleRole403Response = GenericForbiddenResponse
+type GetV1Queue403Response = GenericForbiddenResponse
 
-func (response DeleteV1RbacRoleRole403Response) VisitDeleteV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response GetV1Queue403Response) VisitGetV1QueueResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type DeleteV1RbacRoleRole404JSONResponse struct{ GenericNotFoundJSONResponse }
+type GetV1Queue500Response = GenericInternalServerErrorResponse
 
-func (response DeleteV1RbacRoleRole404JSONResponse) VisitDeleteV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response GetV1Queue500Response) VisitGetV1QueueResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetV1QueueNameRequestObject struct {
+	Name   string `json:"name"`
+	Params GetV1QueueNameParams
+}
+
+type GetV1QueueNameResponseObject interface {
+	VisitGetV1QueueNameResponse(w http.ResponseWriter) error
+}
+
+type GetV1QueueName200JSONResponse QueueStats
+
+func (response GetV1QueueName200JSONResponse) VisitGetV1QueueNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteV1RbacRoleRole409JSONResponse ErrGeneric
+type GetV1QueueName400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response DeleteV1RbacRoleRole409JSONResponse) VisitDeleteV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response GetV1QueueName400JSONResponse) VisitGetV1QueueNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteV1RbacRoleRole413JSONResponse struct{ GenericTooLargeJSONResponse }
+type GetV1QueueName401Response = GenericUnauthenticatedResponse
 
-func (response DeleteV1RbacRoleRole413JSONResponse) VisitDeleteV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response GetV1QueueName401Response) VisitGetV1QueueNameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetV1QueueName403Response = GenericForbiddenResponse
+
+func (response GetV1QueueName403Response) VisitGetV1QueueNameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetV1QueueName404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response GetV1QueueName404JSONResponse) VisitGetV1QueueNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(413)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteV1RbacRoleRole500Response = GenericInternalServerErrorResponse
+type GetV1QueueName500Response = GenericInternalServerErrorResponse
 
-func (response DeleteV1RbacRoleRole500Response) VisitDeleteV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response GetV1QueueName500Response) VisitGetV1QueueNameResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type GetV1RbacRoleRoleRequestObject struct {
-	Role string `json:"role"`
+type PostV1QueueNamePauseRequestObject struct {
+	Name string `json:"name"`
 }
 
-type GetV1RbacRoleRoleResponseObject interface {
-	VisitGetV1RbacRoleRoleResponse(w http.ResponseWriter) error
+type PostV1QueueNamePauseResponseObject interface {
+	VisitPostV1QueueNamePauseResponse(w http.ResponseWriter) error
 }
 
-type GetV1RbacRoleRole200JSONResponse RoleResource
+type PostV1QueueNamePause200JSONResponse QueueStats
 
-func (response GetV1RbacRoleRole200JSONResponse) VisitGetV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response PostV1QueueNamePause200JSONResponse) VisitPostV1QueueNamePauseResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1RbacRoleRole400JSONResponse struct{ GenericBadRequestJSONResponse }
+type PostV1QueueNamePause400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response GetV1RbacRoleRole400JSONResponse) VisitGetV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response PostV1QueueNamePause400JSONResponse) VisitPostV1QueueNamePauseResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1RbacRoleRole401Response = GenericUnauthenticatedResponse
+type PostV1QueueNamePause401Response = GenericUnauthenticatedResponse
 
-func (response GetV1RbacRoleRole401Response) VisitGetV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response PostV1QueueNamePause401Response) VisitPostV1QueueNamePauseResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetV1RbacRoleRole403Response = GenericForbiddenResponse
+type PostV1QueueNamePause403Response = GenericForbiddenResponse
 
-func (response GetV1RbacRoleRole403Response) VisitGetV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response PostV1QueueNamePause403Response) VisitPostV1QueueNamePauseResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type GetV1RbacRoleRole404JSONResponse struct{ GenericNotFoundJSONResponse }
+type PostV1QueueNamePause404JSONResponse struct{ GenericNotFoundJSONResponse }
 
-func (response GetV1RbacRoleRole404JSONResponse) VisitGetV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response PostV1QueueNamePause404JSONResponse) VisitPostV1QueueNamePauseResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1RbacRoleRole413JSONResponse struct{ GenericTooLargeJSONResponse }
-
-func (response GetV1RbacRoleRole413JSONResponse) VisitGetV1RbacRoleRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(413)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetV1RbacRoleRole500Response = GenericInternalServerErrorResponse
+type PostV1QueueNamePause500Response = GenericInternalServerErrorResponse
 
-func (response GetV1RbacRoleRole500Response) VisitGetV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response PostV1QueueNamePause500Response) VisitPostV1QueueNamePauseResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type HeadV1RbacRoleRoleRequestObject struct {
-	Role string `json:"role"`
+type PostV1QueueNameResumeRequestObject struct {
+	Name string `json:"name"`
 }
 
-type HeadV1RbacRoleRoleResponseObject interface {
-	VisitHeadV1RbacRoleRoleResponse(w http.ResponseWriter) error
+type PostV1QueueNameResumeResponseObject interface {
+	VisitPostV1QueueNameResumeResponse(w http.ResponseWriter) error
 }
 
-type HeadV1RbacRoleRole200Response struct {
-}
+type PostV1QueueNameResume200JSONResponse QueueStats
 
-func (response HeadV1RbacRoleRole200Response) VisitHeadV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response PostV1QueueNameResume200JSONResponse) VisitPostV1QueueNameResumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
-}
 
-type HeadV1RbacRoleRole400Response struct {
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response HeadV1RbacRoleRole400Response) VisitHeadV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+type PostV1QueueNameResume400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response PostV1QueueNameResume400JSONResponse) VisitPostV1QueueNameResumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
-	return nil
-}
 
-type HeadV1RbacRoleRole401Response struct {
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response HeadV1RbacRoleRole401Response) VisitHeadV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+type PostV1QueueNameResume401Response = GenericUnauthenticatedResponse
+
+func (response PostV1QueueNameResume401Response) VisitPostV1QueueNameResumeResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type HeadV1RbacRoleRole403Response struct {
-}
+type PostV1QueueNameResume403Response = GenericForbiddenResponse
 
-func (response HeadV1RbacRoleRole403Response) VisitHeadV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response PostV1QueueNameResume403Response) VisitPostV1QueueNameResumeResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type HeadV1RbacRoleRole404Response struct {
-}
-
-func (response HeadV1RbacRoleRole404Response) VisitHeadV1RbacRoleRoleResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
-
-type HeadV1RbacRoleRole413JSONResponse struct{ GenericTooLargeJSONResponse }
+type PostV1QueueNameResume404JSONResponse struct{ GenericNotFoundJSONResponse }
 
-func (response HeadV1RbacRoleRole413JSONResponse) VisitHeadV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response PostV1QueueNameResume404JSONResponse) VisitPostV1QueueNameResumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(413)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type HeadV1RbacRoleRole500Response struct {
-}
+type PostV1QueueNameResume500Response = GenericInternalServerErrorResponse
 
-func (response HeadV1RbacRoleRole500Response) VisitHeadV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response PostV1QueueNameResume500Response) VisitPostV1QueueNameResumeResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type PutV1RbacRoleRoleRequestObject struct {
-	Role string `json:"role"`
-	Body *PutV1RbacRoleRoleJSONRequestBody
+type DeleteV1RbacPolicyRequestObject struct {
+	Body *DeleteV1RbacPolicyJSONRequestBody
 }
 
-type PutV1RbacRoleRoleResponseObject interface {
-	VisitPutV1RbacRoleRoleResponse(w http.ResponseWriter) error
+type DeleteV1RbacPolicyResponseObject interface {
+	VisitDeleteV1RbacPolicyResponse(w http.ResponseWriter) error
 }
 
-type PutV1RbacRoleRole201JSONResponse RoleResource
-
-func (response PutV1RbacRoleRole201JSONResponse) VisitPutV1RbacRoleRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+type DeleteV1RbacPolicy200Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response DeleteV1RbacPolicy200Response) VisitDeleteV1RbacPolicyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
 }
 
-type PutV1RbacRoleRole400JSONResponse struct{ GenericBadRequestJSONResponse }
+type DeleteV1RbacPolicy400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response PutV1RbacRoleRole400JSONResponse) VisitPutV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response DeleteV1RbacPolicy400JSONResponse) VisitDeleteV1RbacPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutV1RbacRoleRole401Response = GenericUnauthenticatedResponse
+type DeleteV1RbacPolicy401Response = GenericUnauthenticatedResponse
 
-func (response PutV1RbacRoleRole401Response) VisitPutV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response DeleteV1RbacPolicy401Response) VisitDeleteV1RbacPolicyResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type PutV1RbacRoleRole403Response = GenericForbiddenResponse
+type DeleteV1RbacPolicy403Response = GenericForbiddenResponse
 
-func (response PutV1RbacRoleRole403Response) VisitPutV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response DeleteV1RbacPolicy403Response) VisitDeleteV1RbacPolicyResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type PutV1RbacRoleRole409JSONResponse ErrGeneric
+type DeleteV1RbacPolicy409JSONResponse ErrGeneric
 
-func (response PutV1RbacRoleRole409JSONResponse) VisitPutV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response DeleteV1RbacPolicy409JSONResponse) VisitDeleteV1RbacPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutV1RbacRoleRole413JSONResponse struct{ GenericTooLargeJSONResponse }
+type DeleteV1RbacPolicy413JSONResponse struct{ GenericTooLargeJSONResponse }
 
-func (response PutV1RbacRoleRole413JSONResponse) VisitPutV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response DeleteV1RbacPolicy413JSONResponse) VisitDeleteV1RbacPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutV1RbacRoleRole500Response = GenericInternalServerErrorResponse
+type DeleteV1RbacPolicy500Response = GenericInternalServerErrorResponse
 
-func (response PutV1RbacRoleRole500Response) VisitPutV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+func (response DeleteV1RbacPolicy500Response) VisitDeleteV1RbacPolicyResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type GetV1TaskRequestObject struct {
-	Params GetV1TaskParams
+type GetV1RbacPolicyRequestObject struct {
+	Params GetV1RbacPolicyParams
 }
 
-type GetV1TaskResponseObject interface {
-	VisitGetV1TaskResponse(w http.ResponseWriter) error
+type GetV1RbacPolicyResponseObject interface {
+	VisitGetV1RbacPolicyResponse(w http.ResponseWriter) error
 }
 
-type GetV1Task200JSONResponse []Task
+type GetV1RbacPolicy200JSONResponse []RBACPolicy
 
-func (response GetV1Task200JSONResponse) VisitGetV1TaskResponse(w http.ResponseWriter) error {
+func (response GetV1RbacPolicy200JSONResponse) VisitGetV1RbacPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1Task400JSONResponse struct{ GenericBadRequestJSONResponse }
-
-func (response GetV1Task400JSONResponse) VisitGetV1TaskResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetV1Task401Response = GenericUnauthenticatedResponse
+type GetV1RbacPolicy401Response = GenericUnauthenticatedResponse
 
-func (response GetV1Task401Response) VisitGetV1TaskResponse(w http.ResponseWriter) error {
+func (response GetV1RbacPolicy401Response) VisitGetV1RbacPolicyResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetV1Task403Response = GenericForbiddenResponse
+type GetV1RbacPolicy403Response = GenericForbiddenResponse
 
-func (response GetV1Task403Response) VisitGetV1TaskResponse(w http.ResponseWriter) error {
+func (response GetV1RbacPolicy403Response) VisitGetV1RbacPolicyResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type GetV1Task500Response = GenericInternalServerErrorResponse
+type GetV1RbacPolicy413JSONResponse struct{ GenericTooLargeJSONResponse }
 
-func (response GetV1Task500Response) VisitGetV1TaskResponse(w http.ResponseWriter) error {
+func (response GetV1RbacPolicy413JSONResponse) VisitGetV1RbacPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1RbacPolicy500Response = GenericInternalServerErrorResponse
+
+func (response GetV1RbacPolicy500Response) VisitGetV1RbacPolicyResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type PostV1TaskRequestObject struct {
-	Body *PostV1TaskJSONRequestBody
+type PutV1RbacPolicyRequestObject struct {
+	Body *PutV1RbacPolicyJSONRequestBody
 }
 
-type PostV1TaskResponseObject interface {
-	VisitPostV1TaskResponse(w http.ResponseWriter) error
+type PutV1RbacPolicyResponseObject interface {
+	VisitPutV1RbacPolicyResponse(w http.ResponseWriter) error
 }
 
-type PostV1Task201JSONResponse Task
+type PutV1RbacPolicy201Response struct {
+}
 
-func (response PostV1Task201JSONResponse) VisitPostV1TaskResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response PutV1RbacPolicy201Response) VisitPutV1RbacPolicyResponse(w http.ResponseWriter) error {
 	w.WriteHeader(201)
-
-	return json.NewEncoder(w).Encode(response)
+	return nil
 }
 
-type PostV1Task400JSONResponse struct{ GenericBadRequestJSONResponse }
+type PutV1RbacPolicy400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response PostV1Task400JSONResponse) VisitPostV1TaskResponse(w http.ResponseWriter) error {
+func (response PutV1RbacPolicy400JSONResponse) VisitPutV1RbacPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostV1Task401Response = GenericUnauthenticatedResponse
+type PutV1RbacPolicy401Response = GenericUnauthenticatedResponse
 
-func (response PostV1Task401Response) VisitPostV1TaskResponse(w http.ResponseWriter) error {
+func (response PutV1RbacPolicy401Response) VisitPutV1RbacPolicyResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type PostV1Task403Response = GenericForbiddenResponse
+type PutV1RbacPolicy403Response = GenericForbiddenResponse
 
-func (response PostV1Task403Response) VisitPostV1TaskResponse(w http.ResponseWriter) error {
+func (response PutV1RbacPolicy403Response) VisitPutV1RbacPolicyResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type PostV1Task413JSONResponse struct{ GenericTooLargeJSONResponse }
+type PutV1RbacPolicy404JSONResponse struct{ FieldErrorJSONResponse }
 
-func (response PostV1Task413JSONResponse) VisitPostV1TaskResponse(w http.ResponseWriter) error {
+func (response PutV1RbacPolicy404JSONResponse) VisitPutV1RbacPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV1RbacPolicy413JSONResponse struct{ GenericTooLargeJSONResponse }
+
+func (response PutV1RbacPolicy413JSONResponse) VisitPutV1RbacPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostV1Task500Response = GenericInternalServerErrorResponse
+type PutV1RbacPolicy500Response = GenericInternalServerErrorResponse
 
-func (response PostV1Task500Response) VisitPostV1TaskResponse(w http.ResponseWriter) error {
+func (response PutV1RbacPolicy500Response) VisitPutV1RbacPolicyResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type GetV1TaskIdRequestObject struct {
-	Id string `json:"id"`
+type GetV1RbacResourceGroupRequestObject struct {
+	Params GetV1RbacResourceGroupParams
 }
 
-type GetV1TaskIdResponseObject interface {
-	VisitGetV1TaskIdResponse(w http.ResponseWriter) error
+type GetV1RbacResourceGroupResponseObject interface {
+	VisitGetV1RbacResourceGroupResponse(w http.ResponseWriter) error
 }
 
-type GetV1TaskId200JSONResponse Task
+type GetV1RbacResourceGroup200JSONResponse []ResourceGroupResource
 
-func (response GetV1TaskId200JSONResponse) VisitGetV1TaskIdResponse(w http.ResponseWriter) error {
+func (response GetV1RbacResourceGroup200JSONResponse) VisitGetV1RbacResourceGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1TaskId400JSONResponse struct{ GenericBadRequestJSONResponse }
-
-func (response GetV1TaskId400JSONResponse) VisitGetV1TaskIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetV1TaskId401Response = GenericUnauthenticatedResponse
+type GetV1RbacResourceGroup401Response = GenericUnauthenticatedResponse
 
-func (response GetV1TaskId401Response) VisitGetV1TaskIdResponse(w http.ResponseWriter) error {
+func (response GetV1RbacResourceGroup401Response) VisitGetV1RbacResourceGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetV1TaskId403Response = GenericForbiddenResponse
+type GetV1RbacResourceGroup403Response = GenericForbiddenResponse
 
-func (response GetV1TaskId403Response) VisitGetV1TaskIdResponse(w http.ResponseWriter) error {
+func (response GetV1RbacResourceGroup403Response) VisitGetV1RbacResourceGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type GetV1TaskId404JSONResponse struct{ GenericNotFoundJSONResponse }
+type GetV1RbacResourceGroup413JSONResponse struct{ GenericTooLargeJSONResponse }
 
-func (response GetV1TaskId404JSONResponse) VisitGetV1TaskIdResponse(w http.ResponseWriter) error {
+func (response GetV1RbacResourceGroup413JSONResponse) VisitGetV1RbacResourceGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1TaskId500Response = GenericInternalServerErrorResponse
+type GetV1RbacResourceGroup500Response = GenericInternalServerErrorResponse
 
-func (response GetV1TaskId500Response) VisitGetV1TaskIdResponse(w http.ResponseWriter) error {
+func (response GetV1RbacResourceGroup500Response) VisitGetV1RbacResourceGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type GetV1TaskIdLogsRequestObject struct {
-	Id     string `json:"id"`
-	Params GetV1TaskIdLogsParams
+type DeleteV1RbacResourceGroupResourceGroupRequestObject struct {
+	ResourceGroup string `json:"resourceGroup"`
 }
 
-type GetV1TaskIdLogsResponseObject interface {
-	VisitGetV1TaskIdLogsResponse(w http.ResponseWriter) error
+type DeleteV1RbacResourceGroupResourceGroupResponseObject interface {
+	VisitDeleteV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error
 }
 
-type GetV1TaskIdLogs200JSONResponse []TaskLog
+type DeleteV1RbacResourceGroupResourceGroup200JSONResponse ResourceGroupResource
 
-func (response GetV1TaskIdLogs200JSONResponse) VisitGetV1TaskIdLogsResponse(w http.ResponseWriter) error {
+func (response DeleteV1RbacResourceGroupResourceGroup200JSONResponse) VisitDeleteV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1TaskIdLogs400JSONResponse struct{ GenericBadRequestJSONResponse }
+type DeleteV1RbacResourceGroupResourceGroup400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response GetV1TaskIdLogs400JSONResponse) VisitGetV1TaskIdLogsResponse(w http.ResponseWriter) error {
+func (response DeleteV1RbacResourceGroupResourceGroup400JSONResponse) VisitDeleteV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1TaskIdLogs401Response = GenericUnauthenticatedResponse
+type DeleteV1RbacResourceGroupResourceGroup401Response = GenericUnauthenticatedResponse
 
-func (response GetV1TaskIdLogs401Response) VisitGetV1TaskIdLogsResponse(w http.ResponseWriter) error {
+func (response DeleteV1RbacResourceGroupResourceGroup401Response) VisitDeleteV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetV1TaskIdLogs403Response = GenericForbiddenResponse
+type DeleteV1RbacResourceGroupResourceGroup403Response = GenericForbiddenResponse
 
-func (response GetV1TaskIdLogs403Response) VisitGetV1TaskIdLogsResponse(w http.ResponseWriter) error {
+func (response DeleteV1RbacResourceGroupResourceGroup403Response) VisitDeleteV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type GetV1TaskIdLogs404JSONResponse struct{ GenericNotFoundJSONResponse }
+type DeleteV1RbacResourceGroupResourceGroup404JSONResponse struct{ GenericNotFoundJSONResponse }
 
-func (response GetV1TaskIdLogs404JSONResponse) VisitGetV1TaskIdLogsResponse(w http.ResponseWriter) error {
+func (response DeleteV1RbacResourceGroupResourceGroup404JSONResponse) VisitDeleteV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1TaskIdLogs500Response = GenericInternalServerErrorResponse
+type DeleteV1RbacResourceGroupResourceGroup413JSONResponse struct{ GenericTooLargeJSONResponse }
 
-func (response GetV1TaskIdLogs500Response) VisitGetV1TaskIdLogsResponse(w http.ResponseWriter) error {
+func (response DeleteV1RbacResourceGroupResourceGroup413JSONResponse) VisitDeleteV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1RbacResourceGroupResourceGroup500Response = GenericInternalServerErrorResponse
+
+func (response DeleteV1RbacResourceGroupResourceGroup500Response) VisitDeleteV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type GetV1UserRequestObject struct {
-	Params GetV1UserParams
+type GetV1RbacResourceGroupResourceGroupRequestObject struct {
+	ResourceGroup string `json:"resourceGroup"`
 }
 
-type GetV1UserResponseObject interface {
-	VisitGetV1UserResponse(w http.ResponseWriter) error
+type GetV1RbacResourceGroupResourceGroupResponseObject interface {
+	VisitGetV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error
 }
 
-type GetV1User200JSONResponse []UserResponse
+type GetV1RbacResourceGroupResourceGroup200JSONResponse ResourceGroupResource
 
-func (response GetV1User200JSONResponse) VisitGetV1UserResponse(w http.ResponseWriter) error {
+func (response GetV1RbacResourceGroupResourceGroup200JSONResponse) VisitGetV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1User400JSONResponse struct{ GenericBadRequestJSONResponse }
+type GetV1RbacResourceGroupResourceGroup400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response GetV1User400JSONResponse) VisitGetV1UserResponse(w http.ResponseWriter) error {
+func (response GetV1RbacResourceGroupResourceGroup400JSONResponse) VisitGetV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1User401Response = GenericUnauthenticatedResponse
+type GetV1RbacResourceGroupResourceGroup401Response = GenericUnauthenticatedResponse
 
-func (response GetV1User401Response) VisitGetV1UserResponse(w http.ResponseWriter) error {
+func (response GetV1RbacResourceGroupResourceGroup401Response) VisitGetV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetV1User403Response = GenericForbiddenResponse
+type GetV1RbacResourceGroupResourceGroup403Response = GenericForbiddenResponse
 
-func (response GetV1User403Response) VisitGetV1UserResponse(w http.ResponseWriter) error {
+func (response GetV1RbacResourceGroupResourceGroup403Response) VisitGetV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type GetV1User413JSONResponse struct{ GenericTooLargeJSONResponse }
+type GetV1RbacResourceGroupResourceGroup404JSONResponse struct{ GenericNotFoundJSONResponse }
 
-func (response GetV1User413JSONResponse) VisitGetV1UserResponse(w http.ResponseWriter) error {
+func (response GetV1RbacResourceGroupResourceGroup404JSONResponse) VisitGetV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1RbacResourceGroupResourceGroup413JSONResponse struct{ GenericTooLargeJSONResponse }
+
+func (response GetV1RbacResourceGroupResourceGroup413JSONResponse) VisitGetV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1User500Response = GenericInternalServerErrorResponse
+type GetV1RbacResourceGroupResourceGroup500Response = GenericInternalServerErrorResponse
 
-func (response GetV1User500Response) VisitGetV1UserResponse(w http.ResponseWriter) error {
+func (response GetV1RbacResourceGroupResourceGroup500Response) VisitGetV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type DeleteV1UserMeRequestObject struct {
+type HeadV1RbacResourceGroupResourceGroupRequestObject struct {
+	ResourceGroup string `json:"resourceGroup"`
 }
 
-type DeleteV1UserMeResponseObject interface {
-	VisitDeleteV1UserMeResponse(w http.ResponseWriter) error
+type HeadV1RbacResourceGroupResourceGroupResponseObject interface {
+	VisitHeadV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error
 }
 
-type DeleteV1UserMe200JSONResponse UserResponse
+type HeadV1RbacResourceGroupResourceGroup200Response struct {
+}
 
-func (response DeleteV1UserMe200JSONResponse) VisitDeleteV1UserMeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response HeadV1RbacResourceGroupResourceGroup200Response) VisitHeadV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
+	return nil
+}
 
-	return json.NewEncoder(w).Encode(response)
+type HeadV1RbacResourceGroupResourceGroup400Response struct {
 }
 
-type DeleteV1UserMe401Response = GenericUnauthenticatedResponse
+func (response HeadV1RbacResourceGroupResourceGroup400Response) VisitHeadV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
 
-func (response DeleteV1UserMe401Response) VisitDeleteV1UserMeResponse(w http.ResponseWriter) error {
+type HeadV1RbacResourceGroupResourceGroup401Response struct {
+}
+
+func (response HeadV1RbacResourceGroupResourceGroup401Response) VisitHeadV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type DeleteV1UserMe403Response = GenericForbiddenResponse
+type HeadV1RbacResourceGroupResourceGroup403Response struct {
+}
 
-func (response DeleteV1UserMe403Response) VisitDeleteV1UserMeResponse(w http.ResponseWriter) error {
+func (response HeadV1RbacResourceGroupResourceGroup403Response) VisitHeadV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type DeleteV1UserMe413JSONResponse struct{ GenericTooLargeJSONResponse }
+type HeadV1RbacResourceGroupResourceGroup404Response struct {
+}
 
-func (response DeleteV1UserMe413JSONResponse) VisitDeleteV1UserMeResponse(w http.ResponseWriter) error {
+func (response HeadV1RbacResourceGroupResourceGroup404Response) VisitHeadV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type HeadV1RbacResourceGroupResourceGroup413JSONResponse struct{ GenericTooLargeJSONResponse }
+
+func (response HeadV1RbacResourceGroupResourceGroup413JSONResponse) VisitHeadV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteV1UserMe500Response = GenericInternalServerErrorResponse
+type HeadV1RbacResourceGroupResourceGroup500Response struct {
+}
 
-func (response DeleteV1UserMe500Response) VisitDeleteV1UserMeResponse(w http.ResponseWriter) error {
+func (response HeadV1RbacResourceGroupResourceGroup500Response) VisitHeadV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type GetV1UserMeRequestObject struct {
+type PutV1RbacResourceGroupResourceGroupRequestObject struct {
+	ResourceGroup string `json:"resourceGroup"`
+	Body          *PutV1RbacResourceGroupResourceGroupJSONRequestBody
 }
 
-type GetV1UserMeResponseObject interface {
-	VisitGetV1UserMeResponse(w http.ResponseWriter) error
+type PutV1RbacResourceGroupResourceGroupResponseObject interface {
+	VisitPutV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error
 }
 
-type GetV1UserMe200JSONResponse UserResponse
+type PutV1RbacResourceGroupResourceGroup201JSONResponse ResourceGroupResource
 
-func (response GetV1UserMe200JSONResponse) VisitGetV1UserMeResponse(w http.ResponseWriter) error {
+func (response PutV1RbacResourceGroupResourceGroup201JSONResponse) VisitPutV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1UserMe401Response = GenericUnauthenticatedResponse
+type PutV1RbacResourceGroupResourceGroup400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response GetV1UserMe401Response) VisitGetV1UserMeResponse(w http.ResponseWriter) error {
+func (response PutV1RbacResourceGroupResourceGroup400JSONResponse) VisitPutV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV1RbacResourceGroupResourceGroup401Response = GenericUnauthenticatedResponse
+
+func (response PutV1RbacResourceGroupResourceGroup401Response) VisitPutV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetV1UserMe403Response = GenericForbiddenResponse
+type PutV1RbacResourceGroupResourceGroup403Response = GenericForbiddenResponse
 
-func (response GetV1UserMe403Response) VisitGetV1UserMeResponse(w http.ResponseWriter) error {
+func (response PutV1RbacResourceGroupResourceGroup403Response) VisitPutV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type GetV1UserMe413JSONResponse struct{ GenericTooLargeJSONResponse }
+type PutV1RbacResourceGroupResourceGroup409JSONResponse ErrGeneric
 
-func (response GetV1UserMe413JSONResponse) VisitGetV1UserMeResponse(w http.ResponseWriter) error {
+func (response PutV1RbacResourceGroupResourceGroup409JSONResponse) VisitPutV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV1RbacResourceGroupResourceGroup413JSONResponse struct{ GenericTooLargeJSONResponse }
+
+func (response PutV1RbacResourceGroupResourceGroup413JSONResponse) VisitPutV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1UserMe500Response = GenericInternalServerErrorResponse
+type PutV1RbacResourceGroupResourceGroup500Response = GenericInternalServerErrorResponse
 
-func (response GetV1UserMe500Response) VisitGetV1UserMeResponse(w http.ResponseWriter) error {
+func (response PutV1RbacResourceGroupResourceGroup500Response) VisitPutV1RbacResourceGroupResourceGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type PatchV1UserMeRequestObject struct {
-	Body *PatchV1UserMeJSONRequestBody
+type GetV1RbacRoleRequestObject struct {
+	Params GetV1RbacRoleParams
 }
 
-type PatchV1UserMeResponseObject interface {
-	VisitPatchV1UserMeResponse(w http.ResponseWriter) error
+type GetV1RbacRoleResponseObject interface {
+	VisitGetV1RbacRoleResponse(w http.ResponseWriter) error
 }
 
-type PatchV1UserMe200JSONResponse UserResponse
+type GetV1RbacRole200JSONResponse []RoleResource
 
-func (response PatchV1UserMe200JSONResponse) VisitPatchV1UserMeResponse(w http.ResponseWriter) error {
+func (response GetV1RbacRole200JSONResponse) VisitGetV1RbacRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchV1UserMe400JSONResponse struct{ GenericBadRequestJSONResponse }
-
-func (response PatchV1UserMe400JSONResponse) VisitPatchV1UserMeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type PatchV1UserMe401Response = GenericUnauthenticatedResponse
+type GetV1RbacRole401Response = GenericUnauthenticatedResponse
 
-func (response PatchV1UserMe401Response) VisitPatchV1UserMeResponse(w http.ResponseWriter) error {
+func (response GetV1RbacRole401Response) VisitGetV1RbacRoleResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type PatchV1UserMe403Response = GenericForbiddenResponse
+type GetV1RbacRole403Response = GenericForbiddenResponse
 
-func (response PatchV1UserMe403Response) VisitPatchV1UserMeResponse(w http.ResponseWriter) error {
+func (response GetV1RbacRole403Response) VisitGetV1RbacRoleResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type PatchV1UserMe409JSONResponse ErrGeneric
-
-func (response PatchV1UserMe409JSONResponse) VisitPatchV1UserMeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type PatchV1UserMe413JSONResponse struct{ GenericTooLargeJSONResponse }
+type GetV1RbacRole413JSONResponse struct{ GenericTooLargeJSONResponse }
 
-func (response PatchV1UserMe413JSONResponse) VisitPatchV1UserMeResponse(w http.ResponseWriter) error {
+func (response GetV1RbacRole413JSONResponse) VisitGetV1RbacRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchV1UserMe500Response = GenericInternalServerErrorResponse
+type GetV1RbacRole500Response = GenericInternalServerErrorResponse
 
-func (response PatchV1UserMe500Response) VisitPatchV1UserMeResponse(w http.ResponseWriter) error {
+func (response GetV1RbacRole500Response) VisitGetV1RbacRoleResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type DeleteV1UserUsernameRequestObject struct {
-	Username string `json:"username"`
+type DeleteV1RbacRoleRoleRequestObject struct {
+	Role string `json:"role"`
 }
 
-type DeleteV1UserUsernameResponseObject interface {
-	VisitDeleteV1UserUsernameResponse(w http.ResponseWriter) error
+type DeleteV1RbacRoleRoleResponseObject interface {
+	VisitDeleteV1RbacRoleRoleResponse(w http.ResponseWriter) error
 }
 
-type DeleteV1UserUsername200JSONResponse UserResponse
+type DeleteV1RbacRoleRole200JSONResponse RoleResource
 
-func (response DeleteV1UserUsername200JSONResponse) VisitDeleteV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response DeleteV1RbacRoleRole200JSONResponse) VisitDeleteV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteV1UserUsername400JSONResponse struct{ GenericBadRequestJSONResponse }
+type DeleteV1RbacRoleRole400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response DeleteV1UserUsername400JSONResponse) VisitDeleteV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response DeleteV1RbacRoleRole400JSONResponse) VisitDeleteV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteV1UserUsername401Response = GenericUnauthenticatedResponse
+type DeleteV1RbacRoleRole401Response = GenericUnauthenticatedResponse
 
-func (response DeleteV1UserUsername401Response) VisitDeleteV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response DeleteV1RbacRoleRole401Response) VisitDeleteV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type DeleteV1UserUsername403Response = GenericForbiddenResponse
+type DeleteV1RbacRoleRole403Response = GenericForbiddenResponse
 
-func (response DeleteV1UserUsername403Response) VisitDeleteV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response DeleteV1RbacRoleRole403Response) VisitDeleteV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type DeleteV1UserUsername404JSONResponse struct{ GenericNotFoundJSONResponse }
+type DeleteV1RbacRoleRole404JSONResponse struct{ GenericNotFoundJSONResponse }
 
-func (response DeleteV1UserUsername404JSONResponse) VisitDeleteV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response DeleteV1RbacRoleRole404JSONResponse) VisitDeleteV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteV1UserUsername413JSONResponse struct{ GenericTooLargeJSONResponse }
+type DeleteV1RbacRoleRole409JSONResponse ErrGeneric
 
-func (response DeleteV1UserUsername413JSONResponse) VisitDeleteV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response DeleteV1RbacRoleRole409JSONResponse) VisitDeleteV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1RbacRoleRole413JSONResponse struct{ GenericTooLargeJSONResponse }
+
+func (response DeleteV1RbacRoleRole413JSONResponse) VisitDeleteV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteV1UserUsername500Response = GenericInternalServerErrorResponse
+type DeleteV1RbacRoleRole500Response = GenericInternalServerErrorResponse
 
-func (response DeleteV1UserUsername500Response) VisitDeleteV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response DeleteV1RbacRoleRole500Response) VisitDeleteV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type GetV1UserUsernameRequestObject struct {
-	Username string `json:"username"`
+type GetV1RbacRoleRoleRequestObject struct {
+	Role string `json:"role"`
 }
 
-type GetV1UserUsernameResponseObject interface {
-	VisitGetV1UserUsernameResponse(w http.ResponseWriter) error
+type GetV1RbacRoleRoleResponseObject interface {
+	VisitGetV1RbacRoleRoleResponse(w http.ResponseWriter) error
 }
 
-type GetV1UserUsername200JSONResponse UserResponse
+type GetV1RbacRoleRole200JSONResponse RoleResource
 
-func (response GetV1UserUsername200JSONResponse) VisitGetV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response GetV1RbacRoleRole200JSONResponse) VisitGetV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1UserUsername400JSONResponse struct{ GenericBadRequestJSONResponse }
+type GetV1RbacRoleRole400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response GetV1UserUsername400JSONResponse) VisitGetV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response GetV1RbacRoleRole400JSONResponse) VisitGetV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1UserUsername401Response = GenericUnauthenticatedResponse
+type GetV1RbacRoleRole401Response = GenericUnauthenticatedResponse
 
-func (response GetV1UserUsername401Response) VisitGetV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response GetV1RbacRoleRole401Response) VisitGetV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetV1UserUsername403Response = GenericForbiddenResponse
+type GetV1RbacRoleRole403Response = GenericForbiddenResponse
 
-func (response GetV1UserUsername403Response) VisitGetV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response GetV1RbacRoleRole403Response) VisitGetV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type GetV1UserUsername404JSONResponse struct{ GenericNotFoundJSONResponse }
+type GetV1RbacRoleRole404JSONResponse struct{ GenericNotFoundJSONResponse }
 
-func (response GetV1UserUsername404JSONResponse) VisitGetV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response GetV1RbacRoleRole404JSONResponse) VisitGetV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1UserUsername413JSONResponse struct{ GenericTooLargeJSONResponse }
+type GetV1RbacRoleRole413JSONResponse struct{ GenericTooLargeJSONResponse }
 
-func (response GetV1UserUsername413JSONResponse) VisitGetV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response GetV1RbacRoleRole413JSONResponse) VisitGetV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetV1UserUsername500Response = GenericInternalServerErrorResponse
+type GetV1RbacRoleRole500Response = GenericInternalServerErrorResponse
 
-func (response GetV1UserUsername500Response) VisitGetV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response GetV1RbacRoleRole500Response) VisitGetV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type HeadV1UserUsernameRequestObject struct {
-	Username string `json:"username"`
+type HeadV1RbacRoleRoleRequestObject struct {
+	Role string `json:"role"`
 }
 
-type HeadV1UserUsernameResponseObject interface {
-	VisitHeadV1UserUsernameResponse(w http.ResponseWriter) error
+type HeadV1RbacRoleRoleResponseObject interface {
+	VisitHeadV1RbacRoleRoleResponse(w http.ResponseWriter) error
 }
 
-type HeadV1UserUsername200Response struct {
+type HeadV1RbacRoleRole200Response struct {
 }
 
-func (response HeadV1UserUsername200Response) VisitHeadV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response HeadV1RbacRoleRole200Response) VisitHeadV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type HeadV1UserUsername400Response struct {
+type HeadV1RbacRoleRole400Response struct {
 }
 
-func (response HeadV1UserUsername400Response) VisitHeadV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response HeadV1RbacRoleRole400Response) VisitHeadV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type HeadV1UserUsername401Response struct {
+type HeadV1RbacRoleRole401Response struct {
 }
 
-func (response HeadV1UserUsername401Response) VisitHeadV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response HeadV1RbacRoleRole401Response) VisitHeadV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type HeadV1UserUsername403Response struct {
+type HeadV1RbacRoleRole403Response struct {
 }
 
-func (response HeadV1UserUsername403Response) VisitHeadV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response HeadV1RbacRoleRole403Response) VisitHeadV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type HeadV1UserUsername404Response struct {
+type HeadV1RbacRoleRole404Response struct {
 }
 
-func (response HeadV1UserUsername404Response) VisitHeadV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response HeadV1RbacRoleRole404Response) VisitHeadV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type HeadV1UserUsername413JSONResponse struct{ GenericTooLargeJSONResponse }
+type HeadV1RbacRoleRole413JSONResponse struct{ GenericTooLargeJSONResponse }
 
-func (response HeadV1UserUsername413JSONResponse) VisitHeadV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response HeadV1RbacRoleRole413JSONResponse) VisitHeadV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type HeadV1UserUsername500Response struct {
+type HeadV1RbacRoleRole500Response struct {
 }
 
-func (response HeadV1UserUsername500Response) VisitHeadV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response HeadV1RbacRoleRole500Response) VisitHeadV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type PatchV1UserUsernameRequestObject struct {
-	Username string `json:"username"`
-	Body     *PatchV1UserUsernameJSONRequestBody
+type PutV1RbacRoleRoleRequestObject struct {
+	Role string `json:"role"`
+	Body *PutV1RbacRoleRoleJSONRequestBody
 }
 
-type PatchV1UserUsernameResponseObject interface {
-	VisitPatchV1UserUsernameResponse(w http.ResponseWriter) error
+type PutV1RbacRoleRoleResponseObject interface {
+	VisitPutV1RbacRoleRoleResponse(w http.ResponseWriter) error
 }
 
-type PatchV1UserUsername200JSONResponse UserResponse
+type PutV1RbacRoleRole201JSONResponse RoleResource
 
-func (response PatchV1UserUsername200JSONResponse) VisitPatchV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response PutV1RbacRoleRole201JSONResponse) VisitPutV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchV1UserUsername400JSONResponse struct{ GenericBadRequestJSONResponse }
+type PutV1RbacRoleRole400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response PatchV1UserUsername400JSONResponse) VisitPatchV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response PutV1RbacRoleRole400JSONResponse) VisitPutV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchV1UserUsername401Response = GenericUnauthenticatedResponse
+type PutV1RbacRoleRole401Response = GenericUnauthenticatedResponse
 
-func (response PatchV1UserUsername401Response) VisitPatchV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response PutV1RbacRoleRole401Response) VisitPutV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type PatchV1UserUsername403Response = GenericForbiddenResponse
+type PutV1RbacRoleRole403Response = GenericForbiddenResponse
 
-func (response PatchV1UserUsername403Response) VisitPatchV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response PutV1RbacRoleRole403Response) VisitPutV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type PatchV1UserUsername404JSONResponse struct{ GenericNotFoundJSONResponse }
+type PutV1RbacRoleRole409JSONResponse ErrGeneric
 
-func (response PatchV1UserUsername404JSONResponse) VisitPatchV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response PutV1RbacRoleRole409JSONResponse) VisitPutV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchV1UserUsername409JSONResponse ErrGeneric
+type PutV1RbacRoleRole413JSONResponse struct{ GenericTooLargeJSONResponse }
 
-func (response PatchV1UserUsername409JSONResponse) VisitPatchV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response PutV1RbacRoleRole413JSONResponse) VisitPutV1RbacRoleRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchV1UserUsername413JSONResponse struct{ GenericTooLargeJSONResponse }
+type PutV1RbacRoleRole500Response = GenericInternalServerErrorResponse
 
-func (response PatchV1UserUsername413JSONResponse) VisitPatchV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response PutV1RbacRoleRole500Response) VisitPutV1RbacRoleRoleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetV1ScheduleRequestObject struct {
+	Params GetV1ScheduleParams
+}
+
+type GetV1ScheduleResponseObject interface {
+	VisitGetV1ScheduleResponse(w http.ResponseWriter) error
+}
+
+type GetV1Schedule200JSONResponse []Schedule
+
+func (response GetV1Schedule200JSONResponse) VisitGetV1ScheduleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(413)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchV1UserUsername500Response = GenericInternalServerErrorResponse
+type GetV1Schedule400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response PatchV1UserUsername500Response) VisitPatchV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response GetV1Schedule400JSONResponse) VisitGetV1ScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1Schedule401Response = GenericUnauthenticatedResponse
+
+func (response GetV1Schedule401Response) VisitGetV1ScheduleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetV1Schedule403Response = GenericForbiddenResponse
+
+func (response GetV1Schedule403Response) VisitGetV1ScheduleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetV1Schedule500Response = GenericInternalServerErrorResponse
+
+func (response GetV1Schedule500Response) VisitGetV1ScheduleResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type PutV1UserUsernameRequestObject struct {
-	Username string `json:"username"`
-	Body     *PutV1UserUsernameJSONRequestBody
+type PostV1ScheduleRequestObject struct {
+	Body *PostV1ScheduleJSONRequestBody
 }
 
-type PutV1UserUsernameResponseObject interface {
-	VisitPutV1UserUsernameResponse(w http.ResponseWriter) error
+type PostV1ScheduleResponseObject interface {
+	VisitPostV1ScheduleResponse(w http.ResponseWriter) error
 }
 
-type PutV1UserUsername201JSONResponse UserResponse
+type PostV1Schedule201JSONResponse Schedule
 
-func (response PutV1UserUsername201JSONResponse) VisitPutV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response PostV1Schedule201JSONResponse) VisitPostV1ScheduleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutV1UserUsername400JSONResponse struct{ GenericBadRequestJSONResponse }
+type PostV1Schedule400JSONResponse struct{ GenericBadRequestJSONResponse }
 
-func (response PutV1UserUsername400JSONResponse) VisitPutV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response PostV1Schedule400JSONResponse) VisitPostV1ScheduleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutV1UserUsername401Response = GenericUnauthenticatedResponse
+type PostV1Schedule401Response = GenericUnauthenticatedResponse
 
-func (response PutV1UserUsername401Response) VisitPutV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response PostV1Schedule401Response) VisitPostV1ScheduleResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type PutV1UserUsername403Response = GenericForbiddenResponse
+type PostV1Schedule403Response = GenericForbiddenResponse
 
-func (response PutV1UserUsername403Response) VisitPutV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response PostV1Schedule403Response) VisitPostV1ScheduleResponse(w http.ResponseWriter) error {
 	w.WriteHeader(403)
 	return nil
 }
 
-type PutV1UserUsername409JSONResponse ErrGeneric
+type PostV1Schedule409JSONResponse ErrGeneric
 
-func (response PutV1UserUsername409JSONResponse) VisitPutV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response PostV1Schedule409JSONResponse) VisitPostV1ScheduleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutV1UserUsername413JSONResponse struct{ GenericTooLargeJSONResponse }
+type PostV1Schedule422JSONResponse struct{ FieldErrorJSONResponse }
 
-func (response PutV1UserUsername413JSONResponse) VisitPutV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response PostV1Schedule422JSONResponse) VisitPostV1ScheduleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(413)
+	w.WriteHeader(422)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutV1UserUsername500Response = GenericInternalServerErrorResponse
+type PostV1Schedule500Response = GenericInternalServerErrorResponse
 
-func (response PutV1UserUsername500Response) VisitPutV1UserUsernameResponse(w http.ResponseWriter) error {
+func (response PostV1Schedule500Response) VisitPostV1ScheduleResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// List Artifact Namespaces
-	// (GET /v1/artifact)
-	GetV1Artifact(ctx context.Context, request GetV1ArtifactRequestObject) (GetV1ArtifactResponseObject, error)
-	// Upload Artifact
-	// (POST /v1/artifact/raw/{namespace}/{name})
-	PostV1ArtifactRawNamespaceName(ctx context.Context, request PostV1ArtifactRawNamespaceNameRequestObject) (PostV1ArtifactRawNamespaceNameResponseObject, error)
-	// Download Artifact by Hash
-	// (GET /v1/artifact/raw/{namespace}/{name}/hash/{hash})
-	GetV1ArtifactRawNamespaceNameHashHash(ctx context.Context, request GetV1ArtifactRawNamespaceNameHashHashRequestObject) (GetV1ArtifactRawNamespaceNameHashHashResponseObject, error)
-	// Download Artifact by Tag
-	// (GET /v1/artifact/raw/{namespace}/{name}/tag/{tag})
-	GetV1ArtifactRawNamespaceNameTagTag(ctx context.Context, request GetV1ArtifactRawNamespaceNameTagTagRequestObject) (GetV1ArtifactRawNamespaceNameTagTagResponseObject, error)
-	// List Artifacts in Namespace
-	// (GET /v1/artifact/{namespace})
-	GetV1ArtifactNamespace(ctx context.Context, request GetV1ArtifactNamespaceRequestObject) (GetV1ArtifactNamespaceResponseObject, error)
-	// List Artifact Versions
-	// (GET /v1/artifact/{namespace}/{name})
-	GetV1ArtifactNamespaceName(ctx context.Context, request GetV1ArtifactNamespaceNameRequestObject) (GetV1ArtifactNamespaceNameResponseObject, error)
-	// Delete Artifact by Hash
-	// (DELETE /v1/artifact/{namespace}/{name}/hash/{hash})
-	DeleteV1ArtifactNamespaceNameHashHash(ctx context.Context, request DeleteV1ArtifactNamespaceNameHashHashRequestObject) (DeleteV1ArtifactNamespaceNameHashHashResponseObject, error)
-	// Retrieve Artifact Metadata by Hash
-	// (GET /v1/artifact/{namespace}/{name}/hash/{hash})
-	GetV1ArtifactNamespaceNameHashHash(ctx context.Context, request GetV1ArtifactNamespaceNameHashHashRequestObject) (GetV1ArtifactNamespaceNameHashHashResponseObject, error)
-	// Patch Artifact Metadata by Hash
-	// (PATCH /v1/artifact/{namespace}/{name}/hash/{hash})
-	PatchV1ArtifactNamespaceNameHashHash(ctx context.Context, request PatchV1ArtifactNamespaceNameHashHashRequestObject) (PatchV1ArtifactNamespaceNameHashHashResponseObject, error)
-	// Delete Artifact by Tag
-	// (DELETE /v1/artifact/{namespace}/{name}/tag/{tag})
-	DeleteV1ArtifactNamespaceNameTagTag(ctx context.Context, request DeleteV1ArtifactNamespaceNameTagTagRequestObject) (DeleteV1ArtifactNamespaceNameTagTagResponseObject, error)
-	// Retrieve Artifact Metadata by Tag
-	// (GET /v1/artifact/{namespace}/{name}/tag/{tag})
-	GetV1ArtifactNamespaceNameTagTag(ctx context.Context, request GetV1ArtifactNamespaceNameTagTagRequestObject) (GetV1ArtifactNamespaceNameTagTagResponseObject, error)
-	// Patch Artifact Metadata by Tag
-	// (PATCH /v1/artifact/{namespace}/{name}/tag/{tag})
-	PatchV1ArtifactNamespaceNameTagTag(ctx context.Context, request PatchV1ArtifactNamespaceNameTagTagRequestObject) (PatchV1ArtifactNamespaceNameTagTagResponseObject, error)
-	// Delete RBAC Policy
-	// (DELETE /v1/rbac/policy)
-	DeleteV1RbacPolicy(ctx context.Context, request DeleteV1RbacPolicyRequestObject) (DeleteV1RbacPolicyResponseObject, error)
-	// List RBAC Policies
-	// (GET /v1/rbac/policy)
-	GetV1RbacPolicy(ctx context.Context, request GetV1RbacPolicyRequestObject) (GetV1RbacPolicyResponseObject, error)
-	// Create or Replace RBAC Policy
-	// (PUT /v1/rbac/policy)
-	PutV1RbacPolicy(ctx context.Context, request PutV1RbacPolicyRequestObject) (PutV1RbacPolicyResponseObject, error)
-	// List All Resource Groups
-	// (GET /v1/rbac/resource-group)
-	GetV1RbacResourceGroup(ctx context.Context, request GetV1RbacResourceGroupRequestObject) (GetV1RbacResourceGroupResponseObject, error)
-	// Delete Resource Group
-	// (DELETE /v1/rbac/resource-group/{resourceGroup})
-	DeleteV1RbacResourceGroupResourceGroup(ctx context.Context, request DeleteV1RbacResourceGroupResourceGroupRequestObject) (DeleteV1RbacResourceGroupResourceGroupResponseObject, error)
-	// Get Endpoints Assigned to Resource Group
-	// (GET /v1/rbac/resource-group/{resourceGroup})
-	GetV1RbacResourceGroupResourceGroup(ctx context.Context, request GetV1RbacResourceGroupResourceGroupRequestObject) (GetV1RbacResourceGroupResourceGroupResponseObject, error)
-	// Check Resource Group Existence
-	// (HEAD /v1/rbac/resource-group/{resourceGroup})
-	HeadV1RbacResourceGroupResourceGroup(ctx context.Context, request HeadV1RbacResourceGroupResourceGroupRequestObject) (HeadV1RbacResourceGroupResourceGroupResponseObject, error)
-	// Create or Replace Resource Group
-	// (PUT /v1/rbac/resource-group/{resourceGroup})
-	PutV1RbacResourceGroupResourceGroup(ctx context.Context, request PutV1RbacResourceGroupResourceGroupRequestObject) (PutV1RbacResourceGroupResourceGroupResponseObject, error)
-	// List All Roles
+type DeleteV1ScheduleIdRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteV1ScheduleIdResponseObject interface {
+	VisitDeleteV1ScheduleIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteV1ScheduleId200JSONResponse Schedule
+
+func (response DeleteV1ScheduleId200JSONResponse) VisitDeleteV1ScheduleIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1ScheduleId400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response DeleteV1ScheduleId400JSONResponse) VisitDeleteV1ScheduleIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1ScheduleId401Response = GenericUnauthenticatedResponse
+
+func (response DeleteV1ScheduleId401Response) VisitDeleteV1ScheduleIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteV1ScheduleId403Response = GenericForbiddenResponse
+
+func (response DeleteV1ScheduleId403Response) VisitDeleteV1ScheduleIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type DeleteV1ScheduleId404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response DeleteV1ScheduleId404JSONResponse) VisitDeleteV1ScheduleIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1ScheduleId500Response = GenericInternalServerErrorResponse
+
+func (response DeleteV1ScheduleId500Response) VisitDeleteV1ScheduleIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetV1ScheduleIdRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetV1ScheduleIdResponseObject interface {
+	VisitGetV1ScheduleIdResponse(w http.ResponseWriter) error
+}
+
+type GetV1ScheduleId200JSONResponse Schedule
+
+func (response GetV1ScheduleId200JSONResponse) VisitGetV1ScheduleIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1ScheduleId400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response GetV1ScheduleId400JSONResponse) VisitGetV1ScheduleIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1ScheduleId401Response = GenericUnauthenticatedResponse
+
+func (response GetV1ScheduleId401Response) VisitGetV1ScheduleIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetV1ScheduleId403Response = GenericForbiddenResponse
+
+func (response GetV1ScheduleId403Response) VisitGetV1ScheduleIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetV1ScheduleId404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response GetV1ScheduleId404JSONResponse) VisitGetV1ScheduleIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1ScheduleId500Response = GenericInternalServerErrorResponse
+
+func (response GetV1ScheduleId500Response) VisitGetV1ScheduleIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PatchV1ScheduleIdRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *PatchV1ScheduleIdJSONRequestBody
+}
+
+type PatchV1ScheduleIdResponseObject interface {
+	VisitPatchV1ScheduleIdResponse(w http.ResponseWriter) error
+}
+
+type PatchV1ScheduleId200JSONResponse Schedule
+
+func (response PatchV1ScheduleId200JSONResponse) VisitPatchV1ScheduleIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV1ScheduleId400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response PatchV1ScheduleId400JSONResponse) VisitPatchV1ScheduleIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV1ScheduleId401Response = GenericUnauthenticatedResponse
+
+func (response PatchV1ScheduleId401Response) VisitPatchV1ScheduleIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PatchV1ScheduleId403Response = GenericForbiddenResponse
+
+func (response PatchV1ScheduleId403Response) VisitPatchV1ScheduleIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PatchV1ScheduleId404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response PatchV1ScheduleId404JSONResponse) VisitPatchV1ScheduleIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV1ScheduleId409JSONResponse ErrGeneric
+
+func (response PatchV1ScheduleId409JSONResponse) VisitPatchV1ScheduleIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV1ScheduleId422JSONResponse struct{ FieldErrorJSONResponse }
+
+func (response PatchV1ScheduleId422JSONResponse) VisitPatchV1ScheduleIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV1ScheduleId500Response = GenericInternalServerErrorResponse
+
+func (response PatchV1ScheduleId500Response) VisitPatchV1ScheduleIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetV1ScheduleIdHistoryRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetV1ScheduleIdHistoryParams
+}
+
+type GetV1ScheduleIdHistoryResponseObject interface {
+	VisitGetV1ScheduleIdHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetV1ScheduleIdHistory200JSONResponse []ScheduleRun
+
+func (response GetV1ScheduleIdHistory200JSONResponse) VisitGetV1ScheduleIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1ScheduleIdHistory400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response GetV1ScheduleIdHistory400JSONResponse) VisitGetV1ScheduleIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1ScheduleIdHistory401Response = GenericUnauthenticatedResponse
+
+func (response GetV1ScheduleIdHistory401Response) VisitGetV1ScheduleIdHistoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetV1ScheduleIdHistory403Response = GenericForbiddenResponse
+
+func (response GetV1ScheduleIdHistory403Response) VisitGetV1ScheduleIdHistoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetV1ScheduleIdHistory404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response GetV1ScheduleIdHistory404JSONResponse) VisitGetV1ScheduleIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1ScheduleIdHistory500Response = GenericInternalServerErrorResponse
+
+func (response GetV1ScheduleIdHistory500Response) VisitGetV1ScheduleIdHistoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PostV1ScheduleIdPauseRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type PostV1ScheduleIdPauseResponseObject interface {
+	VisitPostV1ScheduleIdPauseResponse(w http.ResponseWriter) error
+}
+
+type PostV1ScheduleIdPause200JSONResponse Schedule
+
+func (response PostV1ScheduleIdPause200JSONResponse) VisitPostV1ScheduleIdPauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1ScheduleIdPause400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response PostV1ScheduleIdPause400JSONResponse) VisitPostV1ScheduleIdPauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1ScheduleIdPause401Response = GenericUnauthenticatedResponse
+
+func (response PostV1ScheduleIdPause401Response) VisitPostV1ScheduleIdPauseResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostV1ScheduleIdPause403Response = GenericForbiddenResponse
+
+func (response PostV1ScheduleIdPause403Response) VisitPostV1ScheduleIdPauseResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostV1ScheduleIdPause404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response PostV1ScheduleIdPause404JSONResponse) VisitPostV1ScheduleIdPauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1ScheduleIdPause500Response = GenericInternalServerErrorResponse
+
+func (response PostV1ScheduleIdPause500Response) VisitPostV1ScheduleIdPauseResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PostV1ScheduleIdResumeRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type PostV1ScheduleIdResumeResponseObject interface {
+	VisitPostV1ScheduleIdResumeResponse(w http.ResponseWriter) error
+}
+
+type PostV1ScheduleIdResume200JSONResponse Schedule
+
+func (response PostV1ScheduleIdResume200JSONResponse) VisitPostV1ScheduleIdResumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1ScheduleIdResume400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response PostV1ScheduleIdResume400JSONResponse) VisitPostV1ScheduleIdResumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1ScheduleIdResume401Response = GenericUnauthenticatedResponse
+
+func (response PostV1ScheduleIdResume401Response) VisitPostV1ScheduleIdResumeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostV1ScheduleIdResume403Response = GenericForbiddenResponse
+
+func (response PostV1ScheduleIdResume403Response) VisitPostV1ScheduleIdResumeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostV1ScheduleIdResume404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response PostV1ScheduleIdResume404JSONResponse) VisitPostV1ScheduleIdResumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1ScheduleIdResume500Response = GenericInternalServerErrorResponse
+
+func (response PostV1ScheduleIdResume500Response) VisitPostV1ScheduleIdResumeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetV1TaskRequestObject struct {
+	Params GetV1TaskParams
+}
+
+type GetV1TaskResponseObject interface {
+	VisitGetV1TaskResponse(w http.ResponseWriter) error
+}
+
+type GetV1Task200ResponseHeaders struct {
+	XNextCursor string
+	XTotalCount int
+}
+
+type GetV1Task200JSONResponse struct {
+	Body    []Task
+	Headers GetV1Task200ResponseHeaders
+}
+
+func (response GetV1Task200JSONResponse) VisitGetV1TaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Next-Cursor", fmt.Sprint(response.Headers.XNextCursor))
+	w.Header().Set("X-Total-Count", fmt.Sprint(response.Headers.XTotalCount))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1Task400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response GetV1Task400JSONResponse) VisitGetV1TaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1Task401Response = GenericUnauthenticatedResponse
+
+func (response GetV1Task401Response) VisitGetV1TaskResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetV1Task403Response = GenericForbiddenResponse
+
+func (response GetV1Task403Response) VisitGetV1TaskResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetV1Task500Response = GenericInternalServerErrorResponse
+
+func (response GetV1Task500Response) VisitGetV1TaskResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PostV1TaskRequestObject struct {
+	Params PostV1TaskParams
+	Body   *PostV1TaskJSONRequestBody
+}
+
+type PostV1TaskResponseObject interface {
+	VisitPostV1TaskResponse(w http.ResponseWriter) error
+}
+
+type PostV1Task200JSONResponse Task
+
+func (response PostV1Task200JSONResponse) VisitPostV1TaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1Task201JSONResponse Task
+
+func (response PostV1Task201JSONResponse) VisitPostV1TaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1Task400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response PostV1Task400JSONResponse) VisitPostV1TaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1Task401Response = GenericUnauthenticatedResponse
+
+func (response PostV1Task401Response) VisitPostV1TaskResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostV1Task403Response = GenericForbiddenResponse
+
+func (response PostV1Task403Response) VisitPostV1TaskResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostV1Task409JSONResponse ErrGeneric
+
+func (response PostV1Task409JSONResponse) VisitPostV1TaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1Task413JSONResponse struct{ GenericTooLargeJSONResponse }
+
+func (response PostV1Task413JSONResponse) VisitPostV1TaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1Task422JSONResponse struct{ FieldErrorJSONResponse }
+
+func (response PostV1Task422JSONResponse) VisitPostV1TaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1Task500Response = GenericInternalServerErrorResponse
+
+func (response PostV1Task500Response) VisitPostV1TaskResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetV1TaskGroupIdRequestObject struct {
+	Id     string `json:"id"`
+	Params GetV1TaskGroupIdParams
+}
+
+type GetV1TaskGroupIdResponseObject interface {
+	VisitGetV1TaskGroupIdResponse(w http.ResponseWriter) error
+}
+
+type GetV1TaskGroupId200ResponseHeaders struct {
+	XNextCursor string
+}
+
+type GetV1TaskGroupId200JSONResponse struct {
+	Body    TaskGroup
+	Headers GetV1TaskGroupId200ResponseHeaders
+}
+
+func (response GetV1TaskGroupId200JSONResponse) VisitGetV1TaskGroupIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Next-Cursor", fmt.Sprint(response.Headers.XNextCursor))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1TaskGroupId400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response GetV1TaskGroupId400JSONResponse) VisitGetV1TaskGroupIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskGroupId401Response = GenericUnauthenticatedResponse
+
+func (response GetV1TaskGroupId401Response) VisitGetV1TaskGroupIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetV1TaskGroupId403Response = GenericForbiddenResponse
+
+func (response GetV1TaskGroupId403Response) VisitGetV1TaskGroupIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetV1TaskGroupId404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response GetV1TaskGroupId404JSONResponse) VisitGetV1TaskGroupIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskGroupId500Response = GenericInternalServerErrorResponse
+
+func (response GetV1TaskGroupId500Response) VisitGetV1TaskGroupIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PostV1TaskGroupIdCancelRequestObject struct {
+	Id string `json:"id"`
+}
+
+type PostV1TaskGroupIdCancelResponseObject interface {
+	VisitPostV1TaskGroupIdCancelResponse(w http.ResponseWriter) error
+}
+
+type PostV1TaskGroupIdCancel200JSONResponse CancelTaskGroupResponse
+
+func (response PostV1TaskGroupIdCancel200JSONResponse) VisitPostV1TaskGroupIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TaskGroupIdCancel400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response PostV1TaskGroupIdCancel400JSONResponse) VisitPostV1TaskGroupIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TaskGroupIdCancel401Response = GenericUnauthenticatedResponse
+
+func (response PostV1TaskGroupIdCancel401Response) VisitPostV1TaskGroupIdCancelResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostV1TaskGroupIdCancel403Response = GenericForbiddenResponse
+
+func (response PostV1TaskGroupIdCancel403Response) VisitPostV1TaskGroupIdCancelResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostV1TaskGroupIdCancel404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response PostV1TaskGroupIdCancel404JSONResponse) VisitPostV1TaskGroupIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TaskGroupIdCancel500Response = GenericInternalServerErrorResponse
+
+func (response PostV1TaskGroupIdCancel500Response) VisitPostV1TaskGroupIdCancelResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PostV1TaskBatchRequestObject struct {
+	Body *PostV1TaskBatchJSONRequestBody
+}
+
+type PostV1TaskBatchResponseObject interface {
+	VisitPostV1TaskBatchResponse(w http.ResponseWriter) error
+}
+
+type PostV1TaskBatch201JSONResponse CreateTaskBatchResponse
+
+func (response PostV1TaskBatch201JSONResponse) VisitPostV1TaskBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TaskBatch400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response PostV1TaskBatch400JSONResponse) VisitPostV1TaskBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TaskBatch401Response = GenericUnauthenticatedResponse
+
+func (response PostV1TaskBatch401Response) VisitPostV1TaskBatchResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostV1TaskBatch403Response = GenericForbiddenResponse
+
+func (response PostV1TaskBatch403Response) VisitPostV1TaskBatchResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostV1TaskBatch413JSONResponse struct{ GenericTooLargeJSONResponse }
+
+func (response PostV1TaskBatch413JSONResponse) VisitPostV1TaskBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TaskBatch500Response = GenericInternalServerErrorResponse
+
+func (response PostV1TaskBatch500Response) VisitPostV1TaskBatchResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetV1TaskBatchIdRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetV1TaskBatchIdResponseObject interface {
+	VisitGetV1TaskBatchIdResponse(w http.ResponseWriter) error
+}
+
+type GetV1TaskBatchId200JSONResponse TaskBatch
+
+func (response GetV1TaskBatchId200JSONResponse) VisitGetV1TaskBatchIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskBatchId400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response GetV1TaskBatchId400JSONResponse) VisitGetV1TaskBatchIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskBatchId401Response = GenericUnauthenticatedResponse
+
+func (response GetV1TaskBatchId401Response) VisitGetV1TaskBatchIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetV1TaskBatchId403Response = GenericForbiddenResponse
+
+func (response GetV1TaskBatchId403Response) VisitGetV1TaskBatchIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetV1TaskBatchId404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response GetV1TaskBatchId404JSONResponse) VisitGetV1TaskBatchIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskBatchId500Response = GenericInternalServerErrorResponse
+
+func (response GetV1TaskBatchId500Response) VisitGetV1TaskBatchIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PostV1TaskMapRequestObject struct {
+	Body *PostV1TaskMapJSONRequestBody
+}
+
+type PostV1TaskMapResponseObject interface {
+	VisitPostV1TaskMapResponse(w http.ResponseWriter) error
+}
+
+type PostV1TaskMap201JSONResponse TaskMap
+
+func (response PostV1TaskMap201JSONResponse) VisitPostV1TaskMapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TaskMap400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response PostV1TaskMap400JSONResponse) VisitPostV1TaskMapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TaskMap401Response = GenericUnauthenticatedResponse
+
+func (response PostV1TaskMap401Response) VisitPostV1TaskMapResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostV1TaskMap403Response = GenericForbiddenResponse
+
+func (response PostV1TaskMap403Response) VisitPostV1TaskMapResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostV1TaskMap413JSONResponse struct{ GenericTooLargeJSONResponse }
+
+func (response PostV1TaskMap413JSONResponse) VisitPostV1TaskMapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TaskMap422JSONResponse struct{ FieldErrorJSONResponse }
+
+func (response PostV1TaskMap422JSONResponse) VisitPostV1TaskMapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TaskMap500Response = GenericInternalServerErrorResponse
+
+func (response PostV1TaskMap500Response) VisitPostV1TaskMapResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetV1TaskMapIdRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetV1TaskMapIdResponseObject interface {
+	VisitGetV1TaskMapIdResponse(w http.ResponseWriter) error
+}
+
+type GetV1TaskMapId200JSONResponse TaskMap
+
+func (response GetV1TaskMapId200JSONResponse) VisitGetV1TaskMapIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskMapId400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response GetV1TaskMapId400JSONResponse) VisitGetV1TaskMapIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskMapId401Response = GenericUnauthenticatedResponse
+
+func (response GetV1TaskMapId401Response) VisitGetV1TaskMapIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetV1TaskMapId403Response = GenericForbiddenResponse
+
+func (response GetV1TaskMapId403Response) VisitGetV1TaskMapIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetV1TaskMapId404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response GetV1TaskMapId404JSONResponse) VisitGetV1TaskMapIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskMapId500Response = GenericInternalServerErrorResponse
+
+func (response GetV1TaskMapId500Response) VisitGetV1TaskMapIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PostV1TaskRetryRequestObject struct {
+	Body *PostV1TaskRetryJSONRequestBody
+}
+
+type PostV1TaskRetryResponseObject interface {
+	VisitPostV1TaskRetryResponse(w http.ResponseWriter) error
+}
+
+type PostV1TaskRetry200JSONResponse RetryTasksResponse
+
+func (response PostV1TaskRetry200JSONResponse) VisitPostV1TaskRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TaskRetry400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response PostV1TaskRetry400JSONResponse) VisitPostV1TaskRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TaskRetry401Response = GenericUnauthenticatedResponse
+
+func (response PostV1TaskRetry401Response) VisitPostV1TaskRetryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostV1TaskRetry403Response = GenericForbiddenResponse
+
+func (response PostV1TaskRetry403Response) VisitPostV1TaskRetryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostV1TaskRetry500Response = GenericInternalServerErrorResponse
+
+func (response PostV1TaskRetry500Response) VisitPostV1TaskRetryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type DeleteV1TaskIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteV1TaskIdResponseObject interface {
+	VisitDeleteV1TaskIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteV1TaskId200JSONResponse Task
+
+func (response DeleteV1TaskId200JSONResponse) VisitDeleteV1TaskIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1TaskId400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response DeleteV1TaskId400JSONResponse) VisitDeleteV1TaskIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1TaskId401Response = GenericUnauthenticatedResponse
+
+func (response DeleteV1TaskId401Response) VisitDeleteV1TaskIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteV1TaskId403Response = GenericForbiddenResponse
+
+func (response DeleteV1TaskId403Response) VisitDeleteV1TaskIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type DeleteV1TaskId404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response DeleteV1TaskId404JSONResponse) VisitDeleteV1TaskIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1TaskId409JSONResponse ErrGeneric
+
+func (response DeleteV1TaskId409JSONResponse) VisitDeleteV1TaskIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1TaskId500Response = GenericInternalServerErrorResponse
+
+func (response DeleteV1TaskId500Response) VisitDeleteV1TaskIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetV1TaskIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetV1TaskIdResponseObject interface {
+	VisitGetV1TaskIdResponse(w http.ResponseWriter) error
+}
+
+type GetV1TaskId200JSONResponse Task
+
+func (response GetV1TaskId200JSONResponse) VisitGetV1TaskIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskId400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response GetV1TaskId400JSONResponse) VisitGetV1TaskIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskId401Response = GenericUnauthenticatedResponse
+
+func (response GetV1TaskId401Response) VisitGetV1TaskIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetV1TaskId403Response = GenericForbiddenResponse
+
+func (response GetV1TaskId403Response) VisitGetV1TaskIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetV1TaskId404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response GetV1TaskId404JSONResponse) VisitGetV1TaskIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskId500Response = GenericInternalServerErrorResponse
+
+func (response GetV1TaskId500Response) VisitGetV1TaskIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetV1TaskIdCallbackRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetV1TaskIdCallbackResponseObject interface {
+	VisitGetV1TaskIdCallbackResponse(w http.ResponseWriter) error
+}
+
+type GetV1TaskIdCallback200JSONResponse TaskCallback
+
+func (response GetV1TaskIdCallback200JSONResponse) VisitGetV1TaskIdCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskIdCallback400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response GetV1TaskIdCallback400JSONResponse) VisitGetV1TaskIdCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskIdCallback401Response = GenericUnauthenticatedResponse
+
+func (response GetV1TaskIdCallback401Response) VisitGetV1TaskIdCallbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetV1TaskIdCallback403Response = GenericForbiddenResponse
+
+func (response GetV1TaskIdCallback403Response) VisitGetV1TaskIdCallbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetV1TaskIdCallback404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response GetV1TaskIdCallback404JSONResponse) VisitGetV1TaskIdCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskIdCallback500Response = GenericInternalServerErrorResponse
+
+func (response GetV1TaskIdCallback500Response) VisitGetV1TaskIdCallbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PostV1TaskIdCancelRequestObject struct {
+	Id string `json:"id"`
+}
+
+type PostV1TaskIdCancelResponseObject interface {
+	VisitPostV1TaskIdCancelResponse(w http.ResponseWriter) error
+}
+
+type PostV1TaskIdCancel200JSONResponse Task
+
+func (response PostV1TaskIdCancel200JSONResponse) VisitPostV1TaskIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TaskIdCancel400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response PostV1TaskIdCancel400JSONResponse) VisitPostV1TaskIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TaskIdCancel401Response = GenericUnauthenticatedResponse
+
+func (response PostV1TaskIdCancel401Response) VisitPostV1TaskIdCancelResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostV1TaskIdCancel403Response = GenericForbiddenResponse
+
+func (response PostV1TaskIdCancel403Response) VisitPostV1TaskIdCancelResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostV1TaskIdCancel404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response PostV1TaskIdCancel404JSONResponse) VisitPostV1TaskIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TaskIdCancel409JSONResponse ErrGeneric
+
+func (response PostV1TaskIdCancel409JSONResponse) VisitPostV1TaskIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TaskIdCancel500Response = GenericInternalServerErrorResponse
+
+func (response PostV1TaskIdCancel500Response) VisitPostV1TaskIdCancelResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetV1TaskIdLogsRequestObject struct {
+	Id     string `json:"id"`
+	Params GetV1TaskIdLogsParams
+}
+
+type GetV1TaskIdLogsResponseObject interface {
+	VisitGetV1TaskIdLogsResponse(w http.ResponseWriter) error
+}
+
+type GetV1TaskIdLogs200ResponseHeaders struct {
+	XTotalCount int
+}
+
+type GetV1TaskIdLogs200JSONResponse struct {
+	Body    []TaskLog
+	Headers GetV1TaskIdLogs200ResponseHeaders
+}
+
+func (response GetV1TaskIdLogs200JSONResponse) VisitGetV1TaskIdLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Total-Count", fmt.Sprint(response.Headers.XTotalCount))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1TaskIdLogs400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response GetV1TaskIdLogs400JSONResponse) VisitGetV1TaskIdLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskIdLogs401Response = GenericUnauthenticatedResponse
+
+func (response GetV1TaskIdLogs401Response) VisitGetV1TaskIdLogsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetV1TaskIdLogs403Response = GenericForbiddenResponse
+
+func (response GetV1TaskIdLogs403Response) VisitGetV1TaskIdLogsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetV1TaskIdLogs404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response GetV1TaskIdLogs404JSONResponse) VisitGetV1TaskIdLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskIdLogs500Response = GenericInternalServerErrorResponse
+
+func (response GetV1TaskIdLogs500Response) VisitGetV1TaskIdLogsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PostV1TaskIdLogsRequestObject struct {
+	Id   string `json:"id"`
+	Body *PostV1TaskIdLogsJSONRequestBody
+}
+
+type PostV1TaskIdLogsResponseObject interface {
+	VisitPostV1TaskIdLogsResponse(w http.ResponseWriter) error
+}
+
+type PostV1TaskIdLogs204Response struct {
+}
+
+func (response PostV1TaskIdLogs204Response) VisitPostV1TaskIdLogsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostV1TaskIdLogs400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response PostV1TaskIdLogs400JSONResponse) VisitPostV1TaskIdLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TaskIdLogs401Response = GenericUnauthenticatedResponse
+
+func (response PostV1TaskIdLogs401Response) VisitPostV1TaskIdLogsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostV1TaskIdLogs403Response = GenericForbiddenResponse
+
+func (response PostV1TaskIdLogs403Response) VisitPostV1TaskIdLogsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostV1TaskIdLogs404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response PostV1TaskIdLogs404JSONResponse) VisitPostV1TaskIdLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TaskIdLogs413JSONResponse struct{ GenericTooLargeJSONResponse }
+
+func (response PostV1TaskIdLogs413JSONResponse) VisitPostV1TaskIdLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TaskIdLogs500Response = GenericInternalServerErrorResponse
+
+func (response PostV1TaskIdLogs500Response) VisitPostV1TaskIdLogsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetV1TaskIdLogsExportRequestObject struct {
+	Id     string `json:"id"`
+	Params GetV1TaskIdLogsExportParams
+}
+
+type GetV1TaskIdLogsExportResponseObject interface {
+	VisitGetV1TaskIdLogsExportResponse(w http.ResponseWriter) error
+}
+
+type GetV1TaskIdLogsExport200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetV1TaskIdLogsExport200ApplicationxNdjsonResponse) VisitGetV1TaskIdLogsExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1TaskIdLogsExport200TextResponse string
+
+func (response GetV1TaskIdLogsExport200TextResponse) VisitGetV1TaskIdLogsExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(200)
+
+	_, err := w.Write([]byte(response))
+	return err
+}
+
+type GetV1TaskIdLogsExport400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response GetV1TaskIdLogsExport400JSONResponse) VisitGetV1TaskIdLogsExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskIdLogsExport401Response = GenericUnauthenticatedResponse
+
+func (response GetV1TaskIdLogsExport401Response) VisitGetV1TaskIdLogsExportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetV1TaskIdLogsExport403Response = GenericForbiddenResponse
+
+func (response GetV1TaskIdLogsExport403Response) VisitGetV1TaskIdLogsExportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetV1TaskIdLogsExport404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response GetV1TaskIdLogsExport404JSONResponse) VisitGetV1TaskIdLogsExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskIdLogsExport500Response = GenericInternalServerErrorResponse
+
+func (response GetV1TaskIdLogsExport500Response) VisitGetV1TaskIdLogsExportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetV1TaskIdLogsStreamRequestObject struct {
+	Id     string `json:"id"`
+	Params GetV1TaskIdLogsStreamParams
+}
+
+type GetV1TaskIdLogsStreamResponseObject interface {
+	VisitGetV1TaskIdLogsStreamResponse(w http.ResponseWriter) error
+}
+
+type GetV1TaskIdLogsStream200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetV1TaskIdLogsStream200TexteventStreamResponse) VisitGetV1TaskIdLogsStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1TaskIdLogsStream400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response GetV1TaskIdLogsStream400JSONResponse) VisitGetV1TaskIdLogsStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskIdLogsStream401Response = GenericUnauthenticatedResponse
+
+func (response GetV1TaskIdLogsStream401Response) VisitGetV1TaskIdLogsStreamResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetV1TaskIdLogsStream403Response = GenericForbiddenResponse
+
+func (response GetV1TaskIdLogsStream403Response) VisitGetV1TaskIdLogsStreamResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetV1TaskIdLogsStream404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response GetV1TaskIdLogsStream404JSONResponse) VisitGetV1TaskIdLogsStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskIdLogsStream500Response = GenericInternalServerErrorResponse
+
+func (response GetV1TaskIdLogsStream500Response) VisitGetV1TaskIdLogsStreamResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetV1TaskIdResultRequestObject struct {
+	Id     string `json:"id"`
+	Params GetV1TaskIdResultParams
+}
+
+type GetV1TaskIdResultResponseObject interface {
+	VisitGetV1TaskIdResultResponse(w http.ResponseWriter) error
+}
+
+type GetV1TaskIdResult200JSONResponse TaskResult
+
+func (response GetV1TaskIdResult200JSONResponse) VisitGetV1TaskIdResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskIdResult200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetV1TaskIdResult200ApplicationoctetStreamResponse) VisitGetV1TaskIdResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1TaskIdResult200ApplicationxProtobufResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetV1TaskIdResult200ApplicationxProtobufResponse) VisitGetV1TaskIdResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1TaskIdResult400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response GetV1TaskIdResult400JSONResponse) VisitGetV1TaskIdResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskIdResult401Response = GenericUnauthenticatedResponse
+
+func (response GetV1TaskIdResult401Response) VisitGetV1TaskIdResultResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetV1TaskIdResult403Response = GenericForbiddenResponse
+
+func (response GetV1TaskIdResult403Response) VisitGetV1TaskIdResultResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetV1TaskIdResult404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response GetV1TaskIdResult404JSONResponse) VisitGetV1TaskIdResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskIdResult422JSONResponse ErrGeneric
+
+func (response GetV1TaskIdResult422JSONResponse) VisitGetV1TaskIdResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskIdResult500Response = GenericInternalServerErrorResponse
+
+func (response GetV1TaskIdResult500Response) VisitGetV1TaskIdResultResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetV1TaskIdRetriesRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetV1TaskIdRetriesResponseObject interface {
+	VisitGetV1TaskIdRetriesResponse(w http.ResponseWriter) error
+}
+
+type GetV1TaskIdRetries200JSONResponse []TaskRetry
+
+func (response GetV1TaskIdRetries200JSONResponse) VisitGetV1TaskIdRetriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskIdRetries400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response GetV1TaskIdRetries400JSONResponse) VisitGetV1TaskIdRetriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskIdRetries401Response = GenericUnauthenticatedResponse
+
+func (response GetV1TaskIdRetries401Response) VisitGetV1TaskIdRetriesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetV1TaskIdRetries403Response = GenericForbiddenResponse
+
+func (response GetV1TaskIdRetries403Response) VisitGetV1TaskIdRetriesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetV1TaskIdRetries404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response GetV1TaskIdRetries404JSONResponse) VisitGetV1TaskIdRetriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskIdRetries500Response = GenericInternalServerErrorResponse
+
+func (response GetV1TaskIdRetries500Response) VisitGetV1TaskIdRetriesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PostV1TaskIdRetryRequestObject struct {
+	Id     string `json:"id"`
+	Params PostV1TaskIdRetryParams
+}
+
+type PostV1TaskIdRetryResponseObject interface {
+	VisitPostV1TaskIdRetryResponse(w http.ResponseWriter) error
+}
+
+type PostV1TaskIdRetry200JSONResponse Task
+
+func (response PostV1TaskIdRetry200JSONResponse) VisitPostV1TaskIdRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TaskIdRetry400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response PostV1TaskIdRetry400JSONResponse) VisitPostV1TaskIdRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TaskIdRetry401Response = GenericUnauthenticatedResponse
+
+func (response PostV1TaskIdRetry401Response) VisitPostV1TaskIdRetryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostV1TaskIdRetry403Response = GenericForbiddenResponse
+
+func (response PostV1TaskIdRetry403Response) VisitPostV1TaskIdRetryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostV1TaskIdRetry404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response PostV1TaskIdRetry404JSONResponse) VisitPostV1TaskIdRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TaskIdRetry409JSONResponse ErrGeneric
+
+func (response PostV1TaskIdRetry409JSONResponse) VisitPostV1TaskIdRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TaskIdRetry500Response = GenericInternalServerErrorResponse
+
+func (response PostV1TaskIdRetry500Response) VisitPostV1TaskIdRetryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetV1TaskIdTransitionsRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetV1TaskIdTransitionsResponseObject interface {
+	VisitGetV1TaskIdTransitionsResponse(w http.ResponseWriter) error
+}
+
+type GetV1TaskIdTransitions200JSONResponse []TaskTransition
+
+func (response GetV1TaskIdTransitions200JSONResponse) VisitGetV1TaskIdTransitionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskIdTransitions400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response GetV1TaskIdTransitions400JSONResponse) VisitGetV1TaskIdTransitionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskIdTransitions401Response = GenericUnauthenticatedResponse
+
+func (response GetV1TaskIdTransitions401Response) VisitGetV1TaskIdTransitionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetV1TaskIdTransitions403Response = GenericForbiddenResponse
+
+func (response GetV1TaskIdTransitions403Response) VisitGetV1TaskIdTransitionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetV1TaskIdTransitions404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response GetV1TaskIdTransitions404JSONResponse) VisitGetV1TaskIdTransitionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TaskIdTransitions500Response = GenericInternalServerErrorResponse
+
+func (response GetV1TaskIdTransitions500Response) VisitGetV1TaskIdTransitionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetV1UserRequestObject struct {
+	Params GetV1UserParams
+}
+
+type GetV1UserResponseObject interface {
+	VisitGetV1UserResponse(w http.ResponseWriter) error
+}
+
+type GetV1User200JSONResponse []UserResponse
+
+func (response GetV1User200JSONResponse) VisitGetV1UserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1User400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response GetV1User400JSONResponse) VisitGetV1UserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1User401Response = GenericUnauthenticatedResponse
+
+func (response GetV1User401Response) VisitGetV1UserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetV1User403Response = GenericForbiddenResponse
+
+func (response GetV1User403Response) VisitGetV1UserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetV1User413JSONResponse struct{ GenericTooLargeJSONResponse }
+
+func (response GetV1User413JSONResponse) VisitGetV1UserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1User500Response = GenericInternalServerErrorResponse
+
+func (response GetV1User500Response) VisitGetV1UserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type DeleteV1UserMeRequestObject struct {
+}
+
+type DeleteV1UserMeResponseObject interface {
+	VisitDeleteV1UserMeResponse(w http.ResponseWriter) error
+}
+
+type DeleteV1UserMe200JSONResponse UserResponse
+
+func (response DeleteV1UserMe200JSONResponse) VisitDeleteV1UserMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1UserMe401Response = GenericUnauthenticatedResponse
+
+func (response DeleteV1UserMe401Response) VisitDeleteV1UserMeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteV1UserMe403Response = GenericForbiddenResponse
+
+func (response DeleteV1UserMe403Response) VisitDeleteV1UserMeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type DeleteV1UserMe413JSONResponse struct{ GenericTooLargeJSONResponse }
+
+func (response DeleteV1UserMe413JSONResponse) VisitDeleteV1UserMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1UserMe500Response = GenericInternalServerErrorResponse
+
+func (response DeleteV1UserMe500Response) VisitDeleteV1UserMeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetV1UserMeRequestObject struct {
+}
+
+type GetV1UserMeResponseObject interface {
+	VisitGetV1UserMeResponse(w http.ResponseWriter) error
+}
+
+type GetV1UserMe200JSONResponse UserResponse
+
+func (response GetV1UserMe200JSONResponse) VisitGetV1UserMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1UserMe401Response = GenericUnauthenticatedResponse
+
+func (response GetV1UserMe401Response) VisitGetV1UserMeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetV1UserMe403Response = GenericForbiddenResponse
+
+func (response GetV1UserMe403Response) VisitGetV1UserMeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetV1UserMe413JSONResponse struct{ GenericTooLargeJSONResponse }
+
+func (response GetV1UserMe413JSONResponse) VisitGetV1UserMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1UserMe500Response = GenericInternalServerErrorResponse
+
+func (response GetV1UserMe500Response) VisitGetV1UserMeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PatchV1UserMeRequestObject struct {
+	Body *PatchV1UserMeJSONRequestBody
+}
+
+type PatchV1UserMeResponseObject interface {
+	VisitPatchV1UserMeResponse(w http.ResponseWriter) error
+}
+
+type PatchV1UserMe200JSONResponse UserResponse
+
+func (response PatchV1UserMe200JSONResponse) VisitPatchV1UserMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV1UserMe400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response PatchV1UserMe400JSONResponse) VisitPatchV1UserMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV1UserMe401Response = GenericUnauthenticatedResponse
+
+func (response PatchV1UserMe401Response) VisitPatchV1UserMeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PatchV1UserMe403Response = GenericForbiddenResponse
+
+func (response PatchV1UserMe403Response) VisitPatchV1UserMeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PatchV1UserMe409JSONResponse ErrGeneric
+
+func (response PatchV1UserMe409JSONResponse) VisitPatchV1UserMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV1UserMe413JSONResponse struct{ GenericTooLargeJSONResponse }
+
+func (response PatchV1UserMe413JSONResponse) VisitPatchV1UserMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV1UserMe500Response = GenericInternalServerErrorResponse
+
+func (response PatchV1UserMe500Response) VisitPatchV1UserMeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type DeleteV1UserUsernameRequestObject struct {
+	Username string `json:"username"`
+}
+
+type DeleteV1UserUsernameResponseObject interface {
+	VisitDeleteV1UserUsernameResponse(w http.ResponseWriter) error
+}
+
+type DeleteV1UserUsername200JSONResponse UserResponse
+
+func (response DeleteV1UserUsername200JSONResponse) VisitDeleteV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1UserUsername400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response DeleteV1UserUsername400JSONResponse) VisitDeleteV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1UserUsername401Response = GenericUnauthenticatedResponse
+
+func (response DeleteV1UserUsername401Response) VisitDeleteV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteV1UserUsername403Response = GenericForbiddenResponse
+
+func (response DeleteV1UserUsername403Response) VisitDeleteV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type DeleteV1UserUsername404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response DeleteV1UserUsername404JSONResponse) VisitDeleteV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1UserUsername413JSONResponse struct{ GenericTooLargeJSONResponse }
+
+func (response DeleteV1UserUsername413JSONResponse) VisitDeleteV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1UserUsername500Response = GenericInternalServerErrorResponse
+
+func (response DeleteV1UserUsername500Response) VisitDeleteV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetV1UserUsernameRequestObject struct {
+	Username string `json:"username"`
+}
+
+type GetV1UserUsernameResponseObject interface {
+	VisitGetV1UserUsernameResponse(w http.ResponseWriter) error
+}
+
+type GetV1UserUsername200JSONResponse UserResponse
+
+func (response GetV1UserUsername200JSONResponse) VisitGetV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1UserUsername400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response GetV1UserUsername400JSONResponse) VisitGetV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1UserUsername401Response = GenericUnauthenticatedResponse
+
+func (response GetV1UserUsername401Response) VisitGetV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetV1UserUsername403Response = GenericForbiddenResponse
+
+func (response GetV1UserUsername403Response) VisitGetV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetV1UserUsername404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response GetV1UserUsername404JSONResponse) VisitGetV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1UserUsername413JSONResponse struct{ GenericTooLargeJSONResponse }
+
+func (response GetV1UserUsername413JSONResponse) VisitGetV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1UserUsername500Response = GenericInternalServerErrorResponse
+
+func (response GetV1UserUsername500Response) VisitGetV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type HeadV1UserUsernameRequestObject struct {
+	Username string `json:"username"`
+}
+
+type HeadV1UserUsernameResponseObject interface {
+	VisitHeadV1UserUsernameResponse(w http.ResponseWriter) error
+}
+
+type HeadV1UserUsername200Response struct {
+}
+
+func (response HeadV1UserUsername200Response) VisitHeadV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type HeadV1UserUsername400Response struct {
+}
+
+func (response HeadV1UserUsername400Response) VisitHeadV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type HeadV1UserUsername401Response struct {
+}
+
+func (response HeadV1UserUsername401Response) VisitHeadV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type HeadV1UserUsername403Response struct {
+}
+
+func (response HeadV1UserUsername403Response) VisitHeadV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type HeadV1UserUsername404Response struct {
+}
+
+func (response HeadV1UserUsername404Response) VisitHeadV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type HeadV1UserUsername413JSONResponse struct{ GenericTooLargeJSONResponse }
+
+func (response HeadV1UserUsername413JSONResponse) VisitHeadV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HeadV1UserUsername500Response struct {
+}
+
+func (response HeadV1UserUsername500Response) VisitHeadV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PatchV1UserUsernameRequestObject struct {
+	Username string `json:"username"`
+	Body     *PatchV1UserUsernameJSONRequestBody
+}
+
+type PatchV1UserUsernameResponseObject interface {
+	VisitPatchV1UserUsernameResponse(w http.ResponseWriter) error
+}
+
+type PatchV1UserUsername200JSONResponse UserResponse
+
+func (response PatchV1UserUsername200JSONResponse) VisitPatchV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV1UserUsername400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response PatchV1UserUsername400JSONResponse) VisitPatchV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV1UserUsername401Response = GenericUnauthenticatedResponse
+
+func (response PatchV1UserUsername401Response) VisitPatchV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PatchV1UserUsername403Response = GenericForbiddenResponse
+
+func (response PatchV1UserUsername403Response) VisitPatchV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PatchV1UserUsername404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response PatchV1UserUsername404JSONResponse) VisitPatchV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV1UserUsername409JSONResponse ErrGeneric
+
+func (response PatchV1UserUsername409JSONResponse) VisitPatchV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV1UserUsername413JSONResponse struct{ GenericTooLargeJSONResponse }
+
+func (response PatchV1UserUsername413JSONResponse) VisitPatchV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV1UserUsername500Response = GenericInternalServerErrorResponse
+
+func (response PatchV1UserUsername500Response) VisitPatchV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PutV1UserUsernameRequestObject struct {
+	Username string `json:"username"`
+	Body     *PutV1UserUsernameJSONRequestBody
+}
+
+type PutV1UserUsernameResponseObject interface {
+	VisitPutV1UserUsernameResponse(w http.ResponseWriter) error
+}
+
+type PutV1UserUsername201JSONResponse UserResponse
+
+func (response PutV1UserUsername201JSONResponse) VisitPutV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV1UserUsername400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response PutV1UserUsername400JSONResponse) VisitPutV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV1UserUsername401Response = GenericUnauthenticatedResponse
+
+func (response PutV1UserUsername401Response) VisitPutV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PutV1UserUsername403Response = GenericForbiddenResponse
+
+func (response PutV1UserUsername403Response) VisitPutV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PutV1UserUsername409JSONResponse ErrGeneric
+
+func (response PutV1UserUsername409JSONResponse) VisitPutV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV1UserUsername413JSONResponse struct{ GenericTooLargeJSONResponse }
+
+func (response PutV1UserUsername413JSONResponse) VisitPutV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV1UserUsername500Response = GenericInternalServerErrorResponse
+
+func (response PutV1UserUsername500Response) VisitPutV1UserUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetV1WorkflowRequestObject struct {
+	Params GetV1WorkflowParams
+}
+
+type GetV1WorkflowResponseObject interface {
+	VisitGetV1WorkflowResponse(w http.ResponseWriter) error
+}
+
+type GetV1Workflow200JSONResponse []Workflow
+
+func (response GetV1Workflow200JSONResponse) VisitGetV1WorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1Workflow400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response GetV1Workflow400JSONResponse) VisitGetV1WorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1Workflow401Response = GenericUnauthenticatedResponse
+
+func (response GetV1Workflow401Response) VisitGetV1WorkflowResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetV1Workflow403Response = GenericForbiddenResponse
+
+func (response GetV1Workflow403Response) VisitGetV1WorkflowResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetV1Workflow500Response = GenericInternalServerErrorResponse
+
+func (response GetV1Workflow500Response) VisitGetV1WorkflowResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PostV1WorkflowRequestObject struct {
+	Body *PostV1WorkflowJSONRequestBody
+}
+
+type PostV1WorkflowResponseObject interface {
+	VisitPostV1WorkflowResponse(w http.ResponseWriter) error
+}
+
+type PostV1Workflow201JSONResponse Workflow
+
+func (response PostV1Workflow201JSONResponse) VisitPostV1WorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1Workflow400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response PostV1Workflow400JSONResponse) VisitPostV1WorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1Workflow401Response = GenericUnauthenticatedResponse
+
+func (response PostV1Workflow401Response) VisitPostV1WorkflowResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostV1Workflow403Response = GenericForbiddenResponse
+
+func (response PostV1Workflow403Response) VisitPostV1WorkflowResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostV1Workflow422JSONResponse struct{ FieldErrorJSONResponse }
+
+func (response PostV1Workflow422JSONResponse) VisitPostV1WorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1Workflow500Response = GenericInternalServerErrorResponse
+
+func (response PostV1Workflow500Response) VisitPostV1WorkflowResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetV1WorkflowIdRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetV1WorkflowIdResponseObject interface {
+	VisitGetV1WorkflowIdResponse(w http.ResponseWriter) error
+}
+
+type GetV1WorkflowId200JSONResponse Workflow
+
+func (response GetV1WorkflowId200JSONResponse) VisitGetV1WorkflowIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1WorkflowId400JSONResponse struct{ GenericBadRequestJSONResponse }
+
+func (response GetV1WorkflowId400JSONResponse) VisitGetV1WorkflowIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1WorkflowId401Response = GenericUnauthenticatedResponse
+
+func (response GetV1WorkflowId401Response) VisitGetV1WorkflowIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetV1WorkflowId403Response = GenericForbiddenResponse
+
+func (response GetV1WorkflowId403Response) VisitGetV1WorkflowIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetV1WorkflowId404JSONResponse struct{ GenericNotFoundJSONResponse }
+
+func (response GetV1WorkflowId404JSONResponse) VisitGetV1WorkflowIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1WorkflowId500Response = GenericInternalServerErrorResponse
+
+func (response GetV1WorkflowId500Response) VisitGetV1WorkflowIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List Artifact Namespaces
+	// (GET /v1/artifact)
+	GetV1Artifact(ctx context.Context, request GetV1ArtifactRequestObject) (GetV1ArtifactResponseObject, error)
+	// Upload Artifact
+	// (POST /v1/artifact/raw/{namespace}/{name})
+	PostV1ArtifactRawNamespaceName(ctx context.Context, request PostV1ArtifactRawNamespaceNameRequestObject) (PostV1ArtifactRawNamespaceNameResponseObject, error)
+	// Download Artifact by Hash
+	// (GET /v1/artifact/raw/{namespace}/{name}/hash/{hash})
+	GetV1ArtifactRawNamespaceNameHashHash(ctx context.Context, request GetV1ArtifactRawNamespaceNameHashHashRequestObject) (GetV1ArtifactRawNamespaceNameHashHashResponseObject, error)
+	// Download Artifact by Tag
+	// (GET /v1/artifact/raw/{namespace}/{name}/tag/{tag})
+	GetV1ArtifactRawNamespaceNameTagTag(ctx context.Context, request GetV1ArtifactRawNamespaceNameTagTagRequestObject) (GetV1ArtifactRawNamespaceNameTagTagResponseObject, error)
+	// List Artifacts in Namespace
+	// (GET /v1/artifact/{namespace})
+	GetV1ArtifactNamespace(ctx context.Context, request GetV1ArtifactNamespaceRequestObject) (GetV1ArtifactNamespaceResponseObject, error)
+	// List Artifact Versions
+	// (GET /v1/artifact/{namespace}/{name})
+	GetV1ArtifactNamespaceName(ctx context.Context, request GetV1ArtifactNamespaceNameRequestObject) (GetV1ArtifactNamespaceNameResponseObject, error)
+	// Delete Artifact by Hash
+	// (DELETE /v1/artifact/{namespace}/{name}/hash/{hash})
+	DeleteV1ArtifactNamespaceNameHashHash(ctx context.Context, request DeleteV1ArtifactNamespaceNameHashHashRequestObject) (DeleteV1ArtifactNamespaceNameHashHashResponseObject, error)
+	// Retrieve Artifact Metadata by Hash
+	// (GET /v1/artifact/{namespace}/{name}/hash/{hash})
+	GetV1ArtifactNamespaceNameHashHash(ctx context.Context, request GetV1ArtifactNamespaceNameHashHashRequestObject) (GetV1ArtifactNamespaceNameHashHashResponseObject, error)
+	// Patch Artifact Metadata by Hash
+	// (PATCH /v1/artifact/{namespace}/{name}/hash/{hash})
+	PatchV1ArtifactNamespaceNameHashHash(ctx context.Context, request PatchV1ArtifactNamespaceNameHashHashRequestObject) (PatchV1ArtifactNamespaceNameHashHashResponseObject, error)
+	// Delete Artifact by Tag
+	// (DELETE /v1/artifact/{namespace}/{name}/tag/{tag})
+	DeleteV1ArtifactNamespaceNameTagTag(ctx context.Context, request DeleteV1ArtifactNamespaceNameTagTagRequestObject) (DeleteV1ArtifactNamespaceNameTagTagResponseObject, error)
+	// Retrieve Artifact Metadata by Tag
+	// (GET /v1/artifact/{namespace}/{name}/tag/{tag})
+	GetV1ArtifactNamespaceNameTagTag(ctx context.Context, request GetV1ArtifactNamespaceNameTagTagRequestObject) (GetV1ArtifactNamespaceNameTagTagResponseObject, error)
+	// Patch Artifact Metadata by Tag
+	// (PATCH /v1/artifact/{namespace}/{name}/tag/{tag})
+	PatchV1ArtifactNamespaceNameTagTag(ctx context.Context, request PatchV1ArtifactNamespaceNameTagTagRequestObject) (PatchV1ArtifactNamespaceNameTagTagResponseObject, error)
+	// List Queues
+	// (GET /v1/queue)
+	GetV1Queue(ctx context.Context, request GetV1QueueRequestObject) (GetV1QueueResponseObject, error)
+	// Get Queue
+	// (GET /v1/queue/{name})
+	GetV1QueueName(ctx context.Context, request GetV1QueueNameRequestObject) (GetV1QueueNameResponseObject, error)
+	// Pause Queue
+	// (POST /v1/queue/{name}/pause)
+	PostV1QueueNamePause(ctx context.Context, request PostV1QueueNamePauseRequestObject) (PostV1QueueNamePauseResponseObject, error)
+	// Resume Queue
+	// (POST /v1/queue/{name}/resume)
+	PostV1QueueNameResume(ctx context.Context, request PostV1QueueNameResumeRequestObject) (PostV1QueueNameResumeResponseObject, error)
+	// Delete RBAC Policy
+	// (DELETE /v1/rbac/policy)
+	DeleteV1RbacPolicy(ctx context.Context, request DeleteV1RbacPolicyRequestObject) (DeleteV1RbacPolicyResponseObject, error)
+	// List RBAC Policies
+	// (GET /v1/rbac/policy)
+	GetV1RbacPolicy(ctx context.Context, request GetV1RbacPolicyRequestObject) (GetV1RbacPolicyResponseObject, error)
+	// Create or Replace RBAC Policy
+	// (PUT /v1/rbac/policy)
+	PutV1RbacPolicy(ctx context.Context, request PutV1RbacPolicyRequestObject) (PutV1RbacPolicyResponseObject, error)
+	// List All Resource Groups
+	// (GET /v1/rbac/resource-group)
+	GetV1RbacResourceGroup(ctx context.Context, request GetV1RbacResourceGroupRequestObject) (GetV1RbacResourceGroupResponseObject, error)
+	// Delete Resource Group
+	// (DELETE /v1/rbac/resource-group/{resourceGroup})
+	DeleteV1RbacResourceGroupResourceGroup(ctx context.Context, request DeleteV1RbacResourceGroupResourceGroupRequestObject) (DeleteV1RbacResourceGroupResourceGroupResponseObject, error)
+	// Get Endpoints Assigned to Resource Group
+	// (GET /v1/rbac/resource-group/{resourceGroup})
+	GetV1RbacResourceGroupResourceGroup(ctx context.Context, request GetV1RbacResourceGroupResourceGroupRequestObject) (GetV1RbacResourceGroupResourceGroupResponseObject, error)
+	// Check Resource Group Existence
+	// (HEAD /v1/rbac/resource-group/{resourceGroup})
+	HeadV1RbacResourceGroupResourceGroup(ctx context.Context, request HeadV1RbacResourceGroupResourceGroupRequestObject) (HeadV1RbacResourceGroupResourceGroupResponseObject, error)
+	// Create or Replace Resource Group
+	// (PUT /v1/rbac/resource-group/{resourceGroup})
+	PutV1RbacResourceGroupResourceGroup(ctx context.Context, request PutV1RbacResourceGroupResourceGroupRequestObject) (PutV1RbacResourceGroupResourceGroupResponseObject, error)
+	// List All Roles
 	// (GET /v1/rbac/role)
 	GetV1RbacRole(ctx context.Context, request GetV1RbacRoleRequestObject) (GetV1RbacRoleResponseObject, error)
 	// Delete Role
@@ -4365,18 +8278,93 @@ type StrictServerInterface interface {
 	// Create or Replace Role
 	// (PUT /v1/rbac/role/{role})
 	PutV1RbacRoleRole(ctx context.Context, request PutV1RbacRoleRoleRequestObject) (PutV1RbacRoleRoleResponseObject, error)
+	// List Schedules
+	// (GET /v1/schedule)
+	GetV1Schedule(ctx context.Context, request GetV1ScheduleRequestObject) (GetV1ScheduleResponseObject, error)
+	// Create Schedule
+	// (POST /v1/schedule)
+	PostV1Schedule(ctx context.Context, request PostV1ScheduleRequestObject) (PostV1ScheduleResponseObject, error)
+	// Delete Schedule
+	// (DELETE /v1/schedule/{id})
+	DeleteV1ScheduleId(ctx context.Context, request DeleteV1ScheduleIdRequestObject) (DeleteV1ScheduleIdResponseObject, error)
+	// Get Schedule
+	// (GET /v1/schedule/{id})
+	GetV1ScheduleId(ctx context.Context, request GetV1ScheduleIdRequestObject) (GetV1ScheduleIdResponseObject, error)
+	// Update Schedule
+	// (PATCH /v1/schedule/{id})
+	PatchV1ScheduleId(ctx context.Context, request PatchV1ScheduleIdRequestObject) (PatchV1ScheduleIdResponseObject, error)
+	// Get Schedule History
+	// (GET /v1/schedule/{id}/history)
+	GetV1ScheduleIdHistory(ctx context.Context, request GetV1ScheduleIdHistoryRequestObject) (GetV1ScheduleIdHistoryResponseObject, error)
+	// Pause Schedule
+	// (POST /v1/schedule/{id}/pause)
+	PostV1ScheduleIdPause(ctx context.Context, request PostV1ScheduleIdPauseRequestObject) (PostV1ScheduleIdPauseResponseObject, error)
+	// Resume Schedule
+	// (POST /v1/schedule/{id}/resume)
+	PostV1ScheduleIdResume(ctx context.Context, request PostV1ScheduleIdResumeRequestObject) (PostV1ScheduleIdResumeResponseObject, error)
 	// List Tasks
 	// (GET /v1/task)
 	GetV1Task(ctx context.Context, request GetV1TaskRequestObject) (GetV1TaskResponseObject, error)
 	// Create Task
 	// (POST /v1/task)
 	PostV1Task(ctx context.Context, request PostV1TaskRequestObject) (PostV1TaskResponseObject, error)
+	// Get Task Group
+	// (GET /v1/task-group/{id})
+	GetV1TaskGroupId(ctx context.Context, request GetV1TaskGroupIdRequestObject) (GetV1TaskGroupIdResponseObject, error)
+	// Cancel Task Group
+	// (POST /v1/task-group/{id}/cancel)
+	PostV1TaskGroupIdCancel(ctx context.Context, request PostV1TaskGroupIdCancelRequestObject) (PostV1TaskGroupIdCancelResponseObject, error)
+	// Create Task Batch
+	// (POST /v1/task/batch)
+	PostV1TaskBatch(ctx context.Context, request PostV1TaskBatchRequestObject) (PostV1TaskBatchResponseObject, error)
+	// Get Task Batch
+	// (GET /v1/task/batch/{id})
+	GetV1TaskBatchId(ctx context.Context, request GetV1TaskBatchIdRequestObject) (GetV1TaskBatchIdResponseObject, error)
+	// Create Task Map
+	// (POST /v1/task/map)
+	PostV1TaskMap(ctx context.Context, request PostV1TaskMapRequestObject) (PostV1TaskMapResponseObject, error)
+	// Get Task Map
+	// (GET /v1/task/map/{id})
+	GetV1TaskMapId(ctx context.Context, request GetV1TaskMapIdRequestObject) (GetV1TaskMapIdResponseObject, error)
+	// Retry Tasks
+	// (POST /v1/task/retry)
+	PostV1TaskRetry(ctx context.Context, request PostV1TaskRetryRequestObject) (PostV1TaskRetryResponseObject, error)
+	// Delete Task
+	// (DELETE /v1/task/{id})
+	DeleteV1TaskId(ctx context.Context, request DeleteV1TaskIdRequestObject) (DeleteV1TaskIdResponseObject, error)
 	// Get Task
 	// (GET /v1/task/{id})
 	GetV1TaskId(ctx context.Context, request GetV1TaskIdRequestObject) (GetV1TaskIdResponseObject, error)
+	// Get Task Callback
+	// (GET /v1/task/{id}/callback)
+	GetV1TaskIdCallback(ctx context.Context, request GetV1TaskIdCallbackRequestObject) (GetV1TaskIdCallbackResponseObject, error)
+	// Cancel Task
+	// (POST /v1/task/{id}/cancel)
+	PostV1TaskIdCancel(ctx context.Context, request PostV1TaskIdCancelRequestObject) (PostV1TaskIdCancelResponseObject, error)
 	// Get Task Logs
 	// (GET /v1/task/{id}/logs)
 	GetV1TaskIdLogs(ctx context.Context, request GetV1TaskIdLogsRequestObject) (GetV1TaskIdLogsResponseObject, error)
+	// Append Task Logs
+	// (POST /v1/task/{id}/logs)
+	PostV1TaskIdLogs(ctx context.Context, request PostV1TaskIdLogsRequestObject) (PostV1TaskIdLogsResponseObject, error)
+	// Export Task Logs
+	// (GET /v1/task/{id}/logs/export)
+	GetV1TaskIdLogsExport(ctx context.Context, request GetV1TaskIdLogsExportRequestObject) (GetV1TaskIdLogsExportResponseObject, error)
+	// Stream Task Logs
+	// (GET /v1/task/{id}/logs/stream)
+	GetV1TaskIdLogsStream(ctx context.Context, request GetV1TaskIdLogsStreamRequestObject) (GetV1TaskIdLogsStreamResponseObject, error)
+	// Get Task Result
+	// (GET /v1/task/{id}/result)
+	GetV1TaskIdResult(ctx context.Context, request GetV1TaskIdResultRequestObject) (GetV1TaskIdResultResponseObject, error)
+	// Get Task Retries
+	// (GET /v1/task/{id}/retries)
+	GetV1TaskIdRetries(ctx context.Context, request GetV1TaskIdRetriesRequestObject) (GetV1TaskIdRetriesResponseObject, error)
+	// Retry Task
+	// (POST /v1/task/{id}/retry)
+	PostV1TaskIdRetry(ctx context.Context, request PostV1TaskIdRetryRequestObject) (PostV1TaskIdRetryResponseObject, error)
+	// Get Task State Transitions
+	// (GET /v1/task/{id}/transitions)
+	GetV1TaskIdTransitions(ctx context.Context, request GetV1TaskIdTransitionsRequestObject) (GetV1TaskIdTransitionsResponseObject, error)
 	// List Users
 	// (GET /v1/user)
 	GetV1User(ctx context.Context, request GetV1UserRequestObject) (GetV1UserResponseObject, error)
@@ -4404,31 +8392,826 @@ type StrictServerInterface interface {
 	// Create User
 	// (PUT /v1/user/{username})
 	PutV1UserUsername(ctx context.Context, request PutV1UserUsernameRequestObject) (PutV1UserUsernameResponseObject, error)
+	// List Workflows
+	// (GET /v1/workflow)
+	GetV1Workflow(ctx context.Context, request GetV1WorkflowRequestObject) (GetV1WorkflowResponseObject, error)
+	// Create Workflow
+	// (POST /v1/workflow)
+	PostV1Workflow(ctx context.Context, request PostV1WorkflowRequestObject) (PostV1WorkflowResponseObject, error)
+	// Get Workflow
+	// (GET /v1/workflow/{id})
+	GetV1WorkflowId(ctx context.Context, request GetV1WorkflowIdRequestObject) (GetV1WorkflowIdResponseObject, error)
+}
+
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetV1Artifact operation middleware
+func (sh *strictHandler) GetV1Artifact(ctx *gin.Context, params GetV1ArtifactParams) {
+	var request GetV1ArtifactRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1Artifact(ctx, request.(GetV1ArtifactRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1Artifact")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetV1ArtifactResponseObject); ok {
+		if err := validResponse.VisitGetV1ArtifactResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1ArtifactRawNamespaceName operation middleware
+func (sh *strictHandler) PostV1ArtifactRawNamespaceName(ctx *gin.Context, namespace string, name string) {
+	var request PostV1ArtifactRawNamespaceNameRequestObject
+
+	request.Namespace = namespace
+	request.Name = name
+
+	request.Body = ctx.Request.Body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1ArtifactRawNamespaceName(ctx, request.(PostV1ArtifactRawNamespaceNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1ArtifactRawNamespaceName")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostV1ArtifactRawNamespaceNameResponseObject); ok {
+		if err := validResponse.VisitPostV1ArtifactRawNamespaceNameResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1ArtifactRawNamespaceNameHashHash operation middleware
+func (sh *strictHandler) GetV1ArtifactRawNamespaceNameHashHash(ctx *gin.Context, namespace string, name string, hash string) {
+	var request GetV1ArtifactRawNamespaceNameHashHashRequestObject
+
+	request.Namespace = namespace
+	request.Name = name
+	request.Hash = hash
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1ArtifactRawNamespaceNameHashHash(ctx, request.(GetV1ArtifactRawNamespaceNameHashHashRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1ArtifactRawNamespaceNameHashHash")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetV1ArtifactRawNamespaceNameHashHashResponseObject); ok {
+		if err := validResponse.VisitGetV1ArtifactRawNamespaceNameHashHashResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1ArtifactRawNamespaceNameTagTag operation middleware
+func (sh *strictHandler) GetV1ArtifactRawNamespaceNameTagTag(ctx *gin.Context, namespace string, name string, tag string) {
+	var request GetV1ArtifactRawNamespaceNameTagTagRequestObject
+
+	request.Namespace = namespace
+	request.Name = name
+	request.Tag = tag
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1ArtifactRawNamespaceNameTagTag(ctx, request.(GetV1ArtifactRawNamespaceNameTagTagRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1ArtifactRawNamespaceNameTagTag")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetV1ArtifactRawNamespaceNameTagTagResponseObject); ok {
+		if err := validResponse.VisitGetV1ArtifactRawNamespaceNameTagTagResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1ArtifactNamespace operation middleware
+func (sh *strictHandler) GetV1ArtifactNamespace(ctx *gin.Context, namespace string, params GetV1ArtifactNamespaceParams) {
+	var request GetV1ArtifactNamespaceRequestObject
+
+	request.Namespace = namespace
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1ArtifactNamespace(ctx, request.(GetV1ArtifactNamespaceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1ArtifactNamespace")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetV1ArtifactNamespaceResponseObject); ok {
+		if err := validResponse.VisitGetV1ArtifactNamespaceResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1ArtifactNamespaceName operation middleware
+func (sh *strictHandler) GetV1ArtifactNamespaceName(ctx *gin.Context, namespace string, name string, params GetV1ArtifactNamespaceNameParams) {
+	var request GetV1ArtifactNamespaceNameRequestObject
+
+	request.Namespace = namespace
+	request.Name = name
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1ArtifactNamespaceName(ctx, request.(GetV1ArtifactNamespaceNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1ArtifactNamespaceName")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetV1ArtifactNamespaceNameResponseObject); ok {
+		if err := validResponse.VisitGetV1ArtifactNamespaceNameResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteV1ArtifactNamespaceNameHashHash operation middleware
+func (sh *strictHandler) DeleteV1ArtifactNamespaceNameHashHash(ctx *gin.Context, namespace string, name string, hash string) {
+	var request DeleteV1ArtifactNamespaceNameHashHashRequestObject
+
+	request.Namespace = namespace
+	request.Name = name
+	request.Hash = hash
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteV1ArtifactNamespaceNameHashHash(ctx, request.(DeleteV1ArtifactNamespaceNameHashHashRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteV1ArtifactNamespaceNameHashHash")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteV1ArtifactNamespaceNameHashHashResponseObject); ok {
+		if err := validResponse.VisitDeleteV1ArtifactNamespaceNameHashHashResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1ArtifactNamespaceNameHashHash operation middleware
+func (sh *strictHandler) GetV1ArtifactNamespaceNameHashHash(ctx *gin.Context, namespace string, name string, hash string) {
+	var request GetV1ArtifactNamespaceNameHashHashRequestObject
+
+	request.Namespace = namespace
+	request.Name = name
+	request.Hash = hash
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1ArtifactNamespaceNameHashHash(ctx, request.(GetV1ArtifactNamespaceNameHashHashRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1ArtifactNamespaceNameHashHash")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetV1ArtifactNamespaceNameHashHashResponseObject); ok {
+		if err := validResponse.VisitGetV1ArtifactNamespaceNameHashHashResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchV1ArtifactNamespaceNameHashHash operation middleware
+func (sh *strictHandler) PatchV1ArtifactNamespaceNameHashHash(ctx *gin.Context, namespace string, name string, hash string) {
+	var request PatchV1ArtifactNamespaceNameHashHashRequestObject
+
+	request.Namespace = namespace
+	request.Name = name
+	request.Hash = hash
+
+	var body PatchV1ArtifactNamespaceNameHashHashJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchV1ArtifactNamespaceNameHashHash(ctx, request.(PatchV1ArtifactNamespaceNameHashHashRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchV1ArtifactNamespaceNameHashHash")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PatchV1ArtifactNamespaceNameHashHashResponseObject); ok {
+		if err := validResponse.VisitPatchV1ArtifactNamespaceNameHashHashResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteV1ArtifactNamespaceNameTagTag operation middleware
+func (sh *strictHandler) DeleteV1ArtifactNamespaceNameTagTag(ctx *gin.Context, namespace string, name string, tag string) {
+	var request DeleteV1ArtifactNamespaceNameTagTagRequestObject
+
+	request.Namespace = namespace
+	request.Name = name
+	request.Tag = tag
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteV1ArtifactNamespaceNameTagTag(ctx, request.(DeleteV1ArtifactNamespaceNameTagTagRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteV1ArtifactNamespaceNameTagTag")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteV1ArtifactNamespaceNameTagTagResponseObject); ok {
+		if err := validResponse.VisitDeleteV1ArtifactNamespaceNameTagTagResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1ArtifactNamespaceNameTagTag operation middleware
+func (sh *strictHandler) GetV1ArtifactNamespaceNameTagTag(ctx *gin.Context, namespace string, name string, tag string) {
+	var request GetV1ArtifactNamespaceNameTagTagRequestObject
+
+	request.Namespace = namespace
+	request.Name = name
+	request.Tag = tag
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1ArtifactNamespaceNameTagTag(ctx, request.(GetV1ArtifactNamespaceNameTagTagRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1ArtifactNamespaceNameTagTag")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetV1ArtifactNamespaceNameTagTagResponseObject); ok {
+		if err := validResponse.VisitGetV1ArtifactNamespaceNameTagTagResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchV1ArtifactNamespaceNameTagTag operation middleware
+func (sh *strictHandler) PatchV1ArtifactNamespaceNameTagTag(ctx *gin.Context, namespace string, name string, tag string) {
+	var request PatchV1ArtifactNamespaceNameTagTagRequestObject
+
+	request.Namespace = namespace
+	request.Name = name
+	request.Tag = tag
+
+	var body PatchV1ArtifactNamespaceNameTagTagJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchV1ArtifactNamespaceNameTagTag(ctx, request.(PatchV1ArtifactNamespaceNameTagTagRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchV1ArtifactNamespaceNameTagTag")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PatchV1ArtifactNamespaceNameTagTagResponseObject); ok {
+		if err := validResponse.VisitPatchV1ArtifactNamespaceNameTagTagResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1Queue operation middleware
+func (sh *strictHandler) GetV1Queue(ctx *gin.Context) {
+	var request GetV1QueueRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1Queue(ctx, request.(GetV1QueueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1Queue")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetV1QueueResponseObject); ok {
+		if err := validResponse.VisitGetV1QueueResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1QueueName operation middleware
+func (sh *strictHandler) GetV1QueueName(ctx *gin.Context, name string, params GetV1QueueNameParams) {
+	var request GetV1QueueNameRequestObject
+
+	request.Name = name
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1QueueName(ctx, request.(GetV1QueueNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1QueueName")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetV1QueueNameResponseObject); ok {
+		if err := validResponse.VisitGetV1QueueNameResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1QueueNamePause operation middleware
+func (sh *strictHandler) PostV1QueueNamePause(ctx *gin.Context, name string) {
+	var request PostV1QueueNamePauseRequestObject
+
+	request.Name = name
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1QueueNamePause(ctx, request.(PostV1QueueNamePauseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1QueueNamePause")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostV1QueueNamePauseResponseObject); ok {
+		if err := validResponse.VisitPostV1QueueNamePauseResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1QueueNameResume operation middleware
+func (sh *strictHandler) PostV1QueueNameResume(ctx *gin.Context, name string) {
+	var request PostV1QueueNameResumeRequestObject
+
+	request.Name = name
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1QueueNameResume(ctx, request.(PostV1QueueNameResumeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1QueueNameResume")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostV1QueueNameResumeResponseObject); ok {
+		if err := validResponse.VisitPostV1QueueNameResumeResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteV1RbacPolicy operation middleware
+func (sh *strictHandler) DeleteV1RbacPolicy(ctx *gin.Context) {
+	var request DeleteV1RbacPolicyRequestObject
+
+	var body DeleteV1RbacPolicyJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteV1RbacPolicy(ctx, request.(DeleteV1RbacPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteV1RbacPolicy")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteV1RbacPolicyResponseObject); ok {
+		if err := validResponse.VisitDeleteV1RbacPolicyResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1RbacPolicy operation middleware
+func (sh *strictHandler) GetV1RbacPolicy(ctx *gin.Context, params GetV1RbacPolicyParams) {
+	var request GetV1RbacPolicyRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1RbacPolicy(ctx, request.(GetV1RbacPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1RbacPolicy")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetV1RbacPolicyResponseObject); ok {
+		if err := validResponse.VisitGetV1RbacPolicyResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+// PutV1RbacPolicy operation middleware
+func (sh *strictHandler) PutV1RbacPolicy(ctx *gin.Context) {
+	var request PutV1RbacPolicyRequestObject
+
+	var body PutV1RbacPolicyJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutV1RbacPolicy(ctx, request.(PutV1RbacPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutV1RbacPolicy")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutV1RbacPolicyResponseObject); ok {
+		if err := validResponse.VisitPutV1RbacPolicyResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1RbacResourceGroup operation middleware
+func (sh *strictHandler) GetV1RbacResourceGroup(ctx *gin.Context, params GetV1RbacResourceGroupParams) {
+	var request GetV1RbacResourceGroupRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1RbacResourceGroup(ctx, request.(GetV1RbacResourceGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1RbacResourceGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetV1RbacResourceGroupResponseObject); ok {
+		if err := validResponse.VisitGetV1RbacResourceGroupResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteV1RbacResourceGroupResourceGroup operation middleware
+func (sh *strictHandler) DeleteV1RbacResourceGroupResourceGroup(ctx *gin.Context, resourceGroup string) {
+	var request DeleteV1RbacResourceGroupResourceGroupRequestObject
+
+	request.ResourceGroup = resourceGroup
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteV1RbacResourceGroupResourceGroup(ctx, request.(DeleteV1RbacResourceGroupResourceGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteV1RbacResourceGroupResourceGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteV1RbacResourceGroupResourceGroupResponseObject); ok {
+		if err := validResponse.VisitDeleteV1RbacResourceGroupResourceGroupResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1RbacResourceGroupResourceGroup operation middleware
+func (sh *strictHandler) GetV1RbacResourceGroupResourceGroup(ctx *gin.Context, resourceGroup string) {
+	var request GetV1RbacResourceGroupResourceGroupRequestObject
+
+	request.ResourceGroup = resourceGroup
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1RbacResourceGroupResourceGroup(ctx, request.(GetV1RbacResourceGroupResourceGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1RbacResourceGroupResourceGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetV1RbacResourceGroupResourceGroupResponseObject); ok {
+		if err := validResponse.VisitGetV1RbacResourceGroupResourceGroupResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// HeadV1RbacResourceGroupResourceGroup operation middleware
+func (sh *strictHandler) HeadV1RbacResourceGroupResourceGroup(ctx *gin.Context, resourceGroup string) {
+	var request HeadV1RbacResourceGroupResourceGroupRequestObject
+
+	request.ResourceGroup = resourceGroup
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.HeadV1RbacResourceGroupResourceGroup(ctx, request.(HeadV1RbacResourceGroupResourceGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HeadV1RbacResourceGroupResourceGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(HeadV1RbacResourceGroupResourceGroupResponseObject); ok {
+		if err := validResponse.VisitHeadV1RbacResourceGroupResourceGroupResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutV1RbacResourceGroupResourceGroup operation middleware
+func (sh *strictHandler) PutV1RbacResourceGroupResourceGroup(ctx *gin.Context, resourceGroup string) {
+	var request PutV1RbacResourceGroupResourceGroupRequestObject
+
+	request.ResourceGroup = resourceGroup
+
+	var body PutV1RbacResourceGroupResourceGroupJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutV1RbacResourceGroupResourceGroup(ctx, request.(PutV1RbacResourceGroupResourceGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutV1RbacResourceGroupResourceGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutV1RbacResourceGroupResourceGroupResponseObject); ok {
+		if err := validResponse.VisitPutV1RbacResourceGroupResourceGroupResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1RbacRole operation middleware
+func (sh *strictHandler) GetV1RbacRole(ctx *gin.Context, params GetV1RbacRoleParams) {
+	var request GetV1RbacRoleRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1RbacRole(ctx, request.(GetV1RbacRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1RbacRole")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetV1RbacRoleResponseObject); ok {
+		if err := validResponse.VisitGetV1RbacRoleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteV1RbacRoleRole operation middleware
+func (sh *strictHandler) DeleteV1RbacRoleRole(ctx *gin.Context, role string) {
+	var request DeleteV1RbacRoleRoleRequestObject
+
+	request.Role = role
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteV1RbacRoleRole(ctx, request.(DeleteV1RbacRoleRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteV1RbacRoleRole")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteV1RbacRoleRoleResponseObject); ok {
+		if err := validResponse.VisitDeleteV1RbacRoleRoleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1RbacRoleRole operation middleware
+func (sh *strictHandler) GetV1RbacRoleRole(ctx *gin.Context, role string) {
+	var request GetV1RbacRoleRoleRequestObject
+
+	request.Role = role
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1RbacRoleRole(ctx, request.(GetV1RbacRoleRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1RbacRoleRole")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	response, err := handler(ctx, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetV1RbacRoleRoleResponseObject); ok {
+		if err := validResponse.VisitGetV1RbacRoleRoleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// GetV1Artifact operation middleware
-func (sh *strictHandler) GetV1Artifact(ctx *gin.Context, params GetV1ArtifactParams) {
-	var request GetV1ArtifactRequestObject
+// HeadV1RbacRoleRole operation middleware
+func (sh *strictHandler) HeadV1RbacRoleRole(ctx *gin.Context, role string) {
+	var request HeadV1RbacRoleRoleRequestObject
 
-	request.Params = params
+	request.Role = role
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1Artifact(ctx, request.(GetV1ArtifactRequestObject))
+		return sh.ssi.HeadV1RbacRoleRole(ctx, request.(HeadV1RbacRoleRoleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1Artifact")
+		handler = middleware(handler, "HeadV1RbacRoleRole")
 	}
 
 	response, err := handler(ctx, request)
@@ -4436,8 +9219,8 @@ func (sh *strictHandler) GetV1Artifact(ctx *gin.Context, params GetV1ArtifactPar
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetV1ArtifactResponseObject); ok {
-		if err := validResponse.VisitGetV1ArtifactResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(HeadV1RbacRoleRoleResponseObject); ok {
+		if err := validResponse.VisitHeadV1RbacRoleRoleResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4445,20 +9228,25 @@ func (sh *strictHandler) GetV1Artifact(ctx *gin.Context, params GetV1ArtifactPar
 	}
 }
 
-// PostV1ArtifactRawNamespaceName operation middleware
-func (sh *strictHandler) PostV1ArtifactRawNamespaceName(ctx *gin.Context, namespace string, name string) {
-	var request PostV1ArtifactRawNamespaceNameRequestObject
+// PutV1RbacRoleRole operation middleware
+func (sh *strictHandler) PutV1RbacRoleRole(ctx *gin.Context, role string) {
+	var request PutV1RbacRoleRoleRequestObject
 
-	request.Namespace = namespace
-	request.Name = name
+	request.Role = role
 
-	request.Body = ctx.Request.Body
+	var body PutV1RbacRoleRoleJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostV1ArtifactRawNamespaceName(ctx, request.(PostV1ArtifactRawNamespaceNameRequestObject))
+		return sh.ssi.PutV1RbacRoleRole(ctx, request.(PutV1RbacRoleRoleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostV1ArtifactRawNamespaceName")
+		handler = middleware(handler, "PutV1RbacRoleRole")
 	}
 
 	response, err := handler(ctx, request)
@@ -4466,8 +9254,8 @@ func (sh *strictHandler) PostV1ArtifactRawNamespaceName(ctx *gin.Context, namesp
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostV1ArtifactRawNamespaceNameResponseObject); ok {
-		if err := validResponse.VisitPostV1ArtifactRawNamespaceNameResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PutV1RbacRoleRoleResponseObject); ok {
+		if err := validResponse.VisitPutV1RbacRoleRoleResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4475,19 +9263,17 @@ func (sh *strictHandler) PostV1ArtifactRawNamespaceName(ctx *gin.Context, namesp
 	}
 }
 
-// GetV1ArtifactRawNamespaceNameHashHash operation middleware
-func (sh *strictHandler) GetV1ArtifactRawNamespaceNameHashHash(ctx *gin.Context, namespace string, name string, hash string) {
-	var request GetV1ArtifactRawNamespaceNameHashHashRequestObject
+// GetV1Schedule operation middleware
+func (sh *strictHandler) GetV1Schedule(ctx *gin.Context, params GetV1ScheduleParams) {
+	var request GetV1ScheduleRequestObject
 
-	request.Namespace = namespace
-	request.Name = name
-	request.Hash = hash
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1ArtifactRawNamespaceNameHashHash(ctx, request.(GetV1ArtifactRawNamespaceNameHashHashRequestObject))
+		return sh.ssi.GetV1Schedule(ctx, request.(GetV1ScheduleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1ArtifactRawNamespaceNameHashHash")
+		handler = middleware(handler, "GetV1Schedule")
 	}
 
 	response, err := handler(ctx, request)
@@ -4495,8 +9281,8 @@ func (sh *strictHandler) GetV1ArtifactRawNamespaceNameHashHash(ctx *gin.Context,
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetV1ArtifactRawNamespaceNameHashHashResponseObject); ok {
-		if err := validResponse.VisitGetV1ArtifactRawNamespaceNameHashHashResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetV1ScheduleResponseObject); ok {
+		if err := validResponse.VisitGetV1ScheduleResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4504,19 +9290,23 @@ func (sh *strictHandler) GetV1ArtifactRawNamespaceNameHashHash(ctx *gin.Context,
 	}
 }
 
-// GetV1ArtifactRawNamespaceNameTagTag operation middleware
-func (sh *strictHandler) GetV1ArtifactRawNamespaceNameTagTag(ctx *gin.Context, namespace string, name string, tag string) {
-	var request GetV1ArtifactRawNamespaceNameTagTagRequestObject
+// PostV1Schedule operation middleware
+func (sh *strictHandler) PostV1Schedule(ctx *gin.Context) {
+	var request PostV1ScheduleRequestObject
 
-	request.Namespace = namespace
-	request.Name = name
-	request.Tag = tag
+	var body PostV1ScheduleJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1ArtifactRawNamespaceNameTagTag(ctx, request.(GetV1ArtifactRawNamespaceNameTagTagRequestObject))
+		return sh.ssi.PostV1Schedule(ctx, request.(PostV1ScheduleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1ArtifactRawNamespaceNameTagTag")
+		handler = middleware(handler, "PostV1Schedule")
 	}
 
 	response, err := handler(ctx, request)
@@ -4524,8 +9314,8 @@ func (sh *strictHandler) GetV1ArtifactRawNamespaceNameTagTag(ctx *gin.Context, n
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetV1ArtifactRawNamespaceNameTagTagResponseObject); ok {
-		if err := validResponse.VisitGetV1ArtifactRawNamespaceNameTagTagResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostV1ScheduleResponseObject); ok {
+		if err := validResponse.VisitPostV1ScheduleResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4533,18 +9323,17 @@ func (sh *strictHandler) GetV1ArtifactRawNamespaceNameTagTag(ctx *gin.Context, n
 	}
 }
 
-// GetV1ArtifactNamespace operation middleware
-func (sh *strictHandler) GetV1ArtifactNamespace(ctx *gin.Context, namespace string, params GetV1ArtifactNamespaceParams) {
-	var request GetV1ArtifactNamespaceRequestObject
+// DeleteV1ScheduleId operation middleware
+func (sh *strictHandler) DeleteV1ScheduleId(ctx *gin.Context, id openapi_types.UUID) {
+	var request DeleteV1ScheduleIdRequestObject
 
-	request.Namespace = namespace
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1ArtifactNamespace(ctx, request.(GetV1ArtifactNamespaceRequestObject))
+		return sh.ssi.DeleteV1ScheduleId(ctx, request.(DeleteV1ScheduleIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1ArtifactNamespace")
+		handler = middleware(handler, "DeleteV1ScheduleId")
 	}
 
 	response, err := handler(ctx, request)
@@ -4552,8 +9341,8 @@ func (sh *strictHandler) GetV1ArtifactNamespace(ctx *gin.Context, namespace stri
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetV1ArtifactNamespaceResponseObject); ok {
-		if err := validResponse.VisitGetV1ArtifactNamespaceResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DeleteV1ScheduleIdResponseObject); ok {
+		if err := validResponse.VisitDeleteV1ScheduleIdResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4561,19 +9350,17 @@ func (sh *strictHandler) GetV1ArtifactNamespace(ctx *gin.Context, namespace stri
 	}
 }
 
-// GetV1ArtifactNamespaceName operation middleware
-func (sh *strictHandler) GetV1ArtifactNamespaceName(ctx *gin.Context, namespace string, name string, params GetV1ArtifactNamespaceNameParams) {
-	var request GetV1ArtifactNamespaceNameRequestObject
+// GetV1ScheduleId operation middleware
+func (sh *strictHandler) GetV1ScheduleId(ctx *gin.Context, id openapi_types.UUID) {
+	var request GetV1ScheduleIdRequestObject
 
-	request.Namespace = namespace
-	request.Name = name
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1ArtifactNamespaceName(ctx, request.(GetV1ArtifactNamespaceNameRequestObject))
+		return sh.ssi.GetV1ScheduleId(ctx, request.(GetV1ScheduleIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1ArtifactNamespaceName")
+		handler = middleware(handler, "GetV1ScheduleId")
 	}
 
 	response, err := handler(ctx, request)
@@ -4581,8 +9368,8 @@ func (sh *strictHandler) GetV1ArtifactNamespaceName(ctx *gin.Context, namespace
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetV1ArtifactNamespaceNameResponseObject); ok {
-		if err := validResponse.VisitGetV1ArtifactNamespaceNameResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetV1ScheduleIdResponseObject); ok {
+		if err := validResponse.VisitGetV1ScheduleIdResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4590,19 +9377,25 @@ func (sh *strictHandler) GetV1ArtifactNamespaceName(ctx *gin.Context, namespace
 	}
 }
 
-// DeleteV1ArtifactNamespaceNameHashHash operation middleware
-func (sh *strictHandler) DeleteV1ArtifactNamespaceNameHashHash(ctx *gin.Context, namespace string, name string, hash string) {
-	var request DeleteV1ArtifactNamespaceNameHashHashRequestObject
+// PatchV1ScheduleId operation middleware
+func (sh *strictHandler) PatchV1ScheduleId(ctx *gin.Context, id openapi_types.UUID) {
+	var request PatchV1ScheduleIdRequestObject
 
-	request.Namespace = namespace
-	request.Name = name
-	request.Hash = hash
+	request.Id = id
+
+	var body PatchV1ScheduleIdJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteV1ArtifactNamespaceNameHashHash(ctx, request.(DeleteV1ArtifactNamespaceNameHashHashRequestObject))
+		return sh.ssi.PatchV1ScheduleId(ctx, request.(PatchV1ScheduleIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteV1ArtifactNamespaceNameHashHash")
+		handler = middleware(handler, "PatchV1ScheduleId")
 	}
 
 	response, err := handler(ctx, request)
@@ -4610,8 +9403,8 @@ func (sh *strictHandler) DeleteV1ArtifactNamespaceNameHashHash(ctx *gin.Context,
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(DeleteV1ArtifactNamespaceNameHashHashResponseObject); ok {
-		if err := validResponse.VisitDeleteV1ArtifactNamespaceNameHashHashResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PatchV1ScheduleIdResponseObject); ok {
+		if err := validResponse.VisitPatchV1ScheduleIdResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4619,19 +9412,18 @@ func (sh *strictHandler) DeleteV1ArtifactNamespaceNameHashHash(ctx *gin.Context,
 	}
 }
 
-// GetV1ArtifactNamespaceNameHashHash operation middleware
-func (sh *strictHandler) GetV1ArtifactNamespaceNameHashHash(ctx *gin.Context, namespace string, name string, hash string) {
-	var request GetV1ArtifactNamespaceNameHashHashRequestObject
+// GetV1ScheduleIdHistory operation middleware
+func (sh *strictHandler) GetV1ScheduleIdHistory(ctx *gin.Context, id openapi_types.UUID, params GetV1ScheduleIdHistoryParams) {
+	var request GetV1ScheduleIdHistoryRequestObject
 
-	request.Namespace = namespace
-	request.Name = name
-	request.Hash = hash
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1ArtifactNamespaceNameHashHash(ctx, request.(GetV1ArtifactNamespaceNameHashHashRequestObject))
+		return sh.ssi.GetV1ScheduleIdHistory(ctx, request.(GetV1ScheduleIdHistoryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1ArtifactNamespaceNameHashHash")
+		handler = middleware(handler, "GetV1ScheduleIdHistory")
 	}
 
 	response, err := handler(ctx, request)
@@ -4639,8 +9431,8 @@ func (sh *strictHandler) GetV1ArtifactNamespaceNameHashHash(ctx *gin.Context, na
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetV1ArtifactNamespaceNameHashHashResponseObject); ok {
-		if err := validResponse.VisitGetV1ArtifactNamespaceNameHashHashResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetV1ScheduleIdHistoryResponseObject); ok {
+		if err := validResponse.VisitGetV1ScheduleIdHistoryResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4648,27 +9440,17 @@ func (sh *strictHandler) GetV1ArtifactNamespaceNameHashHash(ctx *gin.Context, na
 	}
 }
 
-// PatchV1ArtifactNamespaceNameHashHash operation middleware
-func (sh *strictHandler) PatchV1ArtifactNamespaceNameHashHash(ctx *gin.Context, namespace string, name string, hash string) {
-	var request PatchV1ArtifactNamespaceNameHashHashRequestObject
-
-	request.Namespace = namespace
-	request.Name = name
-	request.Hash = hash
+// PostV1ScheduleIdPause operation middleware
+func (sh *strictHandler) PostV1ScheduleIdPause(ctx *gin.Context, id openapi_types.UUID) {
+	var request PostV1ScheduleIdPauseRequestObject
 
-	var body PatchV1ArtifactNamespaceNameHashHashJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchV1ArtifactNamespaceNameHashHash(ctx, request.(PatchV1ArtifactNamespaceNameHashHashRequestObject))
+		return sh.ssi.PostV1ScheduleIdPause(ctx, request.(PostV1ScheduleIdPauseRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchV1ArtifactNamespaceNameHashHash")
+		handler = middleware(handler, "PostV1ScheduleIdPause")
 	}
 
 	response, err := handler(ctx, request)
@@ -4676,8 +9458,8 @@ func (sh *strictHandler) PatchV1ArtifactNamespaceNameHashHash(ctx *gin.Context,
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PatchV1ArtifactNamespaceNameHashHashResponseObject); ok {
-		if err := validResponse.VisitPatchV1ArtifactNamespaceNameHashHashResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostV1ScheduleIdPauseResponseObject); ok {
+		if err := validResponse.VisitPostV1ScheduleIdPauseResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4685,19 +9467,17 @@ func (sh *strictHandler) PatchV1ArtifactNamespaceNameHashHash(ctx *gin.Context,
 	}
 }
 
-// DeleteV1ArtifactNamespaceNameTagTag operation middleware
-func (sh *strictHandler) DeleteV1ArtifactNamespaceNameTagTag(ctx *gin.Context, namespace string, name string, tag string) {
-	var request DeleteV1ArtifactNamespaceNameTagTagRequestObject
+// PostV1ScheduleIdResume operation middleware
+func (sh *strictHandler) PostV1ScheduleIdResume(ctx *gin.Context, id openapi_types.UUID) {
+	var request PostV1ScheduleIdResumeRequestObject
 
-	request.Namespace = namespace
-	request.Name = name
-	request.Tag = tag
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteV1ArtifactNamespaceNameTagTag(ctx, request.(DeleteV1ArtifactNamespaceNameTagTagRequestObject))
+		return sh.ssi.PostV1ScheduleIdResume(ctx, request.(PostV1ScheduleIdResumeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteV1ArtifactNamespaceNameTagTag")
+		handler = middleware(handler, "PostV1ScheduleIdResume")
 	}
 
 	response, err := handler(ctx, request)
@@ -4705,8 +9485,8 @@ func (sh *strictHandler) DeleteV1ArtifactNamespaceNameTagTag(ctx *gin.Context, n
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(DeleteV1ArtifactNamespaceNameTagTagResponseObject); ok {
-		if err := validResponse.VisitDeleteV1ArtifactNamespaceNameTagTagResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostV1ScheduleIdResumeResponseObject); ok {
+		if err := validResponse.VisitPostV1ScheduleIdResumeResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4714,19 +9494,17 @@ func (sh *strictHandler) DeleteV1ArtifactNamespaceNameTagTag(ctx *gin.Context, n
 	}
 }
 
-// GetV1ArtifactNamespaceNameTagTag operation middleware
-func (sh *strictHandler) GetV1ArtifactNamespaceNameTagTag(ctx *gin.Context, namespace string, name string, tag string) {
-	var request GetV1ArtifactNamespaceNameTagTagRequestObject
+// GetV1Task operation middleware
+func (sh *strictHandler) GetV1Task(ctx *gin.Context, params GetV1TaskParams) {
+	var request GetV1TaskRequestObject
 
-	request.Namespace = namespace
-	request.Name = name
-	request.Tag = tag
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1ArtifactNamespaceNameTagTag(ctx, request.(GetV1ArtifactNamespaceNameTagTagRequestObject))
+		return sh.ssi.GetV1Task(ctx, request.(GetV1TaskRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1ArtifactNamespaceNameTagTag")
+		handler = middleware(handler, "GetV1Task")
 	}
 
 	response, err := handler(ctx, request)
@@ -4734,8 +9512,8 @@ func (sh *strictHandler) GetV1ArtifactNamespaceNameTagTag(ctx *gin.Context, name
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetV1ArtifactNamespaceNameTagTagResponseObject); ok {
-		if err := validResponse.VisitGetV1ArtifactNamespaceNameTagTagResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetV1TaskResponseObject); ok {
+		if err := validResponse.VisitGetV1TaskResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4743,15 +9521,13 @@ func (sh *strictHandler) GetV1ArtifactNamespaceNameTagTag(ctx *gin.Context, name
 	}
 }
 
-// PatchV1ArtifactNamespaceNameTagTag operation middleware
-func (sh *strictHandler) PatchV1ArtifactNamespaceNameTagTag(ctx *gin.Context, namespace string, name string, tag string) {
-	var request PatchV1ArtifactNamespaceNameTagTagRequestObject
+// PostV1Task operation middleware
+func (sh *strictHandler) PostV1Task(ctx *gin.Context, params PostV1TaskParams) {
+	var request PostV1TaskRequestObject
 
-	request.Namespace = namespace
-	request.Name = name
-	request.Tag = tag
+	request.Params = params
 
-	var body PatchV1ArtifactNamespaceNameTagTagJSONRequestBody
+	var body PostV1TaskJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -4760,10 +9536,10 @@ func (sh *strictHandler) PatchV1ArtifactNamespaceNameTagTag(ctx *gin.Context, na
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchV1ArtifactNamespaceNameTagTag(ctx, request.(PatchV1ArtifactNamespaceNameTagTagRequestObject))
+		return sh.ssi.PostV1Task(ctx, request.(PostV1TaskRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchV1ArtifactNamespaceNameTagTag")
+		handler = middleware(handler, "PostV1Task")
 	}
 
 	response, err := handler(ctx, request)
@@ -4771,8 +9547,8 @@ func (sh *strictHandler) PatchV1ArtifactNamespaceNameTagTag(ctx *gin.Context, na
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PatchV1ArtifactNamespaceNameTagTagResponseObject); ok {
-		if err := validResponse.VisitPatchV1ArtifactNamespaceNameTagTagResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostV1TaskResponseObject); ok {
+		if err := validResponse.VisitPostV1TaskResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4780,11 +9556,66 @@ func (sh *strictHandler) PatchV1ArtifactNamespaceNameTagTag(ctx *gin.Context, na
 	}
 }
 
-// DeleteV1RbacPolicy operation middleware
-func (sh *strictHandler) DeleteV1RbacPolicy(ctx *gin.Context) {
-	var request DeleteV1RbacPolicyRequestObject
+// GetV1TaskGroupId operation middleware
+func (sh *strictHandler) GetV1TaskGroupId(ctx *gin.Context, id string, params GetV1TaskGroupIdParams) {
+	var request GetV1TaskGroupIdRequestObject
 
-	var body DeleteV1RbacPolicyJSONRequestBody
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1TaskGroupId(ctx, request.(GetV1TaskGroupIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1TaskGroupId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetV1TaskGroupIdResponseObject); ok {
+		if err := validResponse.VisitGetV1TaskGroupIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1TaskGroupIdCancel operation middleware
+func (sh *strictHandler) PostV1TaskGroupIdCancel(ctx *gin.Context, id string) {
+	var request PostV1TaskGroupIdCancelRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1TaskGroupIdCancel(ctx, request.(PostV1TaskGroupIdCancelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1TaskGroupIdCancel")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostV1TaskGroupIdCancelResponseObject); ok {
+		if err := validResponse.VisitPostV1TaskGroupIdCancelResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1TaskBatch operation middleware
+func (sh *strictHandler) PostV1TaskBatch(ctx *gin.Context) {
+	var request PostV1TaskBatchRequestObject
+
+	var body PostV1TaskBatchJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -4793,19 +9624,19 @@ func (sh *strictHandler) DeleteV1RbacPolicy(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteV1RbacPolicy(ctx, request.(DeleteV1RbacPolicyRequestObject))
+		return sh.ssi.PostV1TaskBatch(ctx, request.(PostV1TaskBatchRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteV1RbacPolicy")
+		handler = middleware(handler, "PostV1TaskBatch")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		ctx.Error(err)
-		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(DeleteV1RbacPolicyResponseObject); ok {
-		if err := validResponse.VisitDeleteV1RbacPolicyResponse(ctx.Writer); err != nil {
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostV1TaskBatchResponseObject); ok {
+		if err := validResponse.VisitPostV1TaskBatchResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4813,17 +9644,17 @@ func (sh *strictHandler) DeleteV1RbacPolicy(ctx *gin.Context) {
 	}
 }
 
-// GetV1RbacPolicy operation middleware
-func (sh *strictHandler) GetV1RbacPolicy(ctx *gin.Context, params GetV1RbacPolicyParams) {
-	var request GetV1RbacPolicyRequestObject
+// GetV1TaskBatchId operation middleware
+func (sh *strictHandler) GetV1TaskBatchId(ctx *gin.Context, id openapi_types.UUID) {
+	var request GetV1TaskBatchIdRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1RbacPolicy(ctx, request.(GetV1RbacPolicyRequestObject))
+		return sh.ssi.GetV1TaskBatchId(ctx, request.(GetV1TaskBatchIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1RbacPolicy")
+		handler = middleware(handler, "GetV1TaskBatchId")
 	}
 
 	response, err := handler(ctx, request)
@@ -4831,8 +9662,8 @@ func (sh *strictHandler) GetV1RbacPolicy(ctx *gin.Context, params GetV1RbacPolic
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetV1RbacPolicyResponseObject); ok {
-		if err := validResponse.VisitGetV1RbacPolicyResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetV1TaskBatchIdResponseObject); ok {
+		if err := validResponse.VisitGetV1TaskBatchIdResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4840,11 +9671,11 @@ func (sh *strictHandler) GetV1RbacPolicy(ctx *gin.Context, params GetV1RbacPolic
 	}
 }
 
-// PutV1RbacPolicy operation middleware
-func (sh *strictHandler) PutV1RbacPolicy(ctx *gin.Context) {
-	var request PutV1RbacPolicyRequestObject
+// PostV1TaskMap operation middleware
+func (sh *strictHandler) PostV1TaskMap(ctx *gin.Context) {
+	var request PostV1TaskMapRequestObject
 
-	var body PutV1RbacPolicyJSONRequestBody
+	var body PostV1TaskMapJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -4853,10 +9684,10 @@ func (sh *strictHandler) PutV1RbacPolicy(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PutV1RbacPolicy(ctx, request.(PutV1RbacPolicyRequestObject))
+		return sh.ssi.PostV1TaskMap(ctx, request.(PostV1TaskMapRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutV1RbacPolicy")
+		handler = middleware(handler, "PostV1TaskMap")
 	}
 
 	response, err := handler(ctx, request)
@@ -4864,8 +9695,8 @@ func (sh *strictHandler) PutV1RbacPolicy(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PutV1RbacPolicyResponseObject); ok {
-		if err := validResponse.VisitPutV1RbacPolicyResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostV1TaskMapResponseObject); ok {
+		if err := validResponse.VisitPostV1TaskMapResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4873,17 +9704,17 @@ func (sh *strictHandler) PutV1RbacPolicy(ctx *gin.Context) {
 	}
 }
 
-// GetV1RbacResourceGroup operation middleware
-func (sh *strictHandler) GetV1RbacResourceGroup(ctx *gin.Context, params GetV1RbacResourceGroupParams) {
-	var request GetV1RbacResourceGroupRequestObject
+// GetV1TaskMapId operation middleware
+func (sh *strictHandler) GetV1TaskMapId(ctx *gin.Context, id openapi_types.UUID) {
+	var request GetV1TaskMapIdRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1RbacResourceGroup(ctx, request.(GetV1RbacResourceGroupRequestObject))
+		return sh.ssi.GetV1TaskMapId(ctx, request.(GetV1TaskMapIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1RbacResourceGroup")
+		handler = middleware(handler, "GetV1TaskMapId")
 	}
 
 	response, err := handler(ctx, request)
@@ -4891,8 +9722,8 @@ func (sh *strictHandler) GetV1RbacResourceGroup(ctx *gin.Context, params GetV1Rb
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetV1RbacResourceGroupResponseObject); ok {
-		if err := validResponse.VisitGetV1RbacResourceGroupResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetV1TaskMapIdResponseObject); ok {
+		if err := validResponse.VisitGetV1TaskMapIdResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4900,17 +9731,23 @@ func (sh *strictHandler) GetV1RbacResourceGroup(ctx *gin.Context, params GetV1Rb
 	}
 }
 
-// DeleteV1RbacResourceGroupResourceGroup operation middleware
-func (sh *strictHandler) DeleteV1RbacResourceGroupResourceGroup(ctx *gin.Context, resourceGroup string) {
-	var request DeleteV1RbacResourceGroupResourceGroupRequestObject
+// PostV1TaskRetry operation middleware
+func (sh *strictHandler) PostV1TaskRetry(ctx *gin.Context) {
+	var request PostV1TaskRetryRequestObject
 
-	request.ResourceGroup = resourceGroup
+	var body PostV1TaskRetryJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteV1RbacResourceGroupResourceGroup(ctx, request.(DeleteV1RbacResourceGroupResourceGroupRequestObject))
+		return sh.ssi.PostV1TaskRetry(ctx, request.(PostV1TaskRetryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteV1RbacResourceGroupResourceGroup")
+		handler = middleware(handler, "PostV1TaskRetry")
 	}
 
 	response, err := handler(ctx, request)
@@ -4918,8 +9755,8 @@ func (sh *strictHandler) DeleteV1RbacResourceGroupResourceGroup(ctx *gin.Context
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(DeleteV1RbacResourceGroupResourceGroupResponseObject); ok {
-		if err := validResponse.VisitDeleteV1RbacResourceGroupResourceGroupResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostV1TaskRetryResponseObject); ok {
+		if err := validResponse.VisitPostV1TaskRetryResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4927,17 +9764,17 @@ func (sh *strictHandler) DeleteV1RbacResourceGroupResourceGroup(ctx *gin.Context
 	}
 }
 
-// GetV1RbacResourceGroupResourceGroup operation middleware
-func (sh *strictHandler) GetV1RbacResourceGroupResourceGroup(ctx *gin.Context, resourceGroup string) {
-	var request GetV1RbacResourceGroupResourceGroupRequestObject
+// DeleteV1TaskId operation middleware
+func (sh *strictHandler) DeleteV1TaskId(ctx *gin.Context, id string) {
+	var request DeleteV1TaskIdRequestObject
 
-	request.ResourceGroup = resourceGroup
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1RbacResourceGroupResourceGroup(ctx, request.(GetV1RbacResourceGroupResourceGroupRequestObject))
+		return sh.ssi.DeleteV1TaskId(ctx, request.(DeleteV1TaskIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1RbacResourceGroupResourceGroup")
+		handler = middleware(handler, "DeleteV1TaskId")
 	}
 
 	response, err := handler(ctx, request)
@@ -4945,8 +9782,8 @@ func (sh *strictHandler) GetV1RbacResourceGroupResourceGroup(ctx *gin.Context, r
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetV1RbacResourceGroupResourceGroupResponseObject); ok {
-		if err := validResponse.VisitGetV1RbacResourceGroupResourceGroupResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DeleteV1TaskIdResponseObject); ok {
+		if err := validResponse.VisitDeleteV1TaskIdResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4954,17 +9791,17 @@ func (sh *strictHandler) GetV1RbacResourceGroupResourceGroup(ctx *gin.Context, r
 	}
 }
 
-// HeadV1RbacResourceGroupResourceGroup operation middleware
-func (sh *strictHandler) HeadV1RbacResourceGroupResourceGroup(ctx *gin.Context, resourceGroup string) {
-	var request HeadV1RbacResourceGroupResourceGroupRequestObject
+// GetV1TaskId operation middleware
+func (sh *strictHandler) GetV1TaskId(ctx *gin.Context, id string) {
+	var request GetV1TaskIdRequestObject
 
-	request.ResourceGroup = resourceGroup
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.HeadV1RbacResourceGroupResourceGroup(ctx, request.(HeadV1RbacResourceGroupResourceGroupRequestObject))
+		return sh.ssi.GetV1TaskId(ctx, request.(GetV1TaskIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "HeadV1RbacResourceGroupResourceGroup")
+		handler = middleware(handler, "GetV1TaskId")
 	}
 
 	response, err := handler(ctx, request)
@@ -4972,8 +9809,8 @@ func (sh *strictHandler) HeadV1RbacResourceGroupResourceGroup(ctx *gin.Context,
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(HeadV1RbacResourceGroupResourceGroupResponseObject); ok {
-		if err := validResponse.VisitHeadV1RbacResourceGroupResourceGroupResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetV1TaskIdResponseObject); ok {
+		if err := validResponse.VisitGetV1TaskIdResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4981,25 +9818,17 @@ func (sh *strictHandler) HeadV1RbacResourceGroupResourceGroup(ctx *gin.Context,
 	}
 }
 
-// PutV1RbacResourceGroupResourceGroup operation middleware
-func (sh *strictHandler) PutV1RbacResourceGroupResourceGroup(ctx *gin.Context, resourceGroup string) {
-	var request PutV1RbacResourceGroupResourceGroupRequestObject
-
-	request.ResourceGroup = resourceGroup
+// GetV1TaskIdCallback operation middleware
+func (sh *strictHandler) GetV1TaskIdCallback(ctx *gin.Context, id string) {
+	var request GetV1TaskIdCallbackRequestObject
 
-	var body PutV1RbacResourceGroupResourceGroupJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PutV1RbacResourceGroupResourceGroup(ctx, request.(PutV1RbacResourceGroupResourceGroupRequestObject))
+		return sh.ssi.GetV1TaskIdCallback(ctx, request.(GetV1TaskIdCallbackRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutV1RbacResourceGroupResourceGroup")
+		handler = middleware(handler, "GetV1TaskIdCallback")
 	}
 
 	response, err := handler(ctx, request)
@@ -5007,8 +9836,8 @@ func (sh *strictHandler) PutV1RbacResourceGroupResourceGroup(ctx *gin.Context, r
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PutV1RbacResourceGroupResourceGroupResponseObject); ok {
-		if err := validResponse.VisitPutV1RbacResourceGroupResourceGroupResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetV1TaskIdCallbackResponseObject); ok {
+		if err := validResponse.VisitGetV1TaskIdCallbackResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -5016,17 +9845,17 @@ func (sh *strictHandler) PutV1RbacResourceGroupResourceGroup(ctx *gin.Context, r
 	}
 }
 
-// GetV1RbacRole operation middleware
-func (sh *strictHandler) GetV1RbacRole(ctx *gin.Context, params GetV1RbacRoleParams) {
-	var request GetV1RbacRoleRequestObject
+// PostV1TaskIdCancel operation middleware
+func (sh *strictHandler) PostV1TaskIdCancel(ctx *gin.Context, id string) {
+	var request PostV1TaskIdCancelRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1RbacRole(ctx, request.(GetV1RbacRoleRequestObject))
+		return sh.ssi.PostV1TaskIdCancel(ctx, request.(PostV1TaskIdCancelRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1RbacRole")
+		handler = middleware(handler, "PostV1TaskIdCancel")
 	}
 
 	response, err := handler(ctx, request)
@@ -5034,8 +9863,8 @@ func (sh *strictHandler) GetV1RbacRole(ctx *gin.Context, params GetV1RbacRolePar
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetV1RbacRoleResponseObject); ok {
-		if err := validResponse.VisitGetV1RbacRoleResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostV1TaskIdCancelResponseObject); ok {
+		if err := validResponse.VisitPostV1TaskIdCancelResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -5043,17 +9872,18 @@ func (sh *strictHandler) GetV1RbacRole(ctx *gin.Context, params GetV1RbacRolePar
 	}
 }
 
-// DeleteV1RbacRoleRole operation middleware
-func (sh *strictHandler) DeleteV1RbacRoleRole(ctx *gin.Context, role string) {
-	var request DeleteV1RbacRoleRoleRequestObject
+// GetV1TaskIdLogs operation middleware
+func (sh *strictHandler) GetV1TaskIdLogs(ctx *gin.Context, id string, params GetV1TaskIdLogsParams) {
+	var request GetV1TaskIdLogsRequestObject
 
-	request.Role = role
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteV1RbacRoleRole(ctx, request.(DeleteV1RbacRoleRoleRequestObject))
+		return sh.ssi.GetV1TaskIdLogs(ctx, request.(GetV1TaskIdLogsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteV1RbacRoleRole")
+		handler = middleware(handler, "GetV1TaskIdLogs")
 	}
 
 	response, err := handler(ctx, request)
@@ -5061,8 +9891,8 @@ func (sh *strictHandler) DeleteV1RbacRoleRole(ctx *gin.Context, role string) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(DeleteV1RbacRoleRoleResponseObject); ok {
-		if err := validResponse.VisitDeleteV1RbacRoleRoleResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetV1TaskIdLogsResponseObject); ok {
+		if err := validResponse.VisitGetV1TaskIdLogsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -5070,17 +9900,25 @@ func (sh *strictHandler) DeleteV1RbacRoleRole(ctx *gin.Context, role string) {
 	}
 }
 
-// GetV1RbacRoleRole operation middleware
-func (sh *strictHandler) GetV1RbacRoleRole(ctx *gin.Context, role string) {
-	var request GetV1RbacRoleRoleRequestObject
+// PostV1TaskIdLogs operation middleware
+func (sh *strictHandler) PostV1TaskIdLogs(ctx *gin.Context, id string) {
+	var request PostV1TaskIdLogsRequestObject
 
-	request.Role = role
+	request.Id = id
+
+	var body PostV1TaskIdLogsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1RbacRoleRole(ctx, request.(GetV1RbacRoleRoleRequestObject))
+		return sh.ssi.PostV1TaskIdLogs(ctx, request.(PostV1TaskIdLogsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1RbacRoleRole")
+		handler = middleware(handler, "PostV1TaskIdLogs")
 	}
 
 	response, err := handler(ctx, request)
@@ -5088,8 +9926,8 @@ func (sh *strictHandler) GetV1RbacRoleRole(ctx *gin.Context, role string) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetV1RbacRoleRoleResponseObject); ok {
-		if err := validResponse.VisitGetV1RbacRoleRoleResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostV1TaskIdLogsResponseObject); ok {
+		if err := validResponse.VisitPostV1TaskIdLogsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -5097,17 +9935,18 @@ func (sh *strictHandler) GetV1RbacRoleRole(ctx *gin.Context, role string) {
 	}
 }
 
-// HeadV1RbacRoleRole operation middleware
-func (sh *strictHandler) HeadV1RbacRoleRole(ctx *gin.Context, role string) {
-	var request HeadV1RbacRoleRoleRequestObject
+// GetV1TaskIdLogsExport operation middleware
+func (sh *strictHandler) GetV1TaskIdLogsExport(ctx *gin.Context, id string, params GetV1TaskIdLogsExportParams) {
+	var request GetV1TaskIdLogsExportRequestObject
 
-	request.Role = role
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.HeadV1RbacRoleRole(ctx, request.(HeadV1RbacRoleRoleRequestObject))
+		return sh.ssi.GetV1TaskIdLogsExport(ctx, request.(GetV1TaskIdLogsExportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "HeadV1RbacRoleRole")
+		handler = middleware(handler, "GetV1TaskIdLogsExport")
 	}
 
 	response, err := handler(ctx, request)
@@ -5115,8 +9954,8 @@ func (sh *strictHandler) HeadV1RbacRoleRole(ctx *gin.Context, role string) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(HeadV1RbacRoleRoleResponseObject); ok {
-		if err := validResponse.VisitHeadV1RbacRoleRoleResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetV1TaskIdLogsExportResponseObject); ok {
+		if err := validResponse.VisitGetV1TaskIdLogsExportResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -5124,25 +9963,18 @@ func (sh *strictHandler) HeadV1RbacRoleRole(ctx *gin.Context, role string) {
 	}
 }
 
-// PutV1RbacRoleRole operation middleware
-func (sh *strictHandler) PutV1RbacRoleRole(ctx *gin.Context, role string) {
-	var request PutV1RbacRoleRoleRequestObject
-
-	request.Role = role
+// GetV1TaskIdLogsStream operation middleware
+func (sh *strictHandler) GetV1TaskIdLogsStream(ctx *gin.Context, id string, params GetV1TaskIdLogsStreamParams) {
+	var request GetV1TaskIdLogsStreamRequestObject
 
-	var body PutV1RbacRoleRoleJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PutV1RbacRoleRole(ctx, request.(PutV1RbacRoleRoleRequestObject))
+		return sh.ssi.GetV1TaskIdLogsStream(ctx, request.(GetV1TaskIdLogsStreamRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutV1RbacRoleRole")
+		handler = middleware(handler, "GetV1TaskIdLogsStream")
 	}
 
 	response, err := handler(ctx, request)
@@ -5150,8 +9982,8 @@ func (sh *strictHandler) PutV1RbacRoleRole(ctx *gin.Context, role string) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PutV1RbacRoleRoleResponseObject); ok {
-		if err := validResponse.VisitPutV1RbacRoleRoleResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetV1TaskIdLogsStreamResponseObject); ok {
+		if err := validResponse.VisitGetV1TaskIdLogsStreamResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -5159,17 +9991,18 @@ func (sh *strictHandler) PutV1RbacRoleRole(ctx *gin.Context, role string) {
 	}
 }
 
-// GetV1Task operation middleware
-func (sh *strictHandler) GetV1Task(ctx *gin.Context, params GetV1TaskParams) {
-	var request GetV1TaskRequestObject
+// GetV1TaskIdResult operation middleware
+func (sh *strictHandler) GetV1TaskIdResult(ctx *gin.Context, id string, params GetV1TaskIdResultParams) {
+	var request GetV1TaskIdResultRequestObject
 
+	request.Id = id
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1Task(ctx, request.(GetV1TaskRequestObject))
+		return sh.ssi.GetV1TaskIdResult(ctx, request.(GetV1TaskIdResultRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1Task")
+		handler = middleware(handler, "GetV1TaskIdResult")
 	}
 
 	response, err := handler(ctx, request)
@@ -5177,8 +10010,8 @@ func (sh *strictHandler) GetV1Task(ctx *gin.Context, params GetV1TaskParams) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetV1TaskResponseObject); ok {
-		if err := validResponse.VisitGetV1TaskResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetV1TaskIdResultResponseObject); ok {
+		if err := validResponse.VisitGetV1TaskIdResultResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -5186,23 +10019,17 @@ func (sh *strictHandler) GetV1Task(ctx *gin.Context, params GetV1TaskParams) {
 	}
 }
 
-// PostV1Task operation middleware
-func (sh *strictHandler) PostV1Task(ctx *gin.Context) {
-	var request PostV1TaskRequestObject
+// GetV1TaskIdRetries operation middleware
+func (sh *strictHandler) GetV1TaskIdRetries(ctx *gin.Context, id string) {
+	var request GetV1TaskIdRetriesRequestObject
 
-	var body PostV1TaskJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostV1Task(ctx, request.(PostV1TaskRequestObject))
+		return sh.ssi.GetV1TaskIdRetries(ctx, request.(GetV1TaskIdRetriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostV1Task")
+		handler = middleware(handler, "GetV1TaskIdRetries")
 	}
 
 	response, err := handler(ctx, request)
@@ -5210,8 +10037,8 @@ func (sh *strictHandler) PostV1Task(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostV1TaskResponseObject); ok {
-		if err := validResponse.VisitPostV1TaskResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetV1TaskIdRetriesResponseObject); ok {
+		if err := validResponse.VisitGetV1TaskIdRetriesResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -5219,17 +10046,18 @@ func (sh *strictHandler) PostV1Task(ctx *gin.Context) {
 	}
 }
 
-// GetV1TaskId operation middleware
-func (sh *strictHandler) GetV1TaskId(ctx *gin.Context, id string) {
-	var request GetV1TaskIdRequestObject
+// PostV1TaskIdRetry operation middleware
+func (sh *strictHandler) PostV1TaskIdRetry(ctx *gin.Context, id string, params PostV1TaskIdRetryParams) {
+	var request PostV1TaskIdRetryRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1TaskId(ctx, request.(GetV1TaskIdRequestObject))
+		return sh.ssi.PostV1TaskIdRetry(ctx, request.(PostV1TaskIdRetryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1TaskId")
+		handler = middleware(handler, "PostV1TaskIdRetry")
 	}
 
 	response, err := handler(ctx, request)
@@ -5237,8 +10065,8 @@ func (sh *strictHandler) GetV1TaskId(ctx *gin.Context, id string) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetV1TaskIdResponseObject); ok {
-		if err := validResponse.VisitGetV1TaskIdResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostV1TaskIdRetryResponseObject); ok {
+		if err := validResponse.VisitPostV1TaskIdRetryResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -5246,18 +10074,17 @@ func (sh *strictHandler) GetV1TaskId(ctx *gin.Context, id string) {
 	}
 }
 
-// GetV1TaskIdLogs operation middleware
-func (sh *strictHandler) GetV1TaskIdLogs(ctx *gin.Context, id string, params GetV1TaskIdLogsParams) {
-	var request GetV1TaskIdLogsRequestObject
+// GetV1TaskIdTransitions operation middleware
+func (sh *strictHandler) GetV1TaskIdTransitions(ctx *gin.Context, id string) {
+	var request GetV1TaskIdTransitionsRequestObject
 
 	request.Id = id
-	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1TaskIdLogs(ctx, request.(GetV1TaskIdLogsRequestObject))
+		return sh.ssi.GetV1TaskIdTransitions(ctx, request.(GetV1TaskIdTransitionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1TaskIdLogs")
+		handler = middleware(handler, "GetV1TaskIdTransitions")
 	}
 
 	response, err := handler(ctx, request)
@@ -5265,8 +10092,8 @@ func (sh *strictHandler) GetV1TaskIdLogs(ctx *gin.Context, id string, params Get
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetV1TaskIdLogsResponseObject); ok {
-		if err := validResponse.VisitGetV1TaskIdLogsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetV1TaskIdTransitionsResponseObject); ok {
+		if err := validResponse.VisitGetV1TaskIdTransitionsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -5535,85 +10362,324 @@ func (sh *strictHandler) PutV1UserUsername(ctx *gin.Context, username string) {
 	}
 }
 
+// GetV1Workflow operation middleware
+func (sh *strictHandler) GetV1Workflow(ctx *gin.Context, params GetV1WorkflowParams) {
+	var request GetV1WorkflowRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1Workflow(ctx, request.(GetV1WorkflowRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1Workflow")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetV1WorkflowResponseObject); ok {
+		if err := validResponse.VisitGetV1WorkflowResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1Workflow operation middleware
+func (sh *strictHandler) PostV1Workflow(ctx *gin.Context) {
+	var request PostV1WorkflowRequestObject
+
+	var body PostV1WorkflowJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1Workflow(ctx, request.(PostV1WorkflowRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1Workflow")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostV1WorkflowResponseObject); ok {
+		if err := validResponse.VisitPostV1WorkflowResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1WorkflowId operation middleware
+func (sh *strictHandler) GetV1WorkflowId(ctx *gin.Context, id openapi_types.UUID) {
+	var request GetV1WorkflowIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1WorkflowId(ctx, request.(GetV1WorkflowIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1WorkflowId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetV1WorkflowIdResponseObject); ok {
+		if err := validResponse.VisitGetV1WorkflowIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xdW3PbuJL+KyjuPkxOKVayOfuwPi/rOJ4kVUkm5dizDzOpFES2JIxJQAOAcrRe/fct",
-	"XHgHeLFlRXL4klgS2GgA3R/6AjbugpAlK0aBShGc3gUcxIpRAfrDrwTi6IJzxtWnkFEJVKo/8WoVkxBL",
-	"wuj0L8Go+k6ES0iw+mvF2Qq4JIYIqOf1X0RCov/4dw7z4DT4t2nR99Q8LqYXnOtug+0kkJsVBKcB5hxv",
-	"gm3xBZv9BaEMtuqrCETIyUqxEpwGv1FAjKOEcUBzRUagW+CACF3jmEQniupboMBJ+BpHl/B3CkIOGlwH",
-	"75a4i7erJSBuekS3WKAEx3PGE4gUxw4Gf2V8RqIINANNUjiVS6BScQoRSgVwFDEQiDKJlngNaAU8IUIQ",
-	"RpFkCIchCIFkwQREiINgKQ+h3O17KoFTHH8Bvgaer36VgTOKiG2HhG6I9DojFoYp5xCdoA+M3SAsdY+2",
-	"ScwWAs2z9YlAYhKLct+fmPyVpTTa/4qUJkMvjprFuWKlzN4VYx8wX8APEJgV3sQMR4gIJBlDsWKjzNo1",
-	"rciDW2RWnK1JBFFZdpR4hBwi9RHHDXXZTuxQtOKecUnmOJRN8h9B4ghLjNgcYYqwbYjWwJUEngSTGiyE",
-	"HBSjZw5a5+onLbYkASFxslJUlRxlZBU5pTtYBqdBhCU8V02DHCCE5IQu1PRQnECzh084ARdN5+NihUMP",
-	"Df1TL0KrNI6Fg0iazIBrCmqsFTpoiQWaAVCkHoaoRFfp3gK4xki8cNC9wguBsBAsJBoebolcNpjM8bjB",
-	"bRV4J4FdxXdYLJt9/W5+VOwue8zFdhIooSZcSekfpRm2i1XtbVISlGwW7aC/NvaDiREduMLipoTtVcHD",
-	"3DVhZ3yRJkAliomQCk0jBZqErtkN6BFJLG6GTVqI43iGwxuHgNtf0PXlB9tHhJS8Y3GDFEzEIK3S5FKe",
-	"cuKSK6DrJv0LuiacUT2eNeYEz2IQSKQKp8zAnCNqRaqC5O+WomvMK8xx4pjez+p7kMAFWmEh/Ew4aHJQ",
-	"QEuYYx98k3KLFAxxtZ3QnCjCcwm8NpuN6eMguZWLGp7h7yRJE0RzBbVN0QzmavfK+yECJWxtRiQklkr4",
-	"wyVZQ+TUV7PHuDRW3CC7AdlhqLVHuX6cqr+meted4xCm85SG6tn//jN98eJVqLTv/yRe6E/QrXeWD5cS",
-	"uda6oUY3sOkneHoEzslf4ziFnkR02+5RKa4yws6hZdal20x14AIqfc7QzZg6HGKNrVaStbnpHOc867G5",
-	"I9PSRqSbIbnEEoXYIFDWV/fATR8TOwzP0DNbwz/49k78xD9jGS7L1kGVfssmpUxTIciCZvPYMB3Q/yyB",
-	"IgFygjisYhzafRK+EyEJXSBFfQg0b30D+OiQ84iIVYw3n5x2hFpC28AspTJuFW/aCKZ6I+FuewALccu4",
-	"z1Czv/anx1nsgrFL9bWd4EJU69QePHHXAviupm5XU7abqdrJFKXy0voWbzlLV17TBGi0YsR64nVQtD/V",
-	"OCSi8FsWivhAZivqnXf/1TMKFoOXeTVTDsaVaOg9zME4i+EB7JoOPayqbr2s3k8wKdzuTDhbaQ0S0DKl",
-	"e05kzvukMjOumb18fXb+mcUk3DRnNQG5ZJ4JwHHMbiFC766uPiPTEP0CJ4uTCfozeHtx9Weg/vj82xf7",
-	"1z/+DJ6pEQFNE8Xi24urYKJ/V/9d63/Prs7fBZPgzcWHi6uLYBK8uzh7E0yCf5QYLxt6JQXs3otrOoV+",
-	"YdxwpVcQx3GthXjmW8gefSk1cPWg1vtZ585fHZntdJKthnMRq2BUWKQ/Fo18zvpVYz18JqXDtdQy1AZr",
-	"BtN8c9DCEWszbfcOhnasfkxU/sXoDO/VGSYOMLym5O8UENHxtjkBnm8JGQeOrWX0qQ/Up54Eiru0U2AU",
-	"I19My7riEjUkO4CcnE+BP7BFU4eJECk4XNfzjA/jU+pmxqeM2QIBlXzjHFIMa4ib5D6wBdI/ZRt3BLN0",
-	"MUGEztkE3WJOJ8ZZnaA5ljh+5iSegBB4AW7y9kdkY+tOAnlM2LHcJIHq+HQo3wYQ+8aMa+tT9JfNzCSb",
-	"8WI0vvX6kktHLfhtNAiib1i2jEPrwpxQIpYQoRVnIQhB6KJ/+DvGQn7zhDZ0aief8zlniZk8LCSaYxKn",
-	"3C3ymqZq0Ma+tW3qxHoG7eG7/GaH22OGiEBK0aI0BmNhFzOFFKn+HXMQaSy/2WSLwwTXv+fJGDtIL3J7",
-	"kfDcOuCqwQaFLK0IexnZFPj5nzdw0cFHw140TE2CBH//VnwyXbkk+XqlRptFdy5thrgp1a1ZAhOZj7Ko",
-	"js4WdDNbJulkTbt5PoaG+Xl2Fr1umd8W7PX4Y4cdHNZguzenxAvClBO5+aL2KcPdayxIeJbKZZ7FVM/M",
-	"1LfFqJZSrkzGUqG/e1I0AGvDO/doLmgY4zWgs8/vs8SmQJhGyqJIUmrTkXr2iFSuU1B+wiSki/xQcBqs",
-	"X5y8OnmppoOtgOIVCU6DVycvTl4FymySSz2i6frlFJdCkwuQLs1WerAGhNEKLwjVsqrNXzYvQpK54aDj",
-	"jUrUNMfvo+A0eAvy95d5CNSabdo8C07/6DaFCtLW8Eq5ngmimv+dAt9kCbLTICYJUV0USeaEUEUvOH3Z",
-	"RJHtpN77b/O5AIkIzWIIeSpTjdjXK9NPubt94ej266R6muQ/XrwYlDjvZYrnE95UiUY+/ayxjHa820nw",
-	"T8Ocq6t8ENPmyRH95MveT9az9PrxV70fL06EqAdf9n8wP7iwnQT/OWCkrrMgZdzQkl1CjD++qlUXaZJg",
-	"vlFGnVKgfNrzjHmewj39I18UEXxVlMvKOuX4dnqXL9bW/L3VOM+EQ4nNRoU4vi1U1oqbwSC0wuENXsC/",
-	"rIKZRELo2Zqq+v2ZiZKCX+LbfDifDNq2KnxT9nI1U0BVaFk5H15AuuQplBWvsWu29tfS1aBevprGIORr",
-	"Fm1alJmFEuRzITngpKrUuR02IxRrdKl3sq1ztG3gyMudHcDxmDZt4JHqRyBCItVHquZpHG+OFUNe/Nee",
-	"zjLl04djDjjamOydyA6I5SeTJF4gxq0KHgnKWdgp7f33B7epGvn0Tv279Rorb9gt7UK6DMw4zIEDDSFC",
-	"s40H2iqmSx3ZlO1tj+I8fYSbDD3c5Oh0aSZrIKz2NpQeiK2eScxDLkeKY//s/WB+xvRI0CVX9nytZhtk",
-	"FfIBOCPxYnon8eIxUEbixUCQucKLK7z4OSHmCi+Q9pP1WRbjkUUgFLXy8VlH31JP2Qg0I9A8GtAYreyD",
-	"MyWMeViURVhsqSh5C5Z8Kin7QcFHM8hTDHHvMZ4cwH+OEI8WKiVHpbUewzz7CPMIRCgq6+RA8ChFeYZj",
-	"iN0vLYTQiqneB0J+mlBOD7DK53LvWJVZlT8HVFVHO4LUXmLR1q8X98aneqAmghhcqdo3+nvnyfZesRnz",
-	"vAeqxtjMYcVmhkVMC7RoQQcjV08k2vyEnSej5j1jNJMO0ybJ3qY1npBYQUjmJGwiSL+Q7ogZPxdmZOIz",
-	"4sTB4USu4vli5W/Od0HGCstw6ToGLMNlgQwV6DCvMuRGRv5G3W801lFagUJM0QyQpg3Rv4pEnK2egblC",
-	"I74w5ydrGXH10IgyPxJl+iTjhwFM9U3OXun4H4RuKF1FeDSNDh7yDEANxbseHlglhfVw/8uZtWp1v8as",
-	"1eFlrUYXbHTBemSvdumBdae7R9T4+VBjdMKO1AlrA42j8sFGoNkb0Ix+2GgfHbEf1nnKh89wOF3lhT86",
-	"nC1Mi+JMnMXwfIYFRFkJUiWhnMXol8vXZ+fPkKGaQ2VxEjxdxTBBZI6INORc+Jf5Z5czHNrCJI+jj6XK",
-	"J/2VsWZcvj47z4b7pHyKfb1EcMVtxQYzewjs24E4Sgi1M3tk3oqWiVxwMw1U3/ZwUnB+7qWflhHve4sV",
-	"9Rn45mJGev/nRKwu7fKYSKPPX0ksgReDnG2KUi2O/mz1nQGWiquDZg0dV1e21fOFrfzzsE5LpZl8Pdqa",
-	"Qo/pdvU6ZlPG4u6DNl9yiEUZa6YebqE+BTJrFRlP0nSfpCmAi1Te58yha5X6Sjsr4KJw29s00LU2deHH",
-	"imXBKHisBoeblMoDtBFettsI2XupP4tdXbpu4Eg0wYoz4+jSCmjrfl42pmvo3eO0a35Ovll9LqtuJDZC",
-	"QtKyx1/WisWNW/0jbvX72Qudxfx2sy3WpGzcGHsdMY1jlK0D0qsihiDB9K5S0HE71N1umI5+f9kpOnkZ",
-	"yceKLXkEtimgl9Waj2MW7qj82ooG3Me1rZX8LK6q6be5HZ48ewG3WXC2ejHPKOCHJOBvQaKi/u1ZqXBX",
-	"t8wvwVVV7nwJ4Q0i83KeuSYPtlpGh5n3DnB0T1VohV7Te1kWq81f4yir51W2EeqVT5V8MU7+F6KTknTV",
-	"bLBMjE5KctS+L2SXXmkud1E0pNrde9c9VyfD3AS9wFXxQBc6pE0rr8gVznObXd5RrNqd1mqUiR6S4Orh",
-	"y1cXpcVlz64EM5IOUZ4H9HjsHaL8CJk3T7n+PZcmuq+d9KSiBvvKLNTmsFqk6OSIwxEdW1LFD7HV6gfF",
-	"IXT1yt7RB+Mxj0GHow86lIvm7yjWoCRpjDD0jjDoarKd+jy9U//2CSLo+wzyItA+Va6ED5QQGHF8POeq",
-	"ImgO3Nb4M4YGDiYJr/HpyEIVRoaHByiUxvQJSxyAnrTHHqoDGSMOBxhxyAuE6xtedN6Te+R2QJBBLfzg",
-	"0EK7ODfx+dDCB4ql4wkaKG53GCoom5a1AEG2pruNCyj+h0QDKvcq+UICZRF8nABA6aa7ffv9PUye0cm/",
-	"l5OvZu7puPZO+LcOgLS3fbXbMKqVMHYA0z/iGM21P2quZbD1texZeYd5oy8VG+zQm27v4c339uD1JTAV",
-	"5nv67r39dTOG2cZeT2WvRDo7v3r/+8UzX4fmipUf7r3rVXuI165v3TniclV799jVjJe9dfNZ797Oiwwq",
-	"W2h2rY/rOgKrgI+xCzav0t/zRmjE1OGA6osBn9AGeDzbjxW3uhiXNp3pHYl61G4k1NQQJowiPGOpLLsl",
-	"Gl5mG/T+Tcu28z7q2niaVy2W7smy24/mxmMQ65v5DuOF01ZViEBiEoufzHHftwPeU/SnMTM3qnZbXkg1",
-	"RYJxhWM68krhFoRUosniCIRsFf8PqqNdqYDhZc74bnTBZzXpXmYbc4+k1+6ztyzen7y5n9FHP7+9cUAH",
-	"XyTmMp88kgDimC7AWssn6GMqJJqBuSvX2Egkgee60XPJtDU9A5QwDohDaC+3dHFXek7JRIXNftdXOi5r",
-	"vi/nWiot7zEI0Z93yYZzvjfj9wNb9LF/rzIlHaH10aEVWTjz4GsqzA23wytC63ipB0ivhcaBge6ricDu",
-	"PRmtut1LKnq2QfBd33Rlbyv3dWjf0h+O07NN5eZNH33b5nlnP3sBjspVo7vJeVvZHL2Wx/S9r/WV+AWy",
-	"mM8VZJlmF7t2lhALzcW78QZVZjK/r9WdLVddfnzU/F9VOJvCmF0YrEHEmS5Hl6UbEbMWZlijlPVJI2dT",
-	"bDeVurhNBnvEw+Ut39R+rLB5kS8sS2FpwKOI9bCSOuXLU5voWldnqchX9tZsq3SZAkX1WkS3JI61u2Bq",
-	"vngLEpWk8JFq43yEfVfFGQSyT6oozr4ydGqG6zeE5sdUKNxac/Fo7gXVmtehuGU75C4zuYe+9tdtf1xb",
-	"yj9yY7i+t/UxHkc6OHtnZ3ZOKfKvkXO2qXieHhPnEOTZa+h4DJxRig/LpPKKcP8jdHql7U7VKrjmAF0P",
-	"yXVA5mEdoDMofiwH6DS3rgN0ZcO5O4VSPkSXbbaOPElaXtshB+kG2e6Nnf/htvp1lfFHsdi1uh2YzX79",
-	"5Gz1Q39/4Ena+H6nvPOErN5B8gnIddi/k+hjsPvQ2VQa7fkhp396Ke54DHY3Cnhsx2B9rvQ2/66RTctU",
-	"SCAOsRYayYzuJZjiBSQ2r203ckPSkZVz0vFWsitR1Ad0+xIsLop2cldU6O1LUB/4cNIymdbt1+3/BwAA",
-	"//8mMR12C64AAA==",
+	"H4sIAAAAAAAC/+x9a3PbRrbgX+ni7ofkFkU5z7vjqa0axXES37UdX1meuVvjlKcJHJI9Arsx3Q3JjNe/",
+	"Y3/Q/rGtc/qBBgmAICVKlsMviQwC/Th93q/+MMrUslQSpDWjxx9GGkyppAH6x08Civyp1krjvzIlLUiL",
+	"f/KyLETGrVDy9J9GSXxmsgUsOf5ValWCtsINAvg9/SUsLOmP/65hNno8+m+n9dyn7nNz+lRrmnb0cTyy",
+	"qxJGj0dca74afawfqOk/IbOjj/goB5NpUeJSRo9Hv0pgSrOl0sBmOIxh16CBCXnFC5FPcNSfQYIW2Q88",
+	"P4d/VWDsTpvbsnY/eNvaLhbAtJuRXXPDlryYKb2EHFfcssCflJ6KPAdawOZQvLILkBZXCjmrDGiWKzBM",
+	"KssW/ApYCXopjBFKMqsYzzIwhtl6EZAzDUZVOoN02mfSgpa8eA36CnQ8/eYCziQT/j1m6EVG58xUllVa",
+	"Qz5hz5W6ZNzSjP6VQs0Nm4XzycFyUZh07pfK/qQqmd/9iSTAoMNBKM5wKenyLpR6zvUc7gFhSr4qFM+Z",
+	"MMwqxQpcRrq0N7KBD+0oU2p1JXLIU9xB9Mg05PhPXmyQy8ex3woR7llZgswvuLl8ruYmIZ8myeM5b67g",
+	"uZozkFYLwD0wY5WGyWg8jC34OZ9Kq1e47aWQz9x3X7XwCYSa0AiGv7vF/LbBO8ajM23FjGd2c6UvwPKc",
+	"W87UjHHJuH+RXYFGcsJFNzecaUCon7WM9USDA7IVSzCWL0scFYkiDIvDISPgdvR4lHMLJ/jqKK7YWC3k",
+	"HFcs+RI2Z3jJl9A2ZuvnpuRZxxj006CByqooWg74ZbWcgqYRcK+NcdiCGzYFkAw/hjwZFxnJHDQObHkb",
+	"4lzwuWHcGJUJ4nXXwi42FhmxaGO1TewYj/wp/sLNYnOuv7ofcbmLAbBYw7Uawv6wmrONE0QJUPSbbkPQ",
+	"J7wopjy7/BEKcQV6tUlo3FpYlrb3KBbAcj8A8+8TBIVkwhqmkcmNmbG4SzlHjv1V++FAuyg4B26UbJ9n",
+	"xkXzrOtT0YHPN0cLe3XrcuD3g02hUHKOvGPQco3ltjJPVN6C7r9cXLxi7gWWqRyYBltpCTmbrmjOzIOe",
+	"gcxLJaQdMzFjnAX1iGSEhgzEVRcum4ok7ubkf1uAXYBun4fx7FKq6wLyOeQNoCazTJUqgEtC7sBVWshG",
+	"1Hxh/WSG8pw1/HaHNo54l85f77gdl2UGBXLxn7WqynMPx02UzlQlexE6o5EQONxcmnbYi7wF7m+k+FcF",
+	"TJComwnQJkBnc8ih3GQNPm7xbv5WKBD5v84WkFcFdMrPTKsWpe+15TLnOmczceW1W4ZvMnhfanCK3hdL",
+	"ISsLbKEqzXK+OlGzk6WSdsHcf/2ja4DLL5nSjLMwh9LMVNmCccP+knNRrPDnvwAhzZ8eLSfD5ZGHs0zE",
+	"kvE7bpcmvDJBY5nxqrCjxzNeGBi3SVJojMeEZO5zombooBFuLrfpF25wxM9wKp64flcSGmsbvbl4Mlpf",
+	"27Ozl2csvO5wau1khGFwxYuKJJiQw2QJSQwlR34P3SiFC/+B22zRiVOE2m2y1VySOuYk02B9rBVew5Uy",
+	"t5pB++liFE629Io+t7cFtyxTVZGTUj8FBvJfFVRdfFvkA1hHQOwprrHBTKtK5G1YHqG6ZrBWNlOOUByt",
+	"4aLHiNc4vNJ5PZc3BXZSmQmGeCZtipCppkth7WAgkmnQB7y1QyZA1JOMw5GF9fcf/wtedjNIJcnMlNmq",
+	"RXvn78WyWjK5tgcPxiUvEbyzQswXlnHLlMxgwn509E20gK/VXxfCWAYFLBG6uO2lkDhBiuUJ/pRc8yVY",
+	"0A2u8WiDY8gc3oc10RTxw/AUl43LwRWrK9CNuR+16897cboW4txyOJ0nw3WbBn+m5xWCz22U+LVVaGWq",
+	"S4hb3U2LD5pTi8Xlf2Fvzp/7OXKmpAMnAqQAfHPCfpVZPTvTwFGqMM5mgtwallsYM87+4/WvL5kDQm16",
+	"eOeSyMfhRQ2mKuw7b6iPWcGNfeecIlzmYWLI33GLwuDVr68vHBjsQhhc62RtCLZQOIVjMwa+/5aBRGU1",
+	"9++hqCbHStRaNb9mjhE1UMi/HlTMCXuGqmxUPQ1kGmhNmZIzMa805OOU47CMa7LauWT/dfJUZgW/gpPX",
+	"Yi65rTSwBfAcNH5tuZColb8dmQX/+rvv/+fbEZupolDX9SIX8D5u5JcXZ09OXv9y9vV33+OK347eVo8e",
+	"fZPVk1wE9ZJ+gIn7farylXvwdjRh4bwN4xqYksWKGcQ1q1hZTQuRMZ7nGoyhHeSBwBfKWPdAgr1WGr+v",
+	"VxqgM/HP3lmu52DNn/GkiJcoUuJLLa7o+AulSgKn0qwQ8vKkUBkvmP+MlqYBcQiBy90Dr5V7hwguiLQm",
+	"Q66o4goh6Ra7bIoYLdokTA48L4SEDkvgi/OfnnzzzTd/+pLxmQXNrhciW9RIIgyTiqGFRbtSGRiDbrwz",
+	"p+obZqwoCqYrKb3d5ewKNydtJ6jRE/aiMkjrEOTYrCJEIWCjY63SBDyOmIOyxb1Vox9beh4exp+wl8oy",
+	"U5Wl0hZyciIGJdAMd6CAvNqEzlN5JbSSxJ+uuBZ8WoChuQoBEV02OFSvX68e8q9+xDYeNkdTqIWBFQLX",
+	"ki2UAbmmdHBGH+GfGgpSJkm6jRlM5hPGEylirgHKCSNf8XyuYc4tBKM3GSnjEpUiDVYLQGaCp8SLAt8R",
+	"1rjhw1vRUgpyE0USf/8c5NwuRo+//u67VgVf8zbd51VYqmElN2YN1uxVwYV03BcVZ3A0499Eb5JSxZiZ",
+	"779lX3gRaNhcXIFE+v3fZy+efzlms++/HTO3kjEJnzHTkCmdM6XHhEWyKgoiSKkkMFU60fBXN2Ek8789",
+	"u2C4L7cGNws3QSpIviRaxaWvSvBn8eEtgeLt6DF7O6r+x9vRmL0d0U7w0b9/nLBnYdkI8lmhuF0b3ykh",
+	"Y/b9t2wqLIvb5IVR+HsOmVjywu9wws5YtuAaaTkOYYScF0DPeUbKhaZjJbeHd22QVCbWWJUFOO6k+Wrs",
+	"wYEP8BNaO/uCACVmjIILcs48EL8cJ3Lpw9uRusRt0jcf8Z0Pb0egdf0snkQNR49xTraOHTHKMF7GDQEO",
+	"OWQDkmE4kNUS37WL2viknXIDHsLOkeh2F0Sk47hIEEXBDFj32oRdrErIA+Yt+YpJ8sMTUhKskt8jwnCd",
+	"+JI88zO4FpJ4eEYJC2nhCJ7xntntTNyuc3CzICNnCin7voi/Wr4yuCRSVyLzzFklrShwFOn5tlQN3l1y",
+	"Y/H8hjNtPzbixkJp8buikW3Fi2LF4H1WVAY9GKRL+aU+k8OZePyk1XvIV+wLIr+3oz89Wr4ddYi7VmDF",
+	"7U+BSeSXuEylcc0A+S1u96zdr06WVb+Dn15pSO1oYJFjNE/MmLfB/EAt6T/xQ+NWwdlCzBeg091cg7OH",
+	"dAIRNhPa2Pit8m5LHry9NLhfElII6V5TcAr+dMWWgOwrWl4Uo3RC563b6zunzyEJRn2uDTAaLEgHjY0j",
+	"r7SPryimARXQGjru5BOVv2Ns7Y2XbXakf5VNYaZ08xSW6srJL0deXGcLdA23e4YpzNjui2Hux6g4Kb1k",
+	"MarwGP86xZH0jGdwOqtkht/+xYERYxb/x/K515XbNos0pSrbvdk8gDMQ0VeeiIIgCd741MDAs9eVDHAR",
+	"zpiIqgJZP5W0Tmz7mMCGwZ3gYsAsv1oaYRlos58Y/Sfb3Wv+ELrN3L8pfTkr1PVLH0Fo2rk5lCBz86vs",
+	"iKVF6aJy8O4TCspbFS3BGo1I984BoRbdK8i5hfYitaH6JEqSmyV8zg0rgKOUqbVA4zBJGOdL2s3G3h5v",
+	"xHnHrHLuMR9VwufXHnjtSHgrXgrvH93irAin2Omw2L7H3r3Q+bZ8j4/bBtjBudpAwJ28qx40bm1tsGmz",
+	"TzYgcwmrYcYSMahW6JB2NHAQenc73eKqwsCtWwv5Q+2JSC2+KZb8OxwZvVtbWKr2+bTucxZm3My5SMMg",
+	"9Jp3SLuoRZxr+8bdHCEM27H1kE3Svfn+SboHf4We5DRlYj280Bm5t2iqGDGXAY4b+RTsbwuQzABZZ2XB",
+	"M588AO+FoSgvjr5jWK59Ay/aWLkwZcFXL1sZAR6hf8EdJdqMJHvI/0zOTN0R1jLmWumuVBz/6/DxtCra",
+	"OM05PvYArlF1fbQbAy7ELIcGK1/C9UYIbEgwsIMdw3UQMnuFFZteh8qQVo+idQldA91+/HB9Q7cUM2w/",
+	"rzcG9OZZ7Yfqt4Xit4Pat4LSlT332X4+HaFDOwg+c9MmxPxPaysUps4kJGPnBvkE9fS/dexC9aQRIKTa",
+	"8iAMaOf02Fy4KuAGy3UTdiwVp+1c6n6IKeE6osNNkbN3rJ0QNB1pT0DGtY8bkGmDLNnmP2LCxmvLrWkB",
+	"LbctMP2Ru2iMsdyikM185qfllyCZ2nTHtKo9u4Tg3csUiENUy/mqPf4evQ/bx42vpqNi8DwrKjKD/Jyd",
+	"mUJrYPf7rFcQt9gJ+Q6g7wMZqzph4t64UJYXu41pRIhxOi8Npq35HMT2mRbCWKVXbQiDOUE1uowRz8HY",
+	"4CP6FX0/0fU5U7p2GdDUg42fdYRusUtRK28N/ZOPFHerihzXhja60x+dl+aaC9IncXnRwbH85pF5O/qy",
+	"lfCXsFR69cbweQsNnZWlVu/Fkts0XWC6sl59dSBH50UdR6kM+XbOIRfNuJWQ9vtvW49ku4UaATxYCQo5",
+	"iB7VyY1fO3RMY2AEnBunXT3ai2Ct2pFUk3kG0kE92c5UYMTvsH0CIesxx87TS1uJof6+3SAl+ZSJPBc4",
+	"Ay9eNU2qjW/6l9M4sxL0Rkpczbq2ZY12SoY980a9Q8Jjo4dvhEErx9047yYXrJlAk0jTvbUx7fMfzp68",
+	"UoXIWrKpl2AXqkNfCKkBlDnsXnQcBENRPz+9cNE9TOpwf/2b5ygYlEII/Pz0YjSm3/F/b+i/ZxdPfhmN",
+	"Rz8+ff704uloPPrl6dmPo/Ho30a/bcBzPAo65c/tMeN1V8OaCsq+UNqtyrHmolh7w3zZpfcMmAu1xrYZ",
+	"UD36cityNHfmJx2H02g9xKbuXvvT71d572LVFxvn0eUxayebfivgHKxeURpnp3atwYA974pznOOvHmms",
+	"XnlffZJ2iN8FXsaei0toindLGYsuezF9mQkT3DkUDeIsU+UqRKBQSxYdEqUrQBJ0DJ8madj1QhkIIROq",
+	"CTAhRUv4eHVMjJB1iQsuflYVxZ6RFaZhBhp8+sMGUhBXa03fttCUsi5kpVcpo0giR/Tb6LdtWOLm24Ya",
+	"e+fbN8//NtLtN0Y8ULa9M467uEMPrao+n/adW9WeC3Qb132+uc66tFrO+6/XtaJhwXj/wQ+rdoh4Z7d7",
+	"abvPrt2X+GQPP+JOWdzpSFsTuW+n6EHCe3teyc6T8WPgaxhanbCzKSU1Xi9EsXZuDfV82KltMwu6x78T",
+	"7+hteEbHo6rMd0P+ghvL/Fd7KrqEMmuVG2Gbif5LMEuJp1kZWK+8j9zPKzk4yJUU6dVxY6Cg/bbSiESw",
+	"+Yn7QYpjIjTzCoYjJC7q2S4kS7swJb9OivaQTraeULqJNuheeHR+sGntN88U3iU/+DNLvSWLot70NW/m",
+	"V91A1AQ/c9jMMUv2mCV7zJLdL0t29zzJQYSswQm/sxYqfJaHkcmIJbU2Grek5ScZh8I1eAl2DlmkZIP7",
+	"Rwg3MgBbzGOEDQrjkDHnwVNqNddggg0ltCs9RJSJforODf2k1bJvS7T0ehMxB2Pobo4pmwdM2XQVG0OK",
+	"PV+7N9PKzi67LLVTqminJRTSJ6mGJ5EOyRptTxzbt0dHPbyuJNHZPPAbLKlC/F2AbE+gHjOjAmU7rNGV",
+	"e5WYE8kCQIEhwi7mEY8mw0wDj0fxVLs0UKrb3c+ed3yhwfCGa2kzIYVZDAnlpAXQAWgtBZR3WmN9sJiK",
+	"22V/TGWHaupN2G0trR5A0200HGG3ScTDgmieqYVKUDVrHXZYBfiGnWt9KKdRIx4wMB5nL41Qbfu+VnDD",
+	"9N0MCO7pTYreri7+KbD0u0XRV0akOaiOP8n12v9tIKfBu2D2JDEvW1sJ9bZ1Wu8igxpfDsg1Z1yHpYbU",
+	"Q+26p41baS7UvfY0AIpzeKWTxvNp7JCzmVbLEOa3yicjDM+wXm+t1BbMgffWV7+2MdynXBeCZl/31222",
+	"W4pOu1h0LUxIThjOnDtaJj3xAM8brZPWeySxpzFAs2o6UVzYxEVk6NMQoplpMItElwon0tdvqedATRoA",
+	"ifXVSejDA2QUEaQ9Ayfx8OnigH6S9eQ2XdRye1zTyzh2RUrQuov+YvR2WLrQGSv5HFpTM+beU9EleNNq",
+	"rZiQMGZiApNE5ASl1+vduA5vrE4B/whFPJjeUApqDKC0D7sFVbY25bj0yey+eIX4e0AgkfgdQn4BmJ26",
+	"mRD0XlBRWRvB7qy8bIPhcOXl2YYU6LbKginXEiJcUAeDPU67yURUNXUhdKePU8FIS+MQdzAHVZzcurck",
+	"o+ykiazDtVf1CApGolRE6CfpJz1Jfutot0G6YlevdStOYOiho9Xqc25srEfx7Q+mq77hNiHRtbPnat6y",
+	"I2MqaFnIk0CUDg/pNadlFr6z56p9b3AFRXs7UPop5NHkMK3mYybkTI3ZNddy7LY9ZjNuedGVoGfak/Nw",
+	"eP8j871aO43ZbalQcX/7xEjXTqOeL0BmHCBe76bnvFwH1OOh7XloG2WnOJSvWDVW6b3PdeezfMHLu2hs",
+	"1SrChvgRvI+mPEheQLv/Kc0TCNO3nn/oxtXVz64JkY1OahsdvQZrIC94+dR916aADDQ7cVk9nVF3ligB",
+	"TFv9I2utyTrbkcX3IhTRmRZ7kG3ikwtptO3cEK15E64hyLtPZEyYW2utW6IB9+ybHZLqFU4oWDq+i9Jo",
+	"PIqb7Ld0tupIGyi9eUhD8iEaRH9b+RDR6VnjX62apRwvAHRIZkTCBHo4bCDWfT1ESX48PvNT9lJvu3Nn",
+	"s92fH6snO3wIYrUvMMU1X/NAT5x/sR3txiNzKcqyCwH3StDoXt0WtCEgjntSG10uUeA6zbPtqDqn4ONG",
+	"p+dA9OPYlK4Ql2H5MfQ+2VhidwW6W1qrltafiJtmXzVTcV38zYBNGvq4ZxvxQ7sAOWYGgNXhzA7zY4c2",
+	"0rY5K25gB2XAajGfg95VHYh5ooNNnXSicRPa2woDkgBai1ZW927cBqBgaia4PxxQdc/IFn8jPo7aMbk/",
+	"iZcgk0YSrjR0mpbvHI13L1/NWgcbtmp0er7z2x0AIWGS7lMz1ShCwqGGT9yldtyI0kOmpUj42IIyX0Lm",
+	"xgpsyA/xTyg5yjLODGjBC/E75JQM4zPQDUBnN86YGITNO91vk94Yd3D3JhxiJxkSvq87AUb68lkJRHxX",
+	"3ONxxDSXUuED6eSFIudyw+noIq3eS8FN7TVcq4nqdCH6hhguHYW6FCIc65SZTsm7UVQSyH7J37+r/9Uv",
+	"US40ly4I0+KjbU2i2JTIaaZBHC5iVThtIYUVLX7G3WzcegJizGrq+sHuwJnVkC055/DajrYzY7WV6b4p",
+	"sdFt6CrSXaTQmw7wxFuNV0lawPbVpUO2Lo3K1bsWtFu9eiLZdmh6sV5mdW/tE1qKEbZVpYfeRfulMISG",
+	"Sffkeuht+LSTdZ6OtGdhwUE6UcUo6nQVC10G+UDWe1Jt2OID7Ja4C3YW/3bdp4OIIPM/aUFLW0t+pzTF",
+	"0E4gAxTN+Ao1qV3yyyRbjssV3TU2rSwzaol0mfv0OjfYjazyIRZ1A5dvucJgF2u5uxnYbTa6c2K9q2md",
+	"b1S3W0b8dkudzr7HJB/Wxq7100/ctxTW/SnY+mHGuqNKO1DbBUr0EdX41q2sIXQgq7SwKyyGWTpM/YEb",
+	"kZ1VdhHv3yMjF5/Wq1hYW7q79jBk0S50KWpQK+fIiHw/enb26lnIxTGhrf+ykv4iPdqtsAVQaUP9hbtK",
+	"sb4MbPR4dPVo8s3kK4S2KkHyUowej76ZPJp8Q24yu6AdnV59dcqTlmtzaPWxusbd1Pt7LiSJMfIwofrs",
+	"v64Rk5yDSOC0Yjzm0c9g//pVUMJSN50ZPf779iBEPbRP6K00QULg6/+qQK8Cx3o8KsRS4BT19Yh9l2l8",
+	"HG9UAM9mBijFX9Vp6Tg37bhrVkVftU/bco/Gx9/GzXtQv370aKcrHweJ0gjwTZVrI7p+tnGMfr8fx6Nv",
+	"Hz3qmipu4nTzzlP68qvBX67fL0mffzP48/ouU/zwq+Efxis3P45H3+2w07ZbTFO+QZidcIy//4anbqrl",
+	"kusVRiKRgCLY4/WI8b6+x3+Ph2JGv+HIKbGean59+iEe1kf390eSrsq0ELEzhMgHEEnWo5svti95dsnn",
+	"8GdPYKaRarhu+jTp+5UyCYGf8+u4nZehL0cPwW/iXiQzZFQ1laWXH9Yc3uoKUsLbkAa98/VMtdMsv7mX",
+	"wdgfVL7qIWaVWbAnxmrgyyZRR11tKiQn7rI+ycf1FX3c4CNf3drVsR2mcx/zqOgTyJm/qA9bIKweKg95",
+	"9Kc7uoU3go8XGni+cl1JTbgBJd6paznlzTkSfCBczrOdRPbvz9xOceenH/C/HzuVlR/VtdzG6QIzi102",
+	"yExtZ20/Qw9nQ9/OL+7e1c+fw413vcm2ZdKFA9aObHWwonRD3toBxJgn9ED52LeDP4y3oz8Q7hKJPZ7V",
+	"dMU8Qd6Az1g+P/1g+fwQXMby+Y5M5oLPL/j8j8liLvjcFUfXN5WxHAyOlt6V3jK35fOdpj4ymiOj2ZHR",
+	"OKocwmcSHnMzL4vxvKVB5D285GVC7J8U+9h08tRbvHMfT5j6D+LiIaRCPErO+ujmuQs3D6WmpjS5I/NI",
+	"vDy78xAvLz0LkQ1VfQgL+cO4cgYwqwjLO+dVfuY/CKtq7vbIpO7EF+3terM3f1p31ORQQOsVAfS89cae",
+	"Qb4Z930Hqzr6Zj4t38xuHtOaW/RwB4dXn4m3+TM2nhyZD/TRjLeoNkuwPOeWhxbRJWRiJrJNDjLMpXvk",
+	"GX8snhHQ58gnPjk+EUk8HtaLQOvbWEYZulmtd7a02YLx9bOvM+JrJSPeFEgt4HGWcCM9jQ35n+tAnGuM",
+	"SMlnS9BzyDd5DM185DL3yWWGBON3YzDNGyoHhePvibuFFNCjavRpszzHoHbldwMssEYI6+b2V2vUqtf8",
+	"OkatPr2o1dEEO5pgA6JXt2mBbQ93H7nGH49rHI2wB2qE9TGNB2WDHRnNnTGaox121I8esB22Ncsn3hXR",
+	"rzWt3XjqG1ZlSs7EvELSo3HMmC3EfAHGslILhWsP9x/TtcW+d4KEK9BsAUXOuK+gT/siuPa6NHwlXSb5",
+	"76BVhyZGA4/uIgacXGg9IAr8egNaDkQPlH7uPKDrECZBXv+gibmDc0paEDhcgqLmrq8Q4Z2whuV0l7e/",
+	"6rsP7YYklbReRH0QyZg0Iucr2qLfQp3nkd4oHe9Tb0vCwBEaKRi56ws5evzvSd/cPz0a9yeEHFJXT8lx",
+	"G/klwP9DSa+7pNqfwRPtQJo9pev+umv+XltVrndIiw09PfGGljyoaBsrigLV7fQiI8olo5vn6P0xjuIu",
+	"EaqvP6ceCa7FqQZTLSGfsFe8Mq59T/p5aHQEsxlktquSMLKGV7TB++IPnxztJX1ysgWX8yMxHlIlrAzs",
+	"Ro4O97vp8YlCm6uCHppMaWXCsP/g0hFRuG6Mx15g/tXdCOrcLfFIUUeKyu/etYS4t5Wk9JRnp6UqROhQ",
+	"3Ru84tKVyyKVaFXAyZQjVXAyt6miRquCfXH+w9mTL5kbNbqe6sraqixgzMQMhRgN1+ZPCvGu8ynPXrn1",
+	"Hca/gYv1Ewx3bqxp7T+cPQnb/axiNHdVlH2h/f2wDnoMfLcVni+F9JB9YNEfwomIuIH88OmAoA+PdQTD",
+	"qEx09oFpkM+OnWDC0Hefd+9p6TbT7jfm/EkUFnS9yemqvtm/ZT78bbSTfds2AfgmU/Hak9ap/Fsn9NaN",
+	"J/3l4uIVW4JdqLxrRvfr6JDKxCCXVcqLB7isIotlYWnhrqtAPjVnFtGVdaxM6HVk1YxLNPxZkXWVle3q",
+	"2hmvHRumGigdWl43NAsloUNraNG4K/sJ6ghf9esIoc/PHyVO8ROGDz06PgxK8OisNDv3CNorz1Nleo17",
+	"D6gejHXHRbEmIeLtGmZlLCx7ZPy5/+5nLzKOov6Aov5uZGF6ouEftyQW17DsKBgHlewVBQvnwOhUzC6c",
+	"4PSDTk/0467m9obq2G0vt6JO4AwHcwl1IOwmgp43tnLManxYdm2DAvYxbZuYHP85VLh9evjcyXBdxlXH",
+	"bo8I/okhOAYEn/prPAw7Sxrtb8f5BfC2q5wXgHdDz9K83TV88N0Ht6h5vwDP9ySFXtbrZk9xsfn6Dzyv",
+	"7yqv0W69jzTil9J4Tcokwa41HSyg0STBo365oMDFXmiVt9GEcf0OL4cvjG7Z0P6u493MBDrgJnqwp7hc",
+	"kBm0IUqvXr5+RUSHu6gZFtIbSLBDfGiALd88lB6T3XfQ9JgOecyr7LDYt6DyATIZK7s2p+end9vqdV89",
+	"6bPyGtxVZGENhs2mr5MH7I7YIpIadogqYGc/BN02M9j74Czmo9PhwTsdVAG37WtATDp6GAZ7GBBc2+n5",
+	"9AP+d4gTAd+rb33rIuWG+wCRwKHj4YyrBqK18G3iP0fXwCcThCf+9MBcFQ6Hd3dQIMUMcUt8AnTS73to",
+	"buTocfgEPQ7xQr/KgHbtInUH3u7gZMCD39m10I/Om/z5U3Mf4JIejtMAV3uLroJUtVxzEIQzvV2/AK5/",
+	"F28AfbDNJZCi4GEcADjDPdn9A1Seo5G/l5GPkPt8TPtW9u8NgHDV9n5dicPXZuPGUPZs5kobr4QRU1EI",
+	"u/IFJlaLzEI+9gJKVdaI3BWoeQ3xndMQuczRdfDOJdj7/AWFxeUGgH4NWUB2AUIzdS3rBXWoWa/Ddnd2",
+	"LtRb3cO7MNijQPedOzAL1TlBiy/hftoCR3DexLQPkH3IfYLv3LQPkE9N+/oZifv2KpYgcyPUqSrF385p",
+	"QkHy9QJclbKwhmVaoTAuNRjqDDMTGsyEvYTrhCqIHqkIw4S2BOvfKc2sWMLvJM41sFJkl6ioluEK/jCa",
+	"q0X1imZSZG1WMmOI4vqKF47F1Nfm+0YUbgvzMQOeLZiuJLKdUsjkjuvQ4cZ97Nsp0Kpx2/TYf+fBkndV",
+	"5iTs5BD6hTusMMk9qRk1jbfQtP/tqGbsdXVcTYTRxDaog5Mi3qZ/fP31rsmR96B6JETRxpnWFI/TDyIf",
+	"lLtUAwtZDfIlJNJQpe4LYYmZXYNOwOdJeJ3HEAdCw45T7V1fyVBY/bN8m9rQeQVynDW6wzqMK7q/u9u0",
+	"2nJL/EFL9AYxglYPKztPLiWNb/hPjjV7h/ZbbiHI7Q7M6AeKaDxdsWc/btGxb4lctF/HZ0swlovCHKng",
+	"oF7RrSTQ0f/sDXWAajijIt9yjc7We5pd+y4MvndUZ2OzW6YSN90BaeRAjcCaNHJ3jcAG0eax/9dRG77B",
+	"5cn5ntrwqVdqh3V10pX07ScaCjL+9OzH2C/BevUYVsyU/FpCPka3OxjrO5Rtk+W/+DXdpkinH/xm0fV1",
+	"GPY14EYwguHRr7fBHc8reTuuPQTwUcW5ExWH1ZQ6mOEMaUXlDWlqe0PMxDeQjsJSWlGs9ZHa4jZ7lg9q",
+	"ETWIp9AWPlMbwTcL+gOrIXffO2ofub2thZTvoRMbRdWmxDkKoKUw+PR6IQpo4vc1N+Gb4LbKeDVfWFaV",
+	"24lsWNuogZIbh/pMycxzrSOd3WlHqWGEhiJngD7sBJNWy6DcWsVUkeNfpJAo+oQXbEbZ1C5slShm3o3M",
+	"tYtGQu4Gi2Emr6yO2fVCGddRFVDg8cIoRvSJUtENLuR8wp6RPIQrkLbiRbFimZIGR5a2NnMKcRXGStub",
+	"PY4BtSWnCLlW1Ry/EIadvXo2ZmICE5ZxmUEh5HxMevWKOs7J3PlZo7Qe0540zApydjMtkHvwa74ae4ZT",
+	"ajXXYEzdiNh7zHUlJYLKLNS1cd0iU72dgnIm4y7C5gE1YRe4SssvgRoYL5Wxrg9HVRSs5Mb41SsZR5rg",
+	"SO9CeI+VoOtfMi7flRxB8f/+L/vq0SN/0kLW0JqwiAacGSHnhT8zq9gcLEUMakBP2Bvj9pFV2ijtVX/I",
+	"w5j/dfIS3tuTJ+5XTJUDvWG7zFRRqGsEMi7u8EkPdaID/d5htCEO757g4GY8iBH0cnMScylK9kXSv4/g",
+	"TF3c5uIK5Jc3sZE2rbCS/6sKk9Qk3XrGngJLDVdCVcadbMdi3IB7dVRykJiuPN1/AZP5ZMzOnlw8++vT",
+	"L5F81jhCyn4QqZDZEOlmiwEchiiEFeISPMMh3uYooWNv9OPNtjarZIY/pUoFbZT9I9658Ljk2SWfwykR",
+	"/oxncBo++4vl838wpdk/JpPJX/Aeucdvq0ePvsnwT/oL/tGJJz4v7kbr5903SKzNll4hcRsTeqA0r5JY",
+	"m9O/c7MZI9QDnmW8KFCM+TPomjx+d7Pp05TTgTOH3282cePaNEwCIdcYRY7r/slIPl3LcLdp3NYKrtJ7",
+	"FXddir9h8YZrQcmUHoefG4Ub/tRJZ2GJJ9PVzRfhqirbAGBV1wr26GpHkRsn5/z8IYGFW2Q4QS4JQxlL",
+	"nezffXRCr49abZ2cWzjBMUbjmy9rCjOlYfi63PuHWZhalgXUSxsKsfDZIWG2trTBUItr2xdud+KbJe3u",
+	"Jk5Z0iRCqapTemjShjbUkjlIzwN3kPDeeqX3bGpAWuYT6gpubNSZekhy9F8nF8ry4uSJqmSLTUc/bqin",
+	"S4wZhs7b3oab9CuDH48JpUMSSsnwTWxw9+8BiaRYvOFMPg82wzKugx3KnuWwLJUFma1O/hesfIYWNzGi",
+	"R1ZzSM5C+YPSpiMBVNSDsWshc3XNcuXcYevLYdPKRr4Q9OfATv10FPmKFUXsVTSYyFi+4oVw8V8+50Ia",
+	"V4Dyt2cXDCusuK10lJVR0YXlFPK8tiLjsYV89SvQts5EzSErOG4MsdaMGenGZcGF9JhvNt70iE1fNJas",
+	"ZvVsJvgn4kLRPleWTWkklXtHYsn9DRCskhmWEXX77IcYlU8KgVvNcG7JLmEVFP9VIFmS58bdE3cevRXh",
+	"CNYCvzgAgm2q8sDiXQqZ0gI9NoU7VDwa4DkCINFZhHXHFrm943Q1u19DzGZlPn//HOQcieXr7767s4wI",
+	"R1MI6Z1yfW/PTepkS0spL+WEJ0SbEBKXDLguBOiOY1wDNHlUbzNBuXfRx8TkPVIx1nn2Brt2ob9czCjp",
+	"PrJQR6lKN4k0/ChMvKOGPGbhApoblVY9jJRozzzXZWvi2w49HX0y9Pa0Dz6fa5jj4MZyW8Vb1pyetHYH",
+	"EA3uPa748bpaVYKmYdwFQUxdgcaxokuYvJKk1a3nk3DLZlwU6IvyfnZ/4d3tu1dxajeX935GJ1llCLnw",
+	"jHoc9rt7yoKiLOS8z9dKLXO25/E924iseWv3pjmueyS8pHA8UOLL/blcfzuwhKTzbuOb9IPHyFsxrjZR",
+	"fi9j6+MxhnnAxBvSM9bbZvWz+FMXtOu5bIp+Txg6JfgF7xi3LBc52T2aytw4m5GodRGDFdgJO8usiBFR",
+	"roER3nAfLiwI9519UDj2roFxnS3EVbgSziULWlWWyN0Thh/mNpZri7M1P65Di322hGeabqM3YJ1uP7fD",
+	"OA/JONxG487P/TxdiqtpbtTtMja0OJLyAVQ1R3JDqfl0GioGev0jSy5XnnZ8HzMfmo5eh6dXoFfekjWp",
+	"00HmdfGakhS/Vtd0hSm95Ef1/g+UnSCdi0LZBbV+QZnrdG1vBTmNKapitAMm8nBPO6n2Vnm1K1yX4TTA",
+	"hnbpUZO+76PxH/CF0aFtZZrlnopjN1bRTdX0QjiJcQjnM/L5xutJVGUz5WIwQHiRJDI8VNp/OE0ziPYD",
+	"0m6l/b2MtZSg1m6P7CCnaGbQwm5QLuTI/UFX1NVMpZPAAoCPkvLwSu8QUlnysichtpKh4wSpnMgGHd+D",
+	"ApZk6MxC68+I9BP2FHVe+kpDBuIKTC1Xkw9DpgsTkrlWOMnDMckzEpX10Cb1ilfSpd/lE3bmM9gyJbNK",
+	"a4o/1Jq1kGxWOKXXMpL3GGVkv+KOaqEZw4tjn8alwVSFdUNkqvCJedRTlsd1O09aYiT7/Xnvx5KXZCAa",
+	"xg0zSkn8v5K0U2GNnzp4aOxajbxUtlYxgh6PmVllfxzgBS8PLtVf8Pvqpx122OVSRph/Tm7lz97zyhy+",
+	"buFSO4jzhlbMbECK0JeiJmZH4j0y/QUvbyDR48wPXqhvo7djlfydCfXtxEJp5t1C/YW6cnKvdifpOjW9",
+	"M4+DTXl2GcLdJcgcf3YOLaOc0CKPFEnoEDvyMV5vRDsayKMxrSFD2YlG7RxI0kc3PyUZuEG1mM9B++R1",
+	"Wmef7KOo9aGuhcSxCeL3FP9NF7DNPxWA/RmIwLuterF61Zltk5LZtsZI57AkSnPoHiMsLoDVxHiUSjES",
+	"Vqi5b9fGi4KIzldEJC2USCclTdcWq/WALVtwFIQKfUbBMRnCfmGMsI4QNs41+ZFpYE0LTyJ1ocyjswMT",
+	"jnpTOXnDzkt3LRE7xeH2DksUFbqU1A9zvYTg2L7iUN3uA54J0005k3vqANWRf7Ct85OQTi8UaFdOVWXT",
+	"XlAuya6nD9QtEe3txsbvkWyPGuzdaLADxOppEH3D7L0cCuG88dZ1g8rrR4WapwUsOCjTMBfGkk7pMqUc",
+	"5+2hkidhPbdFLc0F7d1b5a7JJ8KhhYzCb2vHcaSow9uECXoOIK3+1AZqJsJDlfDgrAIkOuqt5tOYa5k6",
+	"XG/13uK9syDSVGM3tjeCQmA1lFlwYQ3jbAbXzECmZB4jfEskUkMgkLkvqXY2cMzf+HNQ6V22JPeJHAug",
+	"lsQ1QGqHr7A0pOmzW4emWmzlMA8l4aI/LTiYLcfWbnepGwdrkLKWEIdTvJ/cY8bHEMaGdvOwFhTOxB7S",
+	"cKLZhI1VsqB02yS12gV/yJlFmAZ5ryLxHBd5a0oE7ePWlIcBeak04YF7EIQ5MNB1q10GfEErjT9dsQKu",
+	"oOiagH7cq142DC+MqbrLct2v+1fFOgzWwlqQu5V4GiGz2645bVnN0KpOakt2kOVQhdUSjOFzKlKzXEjj",
+	"FwTv7ZiJuVSa0q+46Vzfv3Y8ozQWHFAB9xDz77/gJvsSIeQ5yxc4wJd1l8dWdNf5GrLkMONVQVACk43G",
+	"I5DVEtkjp3/Rw9/usQL2uZoPKYK9CNx4Ix17p+JTAvQfpvb0QZkmXuANLVt9bZWGkGzlj5aBtFpAEs71",
+	"lZjRQe1aIAVLwpUX+v7PS3B1mokIfYfo8k7IORjr83eXfMV4WYLMCZf+7MwKfJorZlTM/6vTwYrC9Qqa",
+	"uCwbMOOQ4eJZjoltXuMD/x1ha9yLb2a1FC67hBnxO8R6W4J+rLAlqvyzgw0YBu8zgNxVaNIA3nv/Txfc",
+	"5oZx5IIF9Nsdt6CSRF4HhZLztBfEzc2P2w/jndFBey61Wyiv5RpCHIMZRNv8eOXmJ5c56s66lxW1GhKn",
+	"8L5U2nbaEz+qa1konrcQdLPSzrMqq4JnwHkZonrkI+YhalR33ELGp8HiEWM1NqEZvgmC4oZufY7MJVwX",
+	"QtIgno/8x+tfX3oDR7rwwnM1pzJCfHFM6qLxleyoDNXv0kCl02NDghtqZOwfrrcT6jLG8mVJ/wT2d/eY",
+	"9GX36DfmHjkV1z177J95VuibQ221lJ66Q7ipveRgFY7pQMbST6S2hqnj8QTlprVVEn3SodfJnLhardnF",
+	"B3hgN1fu3p/IfJNvRuV7KiSntW5snOY/Jdxpfrv+5oa299zTSTwbFFHW8myxBGmPjuKDcUFHR3twQWM1",
+	"8GUnF3xNP9fyP1FqfL2vPjEgrSvqNc2E2aSLFEnPUANMWhf5V2oFL02xFZpFJmSY8No/eYaJ2SPRUb7E",
+	"03AlSHyBLq9dhWwIcu7UP5aVWTh+GrmyN2l9RjNxROML9XyhMns7KtT87cibmzm3HN/hgef6NOPkHrwW",
+	"z5qr6taw5EI21ktTuXKfupmDnxZkvjmtZGqK6h9bqCKvbaFkpqCUupPFj7JCkZv+HDIlJWQEsoxahRjK",
+	"tfZ3FUSrlhsbXPW5X44/2Ofc2JOn+OTk2Y+NqnHXWJmEm7Bb+b7Dqxvzfb/Hw/L9LsCQ4YKg8IaI99WF",
+	"inlh4wl3tkBpgPOGJdXEtGk9JzVR78C7PakHi4zo+cixD8axPbh34tgum3lYyPyKF1Vaarhq9kmKjBzZ",
+	"Ql0G4Ri1Z+6CF3iHO3VccsN9gWP8lRfR6+YbWExKrazyXVNR52BOzWg0DXJDgHTdj+oGDzULGIfkbqvC",
+	"AP5DX5KZLMqNFpid5tfpFw5QuI3gs2y0Uu5lTw4ct5sH4NdzMBb1FIFKF7XP/Hz+2Ov8953V0zXl1P+T",
+	"DgcXy68P4X7c5nX0h4OkkQ6kMgvtbG+IuttUmmmH02q26zibl5iHQ//D5v59/fWtHf72+KanMWHcpaLt",
+	"DAz5BTXz5ldcFHxaUPFYzT0m9+ZGjWxngBQgZ+kwMeBfThT3NN0+9LMH186+2UDo3H9Kve+nqzSnmNQ8",
+	"HjqjcGthWdp4M4aQWVHlvs5u5cvrKmnr5kN1x6SaNHpZstvxLfNkt71PJjVrcOjFVUAMCL6ch/ILv9ej",
+	"OncXdBxwdRAhby3jkd1VPAMKdtxtUBvVOheh0GaPIh1h+6MNoT7n5qS6OpC2dA4GbF1s5JlTcwkJAOdg",
+	"DRVw0NUZyZ11noEkMK3rnqjc2Vn0LFPlKjQfQYeESO8NiKoaiqbkEv2aRYVyKukyUCrIf9JqWR9i/YZh",
+	"l1BGWz3cVbA2sCuQLFfpgGedHkwNBuyJjkjdoinOeGEgMqOpUgVweZ+5ZZ9RYdTDKruQPnQROZZUmnIx",
+	"kTG5RGxP1/dW8zWEL1vNpRG4v8FKluegjvkm33cFjXrVnYtk/ltVeTaX9/CUnxo4O2lAG1s/6kKH14Ve",
+	"E9Cb6NxBfZUBvZ3YeMjcJAeSIePaJWm009MbA3obCW3mQNKI+yRBLoXEoUaPvxoPv5mW+o1EZStcCzAw",
+	"JzLO+Gg8PD9yirc488zGG0f6LtXZK0VyigEeg6GY3gt0/DsnW+e5E+6CyFJXXd/gfge+jpvHtmGHvDgB",
+	"zy3lLO7fDc5yuoS+Qm5fpOm7JfvalQYk4w087eXROOULGB1Q4W0iZ0ttllu3YyLbK5TDG25bRywbUsMb",
+	"QOyFyjq67V7Luzu+RaF2v8jWyfmyFAuTDR9RbIDKtBW/ytDwdM0GKHO6BDXBr5DN2otdLmpdanUlcsqY",
+	"gCI3rsQPe5HSqG1dyHAVDSy8/QROmuMF3HX3lZ2YrAfQ8WKLXbwFCOFYhAaYvmNqbyP6xpy6+ECo1lPe",
+	"FsJN9ZAPQeX+OEAf4dKBKL0BsFv/eONHvk/B8GZv7eOYUv3J6Tu3puckPUuIc05XDcuzQ8X5FPC5U9Hp",
+	"UHCOWPxpqVSdKIyJeC0t4/EGNkw4XcdYL6l6EfcX4PkgzG1hmW78FInWOyvnsV99gi9rIxFiKI0pD5ME",
+	"LTay2N35TxIEaOPiClwmBa3tNkTyWlalP95QAgV4wJPdysfpuGi1lAkMru61TXHe7kJPb95tXLfbdItX",
+	"6dnu4BzfTXffkPw319XfNBd+EI0d5/jUdPY3n52u/qlHBD9LHb/bKK+23c0ar1MN2a2OhrslyavK3g3N",
+	"VtZRz710+R5EuMfbI2+HACcP7CKQflP6WunLWaGu9wsYhq9Ns/dLhynytzDXzmHEOM+B7vdz4UNUF+p2",
+	"Njdpp3InQbYIzpsE2AJkfbT02PJ4SJgsQD4NldXPBtwzHqA+pvZI2vcgyFZZITI217xcxPtMJ+ylysGJ",
+	"ffQA5EB1ezIT4Gucw6UX9V11Y3/xiLsRRKoc6LLQ8KIKl3lIGlmEQQ1Tsr7aI/aBo4oWp84b8N8kV4tY",
+	"hSNwwwrgubvftC5CWb/1w0/0qxw3SvXwnbJx/be/IczX1TgQuI9xCiXrlGna3oDLPhLuc7jbPsIk96QI",
+	"1CxhkwWE3z4rReBB3NuRYF4bt1iTxAPv7qiZSEuycWyVjWREFLtFJN+gt3GyjAd8cccgyjn2Pb4LT+M2",
+	"cvkYn2/e0+wR3DANBfdXhpPRuOSSz2HpK4g9Pjpd+ON42DhaFXAy5ZT7T5yTGqVpVSQjnv9w9mTwgFxb",
+	"MeOZNe2rOws/Dx7Q36HTMpZLERy8UzwtHWsjkEbyqgCTDPg6PBs8aK3DZwtfu+8UnHrQ+pgH7zjk55Jq",
+	"k471n/Rg9PG3j/9/AELvyXT4lQEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,124 @@
+package api
+
+import (
+	"api-server/orm"
+	"api-server/queue"
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog/log"
+)
+
+// GetV1TaskGroupId implements [StrictServerInterface].
+func (server *Server) GetV1TaskGroupId(
+	ctx context.Context,
+	request GetV1TaskGroupIdRequestObject,
+) (GetV1TaskGroupIdResponseObject, error) {
+	var after *orm.TaskCursor
+	if request.Params.Cursor != nil {
+		cursor, err := decodeTaskCursor(*request.Params.Cursor)
+		if err != nil {
+			return GetV1TaskGroupId400JSONResponse{
+				GenericBadRequestJSONResponse{
+					Error: "Invalid cursor: " + err.Error(),
+				},
+			}, nil
+		}
+		after = &cursor
+	}
+
+	owner, err := server.visibleOwner(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to determine visible tasks")
+
+		return GetV1TaskGroupId500Response{}, nil
+	}
+
+	// Only the visible tasks of a group are taken into account
+	status, err := server.queueClient.GroupStatus(
+		ctx,
+		request.Id,
+		owner,
+		after,
+		*request.Params.Limit,
+	)
+	if err != nil {
+		if errors.Is(err, &queue.GroupNotFoundError{}) {
+			return GetV1TaskGroupId404JSONResponse{
+				GenericNotFoundJSONResponse{Error: err.Error()},
+			}, nil
+		}
+
+		log.Error().
+			Err(err).
+			Str("group", request.Id).
+			Msg("Failed to retrieve task group")
+
+		return GetV1TaskGroupId500Response{}, nil
+	}
+
+	headers := GetV1TaskGroupId200ResponseHeaders{}
+	if status.NextFailed != nil {
+		headers.XNextCursor = encodeTaskCursor(*status.NextFailed)
+	}
+
+	return GetV1TaskGroupId200JSONResponse{
+		Body:    groupStatusToTaskGroup(request.Id, status),
+		Headers: headers,
+	}, nil
+}
+
+// PostV1TaskGroupIdCancel implements [StrictServerInterface].
+func (server *Server) PostV1TaskGroupIdCancel(
+	ctx context.Context,
+	request PostV1TaskGroupIdCancelRequestObject,
+) (PostV1TaskGroupIdCancelResponseObject, error) {
+	owner, err := server.visibleOwner(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to determine visible tasks")
+
+		return PostV1TaskGroupIdCancel500Response{}, nil
+	}
+
+	canceled, err := server.queueClient.CancelGroup(ctx, request.Id, owner)
+	if err != nil {
+		if errors.Is(err, &queue.GroupNotFoundError{}) {
+			return PostV1TaskGroupIdCancel404JSONResponse{
+				GenericNotFoundJSONResponse{Error: err.Error()},
+			}, nil
+		}
+
+		log.Error().
+			Err(err).
+			Str("group", request.Id).
+			Int("canceled", len(canceled)).
+			Msg("Failed to cancel task group")
+
+		return PostV1TaskGroupIdCancel500Response{}, nil
+	}
+
+	return PostV1TaskGroupIdCancel200JSONResponse{
+		Count: len(canceled),
+		Ids:   canceled,
+	}, nil
+}
+
+func groupStatusToTaskGroup(id string, status *queue.GroupStatus) TaskGroup {
+	group := TaskGroup{
+		Id:       id,
+		Total:    status.Total,
+		Finished: status.Finished,
+		Progress: status.Progress(),
+		States:   status.States,
+		Failed:   make([]TaskGroupMember, len(status.Failed)),
+	}
+
+	for i, member := range status.Failed {
+		group.Failed[i] = TaskGroupMember{Id: member.ID}
+		if member.LastError != "" {
+			group.Failed[i].LastError = &member.LastError
+		}
+	}
+
+	return group
+}
@@ -0,0 +1,132 @@
+package api
+
+import (
+	"api-server/orm"
+	"api-server/queue"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/EnclaveRunner/shareddeps/auth"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// Maximum length of an Idempotency-Key header
+const maxIdempotencyKeyLength = 255
+
+// claimIdempotencyKey reserves an idempotency key for a task submission and
+// returns the claimed key. If the user already used the key within the
+// idempotency window, nothing is claimed and the response to send instead is
+// returned: the task created by the original request, or a conflict if the
+// requests differ or the original task does not exist (yet).
+func (server *Server) claimIdempotencyKey(
+	ctx context.Context,
+	key string,
+	body *CreateTaskRequest,
+) (*orm.IdempotencyKey, PostV1TaskResponseObject) {
+	if key == "" || len(key) > maxIdempotencyKeyLength {
+		return nil, PostV1Task400JSONResponse{GenericBadRequestJSONResponse{
+			Error: fmt.Sprintf(
+				"Idempotency-Key must be between 1 and %d characters long",
+				maxIdempotencyKeyLength,
+			),
+		}}
+	}
+
+	requestHash, err := hashTaskRequest(body)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to hash task request")
+
+		return nil, PostV1Task500Response{}
+	}
+
+	claimed := &orm.IdempotencyKey{
+		Key:         key,
+		SubmittedBy: auth.GetAuthenticatedUser(ctx),
+		TaskID:      uuid.NewString(),
+		RequestHash: requestHash,
+	}
+
+	err = server.db.CreateIdempotencyKey(ctx, claimed, server.idempotencyWindow)
+	if err == nil {
+		return claimed, nil
+	}
+
+	var errConflict *orm.ConflictError
+	if !errors.As(err, &errConflict) {
+		log.Error().Err(err).Msg("Failed to store idempotency key")
+
+		return nil, PostV1Task500Response{}
+	}
+
+	existing, err := server.db.GetIdempotencyKey(
+		ctx,
+		claimed.SubmittedBy,
+		key,
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to retrieve idempotency key")
+
+		return nil, PostV1Task500Response{}
+	}
+
+	if existing.RequestHash != requestHash {
+		return nil, PostV1Task409JSONResponse{
+			Error: "Idempotency-Key was already used for a different request",
+		}
+	}
+
+	return nil, server.replayTask(ctx, existing.TaskID)
+}
+
+// replayTask responds with the task created by an earlier request.
+func (server *Server) replayTask(
+	ctx context.Context,
+	id string,
+) PostV1TaskResponseObject {
+	var state Task
+
+	taskInfo, err := server.queueClient.GetTask(id)
+	if err == nil {
+		state, err = taskToTaskResponse(taskInfo)
+	} else if errors.Is(err, &queue.TaskNotFoundError{}) {
+		var record *orm.Task
+		record, err = server.db.GetTask(ctx, id)
+
+		var errNotFound *orm.NotFoundError
+		if errors.As(err, &errNotFound) {
+			return PostV1Task409JSONResponse{
+				Error: "Task of the original request with this Idempotency-Key " +
+					"does not exist (yet)",
+			}
+		}
+
+		if err == nil {
+			state, err = taskRecordToTaskResponse(record)
+		}
+	}
+
+	if err != nil {
+		log.Error().Err(err).Str("id", id).Msg("Failed to retrieve task")
+
+		return PostV1Task500Response{}
+	}
+
+	return PostV1Task200JSONResponse(state)
+}
+
+// hashTaskRequest returns a digest identifying the content of a task request.
+func hashTaskRequest(body *CreateTaskRequest) (string, error) {
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode task request: %w", err)
+	}
+
+	digest := sha256.Sum256(encoded)
+
+	return hex.EncodeToString(digest[:]), nil
+}
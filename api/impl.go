@@ -1,6 +1,7 @@
 package api
 
 import (
+	"api-server/callback"
 	"api-server/orm"
 	"api-server/proto_gen"
 	"api-server/queue"
@@ -14,12 +15,24 @@ import (
 var _ StrictServerInterface = (*Server)(nil)
 
 type Server struct {
-	authModule     auth.AuthModule
-	db             orm.DB
-	maxRetries     int
-	retention      time.Duration
-	queueClient    queue.QueueClient
-	registryClient proto_gen.RegistryServiceClient
+	authModule         auth.AuthModule
+	db                 orm.DB
+	maxRetries         int
+	retention          time.Duration
+	defaultTimeout     time.Duration
+	maxTimeout         time.Duration
+	maxDeadline        time.Duration
+	maxScheduleHorizon time.Duration
+	idempotencyWindow  time.Duration
+	maxBatchSize       int
+	maxLogBatchSize    int
+	maxLogEntrySize    int
+	maxTaskLogSize     int64
+	restrictVisibility bool
+	callbackTargets    *callback.TargetPolicy
+	queueClient        queue.QueueClient
+	registryClient     proto_gen.RegistryServiceClient
+	signatures         *signatureCache
 }
 
 func NewServer(
@@ -27,15 +40,38 @@ func NewServer(
 	db orm.DB,
 	maxRetries int,
 	retention time.Duration,
+	defaultTimeout time.Duration,
+	maxTimeout time.Duration,
+	maxDeadline time.Duration,
+	maxScheduleHorizon time.Duration,
+	idempotencyWindow time.Duration,
+	maxBatchSize int,
+	maxLogBatchSize int,
+	maxLogEntrySize int,
+	maxTaskLogSize int64,
+	restrictVisibility bool,
+	callbackTargets *callback.TargetPolicy,
 	queueClient queue.QueueClient,
 	registryClient proto_gen.RegistryServiceClient,
 ) *Server {
 	return &Server{
-		db:             db,
-		authModule:     authModule,
-		registryClient: registryClient,
-		queueClient:    queueClient,
-		maxRetries:     maxRetries,
-		retention:      retention,
+		db:                 db,
+		authModule:         authModule,
+		registryClient:     registryClient,
+		queueClient:        queueClient,
+		maxRetries:         maxRetries,
+		retention:          retention,
+		defaultTimeout:     defaultTimeout,
+		maxTimeout:         maxTimeout,
+		maxDeadline:        maxDeadline,
+		maxScheduleHorizon: maxScheduleHorizon,
+		idempotencyWindow:  idempotencyWindow,
+		maxBatchSize:       maxBatchSize,
+		maxLogBatchSize:    maxLogBatchSize,
+		maxLogEntrySize:    maxLogEntrySize,
+		maxTaskLogSize:     maxTaskLogSize,
+		restrictVisibility: restrictVisibility,
+		callbackTargets:    callbackTargets,
+		signatures:         newSignatureCache(),
 	}
 }
@@ -0,0 +1,173 @@
+package api
+
+import (
+	"api-server/orm"
+	"api-server/queue"
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Maximum number of logs loaded at once during an export
+const logExportPageSize = 1000
+
+// GetV1TaskIdLogsExport implements [StrictServerInterface].
+func (server *Server) GetV1TaskIdLogsExport(
+	ctx context.Context,
+	request GetV1TaskIdLogsExportRequestObject,
+) (GetV1TaskIdLogsExportResponseObject, error) {
+	format := Ndjson
+	if request.Params.Format != nil {
+		format = *request.Params.Format
+	}
+
+	if format != Ndjson && format != Text {
+		return GetV1TaskIdLogsExport400JSONResponse{
+			GenericBadRequestJSONResponse{
+				Error: "Invalid format: must be ndjson or text",
+			},
+		}, nil
+	}
+
+	visible, err := server.taskVisible(ctx, request.Id)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to retrieve task")
+
+		return GetV1TaskIdLogsExport500Response{}, nil
+	}
+
+	if !visible {
+		return GetV1TaskIdLogsExport404JSONResponse{GenericNotFoundJSONResponse{
+			Error: (&queue.TaskNotFoundError{Id: request.Id}).Error(),
+		}}, nil
+	}
+
+	return &logExport{
+		ctx:    ctx,
+		server: server,
+		taskID: request.Id,
+		format: format,
+	}, nil
+}
+
+// logExport is the response of GetV1TaskIdLogsExport. It writes the logs of a
+// task page by page, so that large exports are not held in memory.
+type logExport struct {
+	ctx    context.Context //nolint:containedctx // Visitors get no context
+	server *Server
+	taskID string
+	format GetV1TaskIdLogsExportParamsFormat
+}
+
+// VisitGetV1TaskIdLogsExportResponse implements
+// [GetV1TaskIdLogsExportResponseObject]. Errors after the first page was
+// written cannot be reported to the client anymore, they truncate the export.
+func (e *logExport) VisitGetV1TaskIdLogsExportResponse(
+	w http.ResponseWriter,
+) error {
+	logs, err := e.page(nil)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", e.taskID).
+			Msg("Failed to export task logs")
+		w.WriteHeader(http.StatusInternalServerError)
+
+		return nil
+	}
+
+	contentType, extension := "application/x-ndjson", ".ndjson"
+	if e.format == Text {
+		contentType, extension = "text/plain; charset=utf-8", ".log"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType(
+		"attachment",
+		map[string]string{"filename": e.taskID + extension},
+	))
+	w.WriteHeader(http.StatusOK)
+
+	buffered := bufio.NewWriter(w)
+	for {
+		for i := range logs {
+			err = e.write(buffered, &logs[i])
+			if err != nil {
+				return nil //nolint:nilerr // The client disconnected
+			}
+		}
+
+		if len(logs) < logExportPageSize {
+			break
+		}
+
+		last := logs[len(logs)-1]
+		logs, err = e.page(&orm.LogCursor{Timestamp: last.Timestamp, ID: last.ID})
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("id", e.taskID).
+				Msg("Failed to export task logs")
+
+			break
+		}
+	}
+
+	err = buffered.Flush()
+	if err != nil {
+		return nil //nolint:nilerr // The client disconnected
+	}
+
+	return nil
+}
+
+// page returns the logs following the cursor, oldest first.
+func (e *logExport) page(after *orm.LogCursor) ([]orm.TaskLog, error) {
+	logs, err := e.server.db.GetLogsOfTaskAfter(
+		e.ctx,
+		e.taskID,
+		after,
+		logExportPageSize,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get logs of task: %w", err)
+	}
+
+	return logs, nil
+}
+
+// write writes a single log in the format of the export.
+func (e *logExport) write(w io.Writer, taskLog *orm.TaskLog) error {
+	if e.format == Text {
+		_, err := fmt.Fprintf(
+			w,
+			"%s [%s] %s: %s\n",
+			taskLog.Timestamp.UTC().Format(time.RFC3339Nano),
+			taskLog.Level,
+			taskLog.Issuer,
+			taskLog.Message,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to write log: %w", err)
+		}
+
+		return nil
+	}
+
+	err := json.NewEncoder(w).Encode(dbLogToJsonLog(taskLog))
+	if err != nil {
+		return fmt.Errorf("failed to write log: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,246 @@
+package api
+
+import (
+	"api-server/orm"
+	"api-server/queue"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-contrib/sse"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// Interval in which new logs of a streamed task are looked up
+	logStreamPollInterval = time.Second
+	// Interval in which idle streams send a comment, so that proxies do not
+	// close them
+	logStreamHeartbeat = 15 * time.Second
+	// Maximum number of logs loaded at once
+	logStreamPageSize = 500
+
+	logEvent = "log"
+	endEvent = "end"
+)
+
+// GetV1TaskIdLogsStream implements [StrictServerInterface].
+func (server *Server) GetV1TaskIdLogsStream(
+	ctx context.Context,
+	request GetV1TaskIdLogsStreamRequestObject,
+) (GetV1TaskIdLogsStreamResponseObject, error) {
+	var after int64
+	if request.Params.LastEventID != nil && *request.Params.LastEventID != "" {
+		seq, err := decodeLogCursor(*request.Params.LastEventID)
+		if err != nil {
+			return GetV1TaskIdLogsStream400JSONResponse{
+				GenericBadRequestJSONResponse{
+					Error: "Invalid Last-Event-ID: " + err.Error(),
+				},
+			}, nil
+		}
+		after = seq
+	}
+
+	visible, err := server.taskVisible(ctx, request.Id)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to retrieve task")
+
+		return GetV1TaskIdLogsStream500Response{}, nil
+	}
+
+	if !visible {
+		return GetV1TaskIdLogsStream404JSONResponse{GenericNotFoundJSONResponse{
+			Error: (&queue.TaskNotFoundError{Id: request.Id}).Error(),
+		}}, nil
+	}
+
+	return &logStream{
+		ctx:    ctx,
+		server: server,
+		taskID: request.Id,
+		after:  after,
+	}, nil
+}
+
+// logStream is the response of GetV1TaskIdLogsStream. It sends the logs of a
+// task as server-sent events until the task reached a final state or the
+// client disconnected.
+type logStream struct {
+	ctx    context.Context //nolint:containedctx // Visitors get no context
+	server *Server
+	taskID string
+	// Sequence number of the last sent log
+	after int64
+}
+
+// VisitGetV1TaskIdLogsStreamResponse implements
+// [GetV1TaskIdLogsStreamResponseObject]. Errors after the stream started
+// cannot be reported to the client anymore, they end the stream.
+func (s *logStream) VisitGetV1TaskIdLogsStreamResponse(
+	w http.ResponseWriter,
+) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Disable response buffering of nginx
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	controller := http.NewResponseController(w)
+	ticker := time.NewTicker(logStreamPollInterval)
+	defer ticker.Stop()
+
+	lastWrite := time.Now()
+	for {
+		// The state is looked up first, so that logs written before the task
+		// finished are sent before the stream ends
+		state, err := s.server.taskState(s.ctx, s.taskID)
+		if err != nil {
+			s.fail(err, "Failed to retrieve state of streamed task")
+
+			return nil
+		}
+
+		sent, err := s.sendLogs(w)
+		if err != nil {
+			s.fail(err, "Failed to stream task logs")
+
+			return nil
+		}
+
+		if sent > 0 {
+			lastWrite = time.Now()
+		}
+
+		if queue.IsFinalState(state) {
+			err = sse.Encode(w, sse.Event{
+				Event: endEvent,
+				Data:  map[string]string{"state": state},
+			})
+			if err == nil {
+				err = controller.Flush()
+			}
+			if err != nil {
+				s.fail(err, "Failed to end task log stream")
+			}
+
+			return nil
+		}
+
+		if time.Since(lastWrite) >= logStreamHeartbeat {
+			_, err = w.Write([]byte(":heartbeat\n\n"))
+			if err != nil {
+				return nil //nolint:nilerr // The client disconnected
+			}
+
+			lastWrite = time.Now()
+		}
+
+		err = controller.Flush()
+		if err != nil {
+			return nil //nolint:nilerr // The client disconnected
+		}
+
+		select {
+		case <-s.ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendLogs sends all logs stored after the last sent one. Logs are sent in the
+// order they were stored rather than by timestamp, so that batches arriving
+// late are not skipped.
+func (s *logStream) sendLogs(w http.ResponseWriter) (int, error) {
+	sent := 0
+	for {
+		logs, err := s.server.db.GetLogsOfTaskSince(
+			s.ctx,
+			s.taskID,
+			s.after,
+			logStreamPageSize,
+		)
+		if err != nil {
+			return sent, fmt.Errorf("failed to get logs of task: %w", err)
+		}
+
+		for i := range logs {
+			err = sse.Encode(w, sse.Event{
+				Event: logEvent,
+				Id:    encodeLogCursor(logs[i].Seq),
+				Data:  dbLogToJsonLog(&logs[i]),
+			})
+			if err != nil {
+				return sent, fmt.Errorf("failed to send log: %w", err)
+			}
+
+			s.after = logs[i].Seq
+			sent++
+		}
+
+		if len(logs) < logStreamPageSize {
+			return sent, nil
+		}
+	}
+}
+
+func (s *logStream) fail(err error, msg string) {
+	if errors.Is(err, context.Canceled) {
+		// The client disconnected
+		return
+	}
+
+	log.Error().Err(err).Str("id", s.taskID).Msg(msg)
+}
+
+// taskState returns the state a task is reported in, from the queue or, if
+// the queue already dropped the task, from the task history. Tasks that are
+// neither are reported as expired.
+func (server *Server) taskState(
+	ctx context.Context,
+	id string,
+) (string, error) {
+	task, err := server.queueClient.GetTask(id)
+	if err == nil {
+		return queue.ReportedState(task.State.String(), task.Result), nil
+	}
+
+	if !errors.Is(err, &queue.TaskNotFoundError{}) {
+		return "", fmt.Errorf("failed to get task: %w", err)
+	}
+
+	record, err := server.db.GetTask(ctx, id)
+	if err != nil {
+		var errNotFound *orm.NotFoundError
+		if errors.As(err, &errNotFound) {
+			return orm.TaskStateExpired, nil
+		}
+
+		return "", fmt.Errorf("failed to get task record: %w", err)
+	}
+
+	return record.State, nil
+}
+
+// encodeLogCursor returns the event ID of a streamed log, its sequence number.
+func encodeLogCursor(seq int64) string {
+	return strconv.FormatInt(seq, 10)
+}
+
+// decodeLogCursor parses an event ID created by encodeLogCursor.
+func decodeLogCursor(eventID string) (int64, error) {
+	seq, err := strconv.ParseInt(eventID, 10, 64)
+	if err != nil || seq < 0 {
+		return 0, ErrInvalidCursor
+	}
+
+	return seq, nil
+}
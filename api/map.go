@@ -0,0 +1,253 @@
+package api
+
+import (
+	"api-server/orm"
+	pb "api-server/proto_gen"
+	"api-server/workflow"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/EnclaveRunner/shareddeps/auth"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrInvalidTaskMap is returned when the task of a task map is invalid
+var ErrInvalidTaskMap = errors.New("invalid task map")
+
+// PostV1TaskMap implements [StrictServerInterface].
+func (server *Server) PostV1TaskMap(
+	ctx context.Context,
+	request PostV1TaskMapRequestObject,
+) (PostV1TaskMapResponseObject, error) {
+	parameter := 0
+	if request.Body.Parameter != nil {
+		parameter = *request.Body.Parameter
+	}
+
+	stored, err := server.prepareStoredTask(
+		ctx,
+		&request.Body.Task,
+		server.resolveArtifact,
+		paramShape{mapped: &parameter},
+		ErrInvalidTaskMap,
+	)
+	if err != nil {
+		if errors.Is(err, ErrInvalidTaskMap) {
+			return PostV1TaskMap400JSONResponse{GenericBadRequestJSONResponse{
+				Error: err.Error(),
+			}}, nil
+		}
+
+		if errors.Is(err, ErrQueueForbidden) {
+			return PostV1TaskMap403Response{}, nil
+		}
+
+		if field, ok := paramsFieldError(err); ok {
+			field.Field = "task." + field.Field
+
+			return PostV1TaskMap422JSONResponse{FieldErrorJSONResponse{
+				Errors: &[]ErrField{field},
+			}}, nil
+		}
+
+		log.Error().Err(err).Msg("Failed to prepare task of task map")
+
+		return PostV1TaskMap500Response{}, nil
+	}
+
+	list, err := workflow.ListParameter(stored.task, parameter)
+	if err != nil {
+		return PostV1TaskMap400JSONResponse{GenericBadRequestJSONResponse{
+			Error: err.Error(),
+		}}, nil
+	}
+
+	if len(list) > server.maxBatchSize {
+		return PostV1TaskMap413JSONResponse{GenericTooLargeJSONResponse{
+			Error: fmt.Sprintf(
+				"Task map exceeds the maximum size of %d elements",
+				server.maxBatchSize,
+			),
+		}}, nil
+	}
+
+	concurrency := len(list)
+	if request.Body.Concurrency != nil {
+		concurrency = *request.Body.Concurrency
+		if concurrency < 1 {
+			return PostV1TaskMap400JSONResponse{GenericBadRequestJSONResponse{
+				Error: "Concurrency must be at least 1",
+			}}, nil
+		}
+	}
+
+	payload, err := proto.Marshal(stored.task)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to marshal task proto")
+
+		return PostV1TaskMap500Response{}, nil
+	}
+
+	created := &orm.TaskMap{
+		Task:        payload,
+		Parameter:   parameter,
+		Total:       len(list),
+		Concurrency: concurrency,
+		Retries:     stored.retries,
+		Retention:   stored.retention,
+		Timeout:     stored.timeout,
+		Queue:       stored.queue,
+		State:       orm.TaskMapStateRunning,
+		CreatedBy:   auth.GetAuthenticatedUser(ctx),
+	}
+
+	err = server.db.CreateTaskMap(ctx, created)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create task map")
+
+		return PostV1TaskMap500Response{}, nil
+	}
+
+	elements := make([]orm.TaskMapElement, created.Total)
+	for i := range elements {
+		elements[i] = orm.TaskMapElement{
+			Index: i,
+			State: orm.TaskMapElementStateWaiting,
+		}
+	}
+
+	response, err := taskMapToTaskMapResponse(created, elements)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", created.ID.String()).
+			Msg("Failed to transform task map")
+
+		return PostV1TaskMap500Response{}, nil
+	}
+
+	return PostV1TaskMap201JSONResponse(response), nil
+}
+
+// GetV1TaskMapId implements [StrictServerInterface].
+func (server *Server) GetV1TaskMapId(
+	ctx context.Context,
+	request GetV1TaskMapIdRequestObject,
+) (GetV1TaskMapIdResponseObject, error) {
+	found, err := server.db.GetTaskMap(ctx, request.Id)
+	if err != nil {
+		var errNotFound *orm.NotFoundError
+		if errors.As(err, &errNotFound) {
+			return GetV1TaskMapId404JSONResponse{GenericNotFoundJSONResponse{
+				Error: err.Error(),
+			}}, nil
+		}
+
+		log.Error().
+			Err(err).
+			Str("id", request.Id.String()).
+			Msg("Failed to retrieve task map")
+
+		return GetV1TaskMapId500Response{}, nil
+	}
+
+	owner, err := server.visibleOwner(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to determine visible task maps")
+
+		return GetV1TaskMapId500Response{}, nil
+	}
+
+	if owner != nil && found.CreatedBy != *owner {
+		// Task maps of other users are not disclosed
+		return GetV1TaskMapId404JSONResponse{GenericNotFoundJSONResponse{
+			Error: (&orm.NotFoundError{
+				Search: "Task map " + request.Id.String(),
+			}).Error(),
+		}}, nil
+	}
+
+	elements, err := server.db.GetTaskMapElements(ctx, found.ID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id.String()).
+			Msg("Failed to retrieve task map elements")
+
+		return GetV1TaskMapId500Response{}, nil
+	}
+
+	response, err := taskMapToTaskMapResponse(found, elements)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id.String()).
+			Msg("Failed to transform task map")
+
+		return GetV1TaskMapId500Response{}, nil
+	}
+
+	return GetV1TaskMapId200JSONResponse(response), nil
+}
+
+// taskMapToTaskMapResponse converts a task map. The elements have to be
+// ordered by their index.
+func taskMapToTaskMapResponse(
+	found *orm.TaskMap,
+	elements []orm.TaskMapElement,
+) (TaskMap, error) {
+	var task pb.Task
+	if err := proto.Unmarshal(found.Task, &task); err != nil {
+		//nolint:wrapcheck // Error is not used but only logged later on, no error wrap needed
+		return TaskMap{}, err
+	}
+
+	response := TaskMap{
+		Id:          found.ID,
+		Source:      taskSource(&task),
+		Parameter:   found.Parameter,
+		Total:       found.Total,
+		Concurrency: found.Concurrency,
+		State:       TaskMapState(found.State),
+		CreatedBy:   found.CreatedBy,
+		CreatedAt:   found.CreatedAt,
+		UpdatedAt:   found.UpdatedAt,
+		Elements:    make([]TaskMapElement, len(elements)),
+	}
+
+	for i := range elements {
+		response.Elements[i] = TaskMapElement{
+			Index: elements[i].Index,
+			State: TaskMapElementState(elements[i].State),
+		}
+
+		if elements[i].TaskID != "" {
+			response.Elements[i].TaskId = &elements[i].TaskID
+		}
+
+		if elements[i].Error != "" {
+			response.Elements[i].Error = &elements[i].Error
+		}
+	}
+
+	if found.Result != nil {
+		var result pb.Val
+		if err := proto.Unmarshal(found.Result, &result); err != nil {
+			//nolint:wrapcheck // Error is not used but only logged later on, no error wrap needed
+			return TaskMap{}, err
+		}
+
+		values, ok := protoValToAny(&result).([]any)
+		if ok {
+			response.Result = &values
+		}
+	}
+
+	if found.Error != "" {
+		response.Error = &found.Error
+	}
+
+	return response, nil
+}
@@ -0,0 +1,226 @@
+package api
+
+import (
+	"api-server/queue"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// Number of days of queue history returned by default
+	defaultQueueHistoryDays = 7
+	// Maximum number of days of queue history
+	maxQueueHistoryDays = 90
+)
+
+var (
+	// ErrUnknownQueue is returned when a task is submitted to a queue that is
+	// not configured
+	ErrUnknownQueue = errors.New("unknown queue")
+	// ErrQueueForbidden is returned when a user submits a task to a queue they
+	// are not allowed to use
+	ErrQueueForbidden = errors.New("queue may not be used")
+)
+
+// QueueGroup returns the user group whose members may submit tasks to a queue
+// other than the default queue.
+func QueueGroup(name string) string {
+	return "queue_" + name
+}
+
+// queueOf returns the queue a task request is submitted to. Unknown queues are
+// rejected with ErrUnknownQueue, queues the authenticated user may not use with
+// ErrQueueForbidden.
+func (server *Server) queueOf(
+	ctx context.Context,
+	body *CreateTaskRequest,
+) (string, error) {
+	if body.Queue == nil || *body.Queue == "" {
+		return queue.TaskQueueDefault, nil
+	}
+
+	name := *body.Queue
+	if !server.queueClient.HasQueue(name) {
+		return "", fmt.Errorf("%w: %s", ErrUnknownQueue, name)
+	}
+
+	if name == queue.TaskQueueDefault {
+		return name, nil
+	}
+
+	allowed, err := server.userInAnyGroup(ctx, adminGroup, QueueGroup(name))
+	if err != nil {
+		return "", err
+	}
+
+	if !allowed {
+		return "", fmt.Errorf("%w: %s", ErrQueueForbidden, name)
+	}
+
+	return name, nil
+}
+
+// GetV1Queue implements [StrictServerInterface].
+func (server *Server) GetV1Queue(
+	_ context.Context,
+	_ GetV1QueueRequestObject,
+) (GetV1QueueResponseObject, error) {
+	names := server.queueClient.Queues()
+	response := make([]QueueStats, len(names))
+	for i, name := range names {
+		info, err := server.queueClient.QueueInfo(name)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("queue", name).
+				Msg("Failed to retrieve queue statistics")
+
+			return GetV1Queue500Response{}, nil
+		}
+
+		response[i] = queueStats(info)
+	}
+
+	return GetV1Queue200JSONResponse(response), nil
+}
+
+// GetV1QueueName implements [StrictServerInterface].
+func (server *Server) GetV1QueueName(
+	_ context.Context,
+	request GetV1QueueNameRequestObject,
+) (GetV1QueueNameResponseObject, error) {
+	days := defaultQueueHistoryDays
+	if request.Params.Days != nil {
+		days = *request.Params.Days
+	}
+
+	if days < 1 || days > maxQueueHistoryDays {
+		return GetV1QueueName400JSONResponse{
+			GenericBadRequestJSONResponse{
+				Error: fmt.Sprintf(
+					"Invalid days parameter. Must be an integer between 1 and %d",
+					maxQueueHistoryDays,
+				),
+			},
+		}, nil
+	}
+
+	info, err := server.queueClient.QueueInfo(request.Name)
+	if err != nil {
+		if errors.Is(err, &queue.QueueNotFoundError{}) {
+			return GetV1QueueName404JSONResponse{GenericNotFoundJSONResponse{
+				Error: err.Error(),
+			}}, nil
+		}
+
+		log.Error().
+			Err(err).
+			Str("queue", request.Name).
+			Msg("Failed to retrieve queue statistics")
+
+		return GetV1QueueName500Response{}, nil
+	}
+
+	history, err := server.queueClient.QueueHistory(request.Name, days)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("queue", request.Name).
+			Msg("Failed to retrieve queue history")
+
+		return GetV1QueueName500Response{}, nil
+	}
+
+	stats := queueStats(info)
+	dailyStats := make([]QueueDailyStats, len(history))
+	for i, day := range history {
+		dailyStats[i] = QueueDailyStats{
+			Date:      openapi_types.Date{Time: day.Date},
+			Processed: day.Processed,
+			Failed:    day.Failed,
+		}
+	}
+	stats.History = &dailyStats
+
+	return GetV1QueueName200JSONResponse(stats), nil
+}
+
+// PostV1QueueNamePause implements [StrictServerInterface].
+func (server *Server) PostV1QueueNamePause(
+	_ context.Context,
+	request PostV1QueueNamePauseRequestObject,
+) (PostV1QueueNamePauseResponseObject, error) {
+	err := server.queueClient.PauseQueue(request.Name)
+	if err == nil {
+		var info *asynq.QueueInfo
+		info, err = server.queueClient.QueueInfo(request.Name)
+		if err == nil {
+			return PostV1QueueNamePause200JSONResponse(queueStats(info)), nil
+		}
+	}
+
+	if errors.Is(err, &queue.QueueNotFoundError{}) {
+		return PostV1QueueNamePause404JSONResponse{GenericNotFoundJSONResponse{
+			Error: err.Error(),
+		}}, nil
+	}
+
+	log.Error().Err(err).Str("queue", request.Name).Msg("Failed to pause queue")
+
+	return PostV1QueueNamePause500Response{}, nil
+}
+
+// PostV1QueueNameResume implements [StrictServerInterface].
+func (server *Server) PostV1QueueNameResume(
+	_ context.Context,
+	request PostV1QueueNameResumeRequestObject,
+) (PostV1QueueNameResumeResponseObject, error) {
+	err := server.queueClient.ResumeQueue(request.Name)
+	if err == nil {
+		var info *asynq.QueueInfo
+		info, err = server.queueClient.QueueInfo(request.Name)
+		if err == nil {
+			return PostV1QueueNameResume200JSONResponse(queueStats(info)), nil
+		}
+	}
+
+	if errors.Is(err, &queue.QueueNotFoundError{}) {
+		return PostV1QueueNameResume404JSONResponse{GenericNotFoundJSONResponse{
+			Error: err.Error(),
+		}}, nil
+	}
+
+	log.Error().Err(err).Str("queue", request.Name).Msg("Failed to resume queue")
+
+	return PostV1QueueNameResume500Response{}, nil
+}
+
+// queueStats converts the statistics of a queue reported by asynq.
+func queueStats(info *asynq.QueueInfo) QueueStats {
+	return QueueStats{
+		Name:   info.Queue,
+		Paused: info.Paused,
+		Size:   info.Size,
+		States: map[string]int{
+			asynq.TaskStateActive.String():      info.Active,
+			asynq.TaskStatePending.String():     info.Pending,
+			asynq.TaskStateScheduled.String():   info.Scheduled,
+			asynq.TaskStateRetry.String():       info.Retry,
+			asynq.TaskStateArchived.String():    info.Archived,
+			asynq.TaskStateCompleted.String():   info.Completed,
+			asynq.TaskStateAggregating.String(): info.Aggregating,
+		},
+		Processed:      info.Processed,
+		Failed:         info.Failed,
+		ProcessedTotal: info.ProcessedTotal,
+		FailedTotal:    info.FailedTotal,
+		Latency:        info.Latency.String(),
+		MemoryUsage:    info.MemoryUsage,
+		Timestamp:      info.Timestamp,
+	}
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueueStats(t *testing.T) {
+	t.Parallel()
+	stats := queueStats(&asynq.QueueInfo{
+		Queue:          "critical",
+		Paused:         true,
+		Size:           5,
+		Pending:        3,
+		Active:         2,
+		Completed:      7,
+		ProcessedTotal: 40,
+		FailedTotal:    4,
+		Latency:        90 * time.Second,
+		MemoryUsage:    2048,
+	})
+
+	assert.Equal(t, "critical", stats.Name)
+	assert.True(t, stats.Paused)
+	assert.Equal(t, 3, stats.States["pending"])
+	assert.Equal(t, 2, stats.States["active"])
+	assert.Equal(t, 7, stats.States["completed"])
+	assert.Zero(t, stats.States["archived"])
+	assert.Equal(t, "1m30s", stats.Latency)
+	assert.Equal(t, int64(2048), stats.MemoryUsage)
+	assert.Nil(t, stats.History)
+}
+
+func TestGetQueueHistoryDays(t *testing.T) {
+	t.Parallel()
+	server := &Server{}
+
+	for _, days := range []int{0, maxQueueHistoryDays + 1} {
+		response, err := server.GetV1QueueName(
+			t.Context(),
+			GetV1QueueNameRequestObject{
+				Name:   "default",
+				Params: GetV1QueueNameParams{Days: &days},
+			},
+		)
+		require.NoError(t, err)
+		assert.IsType(t, GetV1QueueName400JSONResponse{}, response)
+	}
+}
@@ -0,0 +1,143 @@
+package api
+
+import (
+	"api-server/orm"
+	"api-server/queue"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+)
+
+// GetV1TaskIdResult implements [StrictServerInterface].
+func (server *Server) GetV1TaskIdResult(
+	ctx context.Context,
+	request GetV1TaskIdResultRequestObject,
+) (GetV1TaskIdResultResponseObject, error) {
+	visible, err := server.taskVisible(ctx, request.Id)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to retrieve task")
+
+		return GetV1TaskIdResult500Response{}, nil
+	}
+
+	notFound := GetV1TaskIdResult404JSONResponse{GenericNotFoundJSONResponse{
+		Error: (&queue.TaskNotFoundError{Id: request.Id}).Error(),
+	}}
+	if !visible {
+		return notFound, nil
+	}
+
+	result, found, err := server.taskResult(ctx, request.Id)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to retrieve task result")
+
+		return GetV1TaskIdResult500Response{}, nil
+	}
+
+	if !found {
+		return notFound, nil
+	}
+
+	if result == nil {
+		return GetV1TaskIdResult404JSONResponse{GenericNotFoundJSONResponse{
+			Error: "Task " + request.Id + " has no result",
+		}}, nil
+	}
+
+	format := Json
+	if request.Params.Format != nil {
+		format = *request.Params.Format
+	}
+
+	if format == Raw {
+		return GetV1TaskIdResult200ApplicationoctetStreamResponse{
+			Body:          bytes.NewReader(result),
+			ContentLength: int64(len(result)),
+		}, nil
+	}
+
+	value, err := queue.DecodeResult(result)
+	if err != nil {
+		return GetV1TaskIdResult422JSONResponse{
+			Error: "Result is not a serialized WIT value, use the raw format",
+		}, nil
+	}
+
+	if format == Proto {
+		// Serialize again so that unknown fields are dropped
+		serialized, err := proto.Marshal(value)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("id", request.Id).
+				Msg("Failed to serialize task result")
+
+			return GetV1TaskIdResult500Response{}, nil
+		}
+
+		return GetV1TaskIdResult200ApplicationxProtobufResponse{
+			Body:          bytes.NewReader(serialized),
+			ContentLength: int64(len(serialized)),
+		}, nil
+	}
+
+	return GetV1TaskIdResult200JSONResponse{Value: payloadToAny(value)}, nil
+}
+
+// taskResult returns the result of a task from the queue or, if the queue
+// already dropped the task, from the task history. found is false if the task
+// does not exist.
+func (server *Server) taskResult(
+	ctx context.Context,
+	id string,
+) (result []byte, found bool, err error) {
+	task, err := server.queueClient.GetTask(id)
+	if err == nil {
+		return task.Result, true, nil
+	}
+
+	if !errors.Is(err, &queue.TaskNotFoundError{}) {
+		return nil, false, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	record, err := server.db.GetTask(ctx, id)
+	if err != nil {
+		var errNotFound *orm.NotFoundError
+		if errors.As(err, &errNotFound) {
+			return nil, false, nil
+		}
+
+		return nil, false, fmt.Errorf("failed to get task record: %w", err)
+	}
+
+	return record.Result, true, nil
+}
+
+// resultToAny decodes the result of a task for the task status. Results which
+// are no serialized WIT value are left out, they are served in raw format by
+// the task result endpoint.
+func resultToAny(id string, result []byte) any {
+	if result == nil {
+		return nil
+	}
+
+	value, err := queue.DecodeResult(result)
+	if err != nil {
+		log.Debug().Err(err).Str("id", id).Msg("Task result is not a value")
+
+		return nil
+	}
+
+	// A none option is returned typed to tell it from a missing result
+	return payloadToAny(value)
+}
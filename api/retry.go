@@ -0,0 +1,244 @@
+package api
+
+import (
+	pb "api-server/proto_gen"
+	"api-server/queue"
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/EnclaveRunner/shareddeps/auth"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+)
+
+// PostV1TaskIdRetry implements [StrictServerInterface].
+func (server *Server) PostV1TaskIdRetry(
+	ctx context.Context,
+	request PostV1TaskIdRetryRequestObject,
+) (PostV1TaskIdRetryResponseObject, error) {
+	visible, err := server.taskVisible(ctx, request.Id)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to retrieve task")
+
+		return PostV1TaskIdRetry500Response{}, nil
+	}
+
+	if !visible {
+		return PostV1TaskIdRetry404JSONResponse{GenericNotFoundJSONResponse{
+			Error: (&queue.TaskNotFoundError{Id: request.Id}).Error(),
+		}}, nil
+	}
+
+	resetRetries := request.Params.ResetRetries != nil &&
+		*request.Params.ResetRetries
+
+	task, err := server.queueClient.RetryTask(
+		ctx,
+		request.Id,
+		resetRetries,
+	)
+	if err != nil {
+		if errors.Is(err, &queue.TaskNotFoundError{}) {
+			return PostV1TaskIdRetry404JSONResponse{GenericNotFoundJSONResponse{
+				Error: err.Error(),
+			}}, nil
+		}
+
+		var errConflict *queue.TaskStateConflictError
+		if errors.As(err, &errConflict) {
+			return PostV1TaskIdRetry409JSONResponse{
+				Error: "Only archived or retrying tasks can be retried, task is " +
+					errConflict.State,
+			}, nil
+		}
+
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to retry task")
+
+		return PostV1TaskIdRetry500Response{}, nil
+	}
+
+	server.recordRetries(ctx, []string{request.Id}, resetRetries)
+
+	state, err := taskToTaskResponse(task)
+	if err != nil {
+		log.Error().Err(err).Str("id", request.Id).Msg("Failed to transform task")
+
+		return PostV1TaskIdRetry500Response{}, nil
+	}
+
+	return PostV1TaskIdRetry200JSONResponse(state), nil
+}
+
+// PostV1TaskRetry implements [StrictServerInterface].
+func (server *Server) PostV1TaskRetry(
+	ctx context.Context,
+	request PostV1TaskRetryRequestObject,
+) (PostV1TaskRetryResponseObject, error) {
+	if request.Body == nil {
+		return PostV1TaskRetry400JSONResponse{GenericBadRequestJSONResponse{
+			Error: ErrRequestBodyRequired.Error(),
+		}}, nil
+	}
+
+	var state asynq.TaskState
+	switch request.Body.State {
+	case Archived:
+		state = asynq.TaskStateArchived
+	case Retry:
+		state = asynq.TaskStateRetry
+	default:
+		return PostV1TaskRetry400JSONResponse{GenericBadRequestJSONResponse{
+			Error: "State must be one of archived, retry",
+		}}, nil
+	}
+
+	owner, err := server.visibleOwner(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to determine visible tasks")
+
+		return PostV1TaskRetry500Response{}, nil
+	}
+
+	var match func(*asynq.TaskInfo) bool
+	if request.Body.Source != nil && *request.Body.Source != "" {
+		match = sourceHasPrefix(*request.Body.Source)
+	}
+
+	if owner != nil {
+		matchSource := match
+		match = func(task *asynq.TaskInfo) bool {
+			return submittedBy(task) == *owner &&
+				(matchSource == nil || matchSource(task))
+		}
+	}
+
+	resetRetries := request.Body.ResetRetries != nil &&
+		*request.Body.ResetRetries
+
+	ids, err := server.queueClient.RetryTasks(
+		ctx,
+		state,
+		match,
+		resetRetries,
+	)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("state", state.String()).
+			Int("retried", len(ids)).
+			Msg("Failed to retry tasks")
+
+		// Tasks that were retried before the failure are still recorded
+		server.recordRetries(ctx, ids, resetRetries)
+
+		return PostV1TaskRetry500Response{}, nil
+	}
+
+	server.recordRetries(ctx, ids, resetRetries)
+
+	return PostV1TaskRetry200JSONResponse{
+		Count: len(ids),
+		Ids:   ids,
+	}, nil
+}
+
+// recordRetries stores who retried the given tasks and schedules their
+// callbacks for delivery again, unless the tasks were replaced by copies with
+// reset retries. The tasks are already retried at this point, so
+// failures are only logged.
+func (server *Server) recordRetries(
+	ctx context.Context,
+	ids []string,
+	resetRetries bool,
+) {
+	triggeredBy := auth.GetAuthenticatedUser(ctx)
+
+	err := server.db.RecordTaskRetries(ctx, ids, triggeredBy, resetRetries)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Strs("ids", ids).
+			Str("user", triggeredBy).
+			Msg("Failed to record task retries")
+	}
+
+	if resetRetries {
+		// The copies replacing the tasks registered callbacks of their own
+		return
+	}
+
+	for _, id := range ids {
+		err := server.db.RearmCallback(ctx, id)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("id", id).
+				Msg("Failed to rearm callback of retried task")
+		}
+	}
+}
+
+// sourceHasPrefix returns a task filter matching tasks whose serialized source
+// starts with prefix.
+func sourceHasPrefix(prefix string) func(*asynq.TaskInfo) bool {
+	return func(task *asynq.TaskInfo) bool {
+		var payload pb.Task
+		err := proto.Unmarshal(task.Payload, &payload)
+		if err != nil || payload.Function == nil {
+			return false
+		}
+
+		return strings.HasPrefix(taskSource(&payload), prefix)
+	}
+}
+
+// GetV1TaskIdRetries implements [StrictServerInterface].
+func (server *Server) GetV1TaskIdRetries(
+	ctx context.Context,
+	request GetV1TaskIdRetriesRequestObject,
+) (GetV1TaskIdRetriesResponseObject, error) {
+	visible, err := server.taskVisible(ctx, request.Id)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to retrieve task")
+
+		return GetV1TaskIdRetries500Response{}, nil
+	}
+
+	if !visible {
+		return GetV1TaskIdRetries404JSONResponse{GenericNotFoundJSONResponse{
+			Error: (&queue.TaskNotFoundError{Id: request.Id}).Error(),
+		}}, nil
+	}
+
+	retries, err := server.db.GetRetriesOfTask(ctx, request.Id)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to retrieve retries of task")
+
+		return GetV1TaskIdRetries500Response{}, nil
+	}
+
+	response := make([]TaskRetry, len(retries))
+	for i, retry := range retries {
+		response[i] = TaskRetry{
+			TriggeredBy:  retry.TriggeredBy,
+			ResetRetries: retry.ResetRetries,
+			Timestamp:    retry.Timestamp,
+		}
+	}
+
+	return GetV1TaskIdRetries200JSONResponse(response), nil
+}
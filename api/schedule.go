@@ -0,0 +1,651 @@
+package api
+
+import (
+	"api-server/orm"
+	pb "api-server/proto_gen"
+	"api-server/queue"
+	"api-server/scheduler"
+	"api-server/wit"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/EnclaveRunner/shareddeps/auth"
+	"github.com/EnclaveRunner/shareddeps/utils"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultScheduleTimezone = "UTC"
+	scheduleNotFound        = "Schedule not found"
+)
+
+var (
+	// ErrInvalidScheduleTask is returned when the task of a schedule is invalid
+	ErrInvalidScheduleTask = errors.New("invalid schedule task")
+	// ErrScheduleNameRequired is returned when a schedule has an empty name
+	ErrScheduleNameRequired = errors.New("schedule name must not be empty")
+)
+
+// GetV1Schedule implements [StrictServerInterface].
+func (server *Server) GetV1Schedule(
+	ctx context.Context,
+	request GetV1ScheduleRequestObject,
+) (GetV1ScheduleResponseObject, error) {
+	owner, err := server.visibleOwner(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to determine visible schedules")
+
+		return GetV1Schedule500Response{}, nil
+	}
+
+	schedules, err := server.db.ListSchedules(ctx, owner)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list schedules")
+
+		return GetV1Schedule500Response{}, nil
+	}
+
+	schedulePage := paginate(
+		schedules,
+		*request.Params.Limit,
+		*request.Params.Offset,
+		func(a, b orm.Schedule) int {
+			return strings.Compare(a.Name, b.Name)
+		},
+	)
+
+	response := make([]Schedule, len(schedulePage))
+	for i := range schedulePage {
+		response[i], err = scheduleToScheduleResponse(&schedulePage[i])
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("id", schedulePage[i].ID.String()).
+				Msg("Failed to transform schedule")
+
+			return GetV1Schedule500Response{}, nil
+		}
+	}
+
+	return GetV1Schedule200JSONResponse(response), nil
+}
+
+// PostV1Schedule implements [StrictServerInterface].
+func (server *Server) PostV1Schedule(
+	ctx context.Context,
+	request PostV1ScheduleRequestObject,
+) (PostV1ScheduleResponseObject, error) {
+	if request.Body.Name == "" {
+		return PostV1Schedule400JSONResponse{GenericBadRequestJSONResponse{
+			Error: ErrScheduleNameRequired.Error(),
+		}}, nil
+	}
+
+	timezone := defaultScheduleTimezone
+	if request.Body.Timezone != nil && *request.Body.Timezone != "" {
+		timezone = *request.Body.Timezone
+	}
+
+	_, err := scheduler.Parse(request.Body.Cron, timezone)
+	if err != nil {
+		return PostV1Schedule400JSONResponse{GenericBadRequestJSONResponse{
+			Error: err.Error(),
+		}}, nil
+	}
+
+	schedule := &orm.Schedule{
+		Name:           request.Body.Name,
+		CronExpression: request.Body.Cron,
+		Timezone:       timezone,
+		Paused:         request.Body.Paused != nil && *request.Body.Paused,
+		CreatedBy:      auth.GetAuthenticatedUser(ctx),
+	}
+
+	err = server.setScheduleTask(ctx, schedule, &request.Body.Task)
+	if err != nil {
+		if errors.Is(err, ErrInvalidScheduleTask) {
+			return PostV1Schedule400JSONResponse{GenericBadRequestJSONResponse{
+				Error: err.Error(),
+			}}, nil
+		}
+
+		if errors.Is(err, ErrQueueForbidden) {
+			return PostV1Schedule403Response{}, nil
+		}
+
+		if field, ok := paramsFieldError(err); ok {
+			field.Field = "task." + field.Field
+
+			return PostV1Schedule422JSONResponse{FieldErrorJSONResponse{
+				Errors: &[]ErrField{field},
+			}}, nil
+		}
+
+		log.Error().Err(err).Msg("Failed to prepare task of schedule")
+
+		return PostV1Schedule500Response{}, nil
+	}
+
+	err = server.db.CreateSchedule(ctx, schedule)
+	if err != nil {
+		var errConflict *orm.ConflictError
+		if errors.As(err, &errConflict) {
+			return PostV1Schedule409JSONResponse{
+				Error: "Schedule with name " + schedule.Name + " already exists",
+			}, nil
+		}
+
+		log.Error().Err(err).Msg("Failed to create schedule")
+
+		return PostV1Schedule500Response{}, nil
+	}
+
+	response, err := scheduleToScheduleResponse(schedule)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", schedule.ID.String()).
+			Msg("Failed to transform schedule")
+
+		return PostV1Schedule500Response{}, nil
+	}
+
+	return PostV1Schedule201JSONResponse(response), nil
+}
+
+// GetV1ScheduleId implements [StrictServerInterface].
+func (server *Server) GetV1ScheduleId(
+	ctx context.Context,
+	request GetV1ScheduleIdRequestObject,
+) (GetV1ScheduleIdResponseObject, error) {
+	schedule, err := server.visibleSchedule(ctx, request.Id)
+	if err != nil {
+		var errNotFound *orm.NotFoundError
+		if errors.As(err, &errNotFound) {
+			return GetV1ScheduleId404JSONResponse{GenericNotFoundJSONResponse{
+				Error: scheduleNotFound,
+			}}, nil
+		}
+
+		log.Error().
+			Err(err).
+			Str("id", request.Id.String()).
+			Msg("Failed to get schedule")
+
+		return GetV1ScheduleId500Response{}, nil
+	}
+
+	response, err := scheduleToScheduleResponse(schedule)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id.String()).
+			Msg("Failed to transform schedule")
+
+		return GetV1ScheduleId500Response{}, nil
+	}
+
+	return GetV1ScheduleId200JSONResponse(response), nil
+}
+
+// PatchV1ScheduleId implements [StrictServerInterface].
+func (server *Server) PatchV1ScheduleId(
+	ctx context.Context,
+	request PatchV1ScheduleIdRequestObject,
+) (PatchV1ScheduleIdResponseObject, error) {
+	schedule, err := server.visibleSchedule(ctx, request.Id)
+	if err != nil {
+		var errNotFound *orm.NotFoundError
+		if errors.As(err, &errNotFound) {
+			return PatchV1ScheduleId404JSONResponse{GenericNotFoundJSONResponse{
+				Error: scheduleNotFound,
+			}}, nil
+		}
+
+		log.Error().
+			Err(err).
+			Str("id", request.Id.String()).
+			Msg("Failed to get schedule")
+
+		return PatchV1ScheduleId500Response{}, nil
+	}
+
+	if request.Body.Name != nil {
+		if *request.Body.Name == "" {
+			return PatchV1ScheduleId400JSONResponse{GenericBadRequestJSONResponse{
+				Error: ErrScheduleNameRequired.Error(),
+			}}, nil
+		}
+
+		schedule.Name = *request.Body.Name
+	}
+
+	if request.Body.Cron != nil {
+		schedule.CronExpression = *request.Body.Cron
+	}
+
+	if request.Body.Timezone != nil {
+		schedule.Timezone = *request.Body.Timezone
+		if schedule.Timezone == "" {
+			schedule.Timezone = defaultScheduleTimezone
+		}
+	}
+
+	_, err = scheduler.Parse(schedule.CronExpression, schedule.Timezone)
+	if err != nil {
+		return PatchV1ScheduleId400JSONResponse{GenericBadRequestJSONResponse{
+			Error: err.Error(),
+		}}, nil
+	}
+
+	if request.Body.Paused != nil {
+		schedule.Paused = *request.Body.Paused
+	}
+
+	if request.Body.Task != nil {
+		err = server.setScheduleTask(ctx, schedule, request.Body.Task)
+		if err != nil {
+			if errors.Is(err, ErrInvalidScheduleTask) {
+				return PatchV1ScheduleId400JSONResponse{
+					GenericBadRequestJSONResponse{Error: err.Error()},
+				}, nil
+			}
+
+			if errors.Is(err, ErrQueueForbidden) {
+				return PatchV1ScheduleId403Response{}, nil
+			}
+
+			if field, ok := paramsFieldError(err); ok {
+				field.Field = "task." + field.Field
+
+				return PatchV1ScheduleId422JSONResponse{FieldErrorJSONResponse{
+					Errors: &[]ErrField{field},
+				}}, nil
+			}
+
+			log.Error().
+				Err(err).
+				Str("id", request.Id.String()).
+				Msg("Failed to prepare task of schedule")
+
+			return PatchV1ScheduleId500Response{}, nil
+		}
+	}
+
+	err = server.db.UpdateSchedule(ctx, schedule)
+	if err != nil {
+		var errConflict *orm.ConflictError
+		if errors.As(err, &errConflict) {
+			return PatchV1ScheduleId409JSONResponse{
+				Error: "Schedule with name " + schedule.Name + " already exists",
+			}, nil
+		}
+
+		log.Error().
+			Err(err).
+			Str("id", request.Id.String()).
+			Msg("Failed to update schedule")
+
+		return PatchV1ScheduleId500Response{}, nil
+	}
+
+	response, err := scheduleToScheduleResponse(schedule)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id.String()).
+			Msg("Failed to transform schedule")
+
+		return PatchV1ScheduleId500Response{}, nil
+	}
+
+	return PatchV1ScheduleId200JSONResponse(response), nil
+}
+
+// DeleteV1ScheduleId implements [StrictServerInterface].
+func (server *Server) DeleteV1ScheduleId(
+	ctx context.Context,
+	request DeleteV1ScheduleIdRequestObject,
+) (DeleteV1ScheduleIdResponseObject, error) {
+	schedule, err := server.visibleSchedule(ctx, request.Id)
+	if err == nil {
+		schedule, err = server.db.DeleteSchedule(ctx, request.Id)
+	}
+	if err != nil {
+		var errNotFound *orm.NotFoundError
+		if errors.As(err, &errNotFound) {
+			return DeleteV1ScheduleId404JSONResponse{GenericNotFoundJSONResponse{
+				Error: scheduleNotFound,
+			}}, nil
+		}
+
+		log.Error().
+			Err(err).
+			Str("id", request.Id.String()).
+			Msg("Failed to delete schedule")
+
+		return DeleteV1ScheduleId500Response{}, nil
+	}
+
+	// The schedule is gone, so there is no next run
+	schedule.Paused = true
+
+	response, err := scheduleToScheduleResponse(schedule)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id.String()).
+			Msg("Failed to transform schedule")
+
+		return DeleteV1ScheduleId500Response{}, nil
+	}
+
+	return DeleteV1ScheduleId200JSONResponse(response), nil
+}
+
+// PostV1ScheduleIdPause implements [StrictServerInterface].
+func (server *Server) PostV1ScheduleIdPause(
+	ctx context.Context,
+	request PostV1ScheduleIdPauseRequestObject,
+) (PostV1ScheduleIdPauseResponseObject, error) {
+	response, err := server.setSchedulePaused(ctx, request.Id, true)
+	if err != nil {
+		var errNotFound *orm.NotFoundError
+		if errors.As(err, &errNotFound) {
+			return PostV1ScheduleIdPause404JSONResponse{
+				GenericNotFoundJSONResponse{Error: scheduleNotFound},
+			}, nil
+		}
+
+		log.Error().
+			Err(err).
+			Str("id", request.Id.String()).
+			Msg("Failed to pause schedule")
+
+		return PostV1ScheduleIdPause500Response{}, nil
+	}
+
+	return PostV1ScheduleIdPause200JSONResponse(response), nil
+}
+
+// PostV1ScheduleIdResume implements [StrictServerInterface].
+func (server *Server) PostV1ScheduleIdResume(
+	ctx context.Context,
+	request PostV1ScheduleIdResumeRequestObject,
+) (PostV1ScheduleIdResumeResponseObject, error) {
+	response, err := server.setSchedulePaused(ctx, request.Id, false)
+	if err != nil {
+		var errNotFound *orm.NotFoundError
+		if errors.As(err, &errNotFound) {
+			return PostV1ScheduleIdResume404JSONResponse{
+				GenericNotFoundJSONResponse{Error: scheduleNotFound},
+			}, nil
+		}
+
+		log.Error().
+			Err(err).
+			Str("id", request.Id.String()).
+			Msg("Failed to resume schedule")
+
+		return PostV1ScheduleIdResume500Response{}, nil
+	}
+
+	return PostV1ScheduleIdResume200JSONResponse(response), nil
+}
+
+// GetV1ScheduleIdHistory implements [StrictServerInterface].
+func (server *Server) GetV1ScheduleIdHistory(
+	ctx context.Context,
+	request GetV1ScheduleIdHistoryRequestObject,
+) (GetV1ScheduleIdHistoryResponseObject, error) {
+	_, err := server.visibleSchedule(ctx, request.Id)
+	if err != nil {
+		var errNotFound *orm.NotFoundError
+		if errors.As(err, &errNotFound) {
+			return GetV1ScheduleIdHistory404JSONResponse{
+				GenericNotFoundJSONResponse{Error: scheduleNotFound},
+			}, nil
+		}
+
+		log.Error().
+			Err(err).
+			Str("id", request.Id.String()).
+			Msg("Failed to get schedule")
+
+		return GetV1ScheduleIdHistory500Response{}, nil
+	}
+
+	runs, err := server.db.GetScheduleRuns(
+		ctx,
+		request.Id,
+		*request.Params.Limit,
+		*request.Params.Offset,
+	)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id.String()).
+			Msg("Failed to get schedule history")
+
+		return GetV1ScheduleIdHistory500Response{}, nil
+	}
+
+	response := make([]ScheduleRun, len(runs))
+	for i, run := range runs {
+		response[i] = ScheduleRun{ScheduledAt: run.ScheduledAt}
+		if run.TaskID != "" {
+			response[i].TaskId = &run.TaskID
+		}
+
+		if run.Error != "" {
+			response[i].Error = &run.Error
+		}
+	}
+
+	return GetV1ScheduleIdHistory200JSONResponse(response), nil
+}
+
+// setScheduleTask validates a task request and stores it as the task spawned by
+// the schedule. Errors caused by the request wrap ErrInvalidScheduleTask, a
+// queue the user may not use results in ErrQueueForbidden and parameters not
+// matching the signature of the function in the errors of the wit package.
+func (server *Server) setScheduleTask(
+	ctx context.Context,
+	schedule *orm.Schedule,
+	body *CreateTaskRequest,
+) error {
+	if body.ProcessAt != nil ||
+		(body.ProcessIn != nil && *body.ProcessIn != "") ||
+		body.Deadline != nil {
+		return fmt.Errorf(
+			"%w: processAt, processIn and deadline are not supported for schedules",
+			ErrInvalidScheduleTask,
+		)
+	}
+
+	task, err := server.taskFromRequest(body)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidScheduleTask, err)
+	}
+
+	retention, err := server.retentionOf(body)
+	if err != nil {
+		return fmt.Errorf(
+			"%w: retention string invalid: %w",
+			ErrInvalidScheduleTask,
+			err,
+		)
+	}
+
+	timeout, err := server.timeoutOf(body)
+	if err != nil {
+		return fmt.Errorf("%w: invalid timeout: %w", ErrInvalidScheduleTask, err)
+	}
+
+	queueName, err := server.queueOf(ctx, body)
+	if err != nil {
+		if errors.Is(err, ErrUnknownQueue) {
+			return fmt.Errorf("%w: %w", ErrInvalidScheduleTask, err)
+		}
+
+		return err
+	}
+
+	// The stored task keeps the tag, each run is pinned to the version hash
+	// the tag points to when the run is enqueued. The parameters are validated
+	// against the version the tag currently points to.
+	versionHash, found, err := server.resolveArtifact(
+		ctx,
+		task.Function.Artifact,
+	)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("%w: artifact not found", ErrInvalidScheduleTask)
+	}
+
+	pinned := proto.CloneOf(task)
+	queue.PinArtifact(pinned, versionHash)
+
+	err = server.conformStoredParameters(ctx, pinned, paramShape{})
+	if errors.Is(err, wit.ErrInterfaceNotFound) ||
+		errors.Is(err, wit.ErrFunctionNotFound) {
+		return fmt.Errorf("%w: %w", ErrInvalidScheduleTask, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	task.Parameters = pinned.Parameters
+
+	payload, err := proto.Marshal(task)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task proto: %w", err)
+	}
+
+	schedule.Task = payload
+	schedule.Retention = retention.String()
+	schedule.Retries = server.retriesOf(body)
+	schedule.Queue = queueName
+	schedule.Timeout = timeout.String()
+
+	return nil
+}
+
+// visibleSchedule returns a schedule if it may be accessed by the
+// authenticated user. Schedules of other users are reported as missing so that
+// their existence is not disclosed.
+func (server *Server) visibleSchedule(
+	ctx context.Context,
+	id uuid.UUID,
+) (*orm.Schedule, error) {
+	schedule, err := server.db.GetSchedule(ctx, id)
+	if err != nil {
+		//nolint:wrapcheck // Error types are checked by the caller
+		return nil, err
+	}
+
+	owner, err := server.visibleOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if owner != nil && schedule.CreatedBy != *owner {
+		return nil, &orm.NotFoundError{Search: "Schedule " + id.String()}
+	}
+
+	return schedule, nil
+}
+
+func (server *Server) setSchedulePaused(
+	ctx context.Context,
+	id uuid.UUID,
+	paused bool,
+) (Schedule, error) {
+	schedule, err := server.visibleSchedule(ctx, id)
+	if err != nil {
+		//nolint:wrapcheck // Error types are checked by the caller
+		return Schedule{}, err
+	}
+
+	schedule.Paused = paused
+
+	err = server.db.UpdateSchedule(ctx, schedule)
+	if err != nil {
+		//nolint:wrapcheck // Error types are checked by the caller
+		return Schedule{}, err
+	}
+
+	return scheduleToScheduleResponse(schedule)
+}
+
+func scheduleToScheduleResponse(schedule *orm.Schedule) (Schedule, error) {
+	var task pb.Task
+	if err := proto.Unmarshal(schedule.Task, &task); err != nil {
+		//nolint:wrapcheck // Error is not used but only logged later on, no error wrap needed
+		return Schedule{}, err
+	}
+
+	response := Schedule{
+		Id:        schedule.ID,
+		Name:      schedule.Name,
+		Cron:      schedule.CronExpression,
+		Timezone:  schedule.Timezone,
+		Paused:    schedule.Paused,
+		CreatedBy: schedule.CreatedBy,
+		CreatedAt: schedule.CreatedAt,
+		UpdatedAt: schedule.UpdatedAt,
+		Task: CreateTaskRequest{
+			Source:    serializeSource(task.Function),
+			Args:      &task.Arguments,
+			Retention: &schedule.Retention,
+			Retries:   &schedule.Retries,
+			Queue:     &schedule.Queue,
+		},
+	}
+
+	if task.Callback != "" {
+		response.Task.Callback = &task.Callback
+	}
+
+	if schedule.Timeout != "" {
+		response.Task.Timeout = &schedule.Timeout
+	}
+
+	if task.Parameters != nil {
+		params := make([]any, len(task.Parameters))
+		for i, param := range task.Parameters {
+			params[i] = protoValToAny(param)
+		}
+
+		response.Task.Params = &params
+	}
+
+	if task.EnvironmentVariables != nil {
+		envVars := make([]EnvironmentVariable, len(task.EnvironmentVariables))
+		for i, envVar := range task.EnvironmentVariables {
+			envVars[i] = EnvironmentVariable{Key: envVar.Key, Value: envVar.Value}
+		}
+
+		response.Task.Env = &envVars
+	}
+
+	if !schedule.Paused {
+		cronSchedule, err := scheduler.Parse(
+			schedule.CronExpression,
+			schedule.Timezone,
+		)
+		if err == nil {
+			response.NextRunAt = utils.Ptr(cronSchedule.Next(time.Now()))
+		}
+	}
+
+	return response, nil
+}
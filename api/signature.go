@@ -0,0 +1,258 @@
+package api
+
+import (
+	pb "api-server/proto_gen"
+	"api-server/wit"
+	"api-server/workflow"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Number of decoded components whose signatures are kept in memory
+const signatureCacheSize = 256
+
+// signatureCache holds the decoded components of artifacts by version hash.
+// Components that cannot be decoded are cached with their error, so that they
+// are not pulled again for every task.
+type signatureCache struct {
+	mu         sync.Mutex
+	components map[string]decodedComponent
+}
+
+type decodedComponent struct {
+	component *wit.Component
+	err       error
+}
+
+func newSignatureCache() *signatureCache {
+	return &signatureCache{components: map[string]decodedComponent{}}
+}
+
+func (c *signatureCache) get(versionHash string) (decodedComponent, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	decoded, ok := c.components[versionHash]
+
+	return decoded, ok
+}
+
+func (c *signatureCache) put(versionHash string, decoded decodedComponent) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.components) >= signatureCacheSize {
+		// Artifacts never change, so any entry may be evicted
+		for evicted := range c.components {
+			delete(c.components, evicted)
+
+			break
+		}
+	}
+
+	c.components[versionHash] = decoded
+}
+
+// componentOf pulls and decodes the component of a pinned artifact. Errors
+// of the wit package are returned if the artifact is no decodable component.
+func (server *Server) componentOf(
+	ctx context.Context,
+	artifact *pb.ArtifactIdentifier,
+) (*wit.Component, error) {
+	versionHash := artifact.GetVersionHash()
+	if decoded, ok := server.signatures.get(versionHash); ok {
+		return decoded.component, decoded.err
+	}
+
+	stream, err := server.registryClient.PullArtifact(ctx, artifact)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull artifact: %w", err)
+	}
+
+	var buffer bytes.Buffer
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to receive artifact chunk: %w", err)
+		}
+
+		buffer.Write(chunk.Data)
+	}
+
+	component, err := wit.Decode(buffer.Bytes())
+	server.signatures.put(versionHash, decodedComponent{component, err})
+
+	return component, err //nolint:wrapcheck // Decoding errors are passed on
+}
+
+// conformParameters validates the parameters of a pinned task against the
+// signature of its function and converts them to the declared types.
+// Mismatching parameters are reported with the errors of the wit package.
+// Components whose signatures cannot be decoded are not validated.
+func (server *Server) conformParameters(
+	ctx context.Context,
+	task *pb.Task,
+) error {
+	function, ok, err := server.functionOf(ctx, task)
+	if errors.Is(err, wit.ErrInterfaceNotFound) ||
+		errors.Is(err, wit.ErrFunctionNotFound) {
+		return &taskRequestError{"Invalid task: " + err.Error()}
+	}
+	if err != nil || !ok {
+		return err
+	}
+
+	params, err := function.Conform(task.Parameters)
+	if err != nil {
+		return err //nolint:wrapcheck // Mismatches are reported per parameter
+	}
+
+	task.Parameters = params
+
+	return nil
+}
+
+// paramShape describes how the parameters of a stored task are completed
+// when its task is enqueued.
+type paramShape struct {
+	// Number of leading parameters supplied by the results of other tasks
+	supplied int
+	// Index of the list parameter whose elements are passed one at a time
+	mapped *int
+}
+
+// conformStoredParameters validates the parameters of a pinned task that is
+// enqueued later on like conformParameters. Errors of the wit package are
+// returned if the function is not exported.
+func (server *Server) conformStoredParameters(
+	ctx context.Context,
+	task *pb.Task,
+	shape paramShape,
+) error {
+	function, ok, err := server.functionOf(ctx, task)
+	if err != nil || !ok {
+		return err
+	}
+
+	return conformShaped(function, task, shape)
+}
+
+// conformShaped validates the parameters of a task which are completed as
+// described by the shape. Supplied parameters are not known yet and not
+// validated, the elements of a mapped list are validated against the type of
+// the parameter one by one. A mapped parameter which is missing or no list is
+// reported with the errors of the workflow package.
+func conformShaped(function *wit.Func, task *pb.Task, shape paramShape) error {
+	var list []*pb.Val
+	if shape.mapped != nil {
+		var err error
+		list, err = workflow.ListParameter(task, *shape.mapped)
+		if err != nil {
+			return err //nolint:wrapcheck // Reported by the caller as invalid
+		}
+	}
+
+	expected := max(len(function.Params)-shape.supplied, 0)
+	if len(task.Parameters) != expected {
+		return fmt.Errorf(
+			"%w: expected %d, got %d",
+			wit.ErrArity,
+			expected,
+			len(task.Parameters),
+		)
+	}
+
+	for i, param := range task.Parameters {
+		declared := function.Params[shape.supplied+i]
+
+		var err error
+		if shape.mapped != nil && i == *shape.mapped {
+			err = conformElements(list, declared.Type)
+		} else {
+			task.Parameters[i], err = wit.Conform(param, declared.Type)
+		}
+		if err != nil {
+			return &wit.ParamError{Index: i, Name: declared.Name, Err: err}
+		}
+	}
+
+	return nil
+}
+
+// conformElements converts the elements of a list to the given type in place.
+func conformElements(list []*pb.Val, t *wit.Type) error {
+	for i, element := range list {
+		var err error
+		list[i], err = wit.Conform(element, t)
+		if err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// functionOf returns the signature of the function of a pinned task. ok is
+// false if the task is not pinned or its component cannot be decoded.
+func (server *Server) functionOf(
+	ctx context.Context,
+	task *pb.Task,
+) (function *wit.Func, ok bool, err error) {
+	if task.Function.Artifact.GetVersionHash() == "" {
+		return nil, false, nil
+	}
+
+	component, err := server.componentOf(ctx, task.Function.Artifact)
+	if errors.Is(err, wit.ErrNotComponent) ||
+		errors.Is(err, wit.ErrMalformed) ||
+		errors.Is(err, wit.ErrUnsupported) {
+		log.Warn().
+			Err(err).
+			Str("source", taskSource(task)).
+			Msg("Skipping parameter validation of undecodable component")
+
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	function, err = component.Function(
+		task.Function.Interface,
+		task.Function.Name,
+	)
+	if err != nil {
+		//nolint:wrapcheck // Missing functions are reported by the caller
+		return nil, false, err
+	}
+
+	return function, true, nil
+}
+
+// paramsFieldError returns the field error of a task whose parameters do not
+// match the signature of its function. ok is false for all other errors.
+func paramsFieldError(err error) (field ErrField, ok bool) {
+	var errParam *wit.ParamError
+	if errors.As(err, &errParam) {
+		return ErrField{
+			Field: "params[" + strconv.Itoa(errParam.Index) + "]",
+			Error: errParam.Error(),
+		}, true
+	}
+
+	if errors.Is(err, wit.ErrArity) {
+		return ErrField{Field: "params", Error: err.Error()}, true
+	}
+
+	return ErrField{}, false
+}
@@ -0,0 +1,174 @@
+package api
+
+import (
+	pb "api-server/proto_gen"
+	"api-server/wit"
+	"api-server/workflow"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// pullingRegistry serves the same artifact content for every pull.
+type pullingRegistry struct {
+	pb.RegistryServiceClient
+
+	content []byte
+	pulls   int
+}
+
+func (r *pullingRegistry) PullArtifact(
+	_ context.Context,
+	_ *pb.ArtifactIdentifier,
+	_ ...grpc.CallOption,
+) (grpc.ServerStreamingClient[pb.ArtifactContent], error) {
+	r.pulls++
+
+	return &artifactStream{chunks: [][]byte{r.content}}, nil
+}
+
+type artifactStream struct {
+	grpc.ClientStream
+
+	chunks [][]byte
+}
+
+func (s *artifactStream) Recv() (*pb.ArtifactContent, error) {
+	if len(s.chunks) == 0 {
+		return nil, io.EOF
+	}
+
+	chunk := s.chunks[0]
+	s.chunks = s.chunks[1:]
+
+	return &pb.ArtifactContent{Data: chunk}, nil
+}
+
+func TestConformParametersUndecodable(t *testing.T) {
+	t.Parallel()
+	registry := &pullingRegistry{content: []byte("not a component")}
+	server := &Server{registryClient: registry, signatures: newSignatureCache()}
+
+	source, err := parseSource("acme:billing/api/run@hash:abc123")
+	require.NoError(t, err)
+	params := []*pb.Val{{Value: &pb.Val_StringVal{StringVal: "a"}}}
+
+	for range 2 {
+		task := &pb.Task{Function: source, Parameters: params}
+		require.NoError(t, server.conformParameters(t.Context(), task))
+		assert.Equal(t, params, task.Parameters, "parameters are passed on")
+	}
+	assert.Equal(t, 1, registry.pulls, "components are decoded once")
+}
+
+func TestSignatureCacheBound(t *testing.T) {
+	t.Parallel()
+	cache := newSignatureCache()
+
+	for i := range signatureCacheSize + 10 {
+		cache.put(strconv.Itoa(i), decodedComponent{})
+	}
+	assert.Len(t, cache.components, signatureCacheSize)
+
+	_, ok := cache.get(strconv.Itoa(signatureCacheSize + 9))
+	assert.True(t, ok, "the latest component is cached")
+}
+
+func TestParamsFieldError(t *testing.T) {
+	t.Parallel()
+
+	field, ok := paramsFieldError(fmt.Errorf("wrapped: %w", &wit.ParamError{
+		Index: 2,
+		Name:  "count",
+		Err:   wit.ErrTypeMismatch,
+	}))
+	require.True(t, ok)
+	assert.Equal(t, "params[2]", field.Field)
+	assert.Contains(t, field.Error, "count")
+
+	field, ok = paramsFieldError(wit.ErrArity)
+	require.True(t, ok)
+	assert.Equal(t, "params", field.Field)
+
+	_, ok = paramsFieldError(ErrQueueForbidden)
+	assert.False(t, ok)
+}
+
+func TestConformShaped(t *testing.T) {
+	t.Parallel()
+	function := &wit.Func{Params: []wit.Param{
+		{Name: "upstream", Type: &wit.Type{Kind: wit.KindString}},
+		{Name: "count", Type: &wit.Type{Kind: wit.KindU32}},
+	}}
+	count := &pb.Val{Value: &pb.Val_F64Val{F64Val: 3}}
+
+	task := &pb.Task{Parameters: []*pb.Val{count}}
+	require.NoError(t, conformShaped(function, task, paramShape{supplied: 1}))
+	assert.Equal(t, uint32(3), task.Parameters[0].GetU32Val())
+
+	task = &pb.Task{Parameters: []*pb.Val{count}}
+	require.ErrorIs(t, conformShaped(function, task, paramShape{}), wit.ErrArity)
+
+	task = &pb.Task{Parameters: []*pb.Val{
+		{Value: &pb.Val_StringVal{StringVal: "a"}},
+	}}
+	err := conformShaped(function, task, paramShape{supplied: 1})
+	field, ok := paramsFieldError(err)
+	require.True(t, ok)
+	assert.Equal(t, "params[0]", field.Field, "supplied parameters are skipped")
+}
+
+func TestConformShapedMapped(t *testing.T) {
+	t.Parallel()
+	function := &wit.Func{Params: []wit.Param{
+		{Name: "name", Type: &wit.Type{Kind: wit.KindString}},
+		{Name: "count", Type: &wit.Type{Kind: wit.KindU32}},
+	}}
+	mapped := 1
+	list := func(values ...*pb.Val) *pb.Val {
+		return &pb.Val{Value: &pb.Val_ListVal{
+			ListVal: &pb.ListVal{Values: values},
+		}}
+	}
+	name := &pb.Val{Value: &pb.Val_StringVal{StringVal: "a"}}
+
+	task := &pb.Task{Parameters: []*pb.Val{name, list(
+		&pb.Val{Value: &pb.Val_F64Val{F64Val: 1}},
+		&pb.Val{Value: &pb.Val_F64Val{F64Val: 2}},
+	)}}
+	require.NoError(t, conformShaped(function, task, paramShape{mapped: &mapped}))
+	elements := task.Parameters[1].GetListVal().GetValues()
+	assert.Equal(t, uint32(1), elements[0].GetU32Val())
+	assert.Equal(t, uint32(2), elements[1].GetU32Val())
+
+	task = &pb.Task{Parameters: []*pb.Val{name, list(
+		&pb.Val{Value: &pb.Val_F64Val{F64Val: 1}},
+		&pb.Val{Value: &pb.Val_StringVal{StringVal: "b"}},
+	)}}
+	err := conformShaped(function, task, paramShape{mapped: &mapped})
+	require.ErrorIs(t, err, wit.ErrTypeMismatch)
+	field, ok := paramsFieldError(err)
+	require.True(t, ok)
+	assert.Equal(t, "params[1]", field.Field)
+	assert.Contains(t, field.Error, "element 1")
+
+	task = &pb.Task{Parameters: []*pb.Val{name, name}}
+	require.ErrorIs(
+		t,
+		conformShaped(function, task, paramShape{mapped: &mapped}),
+		workflow.ErrMapParameterNotList,
+	)
+
+	missing := 2
+	require.ErrorIs(
+		t,
+		conformShaped(function, task, paramShape{mapped: &missing}),
+		workflow.ErrMapParameterMissing,
+	)
+}
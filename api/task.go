@@ -1,18 +1,23 @@
 package api
 
 import (
+	"api-server/callback"
 	"api-server/orm"
 	pb "api-server/proto_gen"
 	"api-server/queue"
-	"cmp"
+	serverutils "api-server/utils"
+	"api-server/wit"
+	"api-server/workflow"
 	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"net/url"
 	"slices"
 	"strings"
 	"time"
 
+	"github.com/EnclaveRunner/shareddeps/auth"
 	"github.com/EnclaveRunner/shareddeps/utils"
 	"github.com/hibiken/asynq"
 	"github.com/rs/zerolog/log"
@@ -29,229 +34,1161 @@ var (
 	// ErrInvalidIdentifier is returned when artifact source identifier format is
 	// invalid
 	ErrInvalidIdentifier = errors.New("invalid identifier format")
+	// ErrInvalidCallbackURL is returned when a callback is not an absolute
+	// http(s) URL
+	ErrInvalidCallbackURL = errors.New("callback must be an absolute http(s) URL")
+	// ErrAmbiguousSchedule is returned when both processAt and processIn are
+	// set
+	ErrAmbiguousSchedule = errors.New(
+		"only one of processAt and processIn may be set",
+	)
+	// ErrScheduleInPast is returned when a task is scheduled before now
+	ErrScheduleInPast = errors.New("schedule must not lie in the past")
+	// ErrScheduleBeyondHorizon is returned when a task is scheduled further
+	// ahead than the configured maximum horizon
+	ErrScheduleBeyondHorizon = errors.New(
+		"schedule exceeds the maximum scheduling horizon",
+	)
+	// ErrInvalidTimeout is returned when a task timeout is not positive
+	ErrInvalidTimeout = errors.New("timeout must be positive")
+	// ErrTimeoutExceedsMaximum is returned when a task timeout is longer than
+	// the configured maximum timeout
+	ErrTimeoutExceedsMaximum = errors.New("timeout exceeds the maximum timeout")
+	// ErrDeadlineInPast is returned when a task deadline does not lie in the
+	// future
+	ErrDeadlineInPast = errors.New("deadline must lie in the future")
+	// ErrDeadlineBeyondMaximum is returned when a task deadline lies further
+	// ahead than the configured maximum deadline
+	ErrDeadlineBeyondMaximum = errors.New(
+		"deadline exceeds the maximum deadline",
+	)
+	// ErrInvalidCursor is returned when a pagination cursor cannot be decoded
+	ErrInvalidCursor = errors.New("malformed cursor")
+	// ErrAmbiguousSourceFilter is returned when tasks are filtered by a full
+	// source identifier and by single parts of it at the same time
+	ErrAmbiguousSourceFilter = errors.New(
+		"source cannot be combined with namespace, package, interface, " +
+			"function, tag or hash",
+	)
+	// ErrInvalidTimeRange is returned when the start of a time range lies after
+	// its end
+	ErrInvalidTimeRange = errors.New("time range ends before it starts")
+	// ErrGroupTooLong is returned when a task group identifier exceeds
+	// maxGroupLength
+	ErrGroupTooLong = errors.New(
+		"group must not be longer than 255 characters",
+	)
 )
 
+// Maximum length of a task group identifier
+const maxGroupLength = 255
+
 // GetV1Task implements [StrictServerInterface].
 func (server *Server) GetV1Task(
 	ctx context.Context,
-	request GetV1TaskRequestObject,
+	request GetV1TaskRequestObject, //nolint:gocritic // Signature is generated
 ) (GetV1TaskResponseObject, error) {
-	tasks, err := server.queueClient.GetAllTasks()
+	var after *orm.TaskCursor
+	if request.Params.Cursor != nil {
+		cursor, err := decodeTaskCursor(*request.Params.Cursor)
+		if err != nil {
+			return GetV1Task400JSONResponse{
+				GenericBadRequestJSONResponse{
+					Error: "Invalid cursor: " + err.Error(),
+				},
+			}, nil
+		}
+		after = &cursor
+	}
+
+	filter, err := taskFilterFromParams(&request.Params)
+	if err != nil {
+		return GetV1Task400JSONResponse{
+			GenericBadRequestJSONResponse{
+				Error: "Invalid filter: " + err.Error(),
+			},
+		}, nil
+	}
+
+	owner, err := server.visibleOwner(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to determine visible tasks")
+
+		return GetV1Task500Response{}, nil
+	}
+
+	if owner != nil {
+		if filter.SubmittedBy != nil && *filter.SubmittedBy != *owner {
+			// Tasks of other users are not visible
+			return GetV1Task200JSONResponse{Body: []Task{}}, nil
+		}
+
+		filter.SubmittedBy = owner
+	}
+
+	limit := *request.Params.Limit
+	records, total, err := server.db.ListTasks(
+		ctx,
+		&filter,
+		after,
+		limit,
+		*request.Params.Offset,
+	)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to list tasks")
 
-		return GetV1Task500Response{}, nil
+		return GetV1Task500Response{}, nil
+	}
+
+	// Tasks are served from their records, so that they are in the state they
+	// were filtered by and no lookups in the queue are needed
+	taskPage := make([]Task, len(records))
+	for i := range records {
+		state, err := taskRecordToTaskResponse(&records[i])
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("id", records[i].ID).
+				Msg("Failed to transform task")
+
+			return GetV1Task500Response{}, nil
+		}
+
+		taskPage[i] = state
+	}
+
+	headers := GetV1Task200ResponseHeaders{XTotalCount: int(total)}
+	if len(records) > 0 && len(records) == limit {
+		last := records[len(records)-1]
+		headers.XNextCursor = encodeTaskCursor(orm.TaskCursor{
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		})
+	}
+
+	return GetV1Task200JSONResponse{
+		Body:    taskPage,
+		Headers: headers,
+	}, nil
+}
+
+// PostV1Task implements [StrictServerInterface].
+func (server *Server) PostV1Task(
+	ctx context.Context,
+	request PostV1TaskRequestObject,
+) (PostV1TaskResponseObject, error) {
+	task, taskOptions, err := server.prepareTask(
+		ctx,
+		request.Body,
+		server.resolveArtifact,
+	)
+	if err != nil {
+		var errRequest *taskRequestError
+		if errors.As(err, &errRequest) {
+			return PostV1Task400JSONResponse{
+				GenericBadRequestJSONResponse{Error: errRequest.message},
+			}, nil
+		}
+
+		if errors.Is(err, ErrQueueForbidden) {
+			return PostV1Task403Response{}, nil
+		}
+
+		if field, ok := paramsFieldError(err); ok {
+			return PostV1Task422JSONResponse{
+				FieldErrorJSONResponse{Errors: &[]ErrField{field}},
+			}, nil
+		}
+
+		log.Error().Err(err).Msg("Failed to prepare task")
+
+		return PostV1Task500Response{}, nil
+	}
+
+	var idempotencyKey *orm.IdempotencyKey
+	if request.Params.IdempotencyKey != nil {
+		var replay PostV1TaskResponseObject
+		idempotencyKey, replay = server.claimIdempotencyKey(
+			ctx,
+			*request.Params.IdempotencyKey,
+			request.Body,
+		)
+		if replay != nil {
+			return replay, nil
+		}
+
+		taskOptions = append(taskOptions, asynq.TaskID(idempotencyKey.TaskID))
+	}
+
+	// Enqueue the task for processing
+	taskInfo, err := server.queueClient.EnqueueTask(
+		ctx,
+		task,
+		queue.TaskMetadata{
+			SubmittedBy: auth.GetAuthenticatedUser(ctx),
+			GroupID:     groupOf(request.Body),
+		},
+		taskOptions...,
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to enqueue task")
+
+		if idempotencyKey != nil {
+			// Release the key so that the request can be retried
+			err = server.db.DeleteIdempotencyKey(
+				ctx,
+				idempotencyKey.SubmittedBy,
+				idempotencyKey.Key,
+			)
+			if err != nil {
+				log.Error().
+					Err(err).
+					Str("key", idempotencyKey.Key).
+					Msg("Failed to release idempotency key")
+			}
+		}
+
+		return PostV1Task500Response{}, nil
+	}
+
+	return PostV1Task201JSONResponse{
+		Id:          taskInfo.ID,
+		Source:      request.Body.Source,
+		VersionHash: versionHashOf(task),
+		Params:      request.Body.Params,
+		Args:        request.Body.Args,
+		Env:         request.Body.Env,
+		Callback:    request.Body.Callback,
+		Retention:   utils.Ptr(taskInfo.Retention.String()),
+		Retries:     &taskInfo.MaxRetry,
+		Queue:       &taskInfo.Queue,
+		SubmittedBy: utils.Ptr(submittedBy(taskInfo)),
+		Group:       request.Body.Group,
+		Timeout:     utils.Ptr(taskInfo.Timeout.String()),
+		Deadline:    serverutils.TimeOrNil(taskInfo.Deadline),
+		Status: TaskStatus{
+			State:         taskInfo.State.String(),
+			NextProcessAt: &taskInfo.NextProcessAt,
+		},
+	}, nil
+}
+
+// taskRequestError is returned by prepareTask if a task request is invalid.
+// Its message is returned to the client as is.
+type taskRequestError struct {
+	message string
+}
+
+func (e *taskRequestError) Error() string {
+	return e.message
+}
+
+// artifactResolver resolves an artifact to its version hash. found is false if
+// the artifact does not exist in the registry.
+type artifactResolver func(
+	ctx context.Context,
+	artifact *pb.ArtifactIdentifier,
+) (versionHash string, found bool, err error)
+
+// resolveArtifact is the artifactResolver asking the registry.
+func (server *Server) resolveArtifact(
+	ctx context.Context,
+	artifact *pb.ArtifactIdentifier,
+) (versionHash string, found bool, err error) {
+	resolved, err := server.registryClient.GetArtifact(ctx, artifact)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", false, nil
+		}
+
+		return "", false, fmt.Errorf("failed to get artifact: %w", err)
+	}
+
+	return resolved.GetVersionHash(), true, nil
+}
+
+// prepareTask validates a task request and converts it into the task proto
+// and the options it is enqueued with. Invalid requests are reported with a
+// taskRequestError, requests for a queue the user may not use with
+// ErrQueueForbidden and parameters not matching the signature of the function
+// with the errors of the wit package.
+func (server *Server) prepareTask(
+	ctx context.Context,
+	body *CreateTaskRequest,
+	resolveArtifact artifactResolver,
+) (*pb.Task, []asynq.Option, error) {
+	task, err := server.taskFromRequest(body)
+	if err != nil {
+		return nil, nil, &taskRequestError{"Invalid task: " + err.Error()}
+	}
+
+	versionHash, found, err := resolveArtifact(ctx, task.Function.Artifact)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !found {
+		return nil, nil, &taskRequestError{"Artifact not found"}
+	}
+
+	queue.PinArtifact(task, versionHash)
+
+	err = server.conformParameters(ctx, task)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(groupOf(body)) > maxGroupLength {
+		return nil, nil, &taskRequestError{
+			"Invalid group: " + ErrGroupTooLong.Error(),
+		}
+	}
+
+	retention, err := server.retentionOf(body)
+	if err != nil {
+		return nil, nil, &taskRequestError{
+			"Retention string invalid: " + err.Error(),
+		}
+	}
+
+	timeout, err := server.timeoutOf(body)
+	if err != nil {
+		return nil, nil, &taskRequestError{"Invalid timeout: " + err.Error()}
+	}
+
+	deadlineOptions, err := parseDeadline(
+		body.Deadline,
+		time.Now(),
+		server.maxDeadline,
+	)
+	if err != nil {
+		return nil, nil, &taskRequestError{"Invalid deadline: " + err.Error()}
+	}
+
+	queueName, err := server.queueOf(ctx, body)
+	if err != nil {
+		if errors.Is(err, ErrUnknownQueue) {
+			return nil, nil, &taskRequestError{err.Error()}
+		}
+
+		return nil, nil, err
+	}
+
+	scheduleOptions, err := parseSchedule(
+		body.ProcessAt,
+		body.ProcessIn,
+		time.Now(),
+		server.maxScheduleHorizon,
+	)
+	if err != nil {
+		return nil, nil, &taskRequestError{"Invalid schedule: " + err.Error()}
+	}
+
+	taskOptions := slices.Concat(
+		[]asynq.Option{
+			asynq.Queue(queueName),
+			asynq.Retention(retention),
+			asynq.MaxRetry(server.retriesOf(body)),
+			asynq.Timeout(timeout),
+		},
+		scheduleOptions,
+		deadlineOptions,
+	)
+
+	return task, taskOptions, nil
+}
+
+// storedTask is a validated task request whose task is enqueued later on, e.g.
+// by a workflow.
+type storedTask struct {
+	task      *pb.Task
+	retries   int
+	retention string
+	timeout   string
+	queue     string
+}
+
+// prepareStoredTask validates a task request whose task is enqueued later on.
+// Such tasks cannot be scheduled, have a deadline or join a group. Their
+// parameters are validated as completed according to the shape. Errors caused
+// by the request wrap errInvalid, a queue the user may not use results in
+// ErrQueueForbidden and parameters not matching the signature of the function
+// in the errors of the wit package.
+func (server *Server) prepareStoredTask(
+	ctx context.Context,
+	body *CreateTaskRequest,
+	resolveArtifact artifactResolver,
+	shape paramShape,
+	errInvalid error,
+) (storedTask, error) {
+	if body.ProcessAt != nil ||
+		(body.ProcessIn != nil && *body.ProcessIn != "") ||
+		body.Deadline != nil || groupOf(body) != "" {
+		return storedTask{}, fmt.Errorf(
+			"%w: processAt, processIn, deadline and group are not supported",
+			errInvalid,
+		)
+	}
+
+	task, err := server.taskFromRequest(body)
+	if err != nil {
+		return storedTask{}, fmt.Errorf("%w: %w", errInvalid, err)
+	}
+
+	retention, err := server.retentionOf(body)
+	if err != nil {
+		return storedTask{}, fmt.Errorf(
+			"%w: retention string invalid: %w",
+			errInvalid,
+			err,
+		)
+	}
+
+	timeout, err := server.timeoutOf(body)
+	if err != nil {
+		return storedTask{}, fmt.Errorf("%w: invalid timeout: %w", errInvalid, err)
+	}
+
+	queueName, err := server.queueOf(ctx, body)
+	if err != nil {
+		if errors.Is(err, ErrUnknownQueue) {
+			return storedTask{}, fmt.Errorf("%w: %w", errInvalid, err)
+		}
+
+		return storedTask{}, err
+	}
+
+	versionHash, found, err := resolveArtifact(ctx, task.Function.Artifact)
+	if err != nil {
+		return storedTask{}, err
+	}
+
+	if !found {
+		return storedTask{}, fmt.Errorf("%w: artifact not found", errInvalid)
+	}
+
+	queue.PinArtifact(task, versionHash)
+
+	err = server.conformStoredParameters(ctx, task, shape)
+	if errors.Is(err, wit.ErrInterfaceNotFound) ||
+		errors.Is(err, wit.ErrFunctionNotFound) ||
+		errors.Is(err, workflow.ErrMapParameterMissing) ||
+		errors.Is(err, workflow.ErrMapParameterNotList) {
+		return storedTask{}, fmt.Errorf("%w: %w", errInvalid, err)
+	}
+	if err != nil {
+		return storedTask{}, err
+	}
+
+	return storedTask{
+		task:      task,
+		retries:   server.retriesOf(body),
+		retention: retention.String(),
+		timeout:   timeout.String(),
+		queue:     queueName,
+	}, nil
+}
+
+// taskFromRequest converts a task request into the task proto. Scheduling,
+// retention, retries and the queue are not part of the proto and are ignored.
+func (server *Server) taskFromRequest(
+	body *CreateTaskRequest,
+) (*pb.Task, error) {
+	fullIdentifier, err := parseSource(body.Source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid artifact source format: %w", err)
+	}
+
+	task := &pb.Task{
+		Function: fullIdentifier,
+	}
+
+	// Convert params to proto Parameters
+	if body.Params != nil {
+		params := make([]*pb.Val, len(*body.Params))
+		for i, p := range *body.Params {
+			params[i], err = anyToProtoVal(p)
+			if err != nil {
+				return nil, fmt.Errorf("invalid parameter %d: %w", i, err)
+			}
+		}
+
+		task.Parameters = params
+	}
+
+	// Convert env to proto EnvironmentVariables
+	if body.Env != nil {
+		envVars := make([]*pb.EnvironmentVariable, len(*body.Env))
+		for i, e := range *body.Env {
+			envVars[i] = &pb.EnvironmentVariable{Key: e.Key, Value: e.Value}
+		}
+
+		task.EnvironmentVariables = envVars
+	}
+
+	if body.Args != nil {
+		task.Arguments = *body.Args
+	}
+
+	if body.Callback != nil && *body.Callback != "" {
+		err = validateCallbackURL(*body.Callback, server.callbackTargets)
+		if err != nil {
+			return nil, fmt.Errorf("invalid callback URL: %w", err)
+		}
+
+		task.Callback = *body.Callback
+	}
+
+	return task, nil
+}
+
+// retentionOf returns the retention requested for a task or the default
+// retention if none was requested.
+func (server *Server) retentionOf(
+	body *CreateTaskRequest,
+) (time.Duration, error) {
+	if body.Retention == nil || *body.Retention == "" {
+		return server.retention, nil
+	}
+
+	retention, err := time.ParseDuration(*body.Retention)
+	if err != nil {
+		//nolint:wrapcheck // Error message is returned to the client as is
+		return 0, err
+	}
+
+	return retention, nil
+}
+
+// groupOf returns the group a task is submitted to or an empty string if it is
+// not part of a group.
+func groupOf(body *CreateTaskRequest) string {
+	if body.Group == nil {
+		return ""
+	}
+
+	return *body.Group
+}
+
+// retriesOf returns the maximum number of retries requested for a task or the
+// default if none was requested.
+func (server *Server) retriesOf(body *CreateTaskRequest) int {
+	if body.Retries == nil {
+		return server.maxRetries
+	}
+
+	return *body.Retries
+}
+
+// timeoutOf returns the timeout of a single attempt of the requested task,
+// falling back to the configured default timeout.
+func (server *Server) timeoutOf(
+	body *CreateTaskRequest,
+) (time.Duration, error) {
+	if body.Timeout == nil || *body.Timeout == "" {
+		return server.defaultTimeout, nil
+	}
+
+	timeout, err := time.ParseDuration(*body.Timeout)
+	if err != nil {
+		//nolint:wrapcheck // Error message is returned to the client as is
+		return 0, err
+	}
+
+	if timeout <= 0 {
+		return 0, ErrInvalidTimeout
+	}
+
+	if timeout > server.maxTimeout {
+		return 0, fmt.Errorf(
+			"%w of %s",
+			ErrTimeoutExceedsMaximum,
+			server.maxTimeout.String(),
+		)
+	}
+
+	return timeout, nil
+}
+
+// GetV1TaskId implements [StrictServerInterface].
+func (server *Server) GetV1TaskId(
+	ctx context.Context,
+	request GetV1TaskIdRequestObject,
+) (GetV1TaskIdResponseObject, error) {
+	visible, err := server.taskVisible(ctx, request.Id)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to retrieve task")
+
+		return GetV1TaskId500Response{}, nil
+	}
+
+	if !visible {
+		return GetV1TaskId404JSONResponse{GenericNotFoundJSONResponse{
+			Error: (&queue.TaskNotFoundError{Id: request.Id}).Error(),
+		}}, nil
+	}
+
+	task, err := server.queueClient.GetTask(request.Id)
+	if err != nil {
+		if errors.Is(err, &queue.TaskNotFoundError{}) {
+			// The queue already dropped the task, serve it from the history
+			return server.getTaskFromHistory(ctx, request.Id, err)
+		}
+
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to retrieve task")
+
+		return GetV1TaskId500Response{}, nil
+	}
+
+	var taskPayload pb.Task
+	if err := proto.Unmarshal(task.Payload, &taskPayload); err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to unmarshall task payload")
+	}
+
+	state, err := taskToTaskResponse(task)
+	if err != nil {
+		log.Error().Err(err).Str("id", request.Id).Msg("Failed to transform task")
+
+		return GetV1TaskId500Response{}, nil
+	}
+
+	return GetV1TaskId200JSONResponse(state), nil
+}
+
+func (server *Server) getTaskFromHistory(
+	ctx context.Context,
+	id string,
+	errNotInQueue error,
+) (GetV1TaskIdResponseObject, error) {
+	record, err := server.db.GetTask(ctx, id)
+	if err != nil {
+		var errNotFound *orm.NotFoundError
+		if errors.As(err, &errNotFound) {
+			return GetV1TaskId404JSONResponse{GenericNotFoundJSONResponse{
+				Error: errNotInQueue.Error(),
+			}}, nil
+		}
+
+		log.Error().
+			Err(err).
+			Str("id", id).
+			Msg("Failed to retrieve task from history")
+
+		return GetV1TaskId500Response{}, nil
+	}
+
+	state, err := taskRecordToTaskResponse(record)
+	if err != nil {
+		log.Error().Err(err).Str("id", id).Msg("Failed to transform task")
+
+		return GetV1TaskId500Response{}, nil
+	}
+
+	return GetV1TaskId200JSONResponse(state), nil
+}
+
+// DeleteV1TaskId implements [StrictServerInterface].
+func (server *Server) DeleteV1TaskId(
+	ctx context.Context,
+	request DeleteV1TaskIdRequestObject,
+) (DeleteV1TaskIdResponseObject, error) {
+	visible, err := server.taskVisible(ctx, request.Id)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to retrieve task")
+
+		return DeleteV1TaskId500Response{}, nil
+	}
+
+	if !visible {
+		return DeleteV1TaskId404JSONResponse{GenericNotFoundJSONResponse{
+			Error: (&queue.TaskNotFoundError{Id: request.Id}).Error(),
+		}}, nil
+	}
+
+	task, err := server.queueClient.DeleteTask(request.Id)
+	if err != nil {
+		if errors.Is(err, &queue.TaskNotFoundError{}) {
+			// The queue already dropped the task, remove what is left of it
+			return server.deleteTaskFromHistory(ctx, request.Id, err)
+		}
+
+		if errors.Is(err, &queue.TaskStateConflictError{}) {
+			return DeleteV1TaskId409JSONResponse{
+				Error: "Task is currently being processed, cancel it first",
+			}, nil
+		}
+
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to delete task")
+
+		return DeleteV1TaskId500Response{}, nil
+	}
+
+	err = server.db.DeleteTaskData(ctx, request.Id)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to delete data of task")
+
+		return DeleteV1TaskId500Response{}, nil
+	}
+
+	state, err := taskToTaskResponse(task)
+	if err != nil {
+		log.Error().Err(err).Str("id", request.Id).Msg("Failed to transform task")
+
+		return DeleteV1TaskId500Response{}, nil
+	}
+
+	return DeleteV1TaskId200JSONResponse(state), nil
+}
+
+func (server *Server) deleteTaskFromHistory(
+	ctx context.Context,
+	id string,
+	errNotInQueue error,
+) (DeleteV1TaskIdResponseObject, error) {
+	record, err := server.db.GetTask(ctx, id)
+	if err != nil {
+		var errNotFound *orm.NotFoundError
+		if errors.As(err, &errNotFound) {
+			return DeleteV1TaskId404JSONResponse{GenericNotFoundJSONResponse{
+				Error: errNotInQueue.Error(),
+			}}, nil
+		}
+
+		log.Error().
+			Err(err).
+			Str("id", id).
+			Msg("Failed to retrieve task from history")
+
+		return DeleteV1TaskId500Response{}, nil
+	}
+
+	err = server.db.DeleteTaskData(ctx, id)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", id).
+			Msg("Failed to delete data of task")
+
+		return DeleteV1TaskId500Response{}, nil
+	}
+
+	state, err := taskRecordToTaskResponse(record)
+	if err != nil {
+		log.Error().Err(err).Str("id", id).Msg("Failed to transform task")
+
+		return DeleteV1TaskId500Response{}, nil
+	}
+
+	return DeleteV1TaskId200JSONResponse(state), nil
+}
+
+// PostV1TaskIdCancel implements [StrictServerInterface].
+func (server *Server) PostV1TaskIdCancel(
+	ctx context.Context,
+	request PostV1TaskIdCancelRequestObject,
+) (PostV1TaskIdCancelResponseObject, error) {
+	visible, err := server.taskVisible(ctx, request.Id)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to retrieve task")
+
+		return PostV1TaskIdCancel500Response{}, nil
+	}
+
+	if !visible {
+		return PostV1TaskIdCancel404JSONResponse{GenericNotFoundJSONResponse{
+			Error: (&queue.TaskNotFoundError{Id: request.Id}).Error(),
+		}}, nil
+	}
+
+	task, err := server.queueClient.CancelTask(ctx, request.Id)
+	if err != nil {
+		if errors.Is(err, &queue.TaskNotFoundError{}) {
+			return PostV1TaskIdCancel404JSONResponse{GenericNotFoundJSONResponse{
+				Error: err.Error(),
+			}}, nil
+		}
+
+		var errConflict *queue.TaskStateConflictError
+		if errors.As(err, &errConflict) {
+			return PostV1TaskIdCancel409JSONResponse{
+				Error: "Task already reached final state " + errConflict.State,
+			}, nil
+		}
+
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to cancel task")
+
+		return PostV1TaskIdCancel500Response{}, nil
+	}
+
+	state, err := taskToTaskResponse(task)
+	if err != nil {
+		log.Error().Err(err).Str("id", request.Id).Msg("Failed to transform task")
+
+		return PostV1TaskIdCancel500Response{}, nil
+	}
+
+	return PostV1TaskIdCancel200JSONResponse(state), nil
+}
+
+// GetV1TaskIdLogs implements [StrictServerInterface].
+func (server *Server) GetV1TaskIdLogs(
+	ctx context.Context,
+	request GetV1TaskIdLogsRequestObject, //nolint:gocritic // Signature is generated
+) (GetV1TaskIdLogsResponseObject, error) {
+	visible, err := server.taskVisible(ctx, request.Id)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to retrieve task")
+
+		return GetV1TaskIdLogs500Response{}, nil
+	}
+
+	if !visible {
+		return GetV1TaskIdLogs404JSONResponse{}, nil
+	}
+
+	filter, err := logFilterFromParams(&request.Params)
+	if err != nil {
+		return GetV1TaskIdLogs400JSONResponse{
+			GenericBadRequestJSONResponse{
+				Error: "Invalid filter: " + err.Error(),
+			},
+		}, nil
 	}
 
-	if request.Params.State != nil {
-		// Filter tasks matching state parameter
-		tasks = slices.Collect(func(yield func(*asynq.TaskInfo) bool) {
-			for _, task := range tasks {
-				if task.State.String() == *request.Params.State {
-					if !yield(task) {
-						return
-					}
-				}
-			}
-		})
+	ascending := false
+	if request.Params.Order != nil {
+		switch *request.Params.Order {
+		case Asc:
+			ascending = true
+		case Desc:
+		default:
+			return GetV1TaskIdLogs400JSONResponse{
+				GenericBadRequestJSONResponse{
+					Error: "Invalid order: must be asc or desc",
+				},
+			}, nil
+		}
 	}
 
-	taskPage := paginate(
-		tasks,
+	logs, total, err := server.db.ListLogsOfTask(
+		ctx,
+		request.Id,
+		&filter,
+		ascending,
 		*request.Params.Limit,
 		*request.Params.Offset,
-		func(a, b *asynq.TaskInfo) int {
-			return cmp.Compare(a.ID, b.ID)
-		},
 	)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to retrieve logs of task")
 
-	taskPageTransformed := make([]Task, len(taskPage))
-	for i, task := range taskPage {
-		state, err := taskToTaskResponse(task)
-		if err != nil {
-			log.Error().Err(err).Str("id", task.ID).Msg("Failed to transform task")
-
-			return GetV1Task500Response{}, nil
-		}
-
-		taskPageTransformed[i] = state
+		return GetV1TaskIdLogs500Response{}, nil
 	}
 
-	return GetV1Task200JSONResponse(taskPageTransformed), nil
+	return GetV1TaskIdLogs200JSONResponse{
+		Body:    dbLogsToJsonLogs(logs),
+		Headers: GetV1TaskIdLogs200ResponseHeaders{XTotalCount: int(total)},
+	}, nil
 }
 
-// PostV1Task implements [StrictServerInterface].
-func (server *Server) PostV1Task(
+// PostV1TaskIdLogs implements [StrictServerInterface].
+func (server *Server) PostV1TaskIdLogs(
 	ctx context.Context,
-	request PostV1TaskRequestObject,
-) (PostV1TaskResponseObject, error) {
-	fullIdentifier, err := parseSource(request.Body.Source)
+	request PostV1TaskIdLogsRequestObject,
+) (PostV1TaskIdLogsResponseObject, error) {
+	// Logs are written by runners on behalf of the submitter, so the visibility
+	// of the task is not restricted for them. Permission is checked before the
+	// batch is validated to not reveal the limits to other users.
+	allowed, err := server.userInAnyGroup(ctx, adminGroup, LogIngestGroup)
 	if err != nil {
-		return PostV1Task400JSONResponse{
-			GenericBadRequestJSONResponse{
-				Error: "Invalid artifact source format: " + err.Error(),
-			},
-		}, nil
-	}
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to check permission to append task logs")
 
-	task := &pb.Task{
-		Function: fullIdentifier,
+		return PostV1TaskIdLogs500Response{}, nil
 	}
 
-	// Convert params to proto Parameters
-	if request.Body.Params != nil {
-		params := make([]*pb.Val, len(*request.Body.Params))
-		for i, p := range *request.Body.Params {
-			params[i] = anyToProtoVal(p)
-		}
-
-		task.Parameters = params
+	if !allowed {
+		return PostV1TaskIdLogs403Response{}, nil
 	}
 
-	// Convert env to proto EnvironmentVariables
-	if request.Body.Env != nil {
-		envVars := make([]*pb.EnvironmentVariable, len(*request.Body.Env))
-		for i, e := range *request.Body.Env {
-			envVars[i] = &pb.EnvironmentVariable{Key: e.Key, Value: e.Value}
-		}
-
-		task.EnvironmentVariables = envVars
+	entries := request.Body.Logs
+	if len(entries) == 0 {
+		return PostV1TaskIdLogs400JSONResponse{
+			GenericBadRequestJSONResponse{
+				Error: "Batch must contain at least one log",
+			},
+		}, nil
 	}
 
-	if request.Body.Args != nil {
-		task.Arguments = *request.Body.Args
+	if len(entries) > server.maxLogBatchSize {
+		return PostV1TaskIdLogs413JSONResponse{
+			GenericTooLargeJSONResponse{
+				Error: fmt.Sprintf(
+					"Batch exceeds the maximum size of %d logs",
+					server.maxLogBatchSize,
+				),
+			},
+		}, nil
 	}
 
-	// Check that artifact exists
-	_, err = server.registryClient.GetArtifact(ctx, fullIdentifier.Artifact)
-	if err != nil {
-		if status.Code(err) == codes.NotFound {
-			return PostV1Task400JSONResponse{
+	logs := make([]orm.TaskLog, len(entries))
+	for i, entry := range entries {
+		if entry.Level == "" || entry.Issuer == "" {
+			return PostV1TaskIdLogs400JSONResponse{
 				GenericBadRequestJSONResponse{
-					Error: "Artifact not found",
+					Error: fmt.Sprintf("Log %d must have a level and an issuer", i),
 				},
 			}, nil
 		}
 
-		log.Error().Err(err).Msg("Failed to get artifact")
-
-		return &PostV1Task500Response{}, nil
-	}
-
-	taskOptions := []asynq.Option{}
-	if request.Body.Retention != nil && *request.Body.Retention != "" {
-		retention, err := time.ParseDuration(*request.Body.Retention)
-		if err != nil {
-			return &PostV1Task400JSONResponse{
-				GenericBadRequestJSONResponse{
-					Error: "Retention string invalid: " + err.Error(),
+		if len(entry.Message) > server.maxLogEntrySize {
+			return PostV1TaskIdLogs413JSONResponse{
+				GenericTooLargeJSONResponse{
+					Error: fmt.Sprintf(
+						"Message of log %d exceeds the maximum size of %d bytes",
+						i,
+						server.maxLogEntrySize,
+					),
 				},
 			}, nil
 		}
 
-		taskOptions = append(taskOptions, asynq.Retention(retention))
-	} else {
-		taskOptions = append(taskOptions, asynq.Retention(server.retention))
+		logs[i] = orm.TaskLog{
+			Level:   entry.Level,
+			Issuer:  entry.Issuer,
+			Message: entry.Message,
+		}
+		if entry.Timestamp != nil {
+			logs[i].Timestamp = *entry.Timestamp
+		}
 	}
 
-	if request.Body.Retries != nil {
-		taskOptions = append(taskOptions, asynq.MaxRetry(*request.Body.Retries))
-	} else {
-		taskOptions = append(taskOptions, asynq.MaxRetry(server.maxRetries))
+	exists, err := server.taskExists(ctx, request.Id)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to retrieve task")
+
+		return PostV1TaskIdLogs500Response{}, nil
 	}
 
-	// Enqueue the task for processing
-	taskInfo, err := server.queueClient.EnqueueTask(
-		task,
-		taskOptions...,
+	if !exists {
+		return PostV1TaskIdLogs404JSONResponse{GenericNotFoundJSONResponse{
+			Error: (&queue.TaskNotFoundError{Id: request.Id}).Error(),
+		}}, nil
+	}
+
+	err = server.db.AppendLogsOfTask(
+		ctx,
+		request.Id,
+		logs,
+		server.maxTaskLogSize,
 	)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to enqueue task")
+		var errLimit *orm.LimitExceededError
+		if errors.As(err, &errLimit) {
+			return PostV1TaskIdLogs413JSONResponse{
+				GenericTooLargeJSONResponse{
+					Error: fmt.Sprintf(
+						"Logs of task %s would exceed the maximum size of %d bytes",
+						request.Id,
+						server.maxTaskLogSize,
+					),
+				},
+			}, nil
+		}
 
-		return PostV1Task500Response{}, nil
+		log.Error().
+			Err(err).
+			Str("id", request.Id).
+			Msg("Failed to store logs of task")
+
+		return PostV1TaskIdLogs500Response{}, nil
 	}
 
-	return PostV1Task201JSONResponse{
-		Id:        taskInfo.ID,
-		Source:    request.Body.Source,
-		Params:    request.Body.Params,
-		Args:      request.Body.Args,
-		Env:       request.Body.Env,
-		Callback:  request.Body.Callback,
-		Retention: utils.Ptr(taskInfo.Retention.String()),
-		Retries:   &taskInfo.MaxRetry,
-		Status:    TaskStatus{},
-	}, nil
+	return PostV1TaskIdLogs204Response{}, nil
 }
 
-// GetV1TaskId implements [StrictServerInterface].
-func (server *Server) GetV1TaskId(
+// GetV1TaskIdTransitions implements [StrictServerInterface].
+func (server *Server) GetV1TaskIdTransitions(
 	ctx context.Context,
-	request GetV1TaskIdRequestObject,
-) (GetV1TaskIdResponseObject, error) {
-	task, err := server.queueClient.GetTask(request.Id)
+	request GetV1TaskIdTransitionsRequestObject,
+) (GetV1TaskIdTransitionsResponseObject, error) {
+	visible, err := server.taskVisible(ctx, request.Id)
 	if err != nil {
-		if errors.Is(err, &queue.TaskNotFoundError{}) {
-			return GetV1TaskId404JSONResponse{GenericNotFoundJSONResponse{
-				Error: err.Error(),
-			}}, nil
-		}
-
 		log.Error().
 			Err(err).
 			Str("id", request.Id).
 			Msg("Failed to retrieve task")
 
-		return GetV1TaskId500Response{}, nil
+		return GetV1TaskIdTransitions500Response{}, nil
 	}
 
-	var taskPayload pb.Task
-	if err := proto.Unmarshal(task.Payload, &taskPayload); err != nil {
+	if !visible {
+		return GetV1TaskIdTransitions404JSONResponse{
+			GenericNotFoundJSONResponse{
+				Error: (&queue.TaskNotFoundError{Id: request.Id}).Error(),
+			},
+		}, nil
+	}
+
+	transitions, err := server.db.GetTransitionsOfTask(ctx, request.Id)
+	if err != nil {
 		log.Error().
 			Err(err).
 			Str("id", request.Id).
-			Msg("Failed to unmarshall task payload")
-	}
+			Msg("Failed to retrieve state transitions of task")
 
-	state, err := taskToTaskResponse(task)
-	if err != nil {
-		log.Error().Err(err).Str("id", request.Id).Msg("Failed to transform task")
+		return GetV1TaskIdTransitions500Response{}, nil
+	}
 
-		return GetV1TaskId500Response{}, nil
+	response := make([]TaskTransition, len(transitions))
+	for i, transition := range transitions {
+		response[i] = TaskTransition{
+			To:        transition.ToState,
+			Timestamp: transition.Timestamp,
+		}
+		if transition.FromState != "" {
+			response[i].From = &transition.FromState
+		}
 	}
 
-	return GetV1TaskId200JSONResponse(state), nil
+	return GetV1TaskIdTransitions200JSONResponse(response), nil
 }
 
-// GetV1TaskIdLogs implements [StrictServerInterface].
-func (server *Server) GetV1TaskIdLogs(
-	ctx context.Context,
-	request GetV1TaskIdLogsRequestObject,
-) (GetV1TaskIdLogsResponseObject, error) {
-	if _, err := server.queueClient.GetTask(request.Id); err != nil {
-		if !errors.Is(err, &queue.TaskNotFoundError{}) {
-			log.Error().
-				Err(err).
-				Str("id", request.Id).
-				Msg("Failed to retrieve task")
+// taskFilterFromParams builds the filter of a task listing. A source
+// identifier is split into the parts of the task record it matches.
+func taskFilterFromParams(params *GetV1TaskParams) (orm.TaskFilter, error) {
+	filter := orm.TaskFilter{
+		State:           params.State,
+		Namespace:       params.Namespace,
+		Package:         params.Package,
+		Interface:       params.Interface,
+		Function:        params.Function,
+		Tag:             params.Tag,
+		VersionHash:     params.Hash,
+		SubmittedBy:     params.SubmittedBy,
+		GroupID:         params.Group,
+		CreatedAfter:    params.CreatedAfter,
+		CreatedBefore:   params.CreatedBefore,
+		CompletedAfter:  params.CompletedAfter,
+		CompletedBefore: params.CompletedBefore,
+	}
 
-			return GetV1TaskIdLogs500Response{}, nil
+	if params.Source != nil {
+		if filter.Namespace != nil || filter.Package != nil ||
+			filter.Interface != nil || filter.Function != nil ||
+			filter.Tag != nil || filter.VersionHash != nil {
+			return orm.TaskFilter{}, ErrAmbiguousSourceFilter
 		}
 
-		return GetV1TaskIdLogs404JSONResponse{}, nil
+		source, err := parseSource(*params.Source)
+		if err != nil {
+			return orm.TaskFilter{}, err
+		}
+
+		filter.Namespace = &source.Artifact.Package.Namespace
+		filter.Package = &source.Artifact.Package.Name
+		filter.Interface = &source.Interface
+		filter.Function = &source.Name
+
+		switch identifier := source.Artifact.Identifier.(type) {
+		case *pb.ArtifactIdentifier_Tag:
+			filter.Tag = &identifier.Tag
+		case *pb.ArtifactIdentifier_VersionHash:
+			filter.VersionHash = &identifier.VersionHash
+		}
 	}
 
-	logs, err := server.db.GetLogsOfTask(ctx, request.Id)
+	if isReversedRange(filter.CreatedAfter, filter.CreatedBefore) ||
+		isReversedRange(filter.CompletedAfter, filter.CompletedBefore) {
+		return orm.TaskFilter{}, ErrInvalidTimeRange
+	}
+
+	return filter, nil
+}
+
+func logFilterFromParams(params *GetV1TaskIdLogsParams) (orm.LogFilter, error) {
+	filter := orm.LogFilter{
+		Level:  params.Level,
+		Issuer: params.Issuer,
+		Since:  params.Since,
+		Until:  params.Until,
+	}
+
+	if params.Q != nil && *params.Q != "" {
+		filter.Search = params.Q
+	}
+
+	if isReversedRange(filter.Since, filter.Until) {
+		return orm.LogFilter{}, ErrInvalidTimeRange
+	}
+
+	return filter, nil
+}
+
+func isReversedRange(from, to *time.Time) bool {
+	return from != nil && to != nil && from.After(*to)
+}
+
+// encodeTaskCursor returns the opaque representation of a cursor handed out to
+// clients.
+func encodeTaskCursor(cursor orm.TaskCursor) string {
+	return base64.RawURLEncoding.EncodeToString(
+		[]byte(cursor.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + cursor.ID),
+	)
+}
+
+// decodeTaskCursor parses a cursor created by encodeTaskCursor.
+func decodeTaskCursor(cursor string) (orm.TaskCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
 	if err != nil {
-		log.Error().
-			Err(err).
-			Str("id", request.Id).
-			Msg("Failed to retrieve logs of task")
+		return orm.TaskCursor{}, ErrInvalidCursor
+	}
 
-		return GetV1TaskIdLogs500Response{}, nil
+	createdAt, id, found := strings.Cut(string(decoded), "|")
+	if !found || id == "" {
+		return orm.TaskCursor{}, ErrInvalidCursor
+	}
+
+	timestamp, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return orm.TaskCursor{}, ErrInvalidCursor
 	}
 
-	return GetV1TaskIdLogs200JSONResponse(dbLogsToJsonLogs(logs)), nil
+	return orm.TaskCursor{CreatedAt: timestamp, ID: id}, nil
 }
 
 func taskToTaskResponse(task *asynq.TaskInfo) (Task, error) {
@@ -261,21 +1198,109 @@ func taskToTaskResponse(task *asynq.TaskInfo) (Task, error) {
 		return Task{}, err
 	}
 
+	state := taskPayloadToTaskResponse(
+		task.ID,
+		&taskPayload,
+		task.MaxRetry,
+		task.Retention.String(),
+	)
+	state.Queue = &task.Queue
+	state.Deadline = serverutils.TimeOrNil(task.Deadline)
+	if task.Timeout > 0 {
+		state.Timeout = utils.Ptr(task.Timeout.String())
+	}
+	metadata := queue.MetadataOf(task)
+	if metadata.SubmittedBy != "" {
+		state.SubmittedBy = &metadata.SubmittedBy
+	}
+	if metadata.GroupID != "" {
+		state.Group = &metadata.GroupID
+	}
+	if metadata.RequeuedFrom != "" {
+		state.RequeuedFrom = &metadata.RequeuedFrom
+	}
+	state.Status = TaskStatus{
+		Retries:       task.Retried,
+		State:         queue.ReportedState(task.State.String(), task.Result),
+		LastError:     &task.LastErr,
+		LastFailedAt:  &task.LastFailedAt,
+		NextProcessAt: &task.NextProcessAt,
+		CompletedAt:   &task.CompletedAt,
+	}
+
+	state.Status.Result = resultToAny(task.ID, task.Result)
+
+	return state, nil
+}
+
+// taskRecordToTaskResponse converts the history record of a task, e.g. of a
+// listed task or of one that is no longer in the queue.
+func taskRecordToTaskResponse(record *orm.Task) (Task, error) {
+	var taskPayload pb.Task
+	if err := proto.Unmarshal(record.Payload, &taskPayload); err != nil {
+		//nolint:wrapcheck // Error is not used but only logged later on, no error wrap needed
+		return Task{}, err
+	}
+
+	state := taskPayloadToTaskResponse(
+		record.ID,
+		&taskPayload,
+		record.MaxRetry,
+		record.Retention,
+	)
+	state.Queue = &record.Queue
+	state.Deadline = record.Deadline
+	if record.Timeout != "" {
+		state.Timeout = &record.Timeout
+	}
+	if record.SubmittedBy != "" {
+		state.SubmittedBy = &record.SubmittedBy
+	}
+	if record.GroupID != "" {
+		state.Group = &record.GroupID
+	}
+	if record.RequeuedFrom != "" {
+		state.RequeuedFrom = &record.RequeuedFrom
+	}
+	if record.RequeuedAs != "" {
+		state.RequeuedAs = &record.RequeuedAs
+	}
+	state.Status = TaskStatus{
+		Retries:       record.Retried,
+		State:         record.State,
+		LastFailedAt:  record.LastFailedAt,
+		NextProcessAt: record.NextProcessAt,
+		CompletedAt:   record.CompletedAt,
+	}
+
+	if record.LastError != "" {
+		state.Status.LastError = &record.LastError
+	}
+
+	state.Status.Result = resultToAny(record.ID, record.Result)
+
+	return state, nil
+}
+
+// taskPayloadToTaskResponse converts the submitted parts of a task, the status
+// is left empty.
+func taskPayloadToTaskResponse(
+	id string,
+	taskPayload *pb.Task,
+	maxRetry int,
+	retention string,
+) Task {
 	state := Task{
-		Id:        task.ID,
-		Source:    serializeSource(taskPayload.Function),
-		Retries:   &task.MaxRetry,
-		Retention: utils.Ptr(task.Retention.String()),
-		Args:      &taskPayload.Arguments,
-		Callback:  utils.Ptr(""), // Currently not in task proto
-		Status: TaskStatus{
-			Retries:       task.Retried,
-			State:         task.State.String(),
-			LastError:     &task.LastErr,
-			LastFailedAt:  &task.LastFailedAt,
-			NextProcessAt: &task.NextProcessAt,
-			CompletedAt:   &task.CompletedAt,
-		},
+		Id:          id,
+		Source:      taskSource(taskPayload),
+		VersionHash: versionHashOf(taskPayload),
+		Retries:     &maxRetry,
+		Retention:   &retention,
+		Args:        &taskPayload.Arguments,
+	}
+
+	if taskPayload.Callback != "" {
+		state.Callback = &taskPayload.Callback
 	}
 
 	if taskPayload.Parameters != nil {
@@ -304,13 +1329,31 @@ func taskToTaskResponse(task *asynq.TaskInfo) (Task, error) {
 		state.Env = &envVars
 	}
 
-	if task.Result != nil {
-		state.Status.ResultPayload = utils.Ptr(
-			base64.StdEncoding.EncodeToString(task.Result),
-		)
+	return state
+}
+
+// taskSource serializes the source of a task. Pinned artifacts are referenced
+// by the tag they were submitted with.
+func taskSource(task *pb.Task) string {
+	if task.Tag == "" {
+		return serializeSource(task.Function)
 	}
 
-	return state, nil
+	source := proto.CloneOf(task.Function)
+	source.Artifact.Identifier = &pb.ArtifactIdentifier_Tag{Tag: task.Tag}
+
+	return serializeSource(source)
+}
+
+// versionHashOf returns the version hash of the artifact of a task if the
+// artifact is referenced by hash.
+func versionHashOf(task *pb.Task) *string {
+	versionHash := task.GetFunction().GetArtifact().GetVersionHash()
+	if versionHash == "" {
+		return nil
+	}
+
+	return &versionHash
 }
 
 func serializeSource(source *pb.FunctionIdentifier) string {
@@ -338,18 +1381,22 @@ func serializeSource(source *pb.FunctionIdentifier) string {
 func dbLogsToJsonLogs(dbLogs []orm.TaskLog) []TaskLog {
 	jsonLogs := make([]TaskLog, len(dbLogs))
 
-	for i, log := range dbLogs {
-		jsonLogs[i] = TaskLog{
-			Issuer:    log.Issuer,
-			Level:     log.Level,
-			Message:   log.Message,
-			Timestamp: log.Timestamp,
-		}
+	for i := range dbLogs {
+		jsonLogs[i] = dbLogToJsonLog(&dbLogs[i])
 	}
 
 	return jsonLogs
 }
 
+func dbLogToJsonLog(taskLog *orm.TaskLog) TaskLog {
+	return TaskLog{
+		Issuer:    taskLog.Issuer,
+		Level:     taskLog.Level,
+		Message:   taskLog.Message,
+		Timestamp: taskLog.Timestamp,
+	}
+}
+
 // anyToProtoVal converts a value produced by YAML/JSON unmarshalling into a
 // proto Val. Only the types that the standard library decoders can produce are
 // handled:
@@ -360,52 +1407,68 @@ func dbLogsToJsonLogs(dbLogs []orm.TaskLog) []TaskLog {
 //	float64         → F64Val
 //	string          → StringVal
 //	[]interface{}   → ListVal  (recursive)
-//	map[string]any  → RecordVal (recursive)
+//	map[string]any  → RecordVal (recursive) or typed value, see typedValue
 //	nil             → OptionVal{Value: nil}  (none)
-func anyToProtoVal(v any) *pb.Val {
+func anyToProtoVal(v any) (*pb.Val, error) {
 	switch val := v.(type) {
 	case bool:
-		return &pb.Val{Value: &pb.Val_BoolVal{BoolVal: val}}
+		return &pb.Val{Value: &pb.Val_BoolVal{BoolVal: val}}, nil
 	case int:
-		return &pb.Val{Value: &pb.Val_S64Val{S64Val: int64(val)}}
+		return &pb.Val{Value: &pb.Val_S64Val{S64Val: int64(val)}}, nil
 	case int64:
-		return &pb.Val{Value: &pb.Val_S64Val{S64Val: val}}
+		return &pb.Val{Value: &pb.Val_S64Val{S64Val: val}}, nil
 	case float64:
-		return &pb.Val{Value: &pb.Val_F64Val{F64Val: val}}
+		return &pb.Val{Value: &pb.Val_F64Val{F64Val: val}}, nil
 	case string:
-		return &pb.Val{Value: &pb.Val_StringVal{StringVal: val}}
+		return &pb.Val{Value: &pb.Val_StringVal{StringVal: val}}, nil
 	case []interface{}:
-		elems := make([]*pb.Val, len(val))
-		for i, elem := range val {
-			elems[i] = anyToProtoVal(elem)
+		elems, err := valuesOf(witList, val)
+		if err != nil {
+			return nil, err
 		}
 
-		return &pb.Val{Value: &pb.Val_ListVal{ListVal: &pb.ListVal{Values: elems}}}
+		return &pb.Val{
+			Value: &pb.Val_ListVal{ListVal: &pb.ListVal{Values: elems}},
+		}, nil
 	case map[string]interface{}:
+		if witType, value, ok := typedValue(val); ok {
+			return typedToProtoVal(witType, value)
+		}
+
 		fields := make([]*pb.RecordField, 0, len(val))
 		for k, fv := range val {
-			fields = append(
-				fields,
-				&pb.RecordField{Name: k, Value: anyToProtoVal(fv)},
-			)
+			value, err := anyToProtoVal(fv)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", k, err)
+			}
+
+			fields = append(fields, &pb.RecordField{Name: k, Value: value})
 		}
 
 		return &pb.Val{
 			Value: &pb.Val_RecordVal{RecordVal: &pb.RecordVal{Fields: fields}},
-		}
+		}, nil
 	default:
 		// nil or any unrecognised type → option<T> none
-		return &pb.Val{Value: &pb.Val_OptionVal{OptionVal: &pb.OptionVal{}}}
+		return &pb.Val{
+			Value: &pb.Val_OptionVal{OptionVal: &pb.OptionVal{}},
+		}, nil
 	}
 }
 
 // protoValToAny converts a proto Val back into JSON-serializable Go values.
-// It handles only the subset of variants produced by anyToProtoVal.
+// Values that anyToProtoVal produces from plain values are converted to plain
+// values, all others to their typed encoding, so that the result converts
+// back into the same Val.
 func protoValToAny(v *pb.Val) any {
 	if v == nil {
 		return nil
 	}
 
+	if converted, ok := typedProtoValToAny(v); ok {
+		return converted
+	}
+
 	switch val := v.Value.(type) {
 	case *pb.Val_BoolVal:
 		return val.BoolVal
@@ -427,30 +1490,108 @@ func protoValToAny(v *pb.Val) any {
 
 		return elems
 	case *pb.Val_RecordVal:
-		obj := make(map[string]interface{}, 0)
-		if val.RecordVal == nil {
-			return obj
-		}
+		return recordToAny(val.RecordVal)
+	case *pb.Val_OptionVal:
+		// Options with a value are typed
+		return nil
+	default:
+		return nil
+	}
+}
 
-		obj = make(map[string]interface{}, len(val.RecordVal.Fields))
-		for _, field := range val.RecordVal.Fields {
-			if field == nil {
-				continue
-			}
+// validateCallbackURL checks that a callback is an absolute http(s) URL to a
+// target callbacks may be sent to.
+func validateCallbackURL(raw string, targets *callback.TargetPolicy) error {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidCallbackURL, err)
+	}
 
-			obj[field.Name] = protoValToAny(field.Value)
-		}
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") ||
+		parsed.Host == "" {
+		return ErrInvalidCallbackURL
+	}
 
-		return obj
-	case *pb.Val_OptionVal:
-		if val.OptionVal == nil || val.OptionVal.Value == nil {
-			return nil
-		}
+	err = targets.CheckURL(parsed)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidCallbackURL, err)
+	}
 
-		return protoValToAny(val.OptionVal.Value)
+	return nil
+}
+
+// parseSchedule converts the processAt / processIn fields of a task request
+// into the matching asynq options. No options are returned if the task should
+// be processed immediately.
+func parseSchedule(
+	processAt *time.Time,
+	processIn *string,
+	now time.Time,
+	maxHorizon time.Duration,
+) ([]asynq.Option, error) {
+	hasProcessIn := processIn != nil && *processIn != ""
+	if processAt != nil && hasProcessIn {
+		return nil, ErrAmbiguousSchedule
+	}
+
+	var delay time.Duration
+	switch {
+	case processAt != nil:
+		delay = processAt.Sub(now)
+	case hasProcessIn:
+		var err error
+		delay, err = time.ParseDuration(*processIn)
+		if err != nil {
+			//nolint:wrapcheck // Error message is returned to the client as is
+			return nil, err
+		}
 	default:
-		return nil
+		return []asynq.Option{}, nil
+	}
+
+	if delay < 0 {
+		return nil, ErrScheduleInPast
+	}
+
+	if delay > maxHorizon {
+		return nil, fmt.Errorf(
+			"%w of %s",
+			ErrScheduleBeyondHorizon,
+			maxHorizon.String(),
+		)
+	}
+
+	if processAt != nil {
+		return []asynq.Option{asynq.ProcessAt(*processAt)}, nil
+	}
+
+	return []asynq.Option{asynq.ProcessIn(delay)}, nil
+}
+
+// parseDeadline converts the deadline field of a task request into the
+// matching asynq option. No options are returned if the task has no deadline.
+func parseDeadline(
+	deadline *time.Time,
+	now time.Time,
+	maxDeadline time.Duration,
+) ([]asynq.Option, error) {
+	if deadline == nil {
+		return []asynq.Option{}, nil
+	}
+
+	if !deadline.After(now) {
+		return nil, ErrDeadlineInPast
 	}
+
+	if deadline.Sub(now) > maxDeadline {
+		return nil, fmt.Errorf(
+			"%w of %s",
+			ErrDeadlineBeyondMaximum,
+			maxDeadline.String(),
+		)
+	}
+
+	return []asynq.Option{asynq.Deadline(*deadline)}, nil
 }
 
 // parses namespace:package/interface/function@hash<hash>|version into
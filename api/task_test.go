@@ -2,13 +2,25 @@
 package api
 
 import (
+	"api-server/callback"
+	"api-server/orm"
+	"api-server/queue"
+	"context"
+	"encoding/base64"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	pb "api-server/proto_gen"
 
+	"github.com/EnclaveRunner/shareddeps/auth"
+	"github.com/EnclaveRunner/shareddeps/utils"
+	stringadapter "github.com/casbin/casbin/v3/persist/string-adapter"
+	"github.com/hibiken/asynq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 )
 
 // expectedFuncIdentifier holds the fields we want to assert after parsing a
@@ -349,7 +361,8 @@ func TestAnyToProtoVal(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := anyToProtoVal(tt.input)
+			got, err := anyToProtoVal(tt.input)
+			require.NoError(t, err)
 			require.NotNil(t, got)
 			tt.check(t, got)
 		})
@@ -452,10 +465,591 @@ func TestAnyProtoValRoundTripSubset(t *testing.T) {
 		"list": []interface{}{float64(1.5), nil, map[string]interface{}{"k": "v"}},
 	}
 
-	got := protoValToAny(anyToProtoVal(input))
+	val, err := anyToProtoVal(input)
+	require.NoError(t, err)
+
+	got := protoValToAny(val)
 	assert.Equal(t, input, got)
 }
 
+func TestValidateCallbackURL(t *testing.T) {
+	targets, err := callback.NewTargetPolicy([]string{"10.1.0.0/16"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		input       string
+		expectError bool
+	}{
+		{name: "https URL", input: "https://example.com/hook"},
+		{name: "http URL with port", input: "http://hooks.local:8080/done?x=1"},
+		{name: "public address", input: "http://93.184.215.14/hook"},
+		{name: "allowed network", input: "http://10.1.2.3/hook"},
+		{name: "missing scheme", input: "example.com/hook", expectError: true},
+		{name: "unsupported scheme", input: "ftp://example.com", expectError: true},
+		{name: "missing host", input: "https:///hook", expectError: true},
+		{name: "unparsable URL", input: "http://[::1", expectError: true},
+		{name: "loopback", input: "http://127.0.0.1/hook", expectError: true},
+		{name: "localhost", input: "http://localhost:8080", expectError: true},
+		{name: "link-local", input: "http://169.254.169.254/", expectError: true},
+		{name: "private", input: "http://10.2.0.1/hook", expectError: true},
+		{name: "mapped loopback", input: "http://[::ffff:127.0.0.1]/", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCallbackURL(tt.input, targets)
+
+			if tt.expectError {
+				assert.ErrorIs(t, err, ErrInvalidCallbackURL)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSourceHasPrefix(t *testing.T) {
+	source, err := parseSource("myns:myapp/myiface/myfunc@stable")
+	require.NoError(t, err)
+	payload, err := proto.Marshal(&pb.Task{Function: source})
+	require.NoError(t, err)
+	task := &asynq.TaskInfo{Payload: payload}
+
+	tests := []struct {
+		name     string
+		prefix   string
+		expected bool
+	}{
+		{name: "namespace", prefix: "myns:", expected: true},
+		{
+			name:     "full source",
+			prefix:   "myns:myapp/myiface/myfunc@stable",
+			expected: true,
+		},
+		{name: "other namespace", prefix: "other:", expected: false},
+		{
+			name:     "other tag",
+			prefix:   "myns:myapp/myiface/myfunc@v1",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, sourceHasPrefix(tt.prefix)(task))
+		})
+	}
+
+	assert.False(
+		t,
+		sourceHasPrefix("myns:")(&asynq.TaskInfo{Payload: []byte{0xff}}),
+		"undecodable payloads must not match",
+	)
+}
+
+func TestParseSchedule(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	horizon := 24 * time.Hour
+
+	tests := []struct {
+		name         string
+		processAt    *time.Time
+		processIn    *string
+		expectedType asynq.OptionType
+		expectedErr  error
+		expectNone   bool
+	}{
+		{name: "immediate", expectNone: true},
+		{name: "empty delay", processIn: utils.Ptr(""), expectNone: true},
+		{
+			name:         "process at",
+			processAt:    utils.Ptr(now.Add(time.Hour)),
+			expectedType: asynq.ProcessAtOpt,
+		},
+		{
+			name:         "process in",
+			processIn:    utils.Ptr("90m"),
+			expectedType: asynq.ProcessInOpt,
+		},
+		{
+			name:        "both set",
+			processAt:   utils.Ptr(now.Add(time.Hour)),
+			processIn:   utils.Ptr("1h"),
+			expectedErr: ErrAmbiguousSchedule,
+		},
+		{
+			name:        "process at in the past",
+			processAt:   utils.Ptr(now.Add(-time.Minute)),
+			expectedErr: ErrScheduleInPast,
+		},
+		{
+			name:        "negative delay",
+			processIn:   utils.Ptr("-5m"),
+			expectedErr: ErrScheduleInPast,
+		},
+		{
+			name:        "process at beyond horizon",
+			processAt:   utils.Ptr(now.Add(25 * time.Hour)),
+			expectedErr: ErrScheduleBeyondHorizon,
+		},
+		{
+			name:        "delay beyond horizon",
+			processIn:   utils.Ptr("48h"),
+			expectedErr: ErrScheduleBeyondHorizon,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := parseSchedule(tt.processAt, tt.processIn, now, horizon)
+
+			switch {
+			case tt.expectedErr != nil:
+				require.ErrorIs(t, err, tt.expectedErr)
+			case tt.expectNone:
+				require.NoError(t, err)
+				assert.Empty(t, opts)
+			default:
+				require.NoError(t, err)
+				require.Len(t, opts, 1)
+				assert.Equal(t, tt.expectedType, opts[0].Type())
+			}
+		})
+	}
+
+	_, err := parseSchedule(nil, utils.Ptr("soon"), now, horizon)
+	assert.Error(t, err, "invalid durations must be rejected")
+}
+
+func TestTaskCursor(t *testing.T) {
+	cursor := orm.TaskCursor{
+		CreatedAt: time.Date(2025, 3, 4, 5, 6, 7, 891011000, time.UTC),
+		ID:        "f1e2d3c4-b5a6-4978-8695-a4b3c2d1e0f9",
+	}
+
+	decoded, err := decodeTaskCursor(encodeTaskCursor(cursor))
+	require.NoError(t, err)
+	assert.True(t, cursor.CreatedAt.Equal(decoded.CreatedAt))
+	assert.Equal(t, cursor.ID, decoded.ID)
+
+	for _, invalid := range []string{
+		"not base64!",
+		base64.RawURLEncoding.EncodeToString([]byte("missing separator")),
+		base64.RawURLEncoding.EncodeToString([]byte("yesterday|some-id")),
+		base64.RawURLEncoding.EncodeToString([]byte("2025-03-04T05:06:07Z|")),
+	} {
+		_, err := decodeTaskCursor(invalid)
+		require.ErrorIs(t, err, ErrInvalidCursor, invalid)
+	}
+}
+
+func TestLogCursor(t *testing.T) {
+	decoded, err := decodeLogCursor(encodeLogCursor(42))
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), decoded)
+
+	for _, invalid := range []string{"", "abc", "-1", "1.5"} {
+		_, err := decodeLogCursor(invalid)
+		require.ErrorIs(t, err, ErrInvalidCursor, invalid)
+	}
+}
+
+func TestLogExportWrite(t *testing.T) {
+	taskLog := orm.TaskLog{
+		Timestamp: time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC),
+		Level:     "error",
+		Issuer:    "runtime",
+		Message:   "out of fuel",
+	}
+
+	var text strings.Builder
+	require.NoError(t, (&logExport{format: Text}).write(&text, &taskLog))
+	assert.Equal(
+		t,
+		"2025-03-04T05:06:07Z [error] runtime: out of fuel\n",
+		text.String(),
+	)
+
+	var ndjson strings.Builder
+	require.NoError(t, (&logExport{format: Ndjson}).write(&ndjson, &taskLog))
+	assert.JSONEq(
+		t,
+		`{"timestamp":"2025-03-04T05:06:07Z","level":"error",`+
+			`"issuer":"runtime","message":"out of fuel"}`,
+		ndjson.String(),
+	)
+	assert.True(t, strings.HasSuffix(ndjson.String(), "\n"))
+}
+
+func TestTaskFilterFromParams(t *testing.T) {
+	source := "acme:billing/api/run@hash:abc123"
+	filter, err := taskFilterFromParams(&GetV1TaskParams{
+		Source: &source,
+		State:  utils.Ptr("archived"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "acme", *filter.Namespace)
+	assert.Equal(t, "billing", *filter.Package)
+	assert.Equal(t, "api", *filter.Interface)
+	assert.Equal(t, "run", *filter.Function)
+	assert.Equal(t, "abc123", *filter.VersionHash)
+	assert.Nil(t, filter.Tag)
+	assert.Equal(t, "archived", *filter.State)
+
+	_, err = taskFilterFromParams(&GetV1TaskParams{
+		Source: &source,
+		Tag:    utils.Ptr("v2"),
+	})
+	require.ErrorIs(t, err, ErrAmbiguousSourceFilter)
+
+	_, err = taskFilterFromParams(&GetV1TaskParams{Source: utils.Ptr("acme")})
+	require.ErrorIs(t, err, ErrInvalidIdentifier)
+
+	now := time.Now()
+	_, err = taskFilterFromParams(&GetV1TaskParams{
+		CompletedAfter:  &now,
+		CompletedBefore: utils.Ptr(now.Add(-time.Hour)),
+	})
+	require.ErrorIs(t, err, ErrInvalidTimeRange)
+}
+
+func TestLogFilterFromParams(t *testing.T) {
+	now := time.Now()
+	filter, err := logFilterFromParams(&GetV1TaskIdLogsParams{
+		Level: utils.Ptr("error"),
+		Since: utils.Ptr(now.Add(-time.Hour)),
+		Until: &now,
+		Q:     utils.Ptr("timeout"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "error", *filter.Level)
+	assert.Nil(t, filter.Issuer)
+	assert.Equal(t, "timeout", *filter.Search)
+
+	filter, err = logFilterFromParams(&GetV1TaskIdLogsParams{Q: utils.Ptr("")})
+	require.NoError(t, err)
+	assert.Nil(t, filter.Search, "empty searches match every log")
+
+	_, err = logFilterFromParams(&GetV1TaskIdLogsParams{
+		Since: &now,
+		Until: utils.Ptr(now.Add(-time.Hour)),
+	})
+	require.ErrorIs(t, err, ErrInvalidTimeRange)
+}
+
+// memoryAdapter keeps policies in the enforcer only. Batches are accepted as
+// the enforcer does not persist them.
+type memoryAdapter struct {
+	*stringadapter.Adapter
+}
+
+func (memoryAdapter) AddPolicies(string, string, [][]string) error {
+	return nil
+}
+
+func (memoryAdapter) RemovePolicies(string, string, [][]string) error {
+	return nil
+}
+
+// newAuthModule returns an in-memory auth module in which user is a member of
+// the given groups.
+func newAuthModule(t *testing.T, user string, groups ...string) auth.AuthModule {
+	t.Helper()
+
+	authModule := auth.NewModule(memoryAdapter{
+		stringadapter.NewAdapter("p, enclave_admin, *, *"),
+	})
+	for _, group := range groups {
+		require.NoError(t, authModule.CreateUserGroup(group))
+	}
+	require.NoError(t, authModule.AddUserToGroup(user, groups...))
+
+	return authModule
+}
+
+func TestPostTaskLogsLimits(t *testing.T) {
+	server := &Server{
+		authModule:      newAuthModule(t, "runner", LogIngestGroup),
+		maxLogBatchSize: 2,
+		maxLogEntrySize: 5,
+	}
+	ctx := auth.SetAuthenticatedUser(t.Context(), "runner")
+	entry := TaskLogEntry{Level: "info", Issuer: "runner", Message: "hello"}
+
+	for _, tt := range []struct {
+		name     string
+		logs     []TaskLogEntry
+		expected PostV1TaskIdLogsResponseObject
+	}{
+		{"empty batch", nil, PostV1TaskIdLogs400JSONResponse{}},
+		{
+			"too many logs",
+			[]TaskLogEntry{entry, entry, entry},
+			PostV1TaskIdLogs413JSONResponse{},
+		},
+		{
+			"missing level",
+			[]TaskLogEntry{{Issuer: entry.Issuer, Message: entry.Message}},
+			PostV1TaskIdLogs400JSONResponse{},
+		},
+		{
+			"message too large",
+			[]TaskLogEntry{entry, {Level: entry.Level, Issuer: entry.Issuer, Message: "hello!"}},
+			PostV1TaskIdLogs413JSONResponse{},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := server.PostV1TaskIdLogs(
+				ctx,
+				PostV1TaskIdLogsRequestObject{
+					Id:   "task",
+					Body: &PostV1TaskIdLogsJSONRequestBody{Logs: tt.logs},
+				},
+			)
+			require.NoError(t, err)
+			assert.IsType(t, tt.expected, response)
+		})
+	}
+}
+
+func TestPostTaskLogsForbidden(t *testing.T) {
+	server := &Server{
+		authModule:      newAuthModule(t, "alice", "tasks"),
+		maxLogBatchSize: 1,
+	}
+
+	// Batches of unauthorized users are rejected before they are validated
+	response, err := server.PostV1TaskIdLogs(
+		auth.SetAuthenticatedUser(t.Context(), "alice"),
+		PostV1TaskIdLogsRequestObject{
+			Id:   "task",
+			Body: &PostV1TaskIdLogsJSONRequestBody{Logs: make([]TaskLogEntry, 2)},
+		},
+	)
+	require.NoError(t, err)
+	assert.IsType(t, PostV1TaskIdLogs403Response{}, response)
+}
+
+func TestTaskResponseSubmittedBy(t *testing.T) {
+	payload, err := proto.Marshal(&pb.Task{
+		Function: &pb.FunctionIdentifier{
+			Artifact: &pb.ArtifactIdentifier{
+				Package:    &pb.PackageName{Namespace: "acme", Name: "billing"},
+				Identifier: &pb.ArtifactIdentifier_Tag{Tag: "v2"},
+			},
+			Interface: "api",
+			Name:      "run",
+		},
+	})
+	require.NoError(t, err)
+
+	state, err := taskToTaskResponse(&asynq.TaskInfo{
+		ID:      "t1",
+		State:   asynq.TaskStatePending,
+		Payload: payload,
+		Headers: map[string]string{queue.HeaderSubmittedBy: "alice"},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, state.SubmittedBy)
+	assert.Equal(t, "alice", *state.SubmittedBy)
+
+	state, err = taskRecordToTaskResponse(&orm.Task{ID: "t2", Payload: payload})
+	require.NoError(t, err)
+	assert.Nil(t, state.SubmittedBy, "unknown submitters are omitted")
+}
+
+func TestVisibleOwnerUnrestricted(t *testing.T) {
+	server := &Server{restrictVisibility: false}
+
+	owner, err := server.visibleOwner(t.Context())
+	require.NoError(t, err)
+	assert.Nil(t, owner)
+}
+
+func TestQueueOf(t *testing.T) {
+	server := &Server{}
+
+	name, err := server.queueOf(t.Context(), &CreateTaskRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, queue.TaskQueueDefault, name)
+
+	_, err = server.queueOf(
+		t.Context(),
+		&CreateTaskRequest{Queue: utils.Ptr("unconfigured")},
+	)
+	require.ErrorIs(t, err, ErrUnknownQueue)
+}
+
+func TestHashTaskRequest(t *testing.T) {
+	body := CreateTaskRequest{
+		Source: "acme:billing/api/run@v2",
+		Params: &[]any{map[string]any{"b": 1, "a": 2}},
+	}
+
+	first, err := hashTaskRequest(&body)
+	require.NoError(t, err)
+	second, err := hashTaskRequest(&body)
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	body.Retries = utils.Ptr(1)
+	changed, err := hashTaskRequest(&body)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, changed)
+}
+
+func TestClaimIdempotencyKeyLength(t *testing.T) {
+	server := &Server{}
+
+	for _, key := range []string{"", strings.Repeat("k", 256)} {
+		claimed, response := server.claimIdempotencyKey(
+			t.Context(),
+			key,
+			&CreateTaskRequest{},
+		)
+		assert.Nil(t, claimed)
+		assert.IsType(t, PostV1Task400JSONResponse{}, response)
+	}
+}
+
+func TestParseDeadline(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	maxDeadline := 48 * time.Hour
+
+	opts, err := parseDeadline(nil, now, maxDeadline)
+	require.NoError(t, err)
+	assert.Empty(t, opts)
+
+	opts, err = parseDeadline(utils.Ptr(now.Add(time.Hour)), now, maxDeadline)
+	require.NoError(t, err)
+	require.Len(t, opts, 1)
+	assert.Equal(t, asynq.DeadlineOpt, opts[0].Type())
+
+	_, err = parseDeadline(&now, now, maxDeadline)
+	require.ErrorIs(t, err, ErrDeadlineInPast)
+
+	_, err = parseDeadline(utils.Ptr(now.Add(72*time.Hour)), now, maxDeadline)
+	require.ErrorIs(t, err, ErrDeadlineBeyondMaximum)
+}
+
+func TestTimeoutOf(t *testing.T) {
+	server := &Server{defaultTimeout: time.Minute, maxTimeout: time.Hour}
+
+	timeout, err := server.timeoutOf(&CreateTaskRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, timeout)
+
+	timeout, err = server.timeoutOf(&CreateTaskRequest{Timeout: utils.Ptr("90s")})
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Second, timeout)
+
+	_, err = server.timeoutOf(&CreateTaskRequest{Timeout: utils.Ptr("-1s")})
+	require.ErrorIs(t, err, ErrInvalidTimeout)
+
+	_, err = server.timeoutOf(&CreateTaskRequest{Timeout: utils.Ptr("2h")})
+	require.ErrorIs(t, err, ErrTimeoutExceedsMaximum)
+
+	_, err = server.timeoutOf(&CreateTaskRequest{Timeout: utils.Ptr("later")})
+	assert.Error(t, err, "invalid durations must be rejected")
+}
+
+func TestCachedArtifactResolver(t *testing.T) {
+	calls := 0
+	resolve := cachedArtifactResolver(
+		func(
+			_ context.Context,
+			artifact *pb.ArtifactIdentifier,
+		) (string, bool, error) {
+			calls++
+
+			return "abc123", artifact.GetTag() == "v2", nil
+		},
+	)
+
+	first, err := parseSource("acme:billing/api/run@v2")
+	require.NoError(t, err)
+	second, err := parseSource("acme:billing/api/refund@v2")
+	require.NoError(t, err)
+	other, err := parseSource("acme:billing/api/run@hash:v2")
+	require.NoError(t, err)
+
+	for _, source := range []*pb.FunctionIdentifier{first, second, first} {
+		versionHash, found, err := resolve(t.Context(), source.Artifact)
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, "abc123", versionHash)
+	}
+	assert.Equal(t, 1, calls, "artifacts are resolved once")
+
+	_, found, err := resolve(t.Context(), other.Artifact)
+	require.NoError(t, err)
+	assert.False(t, found, "tags and hashes are distinct artifacts")
+	assert.Equal(t, 2, calls)
+}
+
+func TestPinArtifact(t *testing.T) {
+	source, err := parseSource("acme:billing/api/run@v2")
+	require.NoError(t, err)
+	task := &pb.Task{Function: source}
+
+	queue.PinArtifact(task, "abc123")
+	assert.Equal(t, "abc123", task.Function.Artifact.GetVersionHash())
+	assert.Equal(t, "v2", task.Tag)
+	assert.Equal(t, "acme:billing/api/run@v2", taskSource(task))
+	assert.Equal(t, "abc123", *versionHashOf(task))
+	assert.Equal(
+		t,
+		"acme:billing/api/run@hash:abc123",
+		serializeSource(task.Function),
+		"the task runs the pinned version",
+	)
+
+	hashed, err := parseSource("acme:billing/api/run@hash:def456")
+	require.NoError(t, err)
+	task = &pb.Task{Function: hashed}
+
+	queue.PinArtifact(task, "abc123")
+	assert.Equal(t, "def456", task.Function.Artifact.GetVersionHash())
+	assert.Empty(t, task.Tag, "hashes are not resolved again")
+	assert.Equal(t, "acme:billing/api/run@hash:def456", taskSource(task))
+}
+
+func TestBatchProgress(t *testing.T) {
+	batch := &orm.TaskBatch{SubmittedBy: "alice", Total: 5, Submitted: 4}
+
+	progress := batchProgress(batch, map[string]int{
+		asynq.TaskStatePending.String():   1,
+		asynq.TaskStateCompleted.String(): 2,
+		orm.TaskStateExpired:              1,
+	})
+	assert.Equal(t, 5, progress.Total)
+	assert.Equal(t, 4, progress.Submitted)
+	assert.Equal(t, 3, progress.Finished)
+}
+
+func TestResultToAny(t *testing.T) {
+	result, err := proto.Marshal(&pb.Val{Value: &pb.Val_ResultVal{
+		ResultVal: &pb.ResultVal{
+			Value: &pb.Val{Value: &pb.Val_StringVal{StringVal: "failed"}},
+		},
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, typed(witResult, map[string]any{errKey: "failed"}),
+		resultToAny("task-1", result))
+
+	none, err := proto.Marshal(&pb.Val{
+		Value: &pb.Val_OptionVal{OptionVal: &pb.OptionVal{}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{typeKey: witOption},
+		resultToAny("task-1", none), "none is told from a missing result")
+
+	assert.Nil(t, resultToAny("task-1", nil))
+	assert.Nil(t, resultToAny("task-1", []byte{0xff}),
+		"undecodable results are left out")
+}
+
 // Benchmark tests for parseSource function
 func BenchmarkParseSource(b *testing.B) {
 	testCases := []string{
@@ -0,0 +1,587 @@
+package api
+
+import (
+	pb "api-server/proto_gen"
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"unicode/utf8"
+)
+
+// Task parameters are passed as plain JSON values which are converted by
+// anyToProtoVal. Values of WIT types plain JSON cannot express are passed as
+// an object naming the type, e.g. {"type": "u8", "value": 7}:
+//
+//	bool, string        → boolean, string
+//	s8 … s64, u8 … u64  → integer, 64 bit integers also as decimal string
+//	f32, f64            → number
+//	char                → string of a single character or its code point
+//	list, tuple         → array of values
+//	option              → value, none if the value is missing or null
+//	result              → {"ok": value} or {"err": value}, value may be null
+//	record              → object of the field values
+//	variant             → {"case": name, "value": value}, value may be null
+//	enum                → name of the case
+//	flags               → array of the names of the set flags
+//
+// Values nested in typed values may be plain or typed again. Records whose
+// only fields are "type" and "value" have to be passed as typed record.
+const (
+	witBool    = "bool"
+	witS8      = "s8"
+	witU8      = "u8"
+	witS16     = "s16"
+	witU16     = "u16"
+	witS32     = "s32"
+	witU32     = "u32"
+	witS64     = "s64"
+	witU64     = "u64"
+	witF32     = "f32"
+	witF64     = "f64"
+	witChar    = "char"
+	witString  = "string"
+	witList    = "list"
+	witTuple   = "tuple"
+	witOption  = "option"
+	witResult  = "result"
+	witRecord  = "record"
+	witVariant = "variant"
+	witEnum    = "enum"
+	witFlags   = "flags"
+
+	typeKey  = "type"
+	valueKey = "value"
+	okKey    = "ok"
+	errKey   = "err"
+	caseKey  = "case"
+)
+
+var witTypes = []string{
+	witBool, witS8, witU8, witS16, witU16, witS32, witU32, witS64, witU64,
+	witF32, witF64, witChar, witString, witList, witTuple, witOption,
+	witResult, witRecord, witVariant, witEnum, witFlags,
+}
+
+// Largest integer a JSON number holds without losing precision
+const maxExactInteger = 1 << 53
+
+// Number of bits of the integer types
+//
+//nolint:mnd // Sizes are given by the types
+var intBits = map[string]int{
+	witS8: 8, witU8: 8, witS16: 16, witU16: 16,
+	witS32: 32, witU32: 32, witS64: 64, witU64: 64,
+}
+
+// ErrInvalidValue is returned when a task parameter is not a valid WIT value
+var ErrInvalidValue = errors.New("invalid value")
+
+// typedValue returns the type and value of a typed value. ok is false if the
+// object is a plain record.
+func typedValue(object map[string]any) (witType string, value any, ok bool) {
+	witType, ok = object[typeKey].(string)
+	if !ok || !slices.Contains(witTypes, witType) {
+		return "", nil, false
+	}
+
+	for key := range object {
+		if key != typeKey && key != valueKey {
+			return "", nil, false
+		}
+	}
+
+	return witType, object[valueKey], true
+}
+
+// typed returns the typed encoding of a value.
+func typed(witType string, value any) map[string]any {
+	return map[string]any{typeKey: witType, valueKey: value}
+}
+
+func invalidValue(witType string, value any) error {
+	return fmt.Errorf("%w: %v is not a valid %s", ErrInvalidValue, value, witType)
+}
+
+// typedToProtoVal converts a typed value into a proto Val.
+//
+//nolint:gocyclo,cyclop,funlen // One case per WIT type
+func typedToProtoVal(witType string, value any) (*pb.Val, error) {
+	switch witType {
+	case witBool:
+		b, ok := value.(bool)
+		if !ok {
+			return nil, invalidValue(witType, value)
+		}
+
+		return &pb.Val{Value: &pb.Val_BoolVal{BoolVal: b}}, nil
+	case witS8, witS16, witS32, witS64:
+		n, err := signedOf(witType, value)
+		if err != nil {
+			return nil, err
+		}
+
+		return signedToProtoVal(witType, n), nil
+	case witU8, witU16, witU32, witU64:
+		n, err := unsignedOf(witType, value)
+		if err != nil {
+			return nil, err
+		}
+
+		return unsignedToProtoVal(witType, n), nil
+	case witF32:
+		f, ok := value.(float64)
+		if !ok || math.Abs(f) > math.MaxFloat32 {
+			return nil, invalidValue(witType, value)
+		}
+
+		return &pb.Val{Value: &pb.Val_F32Val{F32Val: float32(f)}}, nil
+	case witF64:
+		f, ok := value.(float64)
+		if !ok {
+			return nil, invalidValue(witType, value)
+		}
+
+		return &pb.Val{Value: &pb.Val_F64Val{F64Val: f}}, nil
+	case witChar:
+		char, err := charOf(value)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.Val{Value: &pb.Val_CharVal{CharVal: char}}, nil
+	case witString:
+		s, ok := value.(string)
+		if !ok {
+			return nil, invalidValue(witType, value)
+		}
+
+		return &pb.Val{Value: &pb.Val_StringVal{StringVal: s}}, nil
+	case witList:
+		values, err := valuesOf(witType, value)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.Val{Value: &pb.Val_ListVal{
+			ListVal: &pb.ListVal{Values: values},
+		}}, nil
+	case witTuple:
+		values, err := valuesOf(witType, value)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.Val{Value: &pb.Val_TupleVal{
+			TupleVal: &pb.TupleVal{Values: values},
+		}}, nil
+	case witOption:
+		payload, err := payloadToProtoVal(value)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.Val{Value: &pb.Val_OptionVal{
+			OptionVal: &pb.OptionVal{Value: payload},
+		}}, nil
+	case witResult:
+		return resultToProtoVal(value)
+	case witRecord:
+		return recordToProtoVal(value)
+	case witVariant:
+		return variantToProtoVal(value)
+	case witEnum:
+		name, ok := value.(string)
+		if !ok || name == "" {
+			return nil, invalidValue(witType, value)
+		}
+
+		return &pb.Val{Value: &pb.Val_EnumVal{EnumVal: name}}, nil
+	case witFlags:
+		return flagsToProtoVal(value)
+	default:
+		return nil, fmt.Errorf("%w: unknown type %s", ErrInvalidValue, witType)
+	}
+}
+
+// signedOf returns the value of a signed integer of the given type.
+func signedOf(witType string, value any) (int64, error) {
+	bits := intBits[witType]
+	limit := math.Ldexp(1, bits-1)
+
+	switch n := value.(type) {
+	case float64:
+		if n != math.Trunc(n) || n < -limit || n >= limit {
+			return 0, invalidValue(witType, value)
+		}
+
+		return int64(n), nil
+	case int:
+		return signedOf(witType, int64(n))
+	case int64:
+		if bits < 64 && (n < -(1<<(bits-1)) || n >= 1<<(bits-1)) {
+			return 0, invalidValue(witType, value)
+		}
+
+		return n, nil
+	case string:
+		parsed, err := strconv.ParseInt(n, 10, bits)
+		if err != nil {
+			return 0, invalidValue(witType, value)
+		}
+
+		return parsed, nil
+	default:
+		return 0, invalidValue(witType, value)
+	}
+}
+
+// unsignedOf returns the value of an unsigned integer of the given type.
+func unsignedOf(witType string, value any) (uint64, error) {
+	bits := intBits[witType]
+	limit := math.Ldexp(1, bits)
+
+	switch n := value.(type) {
+	case float64:
+		if n != math.Trunc(n) || n < 0 || n >= limit {
+			return 0, invalidValue(witType, value)
+		}
+
+		return uint64(n), nil
+	case int:
+		return unsignedOf(witType, int64(n))
+	case int64:
+		if n < 0 || (bits < 64 && n >= 1<<bits) {
+			return 0, invalidValue(witType, value)
+		}
+
+		return uint64(n), nil
+	case string:
+		parsed, err := strconv.ParseUint(n, 10, bits)
+		if err != nil {
+			return 0, invalidValue(witType, value)
+		}
+
+		return parsed, nil
+	default:
+		return 0, invalidValue(witType, value)
+	}
+}
+
+//nolint:gosec // The range of the value was checked by signedOf
+func signedToProtoVal(witType string, n int64) *pb.Val {
+	switch witType {
+	case witS8:
+		return &pb.Val{Value: &pb.Val_S8Val{S8Val: int32(n)}}
+	case witS16:
+		return &pb.Val{Value: &pb.Val_S16Val{S16Val: int32(n)}}
+	case witS32:
+		return &pb.Val{Value: &pb.Val_S32Val{S32Val: int32(n)}}
+	default:
+		return &pb.Val{Value: &pb.Val_S64Val{S64Val: n}}
+	}
+}
+
+//nolint:gosec // The range of the value was checked by unsignedOf
+func unsignedToProtoVal(witType string, n uint64) *pb.Val {
+	switch witType {
+	case witU8:
+		return &pb.Val{Value: &pb.Val_U8Val{U8Val: uint32(n)}}
+	case witU16:
+		return &pb.Val{Value: &pb.Val_U16Val{U16Val: uint32(n)}}
+	case witU32:
+		return &pb.Val{Value: &pb.Val_U32Val{U32Val: uint32(n)}}
+	default:
+		return &pb.Val{Value: &pb.Val_U64Val{U64Val: n}}
+	}
+}
+
+// charOf returns the Unicode scalar value of a char given as single character
+// or as code point.
+func charOf(value any) (uint32, error) {
+	if s, ok := value.(string); ok {
+		char, size := utf8.DecodeRuneInString(s)
+		if size == 0 || size != len(s) || (char == utf8.RuneError && size == 1) {
+			return 0, invalidValue(witChar, value)
+		}
+
+		return uint32(char), nil //nolint:gosec // Decoded runes are not negative
+	}
+
+	codePoint, err := unsignedOf(witU32, value)
+	if err != nil || codePoint > utf8.MaxRune ||
+		!utf8.ValidRune(rune(codePoint)) {
+		return 0, invalidValue(witChar, value)
+	}
+
+	return uint32(codePoint), nil
+}
+
+func valuesOf(witType string, value any) ([]*pb.Val, error) {
+	elements, ok := value.([]any)
+	if !ok {
+		return nil, invalidValue(witType, value)
+	}
+
+	values := make([]*pb.Val, len(elements))
+	for i, element := range elements {
+		var err error
+		values[i], err = anyToProtoVal(element)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+
+	return values, nil
+}
+
+// payloadToProtoVal converts the optional payload of an option, result or
+// variant. A missing payload is returned as nil.
+func payloadToProtoVal(value any) (*pb.Val, error) {
+	if value == nil {
+		return nil, nil //nolint:nilnil // A missing payload is a valid result
+	}
+
+	return anyToProtoVal(value)
+}
+
+func resultToProtoVal(value any) (*pb.Val, error) {
+	object, ok := value.(map[string]any)
+	if !ok || len(object) != 1 {
+		return nil, invalidValue(witResult, value)
+	}
+
+	payload, isOk := object[okKey]
+	if !isOk {
+		var isErr bool
+		payload, isErr = object[errKey]
+		if !isErr {
+			return nil, invalidValue(witResult, value)
+		}
+	}
+
+	converted, err := payloadToProtoVal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Val{Value: &pb.Val_ResultVal{
+		ResultVal: &pb.ResultVal{IsOk: isOk, Value: converted},
+	}}, nil
+}
+
+// recordToProtoVal converts a typed record. The fields are ordered by name.
+func recordToProtoVal(value any) (*pb.Val, error) {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return nil, invalidValue(witRecord, value)
+	}
+
+	fields := make([]*pb.RecordField, 0, len(object))
+	for name, fieldValue := range object {
+		converted, err := anyToProtoVal(fieldValue)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", name, err)
+		}
+
+		fields = append(fields, &pb.RecordField{Name: name, Value: converted})
+	}
+
+	slices.SortFunc(fields, func(a, b *pb.RecordField) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return &pb.Val{Value: &pb.Val_RecordVal{
+		RecordVal: &pb.RecordVal{Fields: fields},
+	}}, nil
+}
+
+func variantToProtoVal(value any) (*pb.Val, error) {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return nil, invalidValue(witVariant, value)
+	}
+
+	name, ok := object[caseKey].(string)
+	if !ok || name == "" {
+		return nil, invalidValue(witVariant, value)
+	}
+
+	for key := range object {
+		if key != caseKey && key != valueKey {
+			return nil, invalidValue(witVariant, value)
+		}
+	}
+
+	payload, err := payloadToProtoVal(object[valueKey])
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Val{Value: &pb.Val_VariantVal{
+		VariantVal: &pb.VariantVal{Name: name, Value: payload},
+	}}, nil
+}
+
+func flagsToProtoVal(value any) (*pb.Val, error) {
+	elements, ok := value.([]any)
+	if !ok {
+		return nil, invalidValue(witFlags, value)
+	}
+
+	flags := make([]string, len(elements))
+	for i, element := range elements {
+		flags[i], ok = element.(string)
+		if !ok || flags[i] == "" {
+			return nil, invalidValue(witFlags, value)
+		}
+	}
+
+	return &pb.Val{Value: &pb.Val_FlagsVal{
+		FlagsVal: &pb.FlagsVal{Flags: flags},
+	}}, nil
+}
+
+// typedProtoValToAny converts the proto Vals plain JSON cannot express into
+// their typed encoding. ok is false for all other Vals.
+//
+//nolint:gocyclo,cyclop // One case per WIT type
+func typedProtoValToAny(v *pb.Val) (converted any, ok bool) {
+	switch val := v.Value.(type) {
+	case *pb.Val_S8Val:
+		return typed(witS8, int64(val.S8Val)), true
+	case *pb.Val_U8Val:
+		return typed(witU8, uint64(val.U8Val)), true
+	case *pb.Val_S16Val:
+		return typed(witS16, int64(val.S16Val)), true
+	case *pb.Val_U16Val:
+		return typed(witU16, uint64(val.U16Val)), true
+	case *pb.Val_S32Val:
+		return typed(witS32, int64(val.S32Val)), true
+	case *pb.Val_U32Val:
+		return typed(witU32, uint64(val.U32Val)), true
+	case *pb.Val_S64Val:
+		if val.S64Val > maxExactInteger || val.S64Val < -maxExactInteger {
+			// Larger integers lose precision as JSON number
+			return typed(witS64, strconv.FormatInt(val.S64Val, 10)), true
+		}
+
+		return nil, false
+	case *pb.Val_U64Val:
+		if val.U64Val > maxExactInteger {
+			// Larger integers lose precision as JSON number
+			return typed(witU64, strconv.FormatUint(val.U64Val, 10)), true
+		}
+
+		return typed(witU64, val.U64Val), true
+	case *pb.Val_F32Val:
+		return typed(witF32, float64(val.F32Val)), true
+	case *pb.Val_CharVal:
+		char := rune(val.CharVal) //nolint:gosec // Checked by ValidRune
+		if val.CharVal > utf8.MaxRune || !utf8.ValidRune(char) {
+			// Invalid chars are passed on as code point
+			return typed(witChar, uint64(val.CharVal)), true
+		}
+
+		return typed(witChar, string(char)), true
+	case *pb.Val_TupleVal:
+		values := make([]any, len(val.TupleVal.GetValues()))
+		for i, value := range val.TupleVal.GetValues() {
+			values[i] = protoValToAny(value)
+		}
+
+		return typed(witTuple, values), true
+	case *pb.Val_ResultVal:
+		key := errKey
+		if val.ResultVal.GetIsOk() {
+			key = okKey
+		}
+
+		return typed(witResult, map[string]any{
+			key: payloadToAny(val.ResultVal.GetValue()),
+		}), true
+	case *pb.Val_VariantVal:
+		return typed(witVariant, map[string]any{
+			caseKey:  val.VariantVal.GetName(),
+			valueKey: payloadToAny(val.VariantVal.GetValue()),
+		}), true
+	case *pb.Val_EnumVal:
+		return typed(witEnum, val.EnumVal), true
+	case *pb.Val_FlagsVal:
+		flags := make([]any, len(val.FlagsVal.GetFlags()))
+		for i, flag := range val.FlagsVal.GetFlags() {
+			flags[i] = flag
+		}
+
+		return typed(witFlags, flags), true
+	case *pb.Val_OptionVal:
+		if val.OptionVal.GetValue() == nil {
+			return nil, false
+		}
+
+		return typed(witOption, payloadToAny(val.OptionVal.GetValue())), true
+	case *pb.Val_RecordVal:
+		if !resemblesTypedValue(val.RecordVal) {
+			return nil, false
+		}
+
+		// The plain record would be mistaken for a typed value
+		return typed(witRecord, recordToAny(val.RecordVal)), true
+	default:
+		return nil, false
+	}
+}
+
+// resemblesTypedValue reports whether the plain encoding of a record would be
+// taken for a typed value, i.e. its only fields are "type" and "value" and
+// "type" holds the name of a WIT type.
+func resemblesTypedValue(record *pb.RecordVal) bool {
+	isTyped := false
+	for _, field := range record.GetFields() {
+		if field == nil {
+			continue
+		}
+
+		switch field.GetName() {
+		case typeKey:
+			isTyped = slices.Contains(witTypes, field.GetValue().GetStringVal())
+		case valueKey:
+		default:
+			return false
+		}
+	}
+
+	return isTyped
+}
+
+// recordToAny converts the fields of a record into an object.
+func recordToAny(record *pb.RecordVal) map[string]any {
+	object := make(map[string]any, len(record.GetFields()))
+	for _, field := range record.GetFields() {
+		if field == nil {
+			continue
+		}
+
+		object[field.GetName()] = protoValToAny(field.GetValue())
+	}
+
+	return object
+}
+
+// payloadToAny converts the optional payload of an option, result or variant.
+// A missing payload is converted to null, so that a none option payload has to
+// be typed.
+func payloadToAny(v *pb.Val) any {
+	if v == nil {
+		return nil
+	}
+
+	if option, ok := v.Value.(*pb.Val_OptionVal); ok &&
+		option.OptionVal.GetValue() == nil {
+		return map[string]any{typeKey: witOption}
+	}
+
+	return protoValToAny(v)
+}
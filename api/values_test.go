@@ -0,0 +1,138 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// decodeJSON decodes a parameter the way request bodies are decoded.
+func decodeJSON(t *testing.T, raw string) any {
+	t.Helper()
+
+	var value any
+	require.NoError(t, json.Unmarshal([]byte(raw), &value))
+
+	return value
+}
+
+func TestTypedValueRoundTrip(t *testing.T) {
+	t.Parallel()
+	tests := []string{
+		`{"type":"s8","value":-128}`,
+		`{"type":"u8","value":255}`,
+		`{"type":"s16","value":-32768}`,
+		`{"type":"u16","value":65535}`,
+		`{"type":"s32","value":-7}`,
+		`{"type":"u32","value":7}`,
+		`{"type":"s64","value":"9007199254740993"}`,
+		`{"type":"s64","value":"-9223372036854775808"}`,
+		`{"type":"u64","value":9007199254740992}`,
+		`{"type":"u64","value":"18446744073709551615"}`,
+		`{"type":"f32","value":1.5}`,
+		`{"type":"char","value":"ß"}`,
+		`{"type":"tuple","value":[1,"a",{"type":"u8","value":3}]}`,
+		`{"type":"option","value":"some"}`,
+		`{"type":"option","value":{"type":"option"}}`,
+		`{"type":"result","value":{"ok":null}}`,
+		`{"type":"result","value":{"err":"failed"}}`,
+		`{"type":"variant","value":{"case":"circle","value":{"r":2}}}`,
+		`{"type":"variant","value":{"case":"empty","value":null}}`,
+		`{"type":"enum","value":"red"}`,
+		`{"type":"flags","value":["read","write"]}`,
+		`{"type":"record","value":{"type":"u8","value":1}}`,
+		`{"type":"record","value":{"type":"option"}}`,
+		`{"k":{"type":"record","value":{"type":"enum","value":"b"}}}`,
+		`[{"type":"u8","value":1},{"k":{"type":"enum","value":"b"}}]`,
+	}
+
+	for _, raw := range tests {
+		t.Run(raw, func(t *testing.T) {
+			t.Parallel()
+			input := decodeJSON(t, raw)
+
+			val, err := anyToProtoVal(input)
+			require.NoError(t, err)
+
+			encoded, err := json.Marshal(protoValToAny(val))
+			require.NoError(t, err)
+			assert.JSONEq(t, raw, string(encoded))
+		})
+	}
+}
+
+func TestTypedValueConversion(t *testing.T) {
+	t.Parallel()
+
+	val, err := anyToProtoVal(decodeJSON(t, `{"type":"char","value":128512}`))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0x1F600), val.GetCharVal())
+
+	val, err = anyToProtoVal(
+		decodeJSON(t, `{"type":"s64","value":"-9223372036854775808"}`),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, int64(-9223372036854775808), val.GetS64Val())
+
+	val, err = anyToProtoVal(decodeJSON(t, `{"type":"option"}`))
+	require.NoError(t, err)
+	assert.Nil(t, val.GetOptionVal().GetValue())
+
+	val, err = anyToProtoVal(
+		decodeJSON(t, `{"type":"record","value":{"b":1,"a":2}}`),
+	)
+	require.NoError(t, err)
+	fields := val.GetRecordVal().GetFields()
+	require.Len(t, fields, 2)
+	assert.Equal(t, "a", fields[0].GetName(), "fields are ordered by name")
+}
+
+func TestTypedValueInvalid(t *testing.T) {
+	t.Parallel()
+	tests := []string{
+		`{"type":"u8","value":256}`,
+		`{"type":"u8","value":-1}`,
+		`{"type":"s8","value":128}`,
+		`{"type":"u32","value":1.5}`,
+		`{"type":"u64","value":"18446744073709551616"}`,
+		`{"type":"f32","value":1e39}`,
+		`{"type":"char","value":1114112}`,
+		`{"type":"char","value":55296}`,
+		`{"type":"char","value":"ab"}`,
+		`{"type":"char","value":""}`,
+		`{"type":"bool","value":"true"}`,
+		`{"type":"result","value":{"ok":1,"err":2}}`,
+		`{"type":"result","value":{}}`,
+		`{"type":"variant","value":{"value":1}}`,
+		`{"type":"enum","value":""}`,
+		`{"type":"flags","value":[1]}`,
+		`[1,{"type":"u8","value":300}]`,
+	}
+
+	for _, raw := range tests {
+		t.Run(raw, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := anyToProtoVal(decodeJSON(t, raw))
+			require.ErrorIs(t, err, ErrInvalidValue)
+		})
+	}
+}
+
+func TestTypedValueLookalikeRecord(t *testing.T) {
+	t.Parallel()
+
+	val, err := anyToProtoVal(
+		decodeJSON(t, `{"type":"circle","value":3}`),
+	)
+	require.NoError(t, err)
+	assert.Len(t, val.GetRecordVal().GetFields(), 2, "unknown types are records")
+
+	val, err = anyToProtoVal(
+		decodeJSON(t, `{"type":"u8","value":3,"unit":"cm"}`),
+	)
+	require.NoError(t, err)
+	assert.Len(t, val.GetRecordVal().GetFields(), 3, "extra fields are records")
+}
@@ -0,0 +1,118 @@
+package api
+
+import (
+	"api-server/orm"
+	"api-server/queue"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/EnclaveRunner/shareddeps/auth"
+	"github.com/hibiken/asynq"
+)
+
+const (
+	// AllTasksGroup is the user group whose members see and control the tasks
+	// of all users if task visibility is restricted
+	AllTasksGroup = "all_tasks"
+	// LogIngestGroup is the user group whose members, e.g. runners, may append
+	// logs to the tasks of all users. Appending logs requires membership.
+	LogIngestGroup = "task_logs_ingest"
+	adminGroup     = "enclave_admin"
+)
+
+// visibleOwner returns the user whose tasks the authenticated user may see and
+// control. Nil is returned if the user may access the tasks of all users.
+func (server *Server) visibleOwner(ctx context.Context) (*string, error) {
+	if !server.restrictVisibility {
+		return nil, nil //nolint:nilnil // No restriction is a valid result
+	}
+
+	unrestricted, err := server.userInAnyGroup(ctx, adminGroup, AllTasksGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	if unrestricted {
+		return nil, nil //nolint:nilnil // No restriction is a valid result
+	}
+
+	user := auth.GetAuthenticatedUser(ctx)
+
+	return &user, nil
+}
+
+// userInAnyGroup reports whether the authenticated user is a member of at least
+// one of the given user groups.
+func (server *Server) userInAnyGroup(
+	ctx context.Context,
+	groups ...string,
+) (bool, error) {
+	user := auth.GetAuthenticatedUser(ctx)
+
+	userGroups, err := server.authModule.GetGroupsForUser(user)
+	if err != nil {
+		return false, fmt.Errorf("failed to get groups of user %s: %w", user, err)
+	}
+
+	return slices.ContainsFunc(groups, func(group string) bool {
+		return slices.Contains(userGroups, group)
+	}), nil
+}
+
+// taskVisible reports whether a task exists, either in the queue or in the task
+// history, and may be accessed by the authenticated user. Tasks of other users
+// are reported as missing so that their existence is not disclosed.
+func (server *Server) taskVisible(
+	ctx context.Context,
+	id string,
+) (bool, error) {
+	owner, err := server.visibleOwner(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return server.taskOwnedBy(ctx, id, owner)
+}
+
+// taskExists reports whether a task exists, either in the queue or in the task
+// history, regardless of who submitted it.
+func (server *Server) taskExists(ctx context.Context, id string) (bool, error) {
+	return server.taskOwnedBy(ctx, id, nil)
+}
+
+// taskOwnedBy reports whether a task exists and was submitted by owner. Nil
+// matches any owner.
+func (server *Server) taskOwnedBy(
+	ctx context.Context,
+	id string,
+	owner *string,
+) (bool, error) {
+	taskInfo, err := server.queueClient.GetTask(id)
+	if err == nil {
+		return owner == nil || submittedBy(taskInfo) == *owner, nil
+	}
+
+	if !errors.Is(err, &queue.TaskNotFoundError{}) {
+		//nolint:wrapcheck // Error is not used but only logged later on, no error wrap needed
+		return false, err
+	}
+
+	record, err := server.db.GetTask(ctx, id)
+	if err != nil {
+		var errNotFound *orm.NotFoundError
+		if errors.As(err, &errNotFound) {
+			return false, nil
+		}
+
+		//nolint:wrapcheck // Error is not used but only logged later on, no error wrap needed
+		return false, err
+	}
+
+	return owner == nil || record.SubmittedBy == *owner, nil
+}
+
+func submittedBy(taskInfo *asynq.TaskInfo) string {
+	return queue.MetadataOf(taskInfo).SubmittedBy
+}
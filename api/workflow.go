@@ -0,0 +1,320 @@
+package api
+
+import (
+	"api-server/orm"
+	pb "api-server/proto_gen"
+	"api-server/workflow"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/EnclaveRunner/shareddeps/auth"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// ErrInvalidWorkflowTask is returned when the task of a workflow node is
+	// invalid
+	ErrInvalidWorkflowTask = errors.New("invalid workflow task")
+	// ErrWorkflowNameRequired is returned when a workflow has an empty name
+	ErrWorkflowNameRequired = errors.New("workflow name must not be empty")
+)
+
+// GetV1Workflow implements [StrictServerInterface].
+func (server *Server) GetV1Workflow(
+	ctx context.Context,
+	request GetV1WorkflowRequestObject,
+) (GetV1WorkflowResponseObject, error) {
+	owner, err := server.visibleOwner(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to determine visible workflows")
+
+		return GetV1Workflow500Response{}, nil
+	}
+
+	workflows, err := server.db.ListWorkflows(ctx, owner)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list workflows")
+
+		return GetV1Workflow500Response{}, nil
+	}
+
+	workflowPage := paginate(
+		workflows,
+		*request.Params.Limit,
+		*request.Params.Offset,
+		func(a, b orm.Workflow) int {
+			return b.CreatedAt.Compare(a.CreatedAt)
+		},
+	)
+
+	response := make([]Workflow, len(workflowPage))
+	for i := range workflowPage {
+		response[i], err = server.workflowResponse(ctx, &workflowPage[i])
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("id", workflowPage[i].ID.String()).
+				Msg("Failed to transform workflow")
+
+			return GetV1Workflow500Response{}, nil
+		}
+	}
+
+	return GetV1Workflow200JSONResponse(response), nil
+}
+
+// PostV1Workflow implements [StrictServerInterface].
+func (server *Server) PostV1Workflow(
+	ctx context.Context,
+	request PostV1WorkflowRequestObject,
+) (PostV1WorkflowResponseObject, error) {
+	if request.Body.Name == "" {
+		return PostV1Workflow400JSONResponse{GenericBadRequestJSONResponse{
+			Error: ErrWorkflowNameRequired.Error(),
+		}}, nil
+	}
+
+	graph := make([]workflow.Node, len(request.Body.Nodes))
+	for i := range request.Body.Nodes {
+		node := &request.Body.Nodes[i]
+		graph[i] = workflow.Node{Name: node.Name}
+		if node.DependsOn != nil {
+			graph[i].DependsOn = *node.DependsOn
+		}
+	}
+
+	err := workflow.CheckGraph(graph)
+	if err != nil {
+		return PostV1Workflow400JSONResponse{GenericBadRequestJSONResponse{
+			Error: "Invalid workflow: " + err.Error(),
+		}}, nil
+	}
+
+	nodes := make([]orm.WorkflowNode, len(graph))
+	edges := []orm.WorkflowEdge{}
+	resolveArtifact := cachedArtifactResolver(server.resolveArtifact)
+	for i := range request.Body.Nodes {
+		err := server.setWorkflowNodeTask(
+			ctx,
+			&nodes[i],
+			&request.Body.Nodes[i].Task,
+			len(graph[i].DependsOn),
+			resolveArtifact,
+		)
+		if err != nil {
+			if errors.Is(err, ErrInvalidWorkflowTask) {
+				return PostV1Workflow400JSONResponse{GenericBadRequestJSONResponse{
+					Error: "Node " + graph[i].Name + ": " + err.Error(),
+				}}, nil
+			}
+
+			if errors.Is(err, ErrQueueForbidden) {
+				return PostV1Workflow403Response{}, nil
+			}
+
+			if field, ok := paramsFieldError(err); ok {
+				field.Field = "nodes[" + strconv.Itoa(i) + "].task." +
+					field.Field
+
+				return PostV1Workflow422JSONResponse{FieldErrorJSONResponse{
+					Errors: &[]ErrField{field},
+				}}, nil
+			}
+
+			log.Error().Err(err).Msg("Failed to prepare task of workflow node")
+
+			return PostV1Workflow500Response{}, nil
+		}
+
+		nodes[i].Name = graph[i].Name
+		nodes[i].State = orm.WorkflowNodeStateWaiting
+		edges = append(edges, workflow.Edges(graph[i])...)
+	}
+
+	created := &orm.Workflow{
+		Name:      request.Body.Name,
+		State:     orm.WorkflowStateRunning,
+		CreatedBy: auth.GetAuthenticatedUser(ctx),
+	}
+
+	err = server.db.CreateWorkflow(ctx, created, nodes, edges)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create workflow")
+
+		return PostV1Workflow500Response{}, nil
+	}
+
+	response, err := workflowToWorkflowResponse(created, nodes, edges)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", created.ID.String()).
+			Msg("Failed to transform workflow")
+
+		return PostV1Workflow500Response{}, nil
+	}
+
+	return PostV1Workflow201JSONResponse(response), nil
+}
+
+// GetV1WorkflowId implements [StrictServerInterface].
+func (server *Server) GetV1WorkflowId(
+	ctx context.Context,
+	request GetV1WorkflowIdRequestObject,
+) (GetV1WorkflowIdResponseObject, error) {
+	found, err := server.db.GetWorkflow(ctx, request.Id)
+	if err != nil {
+		var errNotFound *orm.NotFoundError
+		if errors.As(err, &errNotFound) {
+			return GetV1WorkflowId404JSONResponse{GenericNotFoundJSONResponse{
+				Error: err.Error(),
+			}}, nil
+		}
+
+		log.Error().
+			Err(err).
+			Str("id", request.Id.String()).
+			Msg("Failed to retrieve workflow")
+
+		return GetV1WorkflowId500Response{}, nil
+	}
+
+	owner, err := server.visibleOwner(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to determine visible workflows")
+
+		return GetV1WorkflowId500Response{}, nil
+	}
+
+	if owner != nil && found.CreatedBy != *owner {
+		// Workflows of other users are not disclosed
+		return GetV1WorkflowId404JSONResponse{GenericNotFoundJSONResponse{
+			Error: (&orm.NotFoundError{
+				Search: "Workflow " + request.Id.String(),
+			}).Error(),
+		}}, nil
+	}
+
+	response, err := server.workflowResponse(ctx, found)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", request.Id.String()).
+			Msg("Failed to transform workflow")
+
+		return GetV1WorkflowId500Response{}, nil
+	}
+
+	return GetV1WorkflowId200JSONResponse(response), nil
+}
+
+// setWorkflowNodeTask validates a task request and stores it as the task of a
+// workflow node, whose first parameters are the results of its dependencies.
+// Errors caused by the request wrap ErrInvalidWorkflowTask, a queue the user
+// may not use results in ErrQueueForbidden.
+func (server *Server) setWorkflowNodeTask(
+	ctx context.Context,
+	node *orm.WorkflowNode,
+	body *CreateTaskRequest,
+	dependencies int,
+	resolveArtifact artifactResolver,
+) error {
+	stored, err := server.prepareStoredTask(
+		ctx,
+		body,
+		resolveArtifact,
+		paramShape{supplied: dependencies},
+		ErrInvalidWorkflowTask,
+	)
+	if err != nil {
+		return err
+	}
+
+	payload, err := proto.Marshal(stored.task)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task proto: %w", err)
+	}
+
+	node.Task = payload
+	node.Retention = stored.retention
+	node.Retries = stored.retries
+	node.Queue = stored.queue
+	node.Timeout = stored.timeout
+
+	return nil
+}
+
+// workflowResponse loads the nodes and edges of a workflow and converts it.
+func (server *Server) workflowResponse(
+	ctx context.Context,
+	found *orm.Workflow,
+) (Workflow, error) {
+	nodes, err := server.db.GetWorkflowNodes(ctx, found.ID)
+	if err != nil {
+		//nolint:wrapcheck // Error is not used but only logged later on, no error wrap needed
+		return Workflow{}, err
+	}
+
+	edges, err := server.db.GetWorkflowEdges(ctx, found.ID)
+	if err != nil {
+		//nolint:wrapcheck // Error is not used but only logged later on, no error wrap needed
+		return Workflow{}, err
+	}
+
+	return workflowToWorkflowResponse(found, nodes, edges)
+}
+
+// workflowToWorkflowResponse converts a workflow. The edges have to be ordered
+// by their position.
+func workflowToWorkflowResponse(
+	found *orm.Workflow,
+	nodes []orm.WorkflowNode,
+	edges []orm.WorkflowEdge,
+) (Workflow, error) {
+	dependsOn := map[string][]string{}
+	for _, edge := range edges {
+		dependsOn[edge.ToNode] = append(dependsOn[edge.ToNode], edge.FromNode)
+	}
+
+	response := Workflow{
+		Id:        found.ID,
+		Name:      found.Name,
+		State:     WorkflowState(found.State),
+		CreatedBy: found.CreatedBy,
+		CreatedAt: found.CreatedAt,
+		UpdatedAt: found.UpdatedAt,
+		Nodes:     make([]WorkflowNode, len(nodes)),
+	}
+
+	for i := range nodes {
+		var task pb.Task
+		if err := proto.Unmarshal(nodes[i].Task, &task); err != nil {
+			//nolint:wrapcheck // Error is not used but only logged later on, no error wrap needed
+			return Workflow{}, err
+		}
+
+		response.Nodes[i] = WorkflowNode{
+			Name:      nodes[i].Name,
+			Source:    taskSource(&task),
+			DependsOn: dependsOn[nodes[i].Name],
+			State:     WorkflowNodeState(nodes[i].State),
+		}
+
+		if response.Nodes[i].DependsOn == nil {
+			response.Nodes[i].DependsOn = []string{}
+		}
+
+		if nodes[i].TaskID != "" {
+			response.Nodes[i].TaskId = &nodes[i].TaskID
+		}
+
+		if nodes[i].Error != "" {
+			response.Nodes[i].Error = &nodes[i].Error
+		}
+	}
+
+	return response, nil
+}
@@ -0,0 +1,391 @@
+package callback
+
+import (
+	"api-server/config"
+	"api-server/orm"
+	"api-server/queue"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	SignatureHeader = "X-Enclave-Signature"
+	TimestampHeader = "X-Enclave-Timestamp"
+	// Maximum number of due callbacks handled per poll
+	batchSize = 100
+	// Upper bound for the delay between two delivery attempts
+	maxBackoff = time.Hour
+)
+
+// Payload is the JSON body sent to the callback URL of a task once it reached
+// a final state.
+type Payload struct {
+	TaskID        string     `json:"id"`
+	State         string     `json:"state"`
+	ResultPayload *string    `json:"result_payload,omitempty"`
+	LastError     *string    `json:"last_error,omitempty"`
+	CompletedAt   *time.Time `json:"completed_at,omitempty"`
+}
+
+// Dispatcher delivers task completion callbacks. It periodically looks up
+// pending callbacks, checks whether their task reached a final state and POSTs
+// a signed payload to the registered URL, retrying failed deliveries with
+// exponential backoff.
+type Dispatcher struct {
+	db           orm.DB
+	queueClient  queue.QueueClient
+	httpClient   *http.Client
+	secret       []byte
+	maxAttempts  int
+	backoff      time.Duration
+	pollInterval time.Duration
+}
+
+func NewDispatcher(
+	cfg *config.AppConfig,
+	db orm.DB,
+	queueClient queue.QueueClient,
+	targets *TargetPolicy,
+) *Dispatcher {
+	backoff, err := time.ParseDuration(cfg.Callback.Backoff)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Failed to parse callback backoff duration (invalid format)")
+	}
+
+	timeout, err := time.ParseDuration(cfg.Callback.Timeout)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Failed to parse callback timeout duration (invalid format)")
+	}
+
+	pollInterval, err := time.ParseDuration(cfg.Callback.PollInterval)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Failed to parse callback poll interval (invalid format)")
+	}
+
+	if cfg.Callback.Secret == "" {
+		log.Warn().
+			Msg("No callback secret configured, callback payloads will not be signed")
+	}
+
+	return &Dispatcher{
+		db:          db,
+		queueClient: queueClient,
+		httpClient: &http.Client{
+			Transport: targets.Transport(),
+			Timeout:   timeout,
+		},
+		secret:       []byte(cfg.Callback.Secret),
+		maxAttempts:  cfg.Callback.MaxAttempts,
+		backoff:      backoff,
+		pollInterval: pollInterval,
+	}
+}
+
+// Run polls for due callbacks until the context is canceled.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.dispatchDue(ctx)
+		}
+	}
+}
+
+func (d *Dispatcher) dispatchDue(ctx context.Context) {
+	callbacks, err := d.db.GetDueCallbacks(ctx, time.Now(), batchSize)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load due callbacks")
+
+		return
+	}
+
+	for i := range callbacks {
+		d.dispatch(ctx, &callbacks[i])
+	}
+}
+
+func (d *Dispatcher) dispatch(ctx context.Context, callback *orm.Callback) {
+	task, found, err := d.snapshotOf(ctx, callback.TaskID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", callback.TaskID).
+			Msg("Failed to retrieve task for callback")
+
+		return
+	}
+
+	if !found {
+		// The task was deleted before reaching a final state, so there is
+		// nothing left to report.
+		callback.Attempts++
+		callback.Status = orm.CallbackStatusFailed
+		d.record(ctx, callback, &orm.CallbackDelivery{
+			TaskID:  callback.TaskID,
+			Round:   callback.Round,
+			Attempt: callback.Attempts,
+			Error:   (&queue.TaskNotFoundError{Id: callback.TaskID}).Error(),
+		})
+
+		return
+	}
+
+	if !queue.IsFinalState(task.state) {
+		// Check again later, so that callbacks of long running or scheduled
+		// tasks do not hold back the other due callbacks
+		next := nextCheck(time.Now(), d.pollInterval, task.nextProcessAt)
+		err = d.db.DeferCallback(ctx, callback.TaskID, next)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("id", callback.TaskID).
+				Msg("Failed to defer task callback")
+		}
+
+		return
+	}
+
+	callback.Attempts++
+	delivery := &orm.CallbackDelivery{
+		TaskID:  callback.TaskID,
+		Round:   callback.Round,
+		Attempt: callback.Attempts,
+	}
+
+	statusCode, err := d.send(ctx, callback.URL, payloadOf(&task))
+	delivery.StatusCode = statusCode
+
+	switch {
+	case err == nil:
+		delivery.Success = true
+		callback.Status = orm.CallbackStatusDelivered
+	case callback.Attempts >= d.maxAttempts:
+		delivery.Error = err.Error()
+		callback.Status = orm.CallbackStatusFailed
+	default:
+		delivery.Error = err.Error()
+		callback.NextAttemptAt = time.Now().
+			Add(backoffDelay(d.backoff, callback.Attempts))
+	}
+
+	log.Debug().
+		Str("id", callback.TaskID).
+		Int("round", callback.Round).
+		Int("attempt", callback.Attempts).
+		Int("status_code", statusCode).
+		Bool("success", delivery.Success).
+		Msg("Attempted task callback delivery")
+
+	d.record(ctx, callback, delivery)
+}
+
+// taskSnapshot is the state of a task as reported by its callback.
+type taskSnapshot struct {
+	id            string
+	state         string
+	result        []byte
+	lastError     string
+	completedAt   *time.Time
+	nextProcessAt *time.Time
+}
+
+// snapshotOf looks up a task in the queue and in the task history if the
+// queue already dropped it. found is false if the task is in neither.
+func (d *Dispatcher) snapshotOf(
+	ctx context.Context,
+	id string,
+) (snapshot taskSnapshot, found bool, err error) {
+	task, err := d.queueClient.GetTask(id)
+	if err == nil {
+		snapshot = taskSnapshot{
+			id:        task.ID,
+			state:     queue.ReportedState(task.State.String(), task.Result),
+			result:    task.Result,
+			lastError: task.LastErr,
+		}
+		if !task.CompletedAt.IsZero() {
+			snapshot.completedAt = &task.CompletedAt
+		}
+		if !task.NextProcessAt.IsZero() {
+			snapshot.nextProcessAt = &task.NextProcessAt
+		}
+
+		return snapshot, true, nil
+	}
+
+	if !errors.Is(err, &queue.TaskNotFoundError{}) {
+		return taskSnapshot{}, false, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	record, err := d.db.GetTask(ctx, id)
+	if err != nil {
+		var errNotFound *orm.NotFoundError
+		if errors.As(err, &errNotFound) {
+			return taskSnapshot{}, false, nil
+		}
+
+		return taskSnapshot{}, false, fmt.Errorf(
+			"failed to get task record: %w",
+			err,
+		)
+	}
+
+	return taskSnapshot{
+		id:            record.ID,
+		state:         record.State,
+		result:        record.Result,
+		lastError:     record.LastError,
+		completedAt:   record.CompletedAt,
+		nextProcessAt: record.NextProcessAt,
+	}, true, nil
+}
+
+// nextCheck returns when the callback of an unfinished task is looked at
+// again. Tasks scheduled for later are checked once they are due, but at least
+// every maxBackoff, as they may be run early.
+func nextCheck(
+	now time.Time,
+	pollInterval time.Duration,
+	nextProcessAt *time.Time,
+) time.Time {
+	next := now.Add(pollInterval)
+	if nextProcessAt != nil && nextProcessAt.After(next) {
+		next = *nextProcessAt
+	}
+
+	if latest := now.Add(maxBackoff); next.After(latest) {
+		return latest
+	}
+
+	return next
+}
+
+func (d *Dispatcher) record(
+	ctx context.Context,
+	callback *orm.Callback,
+	delivery *orm.CallbackDelivery,
+) {
+	err := d.db.RecordCallbackAttempt(ctx, callback, delivery)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", callback.TaskID).
+			Msg("Failed to record callback delivery")
+	}
+}
+
+// send POSTs the payload to url and returns the HTTP status code of the
+// response (0 if none was received). Any non-2xx status is reported as error.
+func (d *Dispatcher) send(
+	ctx context.Context,
+	url string,
+	payload Payload,
+) (int, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal callback payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		url,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create callback request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TimestampHeader, timestamp)
+	if len(d.secret) > 0 {
+		req.Header.Set(SignatureHeader, Sign(d.secret, timestamp, body))
+	}
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send callback request: %w", err)
+	}
+	//nolint:errcheck // The body is fully drained, a close error is irrelevant
+	defer resp.Body.Close()
+
+	// Drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK ||
+		resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, &UnexpectedStatusError{resp.StatusCode}
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Sign computes the signature sent in the SignatureHeader. It is the hex
+// encoded HMAC-SHA256 of "<timestamp>.<body>" using the configured secret,
+// prefixed with "sha256=".
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// backoffDelay returns the delay before the next attempt after the given
+// number of failed attempts, doubling base each time up to maxBackoff.
+func backoffDelay(base time.Duration, attempts int) time.Duration {
+	delay := base
+	for range attempts - 1 {
+		delay *= 2
+		if delay >= maxBackoff {
+			return maxBackoff
+		}
+	}
+
+	return delay
+}
+
+func payloadOf(task *taskSnapshot) Payload {
+	payload := Payload{
+		TaskID:      task.id,
+		State:       task.state,
+		CompletedAt: task.completedAt,
+	}
+
+	if task.result != nil {
+		result := base64.StdEncoding.EncodeToString(task.result)
+		payload.ResultPayload = &result
+	}
+
+	if task.lastError != "" {
+		payload.LastError = &task.lastError
+	}
+
+	return payload
+}
@@ -0,0 +1,186 @@
+package callback
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/EnclaveRunner/shareddeps/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	signature := Sign([]byte("secret"), "1700000000", []byte(`{"id":"x"}`))
+	assert.Equal(
+		t,
+		"sha256=2f7852138f9dbd8d61c07c2cfb0b8ac96a46a32d78d4527788fb42fcb409a493",
+		signature,
+	)
+	assert.NotEqual(
+		t,
+		signature,
+		Sign([]byte("other"), "1700000000", []byte(`{"id":"x"}`)),
+		"signature must depend on the secret",
+	)
+	assert.NotEqual(
+		t,
+		signature,
+		Sign([]byte("secret"), "1700000001", []byte(`{"id":"x"}`)),
+		"signature must depend on the timestamp",
+	)
+}
+
+func TestBackoffDelay(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		base     time.Duration
+		attempts int
+		expected time.Duration
+	}{
+		{
+			name:     "first retry uses base delay",
+			base:     10 * time.Second,
+			attempts: 1,
+			expected: 10 * time.Second,
+		},
+		{
+			name:     "delay doubles per attempt",
+			base:     10 * time.Second,
+			attempts: 4,
+			expected: 80 * time.Second,
+		},
+		{
+			name:     "delay is capped",
+			base:     10 * time.Minute,
+			attempts: 10,
+			expected: maxBackoff,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, backoffDelay(tt.base, tt.attempts))
+		})
+	}
+}
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+	secret := []byte("secret")
+
+	var (
+		received  Payload
+		signature string
+		timestamp string
+	)
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.NoError(t, json.Unmarshal(body, &received))
+
+			timestamp = r.Header.Get(TimestampHeader)
+			signature = r.Header.Get(SignatureHeader)
+			assert.Equal(t, Sign(secret, timestamp, body), signature)
+
+			w.WriteHeader(http.StatusNoContent)
+		},
+	))
+	defer server.Close()
+
+	dispatcher := &Dispatcher{
+		httpClient: server.Client(),
+		secret:     secret,
+	}
+
+	statusCode, err := dispatcher.send(
+		t.Context(),
+		server.URL,
+		Payload{TaskID: "task-1", State: "completed"},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, statusCode)
+	assert.Equal(t, "task-1", received.TaskID)
+	assert.Equal(t, "completed", received.State)
+	assert.NotEmpty(t, signature)
+}
+
+func TestSendUnexpectedStatus(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		},
+	))
+	defer server.Close()
+
+	dispatcher := &Dispatcher{httpClient: server.Client()}
+
+	statusCode, err := dispatcher.send(
+		t.Context(),
+		server.URL,
+		Payload{TaskID: "task-1", State: "archived"},
+	)
+
+	var errStatus *UnexpectedStatusError
+	require.ErrorAs(t, err, &errStatus)
+	assert.Equal(t, http.StatusInternalServerError, statusCode)
+	assert.Equal(t, http.StatusInternalServerError, errStatus.StatusCode)
+}
+
+func TestNextCheck(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2025, 3, 10, 2, 0, 0, 0, time.UTC)
+
+	assert.Equal(
+		t,
+		now.Add(5*time.Second),
+		nextCheck(now, 5*time.Second, nil),
+		"running tasks are checked every poll interval",
+	)
+	assert.Equal(
+		t,
+		now.Add(5*time.Second),
+		nextCheck(now, 5*time.Second, utils.Ptr(now.Add(-time.Minute))),
+		"overdue tasks are checked every poll interval",
+	)
+	assert.Equal(
+		t,
+		now.Add(10*time.Minute),
+		nextCheck(now, 5*time.Second, utils.Ptr(now.Add(10*time.Minute))),
+		"scheduled tasks are checked once they are due",
+	)
+	assert.Equal(
+		t,
+		now.Add(maxBackoff),
+		nextCheck(now, 5*time.Second, utils.Ptr(now.Add(48*time.Hour))),
+		"tasks scheduled far ahead are checked regularly",
+	)
+}
+
+func TestPayloadOf(t *testing.T) {
+	t.Parallel()
+	completedAt := time.Date(2025, 3, 10, 2, 0, 0, 0, time.UTC)
+
+	payload := payloadOf(&taskSnapshot{
+		id:          "task-7",
+		state:       "failed",
+		lastError:   "boom",
+		completedAt: &completedAt,
+	})
+	assert.Equal(t, "task-7", payload.TaskID)
+	assert.Equal(t, "failed", payload.State)
+	require.NotNil(t, payload.LastError)
+	assert.Equal(t, "boom", *payload.LastError)
+	assert.Equal(t, &completedAt, payload.CompletedAt)
+	assert.Nil(t, payload.ResultPayload)
+}
@@ -0,0 +1,33 @@
+package callback
+
+import "strconv"
+
+type UnexpectedStatusError struct {
+	StatusCode int
+}
+
+func (e *UnexpectedStatusError) Error() string {
+	return "Callback endpoint responded with status " +
+		strconv.Itoa(e.StatusCode)
+}
+
+// ForbiddenTargetError is returned for callbacks to internal addresses that
+// are not allowed explicitly.
+type ForbiddenTargetError struct {
+	Target string
+}
+
+func (e *ForbiddenTargetError) Error() string {
+	return "Callback target " + e.Target + " is not allowed"
+}
+
+// InvalidTargetError is returned for allowed callback targets that are
+// neither a host name nor a network.
+type InvalidTargetError struct {
+	Entry string
+}
+
+func (e *InvalidTargetError) Error() string {
+	return "Invalid allowed callback target " + e.Entry +
+		", expected a host name, an address or a network in CIDR notation"
+}
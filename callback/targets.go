@@ -0,0 +1,155 @@
+package callback
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"syscall"
+)
+
+// Networks that are not reachable from the internet but are not covered by
+// the classifications of netip.Addr, e.g. carrier-grade NAT that clusters use
+// for internal services
+var internalNetworks = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+}
+
+// TargetPolicy decides which hosts callbacks may be sent to. Public addresses
+// are always allowed. Private, loopback, link-local and other internal
+// addresses are rejected unless their host name or network is allowed
+// explicitly, so that submitters cannot make the server reach internal
+// services. Addresses are checked when connecting, so that host names
+// resolving to internal addresses and redirects are covered as well.
+type TargetPolicy struct {
+	hosts    []string
+	networks []netip.Prefix
+}
+
+// NewTargetPolicy creates a policy that allows the given host names and
+// networks in addition to public addresses. Networks are given in CIDR
+// notation or as single address.
+func NewTargetPolicy(allowed []string) (*TargetPolicy, error) {
+	policy := &TargetPolicy{}
+
+	for _, entry := range allowed {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		if prefix, err := netip.ParsePrefix(entry); err == nil {
+			policy.networks = append(policy.networks, prefix.Masked())
+
+			continue
+		}
+
+		if addr, err := netip.ParseAddr(entry); err == nil {
+			policy.networks = append(
+				policy.networks,
+				netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()),
+			)
+
+			continue
+		}
+
+		if strings.ContainsAny(entry, "/:[]") {
+			return nil, &InvalidTargetError{entry}
+		}
+
+		policy.hosts = append(policy.hosts, strings.ToLower(entry))
+	}
+
+	return policy, nil
+}
+
+// CheckURL rejects a callback URL whose host is known to be forbidden before
+// resolving it, i.e. internal addresses and localhost names that are not
+// allowed. Other host names are checked once they are connected to.
+func (p *TargetPolicy) CheckURL(target *url.URL) error {
+	host := strings.ToLower(target.Hostname())
+	if p.allowsHost(host) {
+		return nil
+	}
+
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return p.checkAddr(addr)
+	}
+
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return &ForbiddenTargetError{host}
+	}
+
+	return nil
+}
+
+// Transport returns an HTTP transport that only connects to allowed targets.
+// Proxies are not used, as they would hide the target from the check.
+func (p *TargetPolicy) Transport() *http.Transport {
+	//nolint:forcetypeassert // The default transport is an *http.Transport
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+
+	dialer := &net.Dialer{}
+	guarded := &net.Dialer{Control: p.control}
+	transport.DialContext = func(
+		ctx context.Context,
+		network, address string,
+	) (net.Conn, error) {
+		host, _, err := net.SplitHostPort(address)
+		if err == nil && p.allowsHost(strings.ToLower(host)) {
+			return dialer.DialContext(ctx, network, address)
+		}
+
+		return guarded.DialContext(ctx, network, address)
+	}
+
+	return transport
+}
+
+// control checks the resolved address right before a connection is made.
+func (p *TargetPolicy) control(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("failed to parse callback target address: %w", err)
+	}
+
+	return p.checkAddr(addrPort.Addr())
+}
+
+func (p *TargetPolicy) allowsHost(host string) bool {
+	return slices.Contains(p.hosts, host)
+}
+
+func (p *TargetPolicy) checkAddr(addr netip.Addr) error {
+	addr = addr.Unmap()
+
+	if isPublic(addr) || slices.ContainsFunc(
+		p.networks,
+		func(network netip.Prefix) bool { return network.Contains(addr) },
+	) {
+		return nil
+	}
+
+	return &ForbiddenTargetError{addr.String()}
+}
+
+// isPublic reports whether an address is reachable from the internet.
+func isPublic(addr netip.Addr) bool {
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+
+	return !slices.ContainsFunc(
+		internalNetworks,
+		func(network netip.Prefix) bool { return network.Contains(addr) },
+	)
+}
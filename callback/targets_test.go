@@ -0,0 +1,150 @@
+package callback
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTargetPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy, err := NewTargetPolicy([]string{
+		"Hooks.Internal",
+		"10.0.0.0/8",
+		"192.168.1.7",
+		" ",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hooks.internal"}, policy.hosts)
+	assert.Equal(t, []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("192.168.1.7/32"),
+	}, policy.networks)
+
+	_, err = NewTargetPolicy([]string{"hooks.internal:8080"})
+	var errInvalid *InvalidTargetError
+	require.ErrorAs(t, err, &errInvalid)
+	assert.Equal(t, "hooks.internal:8080", errInvalid.Entry)
+}
+
+func TestTargetPolicyCheckURL(t *testing.T) {
+	t.Parallel()
+
+	policy, err := NewTargetPolicy([]string{"localhost", "fd00::/8"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		url     string
+		allowed bool
+	}{
+		{url: "https://example.com/hook", allowed: true},
+		{url: "https://93.184.215.14/hook", allowed: true},
+		{url: "http://localhost:8080/hook", allowed: true},
+		{url: "http://[fd00::1]/hook", allowed: true},
+		{url: "http://api.localhost/hook"},
+		{url: "http://127.0.0.1/hook"},
+		{url: "http://[::1]/hook"},
+		{url: "http://169.254.169.254/latest/meta-data"},
+		{url: "http://[fe80::1]/hook"},
+		{url: "http://172.16.0.1/hook"},
+		{url: "http://100.64.0.1/hook"},
+		{url: "http://0.0.0.0/hook"},
+		{url: "http://[fc00::1]/hook"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			t.Parallel()
+
+			target, err := url.Parse(tt.url)
+			require.NoError(t, err)
+
+			err = policy.CheckURL(target)
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				var errForbidden *ForbiddenTargetError
+				assert.ErrorAs(t, err, &errForbidden)
+			}
+		})
+	}
+}
+
+func TestTargetPolicyTransport(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		},
+	))
+	// Closed once the parallel subtests finished
+	t.Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	localhostURL := "http://localhost:" + serverURL.Port()
+
+	tests := []struct {
+		name    string
+		allowed []string
+		url     string
+		reached bool
+	}{
+		{name: "loopback by default", url: server.URL},
+		{name: "localhost by default", url: localhostURL},
+		{
+			name:    "allowed network",
+			allowed: []string{"127.0.0.0/8", "::1"},
+			url:     server.URL,
+			reached: true,
+		},
+		{
+			name:    "allowed host name",
+			allowed: []string{"localhost"},
+			url:     localhostURL,
+			reached: true,
+		},
+		{
+			name:    "other allowed host name",
+			allowed: []string{"hooks.internal"},
+			url:     localhostURL,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			policy, err := NewTargetPolicy(tt.allowed)
+			require.NoError(t, err)
+
+			client := &http.Client{Transport: policy.Transport()}
+			req, err := http.NewRequestWithContext(
+				t.Context(),
+				http.MethodPost,
+				tt.url,
+				http.NoBody,
+			)
+			require.NoError(t, err)
+
+			resp, err := client.Do(req)
+			if !tt.reached {
+				var errForbidden *ForbiddenTargetError
+				require.ErrorAs(t, err, &errForbidden)
+
+				return
+			}
+
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+			assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		})
+	}
+}
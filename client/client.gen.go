@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
@@ -32,6 +33,76 @@ const (
 	PUT      RBACPolicyMethod = "PUT"
 )
 
+// Defines values for RetryTasksRequestState.
+const (
+	Archived RetryTasksRequestState = "archived"
+	Retry    RetryTasksRequestState = "retry"
+)
+
+// Defines values for TaskCallbackStatus.
+const (
+	TaskCallbackStatusDelivered TaskCallbackStatus = "delivered"
+	TaskCallbackStatusFailed    TaskCallbackStatus = "failed"
+	TaskCallbackStatusPending   TaskCallbackStatus = "pending"
+)
+
+// Defines values for TaskMapState.
+const (
+	TaskMapStateCompleted TaskMapState = "completed"
+	TaskMapStateFailed    TaskMapState = "failed"
+	TaskMapStateRunning   TaskMapState = "running"
+)
+
+// Defines values for TaskMapElementState.
+const (
+	TaskMapElementStateCompleted TaskMapElementState = "completed"
+	TaskMapElementStateEnqueued  TaskMapElementState = "enqueued"
+	TaskMapElementStateFailed    TaskMapElementState = "failed"
+	TaskMapElementStateSkipped   TaskMapElementState = "skipped"
+	TaskMapElementStateWaiting   TaskMapElementState = "waiting"
+)
+
+// Defines values for WorkflowState.
+const (
+	WorkflowStateCompleted WorkflowState = "completed"
+	WorkflowStateFailed    WorkflowState = "failed"
+	WorkflowStateRunning   WorkflowState = "running"
+)
+
+// Defines values for WorkflowNodeState.
+const (
+	Completed WorkflowNodeState = "completed"
+	Enqueued  WorkflowNodeState = "enqueued"
+	Failed    WorkflowNodeState = "failed"
+	Skipped   WorkflowNodeState = "skipped"
+	Waiting   WorkflowNodeState = "waiting"
+)
+
+// Defines values for GetV1TaskIdLogsParamsOrder.
+const (
+	Asc  GetV1TaskIdLogsParamsOrder = "asc"
+	Desc GetV1TaskIdLogsParamsOrder = "desc"
+)
+
+// Defines values for GetV1TaskIdLogsExportParamsFormat.
+const (
+	Ndjson GetV1TaskIdLogsExportParamsFormat = "ndjson"
+	Text   GetV1TaskIdLogsExportParamsFormat = "text"
+)
+
+// Defines values for GetV1TaskIdResultParamsFormat.
+const (
+	Json  GetV1TaskIdResultParamsFormat = "json"
+	Proto GetV1TaskIdResultParamsFormat = "proto"
+	Raw   GetV1TaskIdResultParamsFormat = "raw"
+)
+
+// AppendTaskLogsRequest defines model for AppendTaskLogsRequest.
+type AppendTaskLogsRequest struct {
+	// Logs Log entries to store.
+	Logs []TaskLogEntry `json:"logs"`
+}
+
 // Artifact Metadata of an artifact version.
 type Artifact struct {
 	// CreatedAt Creation timestamp of the artifact.
@@ -53,20 +124,112 @@ type Artifact struct {
 	VersionHash string `json:"versionHash"`
 }
 
+// CallbackDelivery defines model for CallbackDelivery.
+type CallbackDelivery struct {
+	// Attempt Number of the delivery attempt within its round, starting at 1.
+	Attempt int `json:"attempt"`
+
+	// Error Reason the delivery attempt failed.
+	Error *string `json:"error,omitempty"`
+
+	// Round Delivery round the attempt belongs to, starting at 1.
+	Round int `json:"round"`
+
+	// StatusCode HTTP status code returned by the callback endpoint, if a response was received.
+	StatusCode *int `json:"statusCode,omitempty"`
+
+	// Success Whether the callback endpoint acknowledged the delivery.
+	Success bool `json:"success"`
+
+	// Timestamp Time of the delivery attempt.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// CancelTaskGroupResponse defines model for CancelTaskGroupResponse.
+type CancelTaskGroupResponse struct {
+	// Count Number of canceled tasks.
+	Count int `json:"count"`
+
+	// Ids Unique identifiers of the canceled tasks.
+	Ids []string `json:"ids"`
+}
+
+// CreateScheduleRequest defines model for CreateScheduleRequest.
+type CreateScheduleRequest struct {
+	// Cron Standard five field cron expression (minute hour day-of-month month day-of-week) or a descriptor such as @daily or @every 90m.
+	Cron string `json:"cron"`
+
+	// Name Unique name of the schedule.
+	Name string `json:"name"`
+
+	// Paused Create the schedule in paused state.
+	Paused *bool             `json:"paused,omitempty"`
+	Task   CreateTaskRequest `json:"task"`
+
+	// Timezone IANA timezone the cron expression is evaluated in.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// CreateTaskBatchRequest defines model for CreateTaskBatchRequest.
+type CreateTaskBatchRequest struct {
+	// Tasks Tasks to create.
+	Tasks []CreateTaskRequest `json:"tasks"`
+}
+
+// CreateTaskBatchResponse defines model for CreateTaskBatchResponse.
+type CreateTaskBatchResponse struct {
+	// Failed Number of tasks that could not be enqueued.
+	Failed int `json:"failed"`
+
+	// Id Unique identifier of the batch.
+	Id openapi_types.UUID `json:"id"`
+
+	// Items Outcome of every task, in the order of the request.
+	Items []TaskBatchItem `json:"items"`
+
+	// Submitted Number of tasks that were enqueued.
+	Submitted int `json:"submitted"`
+}
+
+// CreateTaskMapRequest defines model for CreateTaskMapRequest.
+type CreateTaskMapRequest struct {
+	// Concurrency Maximum number of tasks of the map in flight at once. Defaults to the number of list elements.
+	Concurrency *int `json:"concurrency,omitempty"`
+
+	// Parameter Index of the list parameter of the task to map over.
+	Parameter *int              `json:"parameter,omitempty"`
+	Task      CreateTaskRequest `json:"task"`
+}
+
 // CreateTaskRequest defines model for CreateTaskRequest.
 type CreateTaskRequest struct {
 	// Args Argument list used to invoke the task.
 	Args *[]string `json:"args,omitempty"`
 
-	// Callback Callback URL invoked on task completion.
+	// Callback Callback URL invoked on task completion. Once the task reached a final state, a JSON object with the fields id, state, result_payload, last_error and completed_at is POSTed to this URL. result_payload holds the base64 encoded result as served by the raw format of the task result endpoint. If a callback secret is configured, the request carries an X-Enclave-Signature header containing "sha256=" followed by the hex encoded HMAC-SHA256 of "<X-Enclave-Timestamp>.<body>". Callbacks are only sent to public addresses and to the hosts and networks allowed by callback.allowed_targets; URLs of other private, loopback or link-local targets are rejected, as are deliveries to host names resolving to them.
 	Callback *string `json:"callback,omitempty"`
 
+	// Deadline Time (RFC3339) after which the task is no longer processed. Attempts still running at the deadline are canceled. Must lie in the future and not further ahead than the configured maximum deadline. Not supported for schedules.
+	Deadline *time.Time `json:"deadline,omitempty"`
+
 	// Env Environment variables supplied to the task.
 	Env *[]EnvironmentVariable `json:"env,omitempty"`
 
-	// Params Parameters passed to the task.
+	// Group Client chosen identifier of a group of related tasks, e.g. a parameter sweep. The aggregate status of a group can be retrieved and all of its tasks can be canceled at once.
+	Group *string `json:"group,omitempty"`
+
+	// Params Parameters passed to the task. Plain JSON values are passed as bool, s64 (integers given by YAML), f64, string, list, record or, for null, as none option. Values of other WIT types are given as object naming the type, e.g. {"type": "u8", "value": 7}. Integers and floats are given as number, 64 bit integers also as decimal string. A char is given as single character or its code point, list and tuple as array, option as its value (none if missing or null), result as {"ok": value} or {"err": value}, record as object of its fields, variant as {"case": name, "value": value}, enum as the name of its case and flags as array of the names of all set flags. Typed values may nest plain and typed values. Values are returned in the same encoding.
 	Params *[]interface{} `json:"params,omitempty"`
 
+	// ProcessAt Time (RFC3339) at which the task should be processed. The task stays in state scheduled until then. Must not lie in the past or further ahead than the configured maximum scheduling horizon. Mutually exclusive with processIn.
+	ProcessAt *time.Time `json:"processAt,omitempty"`
+
+	// ProcessIn Delay (e.g. "90m") after which the task should be processed. Must not be negative or exceed the configured maximum scheduling horizon. Mutually exclusive with processAt.
+	ProcessIn *string `json:"processIn,omitempty"`
+
+	// Queue Name of the queue the task is submitted to, defaults to "default". Queues with a higher configured weight are processed first. Queues other than the default queue may only be used by members of the user group "queue_<name>".
+	Queue *string `json:"queue,omitempty"`
+
 	// Retention Duration to retain the task after completion.
 	Retention *string `json:"retention,omitempty"`
 
@@ -75,6 +238,28 @@ type CreateTaskRequest struct {
 
 	// Source Task source in the form namespace:name/interface/function@<hash|tag>.
 	Source string `json:"source"`
+
+	// Timeout Maximum duration (e.g. "10m") a single attempt of the task may run before it is canceled and counted as failed. Defaults to the configured default timeout and must not exceed the configured maximum timeout.
+	Timeout *string `json:"timeout,omitempty"`
+}
+
+// CreateWorkflowNode defines model for CreateWorkflowNode.
+type CreateWorkflowNode struct {
+	// DependsOn Names of the nodes that have to complete before this node is enqueued. Their results are passed to the task of this node as leading parameters in this order.
+	DependsOn *[]string `json:"dependsOn,omitempty"`
+
+	// Name Name of the node, unique within the workflow.
+	Name string            `json:"name"`
+	Task CreateTaskRequest `json:"task"`
+}
+
+// CreateWorkflowRequest defines model for CreateWorkflowRequest.
+type CreateWorkflowRequest struct {
+	// Name Name of the workflow.
+	Name string `json:"name"`
+
+	// Nodes Nodes of the workflow.
+	Nodes []CreateWorkflowNode `json:"nodes"`
 }
 
 // EnvironmentVariable defines model for EnvironmentVariable.
@@ -118,6 +303,22 @@ type PatchMe struct {
 	Roles *[]string `json:"roles,omitempty"`
 }
 
+// PatchSchedule defines model for PatchSchedule.
+type PatchSchedule struct {
+	// Cron New cron expression of the schedule.
+	Cron *string `json:"cron,omitempty"`
+
+	// Name New unique name of the schedule.
+	Name *string `json:"name,omitempty"`
+
+	// Paused Pause or resume the schedule.
+	Paused *bool              `json:"paused,omitempty"`
+	Task   *CreateTaskRequest `json:"task,omitempty"`
+
+	// Timezone New IANA timezone the cron expression is evaluated in.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
 // PatchUser defines model for PatchUser.
 type PatchUser struct {
 	// DisplayName The display name for the user.
@@ -154,6 +355,57 @@ type PutUserRequest struct {
 	Roles *[]string `json:"roles,omitempty"`
 }
 
+// QueueDailyStats defines model for QueueDailyStats.
+type QueueDailyStats struct {
+	// Date Day the statistics were taken on.
+	Date openapi_types.Date `json:"date"`
+
+	// Failed Number of tasks that failed on this day.
+	Failed int `json:"failed"`
+
+	// Processed Number of tasks processed on this day, including failed tasks.
+	Processed int `json:"processed"`
+}
+
+// QueueStats defines model for QueueStats.
+type QueueStats struct {
+	// Failed Number of tasks that failed today.
+	Failed int `json:"failed"`
+
+	// FailedTotal Number of tasks that failed since the queue was created.
+	FailedTotal int `json:"failedTotal"`
+
+	// History Daily statistics, newest first. Only returned for a single queue.
+	History *[]QueueDailyStats `json:"history,omitempty"`
+
+	// Latency Time the oldest pending task is waiting for (e.g. "1m30s").
+	Latency string `json:"latency"`
+
+	// MemoryUsage Approximate number of bytes the queue and its tasks use in Redis.
+	MemoryUsage int64 `json:"memoryUsage"`
+
+	// Name Name of the queue.
+	Name string `json:"name"`
+
+	// Paused Whether processing of the tasks of the queue is paused.
+	Paused bool `json:"paused"`
+
+	// Processed Number of tasks processed today, including failed tasks.
+	Processed int `json:"processed"`
+
+	// ProcessedTotal Number of tasks processed since the queue was created.
+	ProcessedTotal int `json:"processedTotal"`
+
+	// Size Number of tasks in the queue, excluding completed tasks.
+	Size int `json:"size"`
+
+	// States Number of tasks of the queue per state.
+	States map[string]int `json:"states"`
+
+	// Timestamp Time the statistics were taken.
+	Timestamp time.Time `json:"timestamp"`
+}
+
 // RBACPolicy defines model for RBACPolicy.
 type RBACPolicy struct {
 	// Method The allowed HTTP method (e.g., "GET", "POST", "*").
@@ -178,6 +430,30 @@ type ResourceGroupResource struct {
 	Name string `json:"name"`
 }
 
+// RetryTasksRequest defines model for RetryTasksRequest.
+type RetryTasksRequest struct {
+	// ResetRetries Reset the retry counter of the retried tasks. Like for a single task, every retried task is replaced by a copy with a new id.
+	ResetRetries *bool `json:"resetRetries,omitempty"`
+
+	// Source Only retry tasks whose source starts with this value, e.g. a namespace or a full namespace:name/interface/function@<hash|tag> reference.
+	Source *string `json:"source,omitempty"`
+
+	// State State of the tasks to retry.
+	State RetryTasksRequestState `json:"state"`
+}
+
+// RetryTasksRequestState State of the tasks to retry.
+type RetryTasksRequestState string
+
+// RetryTasksResponse defines model for RetryTasksResponse.
+type RetryTasksResponse struct {
+	// Count Number of retried tasks.
+	Count int `json:"count"`
+
+	// Ids Unique identifiers of the retried tasks.
+	Ids []string `json:"ids"`
+}
+
 // RoleResource defines model for RoleResource.
 type RoleResource struct {
 	// Name The role name.
@@ -187,6 +463,49 @@ type RoleResource struct {
 	Users []string `json:"users"`
 }
 
+// Schedule defines model for Schedule.
+type Schedule struct {
+	// CreatedAt Time the schedule was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedBy User that created the schedule.
+	CreatedBy string `json:"createdBy"`
+
+	// Cron Cron expression of the schedule.
+	Cron string `json:"cron"`
+
+	// Id Unique identifier of the schedule.
+	Id openapi_types.UUID `json:"id"`
+
+	// Name Unique name of the schedule.
+	Name string `json:"name"`
+
+	// NextRunAt Time of the next run. Absent while the schedule is paused.
+	NextRunAt *time.Time `json:"nextRunAt,omitempty"`
+
+	// Paused Whether the schedule is paused.
+	Paused bool              `json:"paused"`
+	Task   CreateTaskRequest `json:"task"`
+
+	// Timezone IANA timezone the cron expression is evaluated in.
+	Timezone string `json:"timezone"`
+
+	// UpdatedAt Time the schedule was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ScheduleRun defines model for ScheduleRun.
+type ScheduleRun struct {
+	// Error Reason the task of the run could not be enqueued.
+	Error *string `json:"error,omitempty"`
+
+	// ScheduledAt Time the run was due.
+	ScheduledAt time.Time `json:"scheduledAt"`
+
+	// TaskId Unique identifier of the task spawned by the run.
+	TaskId *string `json:"taskId,omitempty"`
+}
+
 // Task defines model for Task.
 type Task struct {
 	// Args Argument list used to invoke the task.
@@ -195,15 +514,30 @@ type Task struct {
 	// Callback Callback URL invoked on task completion.
 	Callback *string `json:"callback,omitempty"`
 
+	// Deadline Time after which the task is no longer processed.
+	Deadline *time.Time `json:"deadline,omitempty"`
+
 	// Env Environment variables supplied to the task.
 	Env *[]EnvironmentVariable `json:"env,omitempty"`
 
+	// Group Group the task was submitted to.
+	Group *string `json:"group,omitempty"`
+
 	// Id Unique identifier for the task.
 	Id string `json:"id"`
 
-	// Params Parameters passed to the task.
+	// Params Parameters passed to the task. Plain JSON values are passed as bool, s64 (integers given by YAML), f64, string, list, record or, for null, as none option. Values of other WIT types are given as object naming the type, e.g. {"type": "u8", "value": 7}. Integers and floats are given as number, 64 bit integers also as decimal string. A char is given as single character or its code point, list and tuple as array, option as its value (none if missing or null), result as {"ok": value} or {"err": value}, record as object of its fields, variant as {"case": name, "value": value}, enum as the name of its case and flags as array of the names of all set flags. Typed values may nest plain and typed values. Values are returned in the same encoding.
 	Params *[]interface{} `json:"params,omitempty"`
 
+	// Queue Name of the queue the task was submitted to.
+	Queue *string `json:"queue,omitempty"`
+
+	// RequeuedAs Id of the copy that replaced this task after it was retried with reset retries. Tasks replaced by a copy are not counted in the progress of their batch or group.
+	RequeuedAs *string `json:"requeuedAs,omitempty"`
+
+	// RequeuedFrom Id of the task this task replaces after it was retried with reset retries.
+	RequeuedFrom *string `json:"requeuedFrom,omitempty"`
+
 	// Retention Duration to retain the task after completion.
 	Retention *string `json:"retention,omitempty"`
 
@@ -213,6 +547,105 @@ type Task struct {
 	// Source Task source in the form namespace:name/interface/function@<hash|tag>.
 	Source string     `json:"source"`
 	Status TaskStatus `json:"status"`
+
+	// SubmittedBy Username of the user that submitted the task.
+	SubmittedBy *string `json:"submittedBy,omitempty"`
+
+	// Timeout Maximum duration a single attempt of the task may run.
+	Timeout *string `json:"timeout,omitempty"`
+
+	// VersionHash Version hash of the artifact the task runs. Tags are resolved when the task is submitted, so that retries run the same code even if the tag is moved.
+	VersionHash *string `json:"versionHash,omitempty"`
+}
+
+// TaskBatch defines model for TaskBatch.
+type TaskBatch struct {
+	// CreatedAt Time the batch was submitted.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Finished Number of tasks of the batch that reached a final state.
+	Finished int `json:"finished"`
+
+	// Id Unique identifier of the batch.
+	Id openapi_types.UUID `json:"id"`
+
+	// States Number of tasks of the batch per state.
+	States map[string]int `json:"states"`
+
+	// Submitted Number of tasks of the batch that were enqueued.
+	Submitted int `json:"submitted"`
+
+	// SubmittedBy User that submitted the batch.
+	SubmittedBy string `json:"submittedBy"`
+
+	// Total Number of tasks in the request of the batch.
+	Total int `json:"total"`
+}
+
+// TaskBatchItem defines model for TaskBatchItem.
+type TaskBatchItem struct {
+	// Error Reason the task could not be created.
+	Error *string `json:"error,omitempty"`
+
+	// Id Unique identifier of the created task.
+	Id *string `json:"id,omitempty"`
+
+	// Index Position of the task in the request.
+	Index int `json:"index"`
+}
+
+// TaskCallback defines model for TaskCallback.
+type TaskCallback struct {
+	// Attempts Number of delivery attempts made so far in the current round.
+	Attempts int `json:"attempts"`
+
+	// Deliveries Delivery attempts of all rounds ordered from oldest to newest.
+	Deliveries []CallbackDelivery `json:"deliveries"`
+
+	// NextAttemptAt Earliest time of the next delivery attempt while the callback is pending.
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
+
+	// Round Current delivery round, starting at 1. Every retry of the task starts a new round with a fresh number of attempts.
+	Round int `json:"round"`
+
+	// Status Delivery state of the callback.
+	Status TaskCallbackStatus `json:"status"`
+
+	// Url Callback URL invoked on task completion.
+	Url string `json:"url"`
+}
+
+// TaskCallbackStatus Delivery state of the callback.
+type TaskCallbackStatus string
+
+// TaskGroup defines model for TaskGroup.
+type TaskGroup struct {
+	// Failed A page of the tasks of the group that reached a final state other than completed, i.e. that were archived after failing or being canceled, expired, or whose function returned an error. Their total number is given by the states.
+	Failed []TaskGroupMember `json:"failed"`
+
+	// Finished Number of tasks of the group that reached a final state.
+	Finished int `json:"finished"`
+
+	// Id Identifier of the group.
+	Id string `json:"id"`
+
+	// Progress Share of the tasks of the group that reached a final state.
+	Progress float64 `json:"progress"`
+
+	// States Number of tasks of the group per state.
+	States map[string]int `json:"states"`
+
+	// Total Number of tasks in the group.
+	Total int `json:"total"`
+}
+
+// TaskGroupMember defines model for TaskGroupMember.
+type TaskGroupMember struct {
+	// Id Unique identifier of the task.
+	Id string `json:"id"`
+
+	// LastError Last error reported by the task.
+	LastError *string `json:"lastError,omitempty"`
 }
 
 // TaskLog defines model for TaskLog.
@@ -230,6 +663,99 @@ type TaskLog struct {
 	Timestamp time.Time `json:"timestamp"`
 }
 
+// TaskLogEntry defines model for TaskLogEntry.
+type TaskLogEntry struct {
+	// Issuer Component that issued the log entry.
+	Issuer string `json:"issuer"`
+
+	// Level Log level (e.g., debug, info, warn, error, fatal).
+	Level string `json:"level"`
+
+	// Message Log message content.
+	Message string `json:"message"`
+
+	// Timestamp Time the log entry was created. Defaults to the time it is stored.
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// TaskMap defines model for TaskMap.
+type TaskMap struct {
+	// Concurrency Maximum number of tasks of the map in flight at once.
+	Concurrency int `json:"concurrency"`
+
+	// CreatedAt Time the task map was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedBy Username of the user that created the task map.
+	CreatedBy string `json:"createdBy"`
+
+	// Elements Tasks of the map in the order of the list elements.
+	Elements []TaskMapElement `json:"elements"`
+
+	// Error Reason the task map failed.
+	Error *string `json:"error,omitempty"`
+
+	// Id Unique identifier of the task map.
+	Id openapi_types.UUID `json:"id"`
+
+	// Parameter Index of the parameter the map runs over.
+	Parameter int `json:"parameter"`
+
+	// Result Results of all tasks in the order of the list elements, once completed.
+	Result *[]interface{} `json:"result,omitempty"`
+
+	// Source Task source in the form namespace:name/interface/function@<hash|tag>.
+	Source string `json:"source"`
+
+	// State State of the task map.
+	State TaskMapState `json:"state"`
+
+	// Total Number of list elements.
+	Total int `json:"total"`
+
+	// UpdatedAt Time the task map was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// TaskMapState State of the task map.
+type TaskMapState string
+
+// TaskMapElement defines model for TaskMapElement.
+type TaskMapElement struct {
+	// Error Reason the task processing the element failed.
+	Error *string `json:"error,omitempty"`
+
+	// Index Index of the list element.
+	Index int `json:"index"`
+
+	// State State of the task processing the element.
+	State TaskMapElementState `json:"state"`
+
+	// TaskId Unique identifier of the task processing the element.
+	TaskId *string `json:"taskId,omitempty"`
+}
+
+// TaskMapElementState State of the task processing the element.
+type TaskMapElementState string
+
+// TaskResult defines model for TaskResult.
+type TaskResult struct {
+	// Value Value returned by the function, encoded like task parameters.
+	Value interface{} `json:"value"`
+}
+
+// TaskRetry defines model for TaskRetry.
+type TaskRetry struct {
+	// ResetRetries Whether the retry counter was reset. The task was replaced by a copy then, see requeuedAs of the task.
+	ResetRetries bool `json:"resetRetries"`
+
+	// Timestamp Time the task was retried.
+	Timestamp time.Time `json:"timestamp"`
+
+	// TriggeredBy Username of the user that retried the task.
+	TriggeredBy string `json:"triggeredBy"`
+}
+
 // TaskStatus defines model for TaskStatus.
 type TaskStatus struct {
 	// CompletedAt Time the task finished processing.
@@ -244,16 +770,28 @@ type TaskStatus struct {
 	// NextProcessAt Time the task is scheduled for processing next.
 	NextProcessAt *time.Time `json:"next_process_at,omitempty"`
 
-	// ResultPayload Result payload of the task.
-	ResultPayload *string `json:"result_payload,omitempty"`
+	// Result Value returned by the function, encoded like task parameters. Absent if the task has no result yet or its result is not a serialized WIT value, see the task result endpoint for the raw result.
+	Result interface{} `json:"result,omitempty"`
 
 	// Retries Current retry count.
 	Retries int `json:"retries"`
 
-	// State Current status of the task.
+	// State Current status of the task. Tasks that vanished from the queue before reaching a final state are reported as expired, completed tasks whose function returned the err case of a WIT result as failed.
 	State string `json:"state"`
 }
 
+// TaskTransition defines model for TaskTransition.
+type TaskTransition struct {
+	// From State of the task before the transition. Absent for the initial state.
+	From *string `json:"from,omitempty"`
+
+	// Timestamp Time the transition was observed.
+	Timestamp time.Time `json:"timestamp"`
+
+	// To State of the task after the transition.
+	To string `json:"to"`
+}
+
 // UploadArtifactResponse defines model for UploadArtifactResponse.
 type UploadArtifactResponse struct {
 	// VersionHash Created version hash.
@@ -272,6 +810,57 @@ type UserResponse struct {
 	Roles *[]string `json:"roles,omitempty"`
 }
 
+// Workflow defines model for Workflow.
+type Workflow struct {
+	// CreatedAt Time the workflow was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedBy Username of the user that created the workflow.
+	CreatedBy string `json:"createdBy"`
+
+	// Id Unique identifier of the workflow.
+	Id openapi_types.UUID `json:"id"`
+
+	// Name Name of the workflow.
+	Name string `json:"name"`
+
+	// Nodes Nodes of the workflow ordered by name.
+	Nodes []WorkflowNode `json:"nodes"`
+
+	// State State of the workflow. A workflow is completed once all of its nodes completed and failed once no node can make progress anymore but some did not complete.
+	State WorkflowState `json:"state"`
+
+	// UpdatedAt Time the workflow was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// WorkflowState State of the workflow. A workflow is completed once all of its nodes completed and failed once no node can make progress anymore but some did not complete.
+type WorkflowState string
+
+// WorkflowNode defines model for WorkflowNode.
+type WorkflowNode struct {
+	// DependsOn Names of the nodes whose results are passed to this node.
+	DependsOn []string `json:"dependsOn"`
+
+	// Error Reason the node failed.
+	Error *string `json:"error,omitempty"`
+
+	// Name Name of the node.
+	Name string `json:"name"`
+
+	// Source Task source in the form namespace:name/interface/function@<hash|tag>.
+	Source string `json:"source"`
+
+	// State State of the node.
+	State WorkflowNodeState `json:"state"`
+
+	// TaskId Unique identifier of the task enqueued for the node.
+	TaskId *string `json:"taskId,omitempty"`
+}
+
+// WorkflowNodeState State of the node.
+type WorkflowNodeState string
+
 // FieldError defines model for FieldError.
 type FieldError struct {
 	Errors *[]ErrField `json:"errors,omitempty"`
@@ -313,6 +902,12 @@ type GetV1ArtifactNamespaceNameParams struct {
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// GetV1QueueNameParams defines parameters for GetV1QueueName.
+type GetV1QueueNameParams struct {
+	// Days Number of days of history to return, including today.
+	Days *int `form:"days,omitempty" json:"days,omitempty"`
+}
+
 // GetV1RbacPolicyParams defines parameters for GetV1RbacPolicy.
 type GetV1RbacPolicyParams struct {
 	// Limit Maximum number of policies to return.
@@ -355,46 +950,175 @@ type GetV1RbacRoleParams struct {
 	Role *string `form:"role,omitempty" json:"role,omitempty"`
 }
 
-// GetV1TaskParams defines parameters for GetV1Task.
-type GetV1TaskParams struct {
-	// Limit Maximum number of tasks to return.
+// GetV1ScheduleParams defines parameters for GetV1Schedule.
+type GetV1ScheduleParams struct {
+	// Limit Maximum number of schedules to return.
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
 	// Offset Offset for pagination.
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
-
-	// State Filter tasks by state (e.g., ACTIVE).
-	State *string `form:"state,omitempty" json:"state,omitempty"`
 }
 
-// GetV1TaskIdLogsParams defines parameters for GetV1TaskIdLogs.
-type GetV1TaskIdLogsParams struct {
-	// Level Filter logs by level.
-	Level *string `form:"level,omitempty" json:"level,omitempty"`
-
-	// Issuer Filter logs by issuer.
-	Issuer *string `form:"issuer,omitempty" json:"issuer,omitempty"`
-
-	// TimeRangeFrom Start of the time range filter. Must be used with time-range-to and be more recent.
-	TimeRangeFrom *time.Time `form:"time-range-from,omitempty" json:"time-range-from,omitempty"`
+// GetV1ScheduleIdHistoryParams defines parameters for GetV1ScheduleIdHistory.
+type GetV1ScheduleIdHistoryParams struct {
+	// Limit Maximum number of runs to return.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-	// TimeRangeTo End of the time range filter. Must be used with time-range-from and be less recent.
-	TimeRangeTo *time.Time `form:"time-range-to,omitempty" json:"time-range-to,omitempty"`
+	// Offset Offset for pagination.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
-// GetV1UserParams defines parameters for GetV1User.
-type GetV1UserParams struct {
-	// Limit Maximum number of users to return.
+// GetV1TaskParams defines parameters for GetV1Task.
+type GetV1TaskParams struct {
+	// Limit Maximum number of tasks to return.
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-	// Offset Offset into the user list.
+	// Offset Number of tasks to skip (after the cursor, if given).
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 
-	// Name Filter by exact username.
-	Name *string `form:"name,omitempty" json:"name,omitempty"`
+	// Cursor Opaque cursor from the X-Next-Cursor header of the previous page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 
-	// DisplayName Filter by display name.
-	DisplayName *string `form:"display-name,omitempty" json:"display-name,omitempty"`
+	// State Filter tasks by state (e.g., ACTIVE). The state of the task history is used, which is eventually consistent with the queue like the listed state.
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+
+	// Source Filter tasks by function identifier (e.g. `namespace:package/interface/function@tag` or `...@hash:<hash>`).
+	Source *string `form:"source,omitempty" json:"source,omitempty"`
+
+	// Namespace Filter tasks by artifact namespace.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// Package Filter tasks by artifact package name.
+	Package *string `form:"package,omitempty" json:"package,omitempty"`
+
+	// Interface Filter tasks by interface of the called function.
+	Interface *string `form:"interface,omitempty" json:"interface,omitempty"`
+
+	// Function Filter tasks by name of the called function.
+	Function *string `form:"function,omitempty" json:"function,omitempty"`
+
+	// Tag Filter tasks by the artifact tag they were submitted with.
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Hash Filter tasks by the artifact version hash they were submitted with.
+	Hash *string `form:"hash,omitempty" json:"hash,omitempty"`
+
+	// SubmittedBy Filter tasks by the username of the submitting user.
+	SubmittedBy *string `form:"submitted-by,omitempty" json:"submitted-by,omitempty"`
+
+	// Group Filter tasks by the group they were submitted to.
+	Group *string `form:"group,omitempty" json:"group,omitempty"`
+
+	// CreatedAfter Only return tasks created at or after this time.
+	CreatedAfter *time.Time `form:"created-after,omitempty" json:"created-after,omitempty"`
+
+	// CreatedBefore Only return tasks created at or before this time.
+	CreatedBefore *time.Time `form:"created-before,omitempty" json:"created-before,omitempty"`
+
+	// CompletedAfter Only return tasks completed at or after this time.
+	CompletedAfter *time.Time `form:"completed-after,omitempty" json:"completed-after,omitempty"`
+
+	// CompletedBefore Only return tasks completed at or before this time.
+	CompletedBefore *time.Time `form:"completed-before,omitempty" json:"completed-before,omitempty"`
+}
+
+// PostV1TaskParams defines parameters for PostV1Task.
+type PostV1TaskParams struct {
+	// IdempotencyKey Client chosen key identifying the submission. Retrying a request with the same key and body returns the original task instead of submitting it again.
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// GetV1TaskGroupIdParams defines parameters for GetV1TaskGroupId.
+type GetV1TaskGroupIdParams struct {
+	// Limit Maximum number of failed tasks to return.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor from the X-Next-Cursor header of the previous page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetV1TaskIdLogsParams defines parameters for GetV1TaskIdLogs.
+type GetV1TaskIdLogsParams struct {
+	// Limit Maximum number of logs to return.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of logs to skip.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Level Filter logs by level.
+	Level *string `form:"level,omitempty" json:"level,omitempty"`
+
+	// Issuer Filter logs by issuer.
+	Issuer *string `form:"issuer,omitempty" json:"issuer,omitempty"`
+
+	// Since Only return logs written at or after this time.
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only return logs written at or before this time.
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Q Only return logs whose message contains this text, ignoring case.
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Order Order of the logs by time, oldest (asc) or newest (desc) first.
+	Order *GetV1TaskIdLogsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+}
+
+// GetV1TaskIdLogsParamsOrder defines parameters for GetV1TaskIdLogs.
+type GetV1TaskIdLogsParamsOrder string
+
+// GetV1TaskIdLogsExportParams defines parameters for GetV1TaskIdLogsExport.
+type GetV1TaskIdLogsExportParams struct {
+	// Format Format of the exported logs.
+	Format *GetV1TaskIdLogsExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetV1TaskIdLogsExportParamsFormat defines parameters for GetV1TaskIdLogsExport.
+type GetV1TaskIdLogsExportParamsFormat string
+
+// GetV1TaskIdLogsStreamParams defines parameters for GetV1TaskIdLogsStream.
+type GetV1TaskIdLogsStreamParams struct {
+	// LastEventID ID of the last received log event. Only logs following it are sent.
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// GetV1TaskIdResultParams defines parameters for GetV1TaskIdResult.
+type GetV1TaskIdResultParams struct {
+	// Format Encoding of the returned result.
+	Format *GetV1TaskIdResultParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetV1TaskIdResultParamsFormat defines parameters for GetV1TaskIdResult.
+type GetV1TaskIdResultParamsFormat string
+
+// PostV1TaskIdRetryParams defines parameters for PostV1TaskIdRetry.
+type PostV1TaskIdRetryParams struct {
+	// ResetRetries Reset the retry counter of the task so that it gets its full number of retries again. The task is replaced by a copy with a new id, which is returned and references the retried task in requeuedFrom. The retried task is kept in the history and references its copy in requeuedAs.
+	ResetRetries *bool `form:"reset-retries,omitempty" json:"reset-retries,omitempty"`
+}
+
+// GetV1UserParams defines parameters for GetV1User.
+type GetV1UserParams struct {
+	// Limit Maximum number of users to return.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Offset into the user list.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Name Filter by exact username.
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// DisplayName Filter by display name.
+	DisplayName *string `form:"display-name,omitempty" json:"display-name,omitempty"`
+}
+
+// GetV1WorkflowParams defines parameters for GetV1Workflow.
+type GetV1WorkflowParams struct {
+	// Limit Maximum number of workflows to return.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Offset for pagination.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
 // PatchV1ArtifactNamespaceNameHashHashJSONRequestBody defines body for PatchV1ArtifactNamespaceNameHashHash for application/json ContentType.
@@ -415,9 +1139,27 @@ type PutV1RbacResourceGroupResourceGroupJSONRequestBody = PutResourceGroupReques
 // PutV1RbacRoleRoleJSONRequestBody defines body for PutV1RbacRoleRole for application/json ContentType.
 type PutV1RbacRoleRoleJSONRequestBody = PutRoleRequest
 
+// PostV1ScheduleJSONRequestBody defines body for PostV1Schedule for application/json ContentType.
+type PostV1ScheduleJSONRequestBody = CreateScheduleRequest
+
+// PatchV1ScheduleIdJSONRequestBody defines body for PatchV1ScheduleId for application/json ContentType.
+type PatchV1ScheduleIdJSONRequestBody = PatchSchedule
+
 // PostV1TaskJSONRequestBody defines body for PostV1Task for application/json ContentType.
 type PostV1TaskJSONRequestBody = CreateTaskRequest
 
+// PostV1TaskBatchJSONRequestBody defines body for PostV1TaskBatch for application/json ContentType.
+type PostV1TaskBatchJSONRequestBody = CreateTaskBatchRequest
+
+// PostV1TaskMapJSONRequestBody defines body for PostV1TaskMap for application/json ContentType.
+type PostV1TaskMapJSONRequestBody = CreateTaskMapRequest
+
+// PostV1TaskRetryJSONRequestBody defines body for PostV1TaskRetry for application/json ContentType.
+type PostV1TaskRetryJSONRequestBody = RetryTasksRequest
+
+// PostV1TaskIdLogsJSONRequestBody defines body for PostV1TaskIdLogs for application/json ContentType.
+type PostV1TaskIdLogsJSONRequestBody = AppendTaskLogsRequest
+
 // PatchV1UserMeJSONRequestBody defines body for PatchV1UserMe for application/json ContentType.
 type PatchV1UserMeJSONRequestBody = PatchMe
 
@@ -427,6 +1169,9 @@ type PatchV1UserUsernameJSONRequestBody = PatchUser
 // PutV1UserUsernameJSONRequestBody defines body for PutV1UserUsername for application/json ContentType.
 type PutV1UserUsernameJSONRequestBody = PutUserRequest
 
+// PostV1WorkflowJSONRequestBody defines body for PostV1Workflow for application/json ContentType.
+type PostV1WorkflowJSONRequestBody = CreateWorkflowRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -540,6 +1285,18 @@ type ClientInterface interface {
 
 	PatchV1ArtifactNamespaceNameTagTag(ctx context.Context, namespace string, name string, tag string, body PatchV1ArtifactNamespaceNameTagTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetV1Queue request
+	GetV1Queue(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1QueueName request
+	GetV1QueueName(ctx context.Context, name string, params *GetV1QueueNameParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1QueueNamePause request
+	PostV1QueueNamePause(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1QueueNameResume request
+	PostV1QueueNameResume(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteV1RbacPolicyWithBody request with any body
 	DeleteV1RbacPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -587,20 +1344,107 @@ type ClientInterface interface {
 
 	PutV1RbacRoleRole(ctx context.Context, role string, body PutV1RbacRoleRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetV1Schedule request
+	GetV1Schedule(ctx context.Context, params *GetV1ScheduleParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1ScheduleWithBody request with any body
+	PostV1ScheduleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV1Schedule(ctx context.Context, body PostV1ScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteV1ScheduleId request
+	DeleteV1ScheduleId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1ScheduleId request
+	GetV1ScheduleId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchV1ScheduleIdWithBody request with any body
+	PatchV1ScheduleIdWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchV1ScheduleId(ctx context.Context, id openapi_types.UUID, body PatchV1ScheduleIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1ScheduleIdHistory request
+	GetV1ScheduleIdHistory(ctx context.Context, id openapi_types.UUID, params *GetV1ScheduleIdHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1ScheduleIdPause request
+	PostV1ScheduleIdPause(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1ScheduleIdResume request
+	PostV1ScheduleIdResume(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetV1Task request
 	GetV1Task(ctx context.Context, params *GetV1TaskParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PostV1TaskWithBody request with any body
-	PostV1TaskWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostV1TaskWithBody(ctx context.Context, params *PostV1TaskParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV1Task(ctx context.Context, params *PostV1TaskParams, body PostV1TaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1TaskGroupId request
+	GetV1TaskGroupId(ctx context.Context, id string, params *GetV1TaskGroupIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1TaskGroupIdCancel request
+	PostV1TaskGroupIdCancel(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1TaskBatchWithBody request with any body
+	PostV1TaskBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV1TaskBatch(ctx context.Context, body PostV1TaskBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1TaskBatchId request
+	GetV1TaskBatchId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PostV1Task(ctx context.Context, body PostV1TaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// PostV1TaskMapWithBody request with any body
+	PostV1TaskMapWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV1TaskMap(ctx context.Context, body PostV1TaskMapJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1TaskMapId request
+	GetV1TaskMapId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1TaskRetryWithBody request with any body
+	PostV1TaskRetryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV1TaskRetry(ctx context.Context, body PostV1TaskRetryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteV1TaskId request
+	DeleteV1TaskId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetV1TaskId request
 	GetV1TaskId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetV1TaskIdCallback request
+	GetV1TaskIdCallback(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1TaskIdCancel request
+	PostV1TaskIdCancel(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetV1TaskIdLogs request
 	GetV1TaskIdLogs(ctx context.Context, id string, params *GetV1TaskIdLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostV1TaskIdLogsWithBody request with any body
+	PostV1TaskIdLogsWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV1TaskIdLogs(ctx context.Context, id string, body PostV1TaskIdLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1TaskIdLogsExport request
+	GetV1TaskIdLogsExport(ctx context.Context, id string, params *GetV1TaskIdLogsExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1TaskIdLogsStream request
+	GetV1TaskIdLogsStream(ctx context.Context, id string, params *GetV1TaskIdLogsStreamParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1TaskIdResult request
+	GetV1TaskIdResult(ctx context.Context, id string, params *GetV1TaskIdResultParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1TaskIdRetries request
+	GetV1TaskIdRetries(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1TaskIdRetry request
+	PostV1TaskIdRetry(ctx context.Context, id string, params *PostV1TaskIdRetryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1TaskIdTransitions request
+	GetV1TaskIdTransitions(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetV1User request
 	GetV1User(ctx context.Context, params *GetV1UserParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -633,6 +1477,17 @@ type ClientInterface interface {
 	PutV1UserUsernameWithBody(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PutV1UserUsername(ctx context.Context, username string, body PutV1UserUsernameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1Workflow request
+	GetV1Workflow(ctx context.Context, params *GetV1WorkflowParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1WorkflowWithBody request with any body
+	PostV1WorkflowWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV1Workflow(ctx context.Context, body PostV1WorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1WorkflowId request
+	GetV1WorkflowId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) GetV1Artifact(ctx context.Context, params *GetV1ArtifactParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -803,6 +1658,54 @@ func (c *Client) PatchV1ArtifactNamespaceNameTagTag(ctx context.Context, namespa
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetV1Queue(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1QueueRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1QueueName(ctx context.Context, name string, params *GetV1QueueNameParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1QueueNameRequest(c.Server, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1QueueNamePause(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1QueueNamePauseRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1QueueNameResume(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1QueueNameResumeRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DeleteV1RbacPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDeleteV1RbacPolicyRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -1007,8 +1910,8 @@ func (c *Client) PutV1RbacRoleRole(ctx context.Context, role string, body PutV1R
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetV1Task(ctx context.Context, params *GetV1TaskParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV1TaskRequest(c.Server, params)
+func (c *Client) GetV1Schedule(ctx context.Context, params *GetV1ScheduleParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1ScheduleRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1019,8 +1922,8 @@ func (c *Client) GetV1Task(ctx context.Context, params *GetV1TaskParams, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostV1TaskWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostV1TaskRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostV1ScheduleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1ScheduleRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1031,8 +1934,8 @@ func (c *Client) PostV1TaskWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostV1Task(ctx context.Context, body PostV1TaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostV1TaskRequest(c.Server, body)
+func (c *Client) PostV1Schedule(ctx context.Context, body PostV1ScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1ScheduleRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1043,8 +1946,8 @@ func (c *Client) PostV1Task(ctx context.Context, body PostV1TaskJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetV1TaskId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV1TaskIdRequest(c.Server, id)
+func (c *Client) DeleteV1ScheduleId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV1ScheduleIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1055,8 +1958,8 @@ func (c *Client) GetV1TaskId(ctx context.Context, id string, reqEditors ...Reque
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetV1TaskIdLogs(ctx context.Context, id string, params *GetV1TaskIdLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV1TaskIdLogsRequest(c.Server, id, params)
+func (c *Client) GetV1ScheduleId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1ScheduleIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1067,8 +1970,8 @@ func (c *Client) GetV1TaskIdLogs(ctx context.Context, id string, params *GetV1Ta
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetV1User(ctx context.Context, params *GetV1UserParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV1UserRequest(c.Server, params)
+func (c *Client) PatchV1ScheduleIdWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchV1ScheduleIdRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1079,8 +1982,8 @@ func (c *Client) GetV1User(ctx context.Context, params *GetV1UserParams, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteV1UserMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteV1UserMeRequest(c.Server)
+func (c *Client) PatchV1ScheduleId(ctx context.Context, id openapi_types.UUID, body PatchV1ScheduleIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchV1ScheduleIdRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1091,8 +1994,8 @@ func (c *Client) DeleteV1UserMe(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetV1UserMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV1UserMeRequest(c.Server)
+func (c *Client) GetV1ScheduleIdHistory(ctx context.Context, id openapi_types.UUID, params *GetV1ScheduleIdHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1ScheduleIdHistoryRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1103,8 +2006,8 @@ func (c *Client) GetV1UserMe(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchV1UserMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchV1UserMeRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostV1ScheduleIdPause(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1ScheduleIdPauseRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1115,8 +2018,8 @@ func (c *Client) PatchV1UserMeWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchV1UserMe(ctx context.Context, body PatchV1UserMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchV1UserMeRequest(c.Server, body)
+func (c *Client) PostV1ScheduleIdResume(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1ScheduleIdResumeRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1127,8 +2030,8 @@ func (c *Client) PatchV1UserMe(ctx context.Context, body PatchV1UserMeJSONReques
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteV1UserUsername(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteV1UserUsernameRequest(c.Server, username)
+func (c *Client) GetV1Task(ctx context.Context, params *GetV1TaskParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1TaskRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1139,8 +2042,8 @@ func (c *Client) DeleteV1UserUsername(ctx context.Context, username string, reqE
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetV1UserUsername(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV1UserUsernameRequest(c.Server, username)
+func (c *Client) PostV1TaskWithBody(ctx context.Context, params *PostV1TaskParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1TaskRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1151,8 +2054,8 @@ func (c *Client) GetV1UserUsername(ctx context.Context, username string, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) HeadV1UserUsername(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewHeadV1UserUsernameRequest(c.Server, username)
+func (c *Client) PostV1Task(ctx context.Context, params *PostV1TaskParams, body PostV1TaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1TaskRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1163,8 +2066,8 @@ func (c *Client) HeadV1UserUsername(ctx context.Context, username string, reqEdi
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchV1UserUsernameWithBody(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchV1UserUsernameRequestWithBody(c.Server, username, contentType, body)
+func (c *Client) GetV1TaskGroupId(ctx context.Context, id string, params *GetV1TaskGroupIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1TaskGroupIdRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1175,8 +2078,8 @@ func (c *Client) PatchV1UserUsernameWithBody(ctx context.Context, username strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchV1UserUsername(ctx context.Context, username string, body PatchV1UserUsernameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchV1UserUsernameRequest(c.Server, username, body)
+func (c *Client) PostV1TaskGroupIdCancel(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1TaskGroupIdCancelRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1187,8 +2090,8 @@ func (c *Client) PatchV1UserUsername(ctx context.Context, username string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutV1UserUsernameWithBody(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutV1UserUsernameRequestWithBody(c.Server, username, contentType, body)
+func (c *Client) PostV1TaskBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1TaskBatchRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1199,8 +2102,8 @@ func (c *Client) PutV1UserUsernameWithBody(ctx context.Context, username string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutV1UserUsername(ctx context.Context, username string, body PutV1UserUsernameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutV1UserUsernameRequest(c.Server, username, body)
+func (c *Client) PostV1TaskBatch(ctx context.Context, body PostV1TaskBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1TaskBatchRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1211,311 +2114,441 @@ func (c *Client) PutV1UserUsername(ctx context.Context, username string, body Pu
 	return c.Client.Do(req)
 }
 
-// NewGetV1ArtifactRequest generates requests for GetV1Artifact
-func NewGetV1ArtifactRequest(server string, params *GetV1ArtifactParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetV1TaskBatchId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1TaskBatchIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/artifact")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostV1TaskMapWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1TaskMapRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostV1ArtifactRawNamespaceNameRequestWithBody generates requests for PostV1ArtifactRawNamespaceName with any type of body
-func NewPostV1ArtifactRawNamespaceNameRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) PostV1TaskMap(ctx context.Context, body PostV1TaskMapJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1TaskMapRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetV1TaskMapId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1TaskMapIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/artifact/raw/%s/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostV1TaskRetryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1TaskRetryRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetV1ArtifactRawNamespaceNameHashHashRequest generates requests for GetV1ArtifactRawNamespaceNameHashHash
-func NewGetV1ArtifactRawNamespaceNameHashHashRequest(server string, namespace string, name string, hash string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) PostV1TaskRetry(ctx context.Context, body PostV1TaskRetryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1TaskRetryRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "hash", runtime.ParamLocationPath, hash)
+func (c *Client) DeleteV1TaskId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV1TaskIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetV1TaskId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1TaskIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/artifact/raw/%s/%s/hash/%s", pathParam0, pathParam1, pathParam2)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetV1TaskIdCallback(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1TaskIdCallbackRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PostV1TaskIdCancel(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1TaskIdCancelRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetV1ArtifactRawNamespaceNameTagTagRequest generates requests for GetV1ArtifactRawNamespaceNameTagTag
-func NewGetV1ArtifactRawNamespaceNameTagTagRequest(server string, namespace string, name string, tag string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) GetV1TaskIdLogs(ctx context.Context, id string, params *GetV1TaskIdLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1TaskIdLogsRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+func (c *Client) PostV1TaskIdLogsWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1TaskIdLogsRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "tag", runtime.ParamLocationPath, tag)
+func (c *Client) PostV1TaskIdLogs(ctx context.Context, id string, body PostV1TaskIdLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1TaskIdLogsRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetV1TaskIdLogsExport(ctx context.Context, id string, params *GetV1TaskIdLogsExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1TaskIdLogsExportRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/artifact/raw/%s/%s/tag/%s", pathParam0, pathParam1, pathParam2)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetV1TaskIdLogsStream(ctx context.Context, id string, params *GetV1TaskIdLogsStreamParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1TaskIdLogsStreamRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetV1TaskIdResult(ctx context.Context, id string, params *GetV1TaskIdResultParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1TaskIdResultRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetV1ArtifactNamespaceRequest generates requests for GetV1ArtifactNamespace
-func NewGetV1ArtifactNamespaceRequest(server string, namespace string, params *GetV1ArtifactNamespaceParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) GetV1TaskIdRetries(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1TaskIdRetriesRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostV1TaskIdRetry(ctx context.Context, id string, params *PostV1TaskIdRetryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1TaskIdRetryRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/artifact/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetV1TaskIdTransitions(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1TaskIdTransitionsRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetV1User(ctx context.Context, params *GetV1UserParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1UserRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetV1ArtifactNamespaceNameRequest generates requests for GetV1ArtifactNamespaceName
-func NewGetV1ArtifactNamespaceNameRequest(server string, namespace string, name string, params *GetV1ArtifactNamespaceNameParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) DeleteV1UserMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV1UserMeRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+func (c *Client) GetV1UserMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1UserMeRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PatchV1UserMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchV1UserMeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/artifact/%s/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PatchV1UserMe(ctx context.Context, body PatchV1UserMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchV1UserMeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteV1UserUsername(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV1UserUsernameRequest(c.Server, username)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1UserUsername(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1UserUsernameRequest(c.Server, username)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HeadV1UserUsername(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHeadV1UserUsernameRequest(c.Server, username)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchV1UserUsernameWithBody(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchV1UserUsernameRequestWithBody(c.Server, username, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchV1UserUsername(ctx context.Context, username string, body PatchV1UserUsernameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchV1UserUsernameRequest(c.Server, username, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutV1UserUsernameWithBody(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV1UserUsernameRequestWithBody(c.Server, username, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutV1UserUsername(ctx context.Context, username string, body PutV1UserUsernameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV1UserUsernameRequest(c.Server, username, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1Workflow(ctx context.Context, params *GetV1WorkflowParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1WorkflowRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1WorkflowWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1WorkflowRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1Workflow(ctx context.Context, body PostV1WorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1WorkflowRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1WorkflowId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1WorkflowIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetV1ArtifactRequest generates requests for GetV1Artifact
+func NewGetV1ArtifactRequest(server string, params *GetV1ArtifactParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/artifact")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
@@ -1566,8 +2599,8 @@ func NewGetV1ArtifactNamespaceNameRequest(server string, namespace string, name
 	return req, nil
 }
 
-// NewDeleteV1ArtifactNamespaceNameHashHashRequest generates requests for DeleteV1ArtifactNamespaceNameHashHash
-func NewDeleteV1ArtifactNamespaceNameHashHashRequest(server string, namespace string, name string, hash string) (*http.Request, error) {
+// NewPostV1ArtifactRawNamespaceNameRequestWithBody generates requests for PostV1ArtifactRawNamespaceName with any type of body
+func NewPostV1ArtifactRawNamespaceNameRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1584,19 +2617,12 @@ func NewDeleteV1ArtifactNamespaceNameHashHashRequest(server string, namespace st
 		return nil, err
 	}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "hash", runtime.ParamLocationPath, hash)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/artifact/%s/%s/hash/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/v1/artifact/raw/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1606,16 +2632,18 @@ func NewDeleteV1ArtifactNamespaceNameHashHashRequest(server string, namespace st
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetV1ArtifactNamespaceNameHashHashRequest generates requests for GetV1ArtifactNamespaceNameHashHash
-func NewGetV1ArtifactNamespaceNameHashHashRequest(server string, namespace string, name string, hash string) (*http.Request, error) {
+// NewGetV1ArtifactRawNamespaceNameHashHashRequest generates requests for GetV1ArtifactRawNamespaceNameHashHash
+func NewGetV1ArtifactRawNamespaceNameHashHashRequest(server string, namespace string, name string, hash string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1644,7 +2672,7 @@ func NewGetV1ArtifactNamespaceNameHashHashRequest(server string, namespace strin
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/artifact/%s/%s/hash/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/v1/artifact/raw/%s/%s/hash/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1662,19 +2690,8 @@ func NewGetV1ArtifactNamespaceNameHashHashRequest(server string, namespace strin
 	return req, nil
 }
 
-// NewPatchV1ArtifactNamespaceNameHashHashRequest calls the generic PatchV1ArtifactNamespaceNameHashHash builder with application/json body
-func NewPatchV1ArtifactNamespaceNameHashHashRequest(server string, namespace string, name string, hash string, body PatchV1ArtifactNamespaceNameHashHashJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPatchV1ArtifactNamespaceNameHashHashRequestWithBody(server, namespace, name, hash, "application/json", bodyReader)
-}
-
-// NewPatchV1ArtifactNamespaceNameHashHashRequestWithBody generates requests for PatchV1ArtifactNamespaceNameHashHash with any type of body
-func NewPatchV1ArtifactNamespaceNameHashHashRequestWithBody(server string, namespace string, name string, hash string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetV1ArtifactRawNamespaceNameTagTagRequest generates requests for GetV1ArtifactRawNamespaceNameTagTag
+func NewGetV1ArtifactRawNamespaceNameTagTagRequest(server string, namespace string, name string, tag string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1693,7 +2710,7 @@ func NewPatchV1ArtifactNamespaceNameHashHashRequestWithBody(server string, names
 
 	var pathParam2 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "hash", runtime.ParamLocationPath, hash)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "tag", runtime.ParamLocationPath, tag)
 	if err != nil {
 		return nil, err
 	}
@@ -1703,7 +2720,7 @@ func NewPatchV1ArtifactNamespaceNameHashHashRequestWithBody(server string, names
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/artifact/%s/%s/hash/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/v1/artifact/raw/%s/%s/tag/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1713,18 +2730,16 @@ func NewPatchV1ArtifactNamespaceNameHashHashRequestWithBody(server string, names
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteV1ArtifactNamespaceNameTagTagRequest generates requests for DeleteV1ArtifactNamespaceNameTagTag
-func NewDeleteV1ArtifactNamespaceNameTagTagRequest(server string, namespace string, name string, tag string) (*http.Request, error) {
+// NewGetV1ArtifactNamespaceRequest generates requests for GetV1ArtifactNamespace
+func NewGetV1ArtifactNamespaceRequest(server string, namespace string, params *GetV1ArtifactNamespaceParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1734,26 +2749,12 @@ func NewDeleteV1ArtifactNamespaceNameTagTagRequest(server string, namespace stri
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "tag", runtime.ParamLocationPath, tag)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/artifact/%s/%s/tag/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/v1/artifact/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1763,7 +2764,45 @@ func NewDeleteV1ArtifactNamespaceNameTagTagRequest(server string, namespace stri
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1771,8 +2810,8 @@ func NewDeleteV1ArtifactNamespaceNameTagTagRequest(server string, namespace stri
 	return req, nil
 }
 
-// NewGetV1ArtifactNamespaceNameTagTagRequest generates requests for GetV1ArtifactNamespaceNameTagTag
-func NewGetV1ArtifactNamespaceNameTagTagRequest(server string, namespace string, name string, tag string) (*http.Request, error) {
+// NewGetV1ArtifactNamespaceNameRequest generates requests for GetV1ArtifactNamespaceName
+func NewGetV1ArtifactNamespaceNameRequest(server string, namespace string, name string, params *GetV1ArtifactNamespaceNameParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1789,19 +2828,12 @@ func NewGetV1ArtifactNamespaceNameTagTagRequest(server string, namespace string,
 		return nil, err
 	}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "tag", runtime.ParamLocationPath, tag)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/artifact/%s/%s/tag/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/v1/artifact/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1811,6 +2843,44 @@ func NewGetV1ArtifactNamespaceNameTagTagRequest(server string, namespace string,
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1819,19 +2889,8 @@ func NewGetV1ArtifactNamespaceNameTagTagRequest(server string, namespace string,
 	return req, nil
 }
 
-// NewPatchV1ArtifactNamespaceNameTagTagRequest calls the generic PatchV1ArtifactNamespaceNameTagTag builder with application/json body
-func NewPatchV1ArtifactNamespaceNameTagTagRequest(server string, namespace string, name string, tag string, body PatchV1ArtifactNamespaceNameTagTagJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPatchV1ArtifactNamespaceNameTagTagRequestWithBody(server, namespace, name, tag, "application/json", bodyReader)
-}
-
-// NewPatchV1ArtifactNamespaceNameTagTagRequestWithBody generates requests for PatchV1ArtifactNamespaceNameTagTag with any type of body
-func NewPatchV1ArtifactNamespaceNameTagTagRequestWithBody(server string, namespace string, name string, tag string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteV1ArtifactNamespaceNameHashHashRequest generates requests for DeleteV1ArtifactNamespaceNameHashHash
+func NewDeleteV1ArtifactNamespaceNameHashHashRequest(server string, namespace string, name string, hash string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1850,7 +2909,7 @@ func NewPatchV1ArtifactNamespaceNameTagTagRequestWithBody(server string, namespa
 
 	var pathParam2 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "tag", runtime.ParamLocationPath, tag)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "hash", runtime.ParamLocationPath, hash)
 	if err != nil {
 		return nil, err
 	}
@@ -1860,7 +2919,7 @@ func NewPatchV1ArtifactNamespaceNameTagTagRequestWithBody(server string, namespa
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/artifact/%s/%s/tag/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/v1/artifact/%s/%s/hash/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1870,66 +2929,45 @@ func NewPatchV1ArtifactNamespaceNameTagTagRequestWithBody(server string, namespa
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteV1RbacPolicyRequest calls the generic DeleteV1RbacPolicy builder with application/json body
-func NewDeleteV1RbacPolicyRequest(server string, body DeleteV1RbacPolicyJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewDeleteV1RbacPolicyRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewDeleteV1RbacPolicyRequestWithBody generates requests for DeleteV1RbacPolicy with any type of body
-func NewDeleteV1RbacPolicyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetV1ArtifactNamespaceNameHashHashRequest generates requests for GetV1ArtifactNamespaceNameHashHash
+func NewGetV1ArtifactNamespaceNameHashHashRequest(server string, namespace string, name string, hash string) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/rbac/policy")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	var pathParam1 string
 
-	queryURL, err := serverURL.Parse(operationPath)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "hash", runtime.ParamLocationPath, hash)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewGetV1RbacPolicyRequest generates requests for GetV1RbacPolicy
-func NewGetV1RbacPolicyRequest(server string, params *GetV1RbacPolicyParams) (*http.Request, error) {
-	var err error
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/rbac/policy")
+	operationPath := fmt.Sprintf("/v1/artifact/%s/%s/hash/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1939,92 +2977,6 @@ func NewGetV1RbacPolicyRequest(server string, params *GetV1RbacPolicyParams) (*h
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Role != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "role", runtime.ParamLocationQuery, *params.Role); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.ResourceGroup != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resource-group", runtime.ParamLocationQuery, *params.ResourceGroup); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Method != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "method", runtime.ParamLocationQuery, *params.Method); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2033,27 +2985,48 @@ func NewGetV1RbacPolicyRequest(server string, params *GetV1RbacPolicyParams) (*h
 	return req, nil
 }
 
-// NewPutV1RbacPolicyRequest calls the generic PutV1RbacPolicy builder with application/json body
-func NewPutV1RbacPolicyRequest(server string, body PutV1RbacPolicyJSONRequestBody) (*http.Request, error) {
+// NewPatchV1ArtifactNamespaceNameHashHashRequest calls the generic PatchV1ArtifactNamespaceNameHashHash builder with application/json body
+func NewPatchV1ArtifactNamespaceNameHashHashRequest(server string, namespace string, name string, hash string, body PatchV1ArtifactNamespaceNameHashHashJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPutV1RbacPolicyRequestWithBody(server, "application/json", bodyReader)
+	return NewPatchV1ArtifactNamespaceNameHashHashRequestWithBody(server, namespace, name, hash, "application/json", bodyReader)
 }
 
-// NewPutV1RbacPolicyRequestWithBody generates requests for PutV1RbacPolicy with any type of body
-func NewPutV1RbacPolicyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPatchV1ArtifactNamespaceNameHashHashRequestWithBody generates requests for PatchV1ArtifactNamespaceNameHashHash with any type of body
+func NewPatchV1ArtifactNamespaceNameHashHashRequestWithBody(server string, namespace string, name string, hash string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "hash", runtime.ParamLocationPath, hash)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/rbac/policy")
+	operationPath := fmt.Sprintf("/v1/artifact/%s/%s/hash/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2063,7 +3036,7 @@ func NewPutV1RbacPolicyRequestWithBody(server string, contentType string, body i
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2073,94 +3046,27 @@ func NewPutV1RbacPolicyRequestWithBody(server string, contentType string, body i
 	return req, nil
 }
 
-// NewGetV1RbacResourceGroupRequest generates requests for GetV1RbacResourceGroup
-func NewGetV1RbacResourceGroupRequest(server string, params *GetV1RbacResourceGroupParams) (*http.Request, error) {
+// NewDeleteV1ArtifactNamespaceNameTagTagRequest generates requests for DeleteV1ArtifactNamespaceNameTagTag
+func NewDeleteV1ArtifactNamespaceNameTagTagRequest(server string, namespace string, name string, tag string) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/rbac/resource-group")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	var pathParam1 string
 
-	queryURL, err := serverURL.Parse(operationPath)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Limit != nil {
+	var pathParam2 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Role != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "role", runtime.ParamLocationQuery, *params.Role); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewDeleteV1RbacResourceGroupResourceGroupRequest generates requests for DeleteV1RbacResourceGroupResourceGroup
-func NewDeleteV1RbacResourceGroupResourceGroupRequest(server string, resourceGroup string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceGroup", runtime.ParamLocationPath, resourceGroup)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "tag", runtime.ParamLocationPath, tag)
 	if err != nil {
 		return nil, err
 	}
@@ -2170,7 +3076,7 @@ func NewDeleteV1RbacResourceGroupResourceGroupRequest(server string, resourceGro
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/rbac/resource-group/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/artifact/%s/%s/tag/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2188,47 +3094,27 @@ func NewDeleteV1RbacResourceGroupResourceGroupRequest(server string, resourceGro
 	return req, nil
 }
 
-// NewGetV1RbacResourceGroupResourceGroupRequest generates requests for GetV1RbacResourceGroupResourceGroup
-func NewGetV1RbacResourceGroupResourceGroupRequest(server string, resourceGroup string) (*http.Request, error) {
+// NewGetV1ArtifactNamespaceNameTagTagRequest generates requests for GetV1ArtifactNamespaceNameTagTag
+func NewGetV1ArtifactNamespaceNameTagTagRequest(server string, namespace string, name string, tag string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceGroup", runtime.ParamLocationPath, resourceGroup)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/rbac/resource-group/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	var pathParam1 string
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewHeadV1RbacResourceGroupResourceGroupRequest generates requests for HeadV1RbacResourceGroupResourceGroup
-func NewHeadV1RbacResourceGroupResourceGroupRequest(server string, resourceGroup string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
+	var pathParam2 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceGroup", runtime.ParamLocationPath, resourceGroup)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "tag", runtime.ParamLocationPath, tag)
 	if err != nil {
 		return nil, err
 	}
@@ -2238,7 +3124,7 @@ func NewHeadV1RbacResourceGroupResourceGroupRequest(server string, resourceGroup
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/rbac/resource-group/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/artifact/%s/%s/tag/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2248,7 +3134,7 @@ func NewHeadV1RbacResourceGroupResourceGroupRequest(server string, resourceGroup
 		return nil, err
 	}
 
-	req, err := http.NewRequest("HEAD", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2256,24 +3142,38 @@ func NewHeadV1RbacResourceGroupResourceGroupRequest(server string, resourceGroup
 	return req, nil
 }
 
-// NewPutV1RbacResourceGroupResourceGroupRequest calls the generic PutV1RbacResourceGroupResourceGroup builder with application/json body
-func NewPutV1RbacResourceGroupResourceGroupRequest(server string, resourceGroup string, body PutV1RbacResourceGroupResourceGroupJSONRequestBody) (*http.Request, error) {
+// NewPatchV1ArtifactNamespaceNameTagTagRequest calls the generic PatchV1ArtifactNamespaceNameTagTag builder with application/json body
+func NewPatchV1ArtifactNamespaceNameTagTagRequest(server string, namespace string, name string, tag string, body PatchV1ArtifactNamespaceNameTagTagJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPutV1RbacResourceGroupResourceGroupRequestWithBody(server, resourceGroup, "application/json", bodyReader)
+	return NewPatchV1ArtifactNamespaceNameTagTagRequestWithBody(server, namespace, name, tag, "application/json", bodyReader)
 }
 
-// NewPutV1RbacResourceGroupResourceGroupRequestWithBody generates requests for PutV1RbacResourceGroupResourceGroup with any type of body
-func NewPutV1RbacResourceGroupResourceGroupRequestWithBody(server string, resourceGroup string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPatchV1ArtifactNamespaceNameTagTagRequestWithBody generates requests for PatchV1ArtifactNamespaceNameTagTag with any type of body
+func NewPatchV1ArtifactNamespaceNameTagTagRequestWithBody(server string, namespace string, name string, tag string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceGroup", runtime.ParamLocationPath, resourceGroup)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "tag", runtime.ParamLocationPath, tag)
 	if err != nil {
 		return nil, err
 	}
@@ -2283,7 +3183,7 @@ func NewPutV1RbacResourceGroupResourceGroupRequestWithBody(server string, resour
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/rbac/resource-group/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/artifact/%s/%s/tag/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2293,7 +3193,7 @@ func NewPutV1RbacResourceGroupResourceGroupRequestWithBody(server string, resour
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2303,8 +3203,8 @@ func NewPutV1RbacResourceGroupResourceGroupRequestWithBody(server string, resour
 	return req, nil
 }
 
-// NewGetV1RbacRoleRequest generates requests for GetV1RbacRole
-func NewGetV1RbacRoleRequest(server string, params *GetV1RbacRoleParams) (*http.Request, error) {
+// NewGetV1QueueRequest generates requests for GetV1Queue
+func NewGetV1QueueRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2312,7 +3212,7 @@ func NewGetV1RbacRoleRequest(server string, params *GetV1RbacRoleParams) (*http.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/rbac/role")
+	operationPath := fmt.Sprintf("/v1/queue")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2322,60 +3222,6 @@ func NewGetV1RbacRoleRequest(server string, params *GetV1RbacRoleParams) (*http.
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Role != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "role", runtime.ParamLocationQuery, *params.Role); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2384,13 +3230,13 @@ func NewGetV1RbacRoleRequest(server string, params *GetV1RbacRoleParams) (*http.
 	return req, nil
 }
 
-// NewDeleteV1RbacRoleRoleRequest generates requests for DeleteV1RbacRoleRole
-func NewDeleteV1RbacRoleRoleRequest(server string, role string) (*http.Request, error) {
+// NewGetV1QueueNameRequest generates requests for GetV1QueueName
+func NewGetV1QueueNameRequest(server string, name string, params *GetV1QueueNameParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "role", runtime.ParamLocationPath, role)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -2400,7 +3246,7 @@ func NewDeleteV1RbacRoleRoleRequest(server string, role string) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/rbac/role/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/queue/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2410,7 +3256,29 @@ func NewDeleteV1RbacRoleRoleRequest(server string, role string) (*http.Request,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Days != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "days", runtime.ParamLocationQuery, *params.Days); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2418,13 +3286,13 @@ func NewDeleteV1RbacRoleRoleRequest(server string, role string) (*http.Request,
 	return req, nil
 }
 
-// NewGetV1RbacRoleRoleRequest generates requests for GetV1RbacRoleRole
-func NewGetV1RbacRoleRoleRequest(server string, role string) (*http.Request, error) {
+// NewPostV1QueueNamePauseRequest generates requests for PostV1QueueNamePause
+func NewPostV1QueueNamePauseRequest(server string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "role", runtime.ParamLocationPath, role)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -2434,7 +3302,7 @@ func NewGetV1RbacRoleRoleRequest(server string, role string) (*http.Request, err
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/rbac/role/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/queue/%s/pause", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2444,7 +3312,7 @@ func NewGetV1RbacRoleRoleRequest(server string, role string) (*http.Request, err
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2452,13 +3320,13 @@ func NewGetV1RbacRoleRoleRequest(server string, role string) (*http.Request, err
 	return req, nil
 }
 
-// NewHeadV1RbacRoleRoleRequest generates requests for HeadV1RbacRoleRole
-func NewHeadV1RbacRoleRoleRequest(server string, role string) (*http.Request, error) {
+// NewPostV1QueueNameResumeRequest generates requests for PostV1QueueNameResume
+func NewPostV1QueueNameResumeRequest(server string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "role", runtime.ParamLocationPath, role)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -2468,7 +3336,7 @@ func NewHeadV1RbacRoleRoleRequest(server string, role string) (*http.Request, er
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/rbac/role/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/queue/%s/resume", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2478,7 +3346,7 @@ func NewHeadV1RbacRoleRoleRequest(server string, role string) (*http.Request, er
 		return nil, err
 	}
 
-	req, err := http.NewRequest("HEAD", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2486,34 +3354,27 @@ func NewHeadV1RbacRoleRoleRequest(server string, role string) (*http.Request, er
 	return req, nil
 }
 
-// NewPutV1RbacRoleRoleRequest calls the generic PutV1RbacRoleRole builder with application/json body
-func NewPutV1RbacRoleRoleRequest(server string, role string, body PutV1RbacRoleRoleJSONRequestBody) (*http.Request, error) {
+// NewDeleteV1RbacPolicyRequest calls the generic DeleteV1RbacPolicy builder with application/json body
+func NewDeleteV1RbacPolicyRequest(server string, body DeleteV1RbacPolicyJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPutV1RbacRoleRoleRequestWithBody(server, role, "application/json", bodyReader)
+	return NewDeleteV1RbacPolicyRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPutV1RbacRoleRoleRequestWithBody generates requests for PutV1RbacRoleRole with any type of body
-func NewPutV1RbacRoleRoleRequestWithBody(server string, role string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteV1RbacPolicyRequestWithBody generates requests for DeleteV1RbacPolicy with any type of body
+func NewDeleteV1RbacPolicyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "role", runtime.ParamLocationPath, role)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/rbac/role/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/rbac/policy")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2523,7 +3384,7 @@ func NewPutV1RbacRoleRoleRequestWithBody(server string, role string, contentType
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2533,8 +3394,8 @@ func NewPutV1RbacRoleRoleRequestWithBody(server string, role string, contentType
 	return req, nil
 }
 
-// NewGetV1TaskRequest generates requests for GetV1Task
-func NewGetV1TaskRequest(server string, params *GetV1TaskParams) (*http.Request, error) {
+// NewGetV1RbacPolicyRequest generates requests for GetV1RbacPolicy
+func NewGetV1RbacPolicyRequest(server string, params *GetV1RbacPolicyParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2542,7 +3403,7 @@ func NewGetV1TaskRequest(server string, params *GetV1TaskParams) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/task")
+	operationPath := fmt.Sprintf("/v1/rbac/policy")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2587,9 +3448,41 @@ func NewGetV1TaskRequest(server string, params *GetV1TaskParams) (*http.Request,
 
 		}
 
-		if params.State != nil {
+		if params.Role != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, *params.State); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "role", runtime.ParamLocationQuery, *params.Role); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ResourceGroup != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resource-group", runtime.ParamLocationQuery, *params.ResourceGroup); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Method != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "method", runtime.ParamLocationQuery, *params.Method); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2614,19 +3507,19 @@ func NewGetV1TaskRequest(server string, params *GetV1TaskParams) (*http.Request,
 	return req, nil
 }
 
-// NewPostV1TaskRequest calls the generic PostV1Task builder with application/json body
-func NewPostV1TaskRequest(server string, body PostV1TaskJSONRequestBody) (*http.Request, error) {
+// NewPutV1RbacPolicyRequest calls the generic PutV1RbacPolicy builder with application/json body
+func NewPutV1RbacPolicyRequest(server string, body PutV1RbacPolicyJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostV1TaskRequestWithBody(server, "application/json", bodyReader)
+	return NewPutV1RbacPolicyRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPostV1TaskRequestWithBody generates requests for PostV1Task with any type of body
-func NewPostV1TaskRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPutV1RbacPolicyRequestWithBody generates requests for PutV1RbacPolicy with any type of body
+func NewPutV1RbacPolicyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2634,7 +3527,7 @@ func NewPostV1TaskRequestWithBody(server string, contentType string, body io.Rea
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/task")
+	operationPath := fmt.Sprintf("/v1/rbac/policy")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2644,7 +3537,7 @@ func NewPostV1TaskRequestWithBody(server string, contentType string, body io.Rea
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2654,57 +3547,16 @@ func NewPostV1TaskRequestWithBody(server string, contentType string, body io.Rea
 	return req, nil
 }
 
-// NewGetV1TaskIdRequest generates requests for GetV1TaskId
-func NewGetV1TaskIdRequest(server string, id string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/v1/task/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewGetV1TaskIdLogsRequest generates requests for GetV1TaskIdLogs
-func NewGetV1TaskIdLogsRequest(server string, id string, params *GetV1TaskIdLogsParams) (*http.Request, error) {
+// NewGetV1RbacResourceGroupRequest generates requests for GetV1RbacResourceGroup
+func NewGetV1RbacResourceGroupRequest(server string, params *GetV1RbacResourceGroupParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/task/%s/logs", pathParam0)
+	operationPath := fmt.Sprintf("/v1/rbac/resource-group")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2717,25 +3569,9 @@ func NewGetV1TaskIdLogsRequest(server string, id string, params *GetV1TaskIdLogs
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Level != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "level", runtime.ParamLocationQuery, *params.Level); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Issuer != nil {
+		if params.Limit != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "issuer", runtime.ParamLocationQuery, *params.Issuer); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2749,9 +3585,9 @@ func NewGetV1TaskIdLogsRequest(server string, id string, params *GetV1TaskIdLogs
 
 		}
 
-		if params.TimeRangeFrom != nil {
+		if params.Offset != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "time-range-from", runtime.ParamLocationQuery, *params.TimeRangeFrom); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2765,9 +3601,9 @@ func NewGetV1TaskIdLogsRequest(server string, id string, params *GetV1TaskIdLogs
 
 		}
 
-		if params.TimeRangeTo != nil {
+		if params.Role != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "time-range-to", runtime.ParamLocationQuery, *params.TimeRangeTo); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "role", runtime.ParamLocationQuery, *params.Role); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2792,16 +3628,23 @@ func NewGetV1TaskIdLogsRequest(server string, id string, params *GetV1TaskIdLogs
 	return req, nil
 }
 
-// NewGetV1UserRequest generates requests for GetV1User
-func NewGetV1UserRequest(server string, params *GetV1UserParams) (*http.Request, error) {
+// NewDeleteV1RbacResourceGroupResourceGroupRequest generates requests for DeleteV1RbacResourceGroupResourceGroup
+func NewDeleteV1RbacResourceGroupResourceGroupRequest(server string, resourceGroup string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceGroup", runtime.ParamLocationPath, resourceGroup)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/user")
+	operationPath := fmt.Sprintf("/v1/rbac/resource-group/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2811,77 +3654,7 @@ func NewGetV1UserRequest(server string, params *GetV1UserParams) (*http.Request,
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Name != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.DisplayName != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "display-name", runtime.ParamLocationQuery, *params.DisplayName); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2889,16 +3662,23 @@ func NewGetV1UserRequest(server string, params *GetV1UserParams) (*http.Request,
 	return req, nil
 }
 
-// NewDeleteV1UserMeRequest generates requests for DeleteV1UserMe
-func NewDeleteV1UserMeRequest(server string) (*http.Request, error) {
+// NewGetV1RbacResourceGroupResourceGroupRequest generates requests for GetV1RbacResourceGroupResourceGroup
+func NewGetV1RbacResourceGroupResourceGroupRequest(server string, resourceGroup string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceGroup", runtime.ParamLocationPath, resourceGroup)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/user/me")
+	operationPath := fmt.Sprintf("/v1/rbac/resource-group/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2908,7 +3688,7 @@ func NewDeleteV1UserMeRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2916,16 +3696,23 @@ func NewDeleteV1UserMeRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetV1UserMeRequest generates requests for GetV1UserMe
-func NewGetV1UserMeRequest(server string) (*http.Request, error) {
+// NewHeadV1RbacResourceGroupResourceGroupRequest generates requests for HeadV1RbacResourceGroupResourceGroup
+func NewHeadV1RbacResourceGroupResourceGroupRequest(server string, resourceGroup string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceGroup", runtime.ParamLocationPath, resourceGroup)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/user/me")
+	operationPath := fmt.Sprintf("/v1/rbac/resource-group/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2935,7 +3722,7 @@ func NewGetV1UserMeRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("HEAD", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2943,27 +3730,34 @@ func NewGetV1UserMeRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewPatchV1UserMeRequest calls the generic PatchV1UserMe builder with application/json body
-func NewPatchV1UserMeRequest(server string, body PatchV1UserMeJSONRequestBody) (*http.Request, error) {
+// NewPutV1RbacResourceGroupResourceGroupRequest calls the generic PutV1RbacResourceGroupResourceGroup builder with application/json body
+func NewPutV1RbacResourceGroupResourceGroupRequest(server string, resourceGroup string, body PutV1RbacResourceGroupResourceGroupJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPatchV1UserMeRequestWithBody(server, "application/json", bodyReader)
+	return NewPutV1RbacResourceGroupResourceGroupRequestWithBody(server, resourceGroup, "application/json", bodyReader)
 }
 
-// NewPatchV1UserMeRequestWithBody generates requests for PatchV1UserMe with any type of body
-func NewPatchV1UserMeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPutV1RbacResourceGroupResourceGroupRequestWithBody generates requests for PutV1RbacResourceGroupResourceGroup with any type of body
+func NewPutV1RbacResourceGroupResourceGroupRequestWithBody(server string, resourceGroup string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceGroup", runtime.ParamLocationPath, resourceGroup)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/user/me")
+	operationPath := fmt.Sprintf("/v1/rbac/resource-group/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2973,7 +3767,7 @@ func NewPatchV1UserMeRequestWithBody(server string, contentType string, body io.
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2983,13 +3777,94 @@ func NewPatchV1UserMeRequestWithBody(server string, contentType string, body io.
 	return req, nil
 }
 
-// NewDeleteV1UserUsernameRequest generates requests for DeleteV1UserUsername
-func NewDeleteV1UserUsernameRequest(server string, username string) (*http.Request, error) {
+// NewGetV1RbacRoleRequest generates requests for GetV1RbacRole
+func NewGetV1RbacRoleRequest(server string, params *GetV1RbacRoleParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/rbac/role")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Role != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "role", runtime.ParamLocationQuery, *params.Role); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteV1RbacRoleRoleRequest generates requests for DeleteV1RbacRoleRole
+func NewDeleteV1RbacRoleRoleRequest(server string, role string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "role", runtime.ParamLocationPath, role)
 	if err != nil {
 		return nil, err
 	}
@@ -2999,7 +3874,7 @@ func NewDeleteV1UserUsernameRequest(server string, username string) (*http.Reque
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/user/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/rbac/role/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3017,13 +3892,13 @@ func NewDeleteV1UserUsernameRequest(server string, username string) (*http.Reque
 	return req, nil
 }
 
-// NewGetV1UserUsernameRequest generates requests for GetV1UserUsername
-func NewGetV1UserUsernameRequest(server string, username string) (*http.Request, error) {
+// NewGetV1RbacRoleRoleRequest generates requests for GetV1RbacRoleRole
+func NewGetV1RbacRoleRoleRequest(server string, role string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "role", runtime.ParamLocationPath, role)
 	if err != nil {
 		return nil, err
 	}
@@ -3033,7 +3908,7 @@ func NewGetV1UserUsernameRequest(server string, username string) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/user/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/rbac/role/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3051,13 +3926,13 @@ func NewGetV1UserUsernameRequest(server string, username string) (*http.Request,
 	return req, nil
 }
 
-// NewHeadV1UserUsernameRequest generates requests for HeadV1UserUsername
-func NewHeadV1UserUsernameRequest(server string, username string) (*http.Request, error) {
+// NewHeadV1RbacRoleRoleRequest generates requests for HeadV1RbacRoleRole
+func NewHeadV1RbacRoleRoleRequest(server string, role string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "role", runtime.ParamLocationPath, role)
 	if err != nil {
 		return nil, err
 	}
@@ -3067,7 +3942,7 @@ func NewHeadV1UserUsernameRequest(server string, username string) (*http.Request
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/user/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/rbac/role/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3085,24 +3960,24 @@ func NewHeadV1UserUsernameRequest(server string, username string) (*http.Request
 	return req, nil
 }
 
-// NewPatchV1UserUsernameRequest calls the generic PatchV1UserUsername builder with application/json body
-func NewPatchV1UserUsernameRequest(server string, username string, body PatchV1UserUsernameJSONRequestBody) (*http.Request, error) {
+// NewPutV1RbacRoleRoleRequest calls the generic PutV1RbacRoleRole builder with application/json body
+func NewPutV1RbacRoleRoleRequest(server string, role string, body PutV1RbacRoleRoleJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPatchV1UserUsernameRequestWithBody(server, username, "application/json", bodyReader)
+	return NewPutV1RbacRoleRoleRequestWithBody(server, role, "application/json", bodyReader)
 }
 
-// NewPatchV1UserUsernameRequestWithBody generates requests for PatchV1UserUsername with any type of body
-func NewPatchV1UserUsernameRequestWithBody(server string, username string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPutV1RbacRoleRoleRequestWithBody generates requests for PutV1RbacRoleRole with any type of body
+func NewPutV1RbacRoleRoleRequestWithBody(server string, role string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "role", runtime.ParamLocationPath, role)
 	if err != nil {
 		return nil, err
 	}
@@ -3112,7 +3987,7 @@ func NewPatchV1UserUsernameRequestWithBody(server string, username string, conte
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/user/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/rbac/role/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3122,7 +3997,7 @@ func NewPatchV1UserUsernameRequestWithBody(server string, username string, conte
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3132,34 +4007,92 @@ func NewPatchV1UserUsernameRequestWithBody(server string, username string, conte
 	return req, nil
 }
 
-// NewPutV1UserUsernameRequest calls the generic PutV1UserUsername builder with application/json body
-func NewPutV1UserUsernameRequest(server string, username string, body PutV1UserUsernameJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewGetV1ScheduleRequest generates requests for GetV1Schedule
+func NewGetV1ScheduleRequest(server string, params *GetV1ScheduleParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutV1UserUsernameRequestWithBody(server, username, "application/json", bodyReader)
-}
 
-// NewPutV1UserUsernameRequestWithBody generates requests for PutV1UserUsername with any type of body
-func NewPutV1UserUsernameRequestWithBody(server string, username string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	operationPath := fmt.Sprintf("/v1/schedule")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	var pathParam0 string
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1ScheduleRequest calls the generic PostV1Schedule builder with application/json body
+func NewPostV1ScheduleRequest(server string, body PostV1ScheduleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV1ScheduleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostV1ScheduleRequestWithBody generates requests for PostV1Schedule with any type of body
+func NewPostV1ScheduleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/user/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/schedule")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3169,7 +4102,7 @@ func NewPutV1UserUsernameRequestWithBody(server string, username string, content
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3179,1542 +4112,6042 @@ func NewPutV1UserUsernameRequestWithBody(server string, username string, content
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewDeleteV1ScheduleIdRequest generates requests for DeleteV1ScheduleId
+func NewDeleteV1ScheduleIdRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	operationPath := fmt.Sprintf("/v1/schedule/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetV1ArtifactWithResponse request
-	GetV1ArtifactWithResponse(ctx context.Context, params *GetV1ArtifactParams, reqEditors ...RequestEditorFn) (*GetV1ArtifactResponse, error)
+	return req, nil
+}
 
-	// PostV1ArtifactRawNamespaceNameWithBodyWithResponse request with any body
-	PostV1ArtifactRawNamespaceNameWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1ArtifactRawNamespaceNameResponse, error)
+// NewGetV1ScheduleIdRequest generates requests for GetV1ScheduleId
+func NewGetV1ScheduleIdRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-	// GetV1ArtifactRawNamespaceNameHashHashWithResponse request
-	GetV1ArtifactRawNamespaceNameHashHashWithResponse(ctx context.Context, namespace string, name string, hash string, reqEditors ...RequestEditorFn) (*GetV1ArtifactRawNamespaceNameHashHashResponse, error)
+	var pathParam0 string
 
-	// GetV1ArtifactRawNamespaceNameTagTagWithResponse request
-	GetV1ArtifactRawNamespaceNameTagTagWithResponse(ctx context.Context, namespace string, name string, tag string, reqEditors ...RequestEditorFn) (*GetV1ArtifactRawNamespaceNameTagTagResponse, error)
-
-	// GetV1ArtifactNamespaceWithResponse request
-	GetV1ArtifactNamespaceWithResponse(ctx context.Context, namespace string, params *GetV1ArtifactNamespaceParams, reqEditors ...RequestEditorFn) (*GetV1ArtifactNamespaceResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetV1ArtifactNamespaceNameWithResponse request
-	GetV1ArtifactNamespaceNameWithResponse(ctx context.Context, namespace string, name string, params *GetV1ArtifactNamespaceNameParams, reqEditors ...RequestEditorFn) (*GetV1ArtifactNamespaceNameResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteV1ArtifactNamespaceNameHashHashWithResponse request
-	DeleteV1ArtifactNamespaceNameHashHashWithResponse(ctx context.Context, namespace string, name string, hash string, reqEditors ...RequestEditorFn) (*DeleteV1ArtifactNamespaceNameHashHashResponse, error)
+	operationPath := fmt.Sprintf("/v1/schedule/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetV1ArtifactNamespaceNameHashHashWithResponse request
-	GetV1ArtifactNamespaceNameHashHashWithResponse(ctx context.Context, namespace string, name string, hash string, reqEditors ...RequestEditorFn) (*GetV1ArtifactNamespaceNameHashHashResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PatchV1ArtifactNamespaceNameHashHashWithBodyWithResponse request with any body
-	PatchV1ArtifactNamespaceNameHashHashWithBodyWithResponse(ctx context.Context, namespace string, name string, hash string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1ArtifactNamespaceNameHashHashResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	PatchV1ArtifactNamespaceNameHashHashWithResponse(ctx context.Context, namespace string, name string, hash string, body PatchV1ArtifactNamespaceNameHashHashJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1ArtifactNamespaceNameHashHashResponse, error)
+	return req, nil
+}
 
-	// DeleteV1ArtifactNamespaceNameTagTagWithResponse request
-	DeleteV1ArtifactNamespaceNameTagTagWithResponse(ctx context.Context, namespace string, name string, tag string, reqEditors ...RequestEditorFn) (*DeleteV1ArtifactNamespaceNameTagTagResponse, error)
+// NewPatchV1ScheduleIdRequest calls the generic PatchV1ScheduleId builder with application/json body
+func NewPatchV1ScheduleIdRequest(server string, id openapi_types.UUID, body PatchV1ScheduleIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchV1ScheduleIdRequestWithBody(server, id, "application/json", bodyReader)
+}
 
-	// GetV1ArtifactNamespaceNameTagTagWithResponse request
-	GetV1ArtifactNamespaceNameTagTagWithResponse(ctx context.Context, namespace string, name string, tag string, reqEditors ...RequestEditorFn) (*GetV1ArtifactNamespaceNameTagTagResponse, error)
+// NewPatchV1ScheduleIdRequestWithBody generates requests for PatchV1ScheduleId with any type of body
+func NewPatchV1ScheduleIdRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// PatchV1ArtifactNamespaceNameTagTagWithBodyWithResponse request with any body
-	PatchV1ArtifactNamespaceNameTagTagWithBodyWithResponse(ctx context.Context, namespace string, name string, tag string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1ArtifactNamespaceNameTagTagResponse, error)
+	var pathParam0 string
 
-	PatchV1ArtifactNamespaceNameTagTagWithResponse(ctx context.Context, namespace string, name string, tag string, body PatchV1ArtifactNamespaceNameTagTagJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1ArtifactNamespaceNameTagTagResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteV1RbacPolicyWithBodyWithResponse request with any body
-	DeleteV1RbacPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteV1RbacPolicyResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	DeleteV1RbacPolicyWithResponse(ctx context.Context, body DeleteV1RbacPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteV1RbacPolicyResponse, error)
+	operationPath := fmt.Sprintf("/v1/schedule/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetV1RbacPolicyWithResponse request
-	GetV1RbacPolicyWithResponse(ctx context.Context, params *GetV1RbacPolicyParams, reqEditors ...RequestEditorFn) (*GetV1RbacPolicyResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PutV1RbacPolicyWithBodyWithResponse request with any body
-	PutV1RbacPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1RbacPolicyResponse, error)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	PutV1RbacPolicyWithResponse(ctx context.Context, body PutV1RbacPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV1RbacPolicyResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// GetV1RbacResourceGroupWithResponse request
-	GetV1RbacResourceGroupWithResponse(ctx context.Context, params *GetV1RbacResourceGroupParams, reqEditors ...RequestEditorFn) (*GetV1RbacResourceGroupResponse, error)
+	return req, nil
+}
 
-	// DeleteV1RbacResourceGroupResourceGroupWithResponse request
-	DeleteV1RbacResourceGroupResourceGroupWithResponse(ctx context.Context, resourceGroup string, reqEditors ...RequestEditorFn) (*DeleteV1RbacResourceGroupResourceGroupResponse, error)
+// NewGetV1ScheduleIdHistoryRequest generates requests for GetV1ScheduleIdHistory
+func NewGetV1ScheduleIdHistoryRequest(server string, id openapi_types.UUID, params *GetV1ScheduleIdHistoryParams) (*http.Request, error) {
+	var err error
 
-	// GetV1RbacResourceGroupResourceGroupWithResponse request
-	GetV1RbacResourceGroupResourceGroupWithResponse(ctx context.Context, resourceGroup string, reqEditors ...RequestEditorFn) (*GetV1RbacResourceGroupResourceGroupResponse, error)
+	var pathParam0 string
 
-	// HeadV1RbacResourceGroupResourceGroupWithResponse request
-	HeadV1RbacResourceGroupResourceGroupWithResponse(ctx context.Context, resourceGroup string, reqEditors ...RequestEditorFn) (*HeadV1RbacResourceGroupResourceGroupResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-	// PutV1RbacResourceGroupResourceGroupWithBodyWithResponse request with any body
-	PutV1RbacResourceGroupResourceGroupWithBodyWithResponse(ctx context.Context, resourceGroup string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1RbacResourceGroupResourceGroupResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	PutV1RbacResourceGroupResourceGroupWithResponse(ctx context.Context, resourceGroup string, body PutV1RbacResourceGroupResourceGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV1RbacResourceGroupResourceGroupResponse, error)
+	operationPath := fmt.Sprintf("/v1/schedule/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetV1RbacRoleWithResponse request
-	GetV1RbacRoleWithResponse(ctx context.Context, params *GetV1RbacRoleParams, reqEditors ...RequestEditorFn) (*GetV1RbacRoleResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteV1RbacRoleRoleWithResponse request
-	DeleteV1RbacRoleRoleWithResponse(ctx context.Context, role string, reqEditors ...RequestEditorFn) (*DeleteV1RbacRoleRoleResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// GetV1RbacRoleRoleWithResponse request
-	GetV1RbacRoleRoleWithResponse(ctx context.Context, role string, reqEditors ...RequestEditorFn) (*GetV1RbacRoleRoleResponse, error)
+		if params.Limit != nil {
 
-	// HeadV1RbacRoleRoleWithResponse request
-	HeadV1RbacRoleRoleWithResponse(ctx context.Context, role string, reqEditors ...RequestEditorFn) (*HeadV1RbacRoleRoleResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// PutV1RbacRoleRoleWithBodyWithResponse request with any body
-	PutV1RbacRoleRoleWithBodyWithResponse(ctx context.Context, role string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1RbacRoleRoleResponse, error)
+		}
 
-	PutV1RbacRoleRoleWithResponse(ctx context.Context, role string, body PutV1RbacRoleRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV1RbacRoleRoleResponse, error)
+		if params.Offset != nil {
 
-	// GetV1TaskWithResponse request
-	GetV1TaskWithResponse(ctx context.Context, params *GetV1TaskParams, reqEditors ...RequestEditorFn) (*GetV1TaskResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// PostV1TaskWithBodyWithResponse request with any body
-	PostV1TaskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1TaskResponse, error)
+		}
 
-	PostV1TaskWithResponse(ctx context.Context, body PostV1TaskJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1TaskResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// GetV1TaskIdWithResponse request
-	GetV1TaskIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetV1TaskIdResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetV1TaskIdLogsWithResponse request
-	GetV1TaskIdLogsWithResponse(ctx context.Context, id string, params *GetV1TaskIdLogsParams, reqEditors ...RequestEditorFn) (*GetV1TaskIdLogsResponse, error)
+	return req, nil
+}
 
-	// GetV1UserWithResponse request
-	GetV1UserWithResponse(ctx context.Context, params *GetV1UserParams, reqEditors ...RequestEditorFn) (*GetV1UserResponse, error)
+// NewPostV1ScheduleIdPauseRequest generates requests for PostV1ScheduleIdPause
+func NewPostV1ScheduleIdPauseRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-	// DeleteV1UserMeWithResponse request
-	DeleteV1UserMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteV1UserMeResponse, error)
+	var pathParam0 string
 
-	// GetV1UserMeWithResponse request
-	GetV1UserMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1UserMeResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-	// PatchV1UserMeWithBodyWithResponse request with any body
-	PatchV1UserMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1UserMeResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	PatchV1UserMeWithResponse(ctx context.Context, body PatchV1UserMeJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1UserMeResponse, error)
+	operationPath := fmt.Sprintf("/v1/schedule/%s/pause", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DeleteV1UserUsernameWithResponse request
-	DeleteV1UserUsernameWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*DeleteV1UserUsernameResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetV1UserUsernameWithResponse request
-	GetV1UserUsernameWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*GetV1UserUsernameResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// HeadV1UserUsernameWithResponse request
-	HeadV1UserUsernameWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*HeadV1UserUsernameResponse, error)
+	return req, nil
+}
 
-	// PatchV1UserUsernameWithBodyWithResponse request with any body
-	PatchV1UserUsernameWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1UserUsernameResponse, error)
+// NewPostV1ScheduleIdResumeRequest generates requests for PostV1ScheduleIdResume
+func NewPostV1ScheduleIdResumeRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-	PatchV1UserUsernameWithResponse(ctx context.Context, username string, body PatchV1UserUsernameJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1UserUsernameResponse, error)
+	var pathParam0 string
 
-	// PutV1UserUsernameWithBodyWithResponse request with any body
-	PutV1UserUsernameWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1UserUsernameResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-	PutV1UserUsernameWithResponse(ctx context.Context, username string, body PutV1UserUsernameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV1UserUsernameResponse, error)
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-type GetV1ArtifactResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Artifact
-	JSON400      *GenericBadRequest
-	JSON413      *GenericTooLarge
-}
+	operationPath := fmt.Sprintf("/v1/schedule/%s/resume", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetV1ArtifactResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV1ArtifactResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostV1ArtifactRawNamespaceNameResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *UploadArtifactResponse
-	JSON400      *GenericBadRequest
-	JSON409      *ErrGeneric
-	JSON413      *GenericTooLarge
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PostV1ArtifactRawNamespaceNameResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
+// NewGetV1TaskRequest generates requests for GetV1Task
+func NewGetV1TaskRequest(server string, params *GetV1TaskParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/task")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.State != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, *params.State); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Source != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source", runtime.ParamLocationQuery, *params.Source); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Namespace != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "namespace", runtime.ParamLocationQuery, *params.Namespace); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Package != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "package", runtime.ParamLocationQuery, *params.Package); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Interface != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "interface", runtime.ParamLocationQuery, *params.Interface); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Function != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "function", runtime.ParamLocationQuery, *params.Function); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Hash != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "hash", runtime.ParamLocationQuery, *params.Hash); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SubmittedBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "submitted-by", runtime.ParamLocationQuery, *params.SubmittedBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Group != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "group", runtime.ParamLocationQuery, *params.Group); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created-after", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created-before", runtime.ParamLocationQuery, *params.CreatedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CompletedAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "completed-after", runtime.ParamLocationQuery, *params.CompletedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CompletedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "completed-before", runtime.ParamLocationQuery, *params.CompletedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1TaskRequest calls the generic PostV1Task builder with application/json body
+func NewPostV1TaskRequest(server string, params *PostV1TaskParams, body PostV1TaskJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV1TaskRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostV1TaskRequestWithBody generates requests for PostV1Task with any type of body
+func NewPostV1TaskRequestWithBody(server string, params *PostV1TaskParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/task")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetV1TaskGroupIdRequest generates requests for GetV1TaskGroupId
+func NewGetV1TaskGroupIdRequest(server string, id string, params *GetV1TaskGroupIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/task-group/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1TaskGroupIdCancelRequest generates requests for PostV1TaskGroupIdCancel
+func NewPostV1TaskGroupIdCancelRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/task-group/%s/cancel", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1TaskBatchRequest calls the generic PostV1TaskBatch builder with application/json body
+func NewPostV1TaskBatchRequest(server string, body PostV1TaskBatchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV1TaskBatchRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostV1TaskBatchRequestWithBody generates requests for PostV1TaskBatch with any type of body
+func NewPostV1TaskBatchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/task/batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV1TaskBatchIdRequest generates requests for GetV1TaskBatchId
+func NewGetV1TaskBatchIdRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/task/batch/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1TaskMapRequest calls the generic PostV1TaskMap builder with application/json body
+func NewPostV1TaskMapRequest(server string, body PostV1TaskMapJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV1TaskMapRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostV1TaskMapRequestWithBody generates requests for PostV1TaskMap with any type of body
+func NewPostV1TaskMapRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/task/map")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV1TaskMapIdRequest generates requests for GetV1TaskMapId
+func NewGetV1TaskMapIdRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/task/map/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1TaskRetryRequest calls the generic PostV1TaskRetry builder with application/json body
+func NewPostV1TaskRetryRequest(server string, body PostV1TaskRetryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV1TaskRetryRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostV1TaskRetryRequestWithBody generates requests for PostV1TaskRetry with any type of body
+func NewPostV1TaskRetryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/task/retry")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteV1TaskIdRequest generates requests for DeleteV1TaskId
+func NewDeleteV1TaskIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/task/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1TaskIdRequest generates requests for GetV1TaskId
+func NewGetV1TaskIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/task/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1TaskIdCallbackRequest generates requests for GetV1TaskIdCallback
+func NewGetV1TaskIdCallbackRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/task/%s/callback", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1TaskIdCancelRequest generates requests for PostV1TaskIdCancel
+func NewPostV1TaskIdCancelRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/task/%s/cancel", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1TaskIdLogsRequest generates requests for GetV1TaskIdLogs
+func NewGetV1TaskIdLogsRequest(server string, id string, params *GetV1TaskIdLogsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/task/%s/logs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Level != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "level", runtime.ParamLocationQuery, *params.Level); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Issuer != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "issuer", runtime.ParamLocationQuery, *params.Issuer); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Q != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1TaskIdLogsRequest calls the generic PostV1TaskIdLogs builder with application/json body
+func NewPostV1TaskIdLogsRequest(server string, id string, body PostV1TaskIdLogsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV1TaskIdLogsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostV1TaskIdLogsRequestWithBody generates requests for PostV1TaskIdLogs with any type of body
+func NewPostV1TaskIdLogsRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/task/%s/logs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV1TaskIdLogsExportRequest generates requests for GetV1TaskIdLogsExport
+func NewGetV1TaskIdLogsExportRequest(server string, id string, params *GetV1TaskIdLogsExportParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/task/%s/logs/export", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1TaskIdLogsStreamRequest generates requests for GetV1TaskIdLogsStream
+func NewGetV1TaskIdLogsStreamRequest(server string, id string, params *GetV1TaskIdLogsStreamParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/task/%s/logs/stream", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.LastEventID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Last-Event-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetV1TaskIdResultRequest generates requests for GetV1TaskIdResult
+func NewGetV1TaskIdResultRequest(server string, id string, params *GetV1TaskIdResultParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/task/%s/result", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1TaskIdRetriesRequest generates requests for GetV1TaskIdRetries
+func NewGetV1TaskIdRetriesRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/task/%s/retries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1TaskIdRetryRequest generates requests for PostV1TaskIdRetry
+func NewPostV1TaskIdRetryRequest(server string, id string, params *PostV1TaskIdRetryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/task/%s/retry", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ResetRetries != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "reset-retries", runtime.ParamLocationQuery, *params.ResetRetries); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1TaskIdTransitionsRequest generates requests for GetV1TaskIdTransitions
+func NewGetV1TaskIdTransitionsRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/task/%s/transitions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1UserRequest generates requests for GetV1User
+func NewGetV1UserRequest(server string, params *GetV1UserParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DisplayName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "display-name", runtime.ParamLocationQuery, *params.DisplayName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteV1UserMeRequest generates requests for DeleteV1UserMe
+func NewDeleteV1UserMeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1UserMeRequest generates requests for GetV1UserMe
+func NewGetV1UserMeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchV1UserMeRequest calls the generic PatchV1UserMe builder with application/json body
+func NewPatchV1UserMeRequest(server string, body PatchV1UserMeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchV1UserMeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPatchV1UserMeRequestWithBody generates requests for PatchV1UserMe with any type of body
+func NewPatchV1UserMeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteV1UserUsernameRequest generates requests for DeleteV1UserUsername
+func NewDeleteV1UserUsernameRequest(server string, username string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1UserUsernameRequest generates requests for GetV1UserUsername
+func NewGetV1UserUsernameRequest(server string, username string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHeadV1UserUsernameRequest generates requests for HeadV1UserUsername
+func NewHeadV1UserUsernameRequest(server string, username string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("HEAD", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchV1UserUsernameRequest calls the generic PatchV1UserUsername builder with application/json body
+func NewPatchV1UserUsernameRequest(server string, username string, body PatchV1UserUsernameJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchV1UserUsernameRequestWithBody(server, username, "application/json", bodyReader)
+}
+
+// NewPatchV1UserUsernameRequestWithBody generates requests for PatchV1UserUsername with any type of body
+func NewPatchV1UserUsernameRequestWithBody(server string, username string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutV1UserUsernameRequest calls the generic PutV1UserUsername builder with application/json body
+func NewPutV1UserUsernameRequest(server string, username string, body PutV1UserUsernameJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutV1UserUsernameRequestWithBody(server, username, "application/json", bodyReader)
+}
+
+// NewPutV1UserUsernameRequestWithBody generates requests for PutV1UserUsername with any type of body
+func NewPutV1UserUsernameRequestWithBody(server string, username string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV1WorkflowRequest generates requests for GetV1Workflow
+func NewGetV1WorkflowRequest(server string, params *GetV1WorkflowParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/workflow")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1WorkflowRequest calls the generic PostV1Workflow builder with application/json body
+func NewPostV1WorkflowRequest(server string, body PostV1WorkflowJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV1WorkflowRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostV1WorkflowRequestWithBody generates requests for PostV1Workflow with any type of body
+func NewPostV1WorkflowRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/workflow")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV1WorkflowIdRequest generates requests for GetV1WorkflowId
+func NewGetV1WorkflowIdRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/workflow/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetV1ArtifactWithResponse request
+	GetV1ArtifactWithResponse(ctx context.Context, params *GetV1ArtifactParams, reqEditors ...RequestEditorFn) (*GetV1ArtifactResponse, error)
+
+	// PostV1ArtifactRawNamespaceNameWithBodyWithResponse request with any body
+	PostV1ArtifactRawNamespaceNameWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1ArtifactRawNamespaceNameResponse, error)
+
+	// GetV1ArtifactRawNamespaceNameHashHashWithResponse request
+	GetV1ArtifactRawNamespaceNameHashHashWithResponse(ctx context.Context, namespace string, name string, hash string, reqEditors ...RequestEditorFn) (*GetV1ArtifactRawNamespaceNameHashHashResponse, error)
+
+	// GetV1ArtifactRawNamespaceNameTagTagWithResponse request
+	GetV1ArtifactRawNamespaceNameTagTagWithResponse(ctx context.Context, namespace string, name string, tag string, reqEditors ...RequestEditorFn) (*GetV1ArtifactRawNamespaceNameTagTagResponse, error)
+
+	// GetV1ArtifactNamespaceWithResponse request
+	GetV1ArtifactNamespaceWithResponse(ctx context.Context, namespace string, params *GetV1ArtifactNamespaceParams, reqEditors ...RequestEditorFn) (*GetV1ArtifactNamespaceResponse, error)
+
+	// GetV1ArtifactNamespaceNameWithResponse request
+	GetV1ArtifactNamespaceNameWithResponse(ctx context.Context, namespace string, name string, params *GetV1ArtifactNamespaceNameParams, reqEditors ...RequestEditorFn) (*GetV1ArtifactNamespaceNameResponse, error)
+
+	// DeleteV1ArtifactNamespaceNameHashHashWithResponse request
+	DeleteV1ArtifactNamespaceNameHashHashWithResponse(ctx context.Context, namespace string, name string, hash string, reqEditors ...RequestEditorFn) (*DeleteV1ArtifactNamespaceNameHashHashResponse, error)
+
+	// GetV1ArtifactNamespaceNameHashHashWithResponse request
+	GetV1ArtifactNamespaceNameHashHashWithResponse(ctx context.Context, namespace string, name string, hash string, reqEditors ...RequestEditorFn) (*GetV1ArtifactNamespaceNameHashHashResponse, error)
+
+	// PatchV1ArtifactNamespaceNameHashHashWithBodyWithResponse request with any body
+	PatchV1ArtifactNamespaceNameHashHashWithBodyWithResponse(ctx context.Context, namespace string, name string, hash string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1ArtifactNamespaceNameHashHashResponse, error)
+
+	PatchV1ArtifactNamespaceNameHashHashWithResponse(ctx context.Context, namespace string, name string, hash string, body PatchV1ArtifactNamespaceNameHashHashJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1ArtifactNamespaceNameHashHashResponse, error)
+
+	// DeleteV1ArtifactNamespaceNameTagTagWithResponse request
+	DeleteV1ArtifactNamespaceNameTagTagWithResponse(ctx context.Context, namespace string, name string, tag string, reqEditors ...RequestEditorFn) (*DeleteV1ArtifactNamespaceNameTagTagResponse, error)
+
+	// GetV1ArtifactNamespaceNameTagTagWithResponse request
+	GetV1ArtifactNamespaceNameTagTagWithResponse(ctx context.Context, namespace string, name string, tag string, reqEditors ...RequestEditorFn) (*GetV1ArtifactNamespaceNameTagTagResponse, error)
+
+	// PatchV1ArtifactNamespaceNameTagTagWithBodyWithResponse request with any body
+	PatchV1ArtifactNamespaceNameTagTagWithBodyWithResponse(ctx context.Context, namespace string, name string, tag string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1ArtifactNamespaceNameTagTagResponse, error)
+
+	PatchV1ArtifactNamespaceNameTagTagWithResponse(ctx context.Context, namespace string, name string, tag string, body PatchV1ArtifactNamespaceNameTagTagJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1ArtifactNamespaceNameTagTagResponse, error)
+
+	// GetV1QueueWithResponse request
+	GetV1QueueWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1QueueResponse, error)
+
+	// GetV1QueueNameWithResponse request
+	GetV1QueueNameWithResponse(ctx context.Context, name string, params *GetV1QueueNameParams, reqEditors ...RequestEditorFn) (*GetV1QueueNameResponse, error)
+
+	// PostV1QueueNamePauseWithResponse request
+	PostV1QueueNamePauseWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*PostV1QueueNamePauseResponse, error)
+
+	// PostV1QueueNameResumeWithResponse request
+	PostV1QueueNameResumeWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*PostV1QueueNameResumeResponse, error)
+
+	// DeleteV1RbacPolicyWithBodyWithResponse request with any body
+	DeleteV1RbacPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteV1RbacPolicyResponse, error)
+
+	DeleteV1RbacPolicyWithResponse(ctx context.Context, body DeleteV1RbacPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteV1RbacPolicyResponse, error)
+
+	// GetV1RbacPolicyWithResponse request
+	GetV1RbacPolicyWithResponse(ctx context.Context, params *GetV1RbacPolicyParams, reqEditors ...RequestEditorFn) (*GetV1RbacPolicyResponse, error)
+
+	// PutV1RbacPolicyWithBodyWithResponse request with any body
+	PutV1RbacPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1RbacPolicyResponse, error)
+
+	PutV1RbacPolicyWithResponse(ctx context.Context, body PutV1RbacPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV1RbacPolicyResponse, error)
+
+	// GetV1RbacResourceGroupWithResponse request
+	GetV1RbacResourceGroupWithResponse(ctx context.Context, params *GetV1RbacResourceGroupParams, reqEditors ...RequestEditorFn) (*GetV1RbacResourceGroupResponse, error)
+
+	// DeleteV1RbacResourceGroupResourceGroupWithResponse request
+	DeleteV1RbacResourceGroupResourceGroupWithResponse(ctx context.Context, resourceGroup string, reqEditors ...RequestEditorFn) (*DeleteV1RbacResourceGroupResourceGroupResponse, error)
+
+	// GetV1RbacResourceGroupResourceGroupWithResponse request
+	GetV1RbacResourceGroupResourceGroupWithResponse(ctx context.Context, resourceGroup string, reqEditors ...RequestEditorFn) (*GetV1RbacResourceGroupResourceGroupResponse, error)
+
+	// HeadV1RbacResourceGroupResourceGroupWithResponse request
+	HeadV1RbacResourceGroupResourceGroupWithResponse(ctx context.Context, resourceGroup string, reqEditors ...RequestEditorFn) (*HeadV1RbacResourceGroupResourceGroupResponse, error)
+
+	// PutV1RbacResourceGroupResourceGroupWithBodyWithResponse request with any body
+	PutV1RbacResourceGroupResourceGroupWithBodyWithResponse(ctx context.Context, resourceGroup string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1RbacResourceGroupResourceGroupResponse, error)
+
+	PutV1RbacResourceGroupResourceGroupWithResponse(ctx context.Context, resourceGroup string, body PutV1RbacResourceGroupResourceGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV1RbacResourceGroupResourceGroupResponse, error)
+
+	// GetV1RbacRoleWithResponse request
+	GetV1RbacRoleWithResponse(ctx context.Context, params *GetV1RbacRoleParams, reqEditors ...RequestEditorFn) (*GetV1RbacRoleResponse, error)
+
+	// DeleteV1RbacRoleRoleWithResponse request
+	DeleteV1RbacRoleRoleWithResponse(ctx context.Context, role string, reqEditors ...RequestEditorFn) (*DeleteV1RbacRoleRoleResponse, error)
+
+	// GetV1RbacRoleRoleWithResponse request
+	GetV1RbacRoleRoleWithResponse(ctx context.Context, role string, reqEditors ...RequestEditorFn) (*GetV1RbacRoleRoleResponse, error)
+
+	// HeadV1RbacRoleRoleWithResponse request
+	HeadV1RbacRoleRoleWithResponse(ctx context.Context, role string, reqEditors ...RequestEditorFn) (*HeadV1RbacRoleRoleResponse, error)
+
+	// PutV1RbacRoleRoleWithBodyWithResponse request with any body
+	PutV1RbacRoleRoleWithBodyWithResponse(ctx context.Context, role string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1RbacRoleRoleResponse, error)
+
+	PutV1RbacRoleRoleWithResponse(ctx context.Context, role string, body PutV1RbacRoleRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV1RbacRoleRoleResponse, error)
+
+	// GetV1ScheduleWithResponse request
+	GetV1ScheduleWithResponse(ctx context.Context, params *GetV1ScheduleParams, reqEditors ...RequestEditorFn) (*GetV1ScheduleResponse, error)
+
+	// PostV1ScheduleWithBodyWithResponse request with any body
+	PostV1ScheduleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1ScheduleResponse, error)
+
+	PostV1ScheduleWithResponse(ctx context.Context, body PostV1ScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1ScheduleResponse, error)
+
+	// DeleteV1ScheduleIdWithResponse request
+	DeleteV1ScheduleIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteV1ScheduleIdResponse, error)
+
+	// GetV1ScheduleIdWithResponse request
+	GetV1ScheduleIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetV1ScheduleIdResponse, error)
+
+	// PatchV1ScheduleIdWithBodyWithResponse request with any body
+	PatchV1ScheduleIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1ScheduleIdResponse, error)
+
+	PatchV1ScheduleIdWithResponse(ctx context.Context, id openapi_types.UUID, body PatchV1ScheduleIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1ScheduleIdResponse, error)
+
+	// GetV1ScheduleIdHistoryWithResponse request
+	GetV1ScheduleIdHistoryWithResponse(ctx context.Context, id openapi_types.UUID, params *GetV1ScheduleIdHistoryParams, reqEditors ...RequestEditorFn) (*GetV1ScheduleIdHistoryResponse, error)
+
+	// PostV1ScheduleIdPauseWithResponse request
+	PostV1ScheduleIdPauseWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostV1ScheduleIdPauseResponse, error)
+
+	// PostV1ScheduleIdResumeWithResponse request
+	PostV1ScheduleIdResumeWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostV1ScheduleIdResumeResponse, error)
+
+	// GetV1TaskWithResponse request
+	GetV1TaskWithResponse(ctx context.Context, params *GetV1TaskParams, reqEditors ...RequestEditorFn) (*GetV1TaskResponse, error)
+
+	// PostV1TaskWithBodyWithResponse request with any body
+	PostV1TaskWithBodyWithResponse(ctx context.Context, params *PostV1TaskParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1TaskResponse, error)
+
+	PostV1TaskWithResponse(ctx context.Context, params *PostV1TaskParams, body PostV1TaskJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1TaskResponse, error)
+
+	// GetV1TaskGroupIdWithResponse request
+	GetV1TaskGroupIdWithResponse(ctx context.Context, id string, params *GetV1TaskGroupIdParams, reqEditors ...RequestEditorFn) (*GetV1TaskGroupIdResponse, error)
+
+	// PostV1TaskGroupIdCancelWithResponse request
+	PostV1TaskGroupIdCancelWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostV1TaskGroupIdCancelResponse, error)
+
+	// PostV1TaskBatchWithBodyWithResponse request with any body
+	PostV1TaskBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1TaskBatchResponse, error)
+
+	PostV1TaskBatchWithResponse(ctx context.Context, body PostV1TaskBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1TaskBatchResponse, error)
+
+	// GetV1TaskBatchIdWithResponse request
+	GetV1TaskBatchIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetV1TaskBatchIdResponse, error)
+
+	// PostV1TaskMapWithBodyWithResponse request with any body
+	PostV1TaskMapWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1TaskMapResponse, error)
+
+	PostV1TaskMapWithResponse(ctx context.Context, body PostV1TaskMapJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1TaskMapResponse, error)
+
+	// GetV1TaskMapIdWithResponse request
+	GetV1TaskMapIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetV1TaskMapIdResponse, error)
+
+	// PostV1TaskRetryWithBodyWithResponse request with any body
+	PostV1TaskRetryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1TaskRetryResponse, error)
+
+	PostV1TaskRetryWithResponse(ctx context.Context, body PostV1TaskRetryJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1TaskRetryResponse, error)
+
+	// DeleteV1TaskIdWithResponse request
+	DeleteV1TaskIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteV1TaskIdResponse, error)
+
+	// GetV1TaskIdWithResponse request
+	GetV1TaskIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetV1TaskIdResponse, error)
+
+	// GetV1TaskIdCallbackWithResponse request
+	GetV1TaskIdCallbackWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetV1TaskIdCallbackResponse, error)
+
+	// PostV1TaskIdCancelWithResponse request
+	PostV1TaskIdCancelWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostV1TaskIdCancelResponse, error)
+
+	// GetV1TaskIdLogsWithResponse request
+	GetV1TaskIdLogsWithResponse(ctx context.Context, id string, params *GetV1TaskIdLogsParams, reqEditors ...RequestEditorFn) (*GetV1TaskIdLogsResponse, error)
+
+	// PostV1TaskIdLogsWithBodyWithResponse request with any body
+	PostV1TaskIdLogsWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1TaskIdLogsResponse, error)
+
+	PostV1TaskIdLogsWithResponse(ctx context.Context, id string, body PostV1TaskIdLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1TaskIdLogsResponse, error)
+
+	// GetV1TaskIdLogsExportWithResponse request
+	GetV1TaskIdLogsExportWithResponse(ctx context.Context, id string, params *GetV1TaskIdLogsExportParams, reqEditors ...RequestEditorFn) (*GetV1TaskIdLogsExportResponse, error)
+
+	// GetV1TaskIdLogsStreamWithResponse request
+	GetV1TaskIdLogsStreamWithResponse(ctx context.Context, id string, params *GetV1TaskIdLogsStreamParams, reqEditors ...RequestEditorFn) (*GetV1TaskIdLogsStreamResponse, error)
+
+	// GetV1TaskIdResultWithResponse request
+	GetV1TaskIdResultWithResponse(ctx context.Context, id string, params *GetV1TaskIdResultParams, reqEditors ...RequestEditorFn) (*GetV1TaskIdResultResponse, error)
+
+	// GetV1TaskIdRetriesWithResponse request
+	GetV1TaskIdRetriesWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetV1TaskIdRetriesResponse, error)
+
+	// PostV1TaskIdRetryWithResponse request
+	PostV1TaskIdRetryWithResponse(ctx context.Context, id string, params *PostV1TaskIdRetryParams, reqEditors ...RequestEditorFn) (*PostV1TaskIdRetryResponse, error)
+
+	// GetV1TaskIdTransitionsWithResponse request
+	GetV1TaskIdTransitionsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetV1TaskIdTransitionsResponse, error)
+
+	// GetV1UserWithResponse request
+	GetV1UserWithResponse(ctx context.Context, params *GetV1UserParams, reqEditors ...RequestEditorFn) (*GetV1UserResponse, error)
+
+	// DeleteV1UserMeWithResponse request
+	DeleteV1UserMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteV1UserMeResponse, error)
+
+	// GetV1UserMeWithResponse request
+	GetV1UserMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1UserMeResponse, error)
+
+	// PatchV1UserMeWithBodyWithResponse request with any body
+	PatchV1UserMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1UserMeResponse, error)
+
+	PatchV1UserMeWithResponse(ctx context.Context, body PatchV1UserMeJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1UserMeResponse, error)
+
+	// DeleteV1UserUsernameWithResponse request
+	DeleteV1UserUsernameWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*DeleteV1UserUsernameResponse, error)
+
+	// GetV1UserUsernameWithResponse request
+	GetV1UserUsernameWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*GetV1UserUsernameResponse, error)
+
+	// HeadV1UserUsernameWithResponse request
+	HeadV1UserUsernameWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*HeadV1UserUsernameResponse, error)
+
+	// PatchV1UserUsernameWithBodyWithResponse request with any body
+	PatchV1UserUsernameWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1UserUsernameResponse, error)
+
+	PatchV1UserUsernameWithResponse(ctx context.Context, username string, body PatchV1UserUsernameJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1UserUsernameResponse, error)
+
+	// PutV1UserUsernameWithBodyWithResponse request with any body
+	PutV1UserUsernameWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1UserUsernameResponse, error)
+
+	PutV1UserUsernameWithResponse(ctx context.Context, username string, body PutV1UserUsernameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV1UserUsernameResponse, error)
+
+	// GetV1WorkflowWithResponse request
+	GetV1WorkflowWithResponse(ctx context.Context, params *GetV1WorkflowParams, reqEditors ...RequestEditorFn) (*GetV1WorkflowResponse, error)
+
+	// PostV1WorkflowWithBodyWithResponse request with any body
+	PostV1WorkflowWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1WorkflowResponse, error)
+
+	PostV1WorkflowWithResponse(ctx context.Context, body PostV1WorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1WorkflowResponse, error)
+
+	// GetV1WorkflowIdWithResponse request
+	GetV1WorkflowIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetV1WorkflowIdResponse, error)
+}
+
+type GetV1ArtifactResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Artifact
+	JSON400      *GenericBadRequest
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1ArtifactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1ArtifactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1ArtifactRawNamespaceNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *UploadArtifactResponse
+	JSON400      *GenericBadRequest
+	JSON409      *ErrGeneric
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1ArtifactRawNamespaceNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1ArtifactRawNamespaceNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1ArtifactRawNamespaceNameHashHashResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1ArtifactRawNamespaceNameHashHashResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1ArtifactRawNamespaceNameHashHashResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1ArtifactRawNamespaceNameTagTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1ArtifactRawNamespaceNameTagTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1ArtifactRawNamespaceNameTagTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1ArtifactNamespaceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Artifact
+	JSON400      *GenericBadRequest
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1ArtifactNamespaceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1ArtifactNamespaceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1ArtifactNamespaceNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Artifact
+	JSON400      *GenericBadRequest
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1ArtifactNamespaceNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1ArtifactNamespaceNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV1ArtifactNamespaceNameHashHashResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Artifact
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV1ArtifactNamespaceNameHashHashResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV1ArtifactNamespaceNameHashHashResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1ArtifactNamespaceNameHashHashResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Artifact
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1ArtifactNamespaceNameHashHashResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1ArtifactNamespaceNameHashHashResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchV1ArtifactNamespaceNameHashHashResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Artifact
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchV1ArtifactNamespaceNameHashHashResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchV1ArtifactNamespaceNameHashHashResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV1ArtifactNamespaceNameTagTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Artifact
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV1ArtifactNamespaceNameTagTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV1ArtifactNamespaceNameTagTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1ArtifactNamespaceNameTagTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Artifact
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1ArtifactNamespaceNameTagTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1ArtifactNamespaceNameTagTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchV1ArtifactNamespaceNameTagTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Artifact
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchV1ArtifactNamespaceNameTagTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchV1ArtifactNamespaceNameTagTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1QueueResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]QueueStats
+	JSON400      *GenericBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1QueueResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1QueueResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1QueueNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *QueueStats
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1QueueNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1QueueNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1QueueNamePauseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *QueueStats
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1QueueNamePauseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1QueueNamePauseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1QueueNameResumeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *QueueStats
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1QueueNameResumeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1QueueNameResumeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV1RbacPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *GenericBadRequest
+	JSON409      *ErrGeneric
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV1RbacPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV1RbacPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1RbacPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RBACPolicy
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1RbacPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1RbacPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutV1RbacPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *GenericBadRequest
+	JSON404      *FieldError
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV1RbacPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV1RbacPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1RbacResourceGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ResourceGroupResource
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1RbacResourceGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1RbacResourceGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV1RbacResourceGroupResourceGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResourceGroupResource
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV1RbacResourceGroupResourceGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV1RbacResourceGroupResourceGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1RbacResourceGroupResourceGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResourceGroupResource
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1RbacResourceGroupResourceGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1RbacResourceGroupResourceGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HeadV1RbacResourceGroupResourceGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r HeadV1RbacResourceGroupResourceGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HeadV1RbacResourceGroupResourceGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutV1RbacResourceGroupResourceGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ResourceGroupResource
+	JSON400      *GenericBadRequest
+	JSON409      *ErrGeneric
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV1RbacResourceGroupResourceGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV1RbacResourceGroupResourceGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1RbacRoleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RoleResource
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1RbacRoleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1RbacRoleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV1RbacRoleRoleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RoleResource
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+	JSON409      *ErrGeneric
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV1RbacRoleRoleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV1RbacRoleRoleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1RbacRoleRoleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RoleResource
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1RbacRoleRoleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1RbacRoleRoleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HeadV1RbacRoleRoleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r HeadV1RbacRoleRoleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HeadV1RbacRoleRoleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutV1RbacRoleRoleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *RoleResource
+	JSON400      *GenericBadRequest
+	JSON409      *ErrGeneric
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV1RbacRoleRoleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV1RbacRoleRoleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1ScheduleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Schedule
+	JSON400      *GenericBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1ScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1ScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1ScheduleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Schedule
+	JSON400      *GenericBadRequest
+	JSON409      *ErrGeneric
+	JSON422      *FieldError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1ScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1ScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV1ScheduleIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Schedule
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV1ScheduleIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV1ScheduleIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1ScheduleIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Schedule
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1ScheduleIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1ScheduleIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchV1ScheduleIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Schedule
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+	JSON409      *ErrGeneric
+	JSON422      *FieldError
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchV1ScheduleIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchV1ScheduleIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1ScheduleIdHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ScheduleRun
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1ScheduleIdHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1ScheduleIdHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1ScheduleIdPauseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Schedule
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1ScheduleIdPauseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1ScheduleIdPauseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1ScheduleIdResumeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Schedule
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1ScheduleIdResumeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1ScheduleIdResumeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1TaskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Task
+	JSON400      *GenericBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1TaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1TaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1TaskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Task
+	JSON201      *Task
+	JSON400      *GenericBadRequest
+	JSON409      *ErrGeneric
+	JSON413      *GenericTooLarge
+	JSON422      *FieldError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1TaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1TaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1TaskGroupIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskGroup
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1TaskGroupIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1TaskGroupIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1TaskGroupIdCancelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CancelTaskGroupResponse
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1TaskGroupIdCancelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1TaskGroupIdCancelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1TaskBatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreateTaskBatchResponse
+	JSON400      *GenericBadRequest
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1TaskBatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1TaskBatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1TaskBatchIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskBatch
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1TaskBatchIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1TaskBatchIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1TaskMapResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TaskMap
+	JSON400      *GenericBadRequest
+	JSON413      *GenericTooLarge
+	JSON422      *FieldError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1TaskMapResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1TaskMapResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1TaskMapIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskMap
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1TaskMapIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1TaskMapIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1TaskRetryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RetryTasksResponse
+	JSON400      *GenericBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1TaskRetryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1TaskRetryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV1TaskIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Task
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+	JSON409      *ErrGeneric
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV1TaskIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV1TaskIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1TaskIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Task
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1TaskIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1TaskIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1TaskIdCallbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskCallback
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1TaskIdCallbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1TaskIdCallbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1TaskIdCancelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Task
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+	JSON409      *ErrGeneric
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1TaskIdCancelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1TaskIdCancelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1TaskIdLogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TaskLog
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1TaskIdLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1TaskIdLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1TaskIdLogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1TaskIdLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1TaskIdLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1TaskIdLogsExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1TaskIdLogsExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1TaskIdLogsExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1TaskIdLogsStreamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1TaskIdLogsStreamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1TaskIdLogsStreamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1TaskIdResultResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskResult
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+	JSON422      *ErrGeneric
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1TaskIdResultResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1TaskIdResultResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1TaskIdRetriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TaskRetry
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1TaskIdRetriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1TaskIdRetriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1TaskIdRetryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Task
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+	JSON409      *ErrGeneric
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1TaskIdRetryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1TaskIdRetryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1TaskIdTransitionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TaskTransition
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1TaskIdTransitionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1TaskIdTransitionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1UserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]UserResponse
+	JSON400      *GenericBadRequest
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1UserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1UserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV1UserMeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserResponse
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV1UserMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV1UserMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1UserMeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserResponse
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1UserMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1UserMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchV1UserMeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserResponse
+	JSON400      *GenericBadRequest
+	JSON409      *ErrGeneric
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchV1UserMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchV1UserMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV1UserUsernameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserResponse
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV1UserUsernameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV1UserUsernameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1UserUsernameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserResponse
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1UserUsernameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1UserUsernameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HeadV1UserUsernameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r HeadV1UserUsernameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HeadV1UserUsernameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchV1UserUsernameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserResponse
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+	JSON409      *ErrGeneric
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchV1UserUsernameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchV1UserUsernameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutV1UserUsernameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *UserResponse
+	JSON400      *GenericBadRequest
+	JSON409      *ErrGeneric
+	JSON413      *GenericTooLarge
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV1UserUsernameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV1UserUsernameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1WorkflowResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Workflow
+	JSON400      *GenericBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1WorkflowResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1WorkflowResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1WorkflowResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Workflow
+	JSON400      *GenericBadRequest
+	JSON422      *FieldError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1WorkflowResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1WorkflowResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1WorkflowIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Workflow
+	JSON400      *GenericBadRequest
+	JSON404      *GenericNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1WorkflowIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
 	return http.StatusText(0)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostV1ArtifactRawNamespaceNameResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1WorkflowIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetV1ArtifactWithResponse request returning *GetV1ArtifactResponse
+func (c *ClientWithResponses) GetV1ArtifactWithResponse(ctx context.Context, params *GetV1ArtifactParams, reqEditors ...RequestEditorFn) (*GetV1ArtifactResponse, error) {
+	rsp, err := c.GetV1Artifact(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1ArtifactResponse(rsp)
+}
+
+// PostV1ArtifactRawNamespaceNameWithBodyWithResponse request with arbitrary body returning *PostV1ArtifactRawNamespaceNameResponse
+func (c *ClientWithResponses) PostV1ArtifactRawNamespaceNameWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1ArtifactRawNamespaceNameResponse, error) {
+	rsp, err := c.PostV1ArtifactRawNamespaceNameWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1ArtifactRawNamespaceNameResponse(rsp)
+}
+
+// GetV1ArtifactRawNamespaceNameHashHashWithResponse request returning *GetV1ArtifactRawNamespaceNameHashHashResponse
+func (c *ClientWithResponses) GetV1ArtifactRawNamespaceNameHashHashWithResponse(ctx context.Context, namespace string, name string, hash string, reqEditors ...RequestEditorFn) (*GetV1ArtifactRawNamespaceNameHashHashResponse, error) {
+	rsp, err := c.GetV1ArtifactRawNamespaceNameHashHash(ctx, namespace, name, hash, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1ArtifactRawNamespaceNameHashHashResponse(rsp)
+}
+
+// GetV1ArtifactRawNamespaceNameTagTagWithResponse request returning *GetV1ArtifactRawNamespaceNameTagTagResponse
+func (c *ClientWithResponses) GetV1ArtifactRawNamespaceNameTagTagWithResponse(ctx context.Context, namespace string, name string, tag string, reqEditors ...RequestEditorFn) (*GetV1ArtifactRawNamespaceNameTagTagResponse, error) {
+	rsp, err := c.GetV1ArtifactRawNamespaceNameTagTag(ctx, namespace, name, tag, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1ArtifactRawNamespaceNameTagTagResponse(rsp)
+}
+
+// GetV1ArtifactNamespaceWithResponse request returning *GetV1ArtifactNamespaceResponse
+func (c *ClientWithResponses) GetV1ArtifactNamespaceWithResponse(ctx context.Context, namespace string, params *GetV1ArtifactNamespaceParams, reqEditors ...RequestEditorFn) (*GetV1ArtifactNamespaceResponse, error) {
+	rsp, err := c.GetV1ArtifactNamespace(ctx, namespace, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1ArtifactNamespaceResponse(rsp)
+}
+
+// GetV1ArtifactNamespaceNameWithResponse request returning *GetV1ArtifactNamespaceNameResponse
+func (c *ClientWithResponses) GetV1ArtifactNamespaceNameWithResponse(ctx context.Context, namespace string, name string, params *GetV1ArtifactNamespaceNameParams, reqEditors ...RequestEditorFn) (*GetV1ArtifactNamespaceNameResponse, error) {
+	rsp, err := c.GetV1ArtifactNamespaceName(ctx, namespace, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1ArtifactNamespaceNameResponse(rsp)
+}
+
+// DeleteV1ArtifactNamespaceNameHashHashWithResponse request returning *DeleteV1ArtifactNamespaceNameHashHashResponse
+func (c *ClientWithResponses) DeleteV1ArtifactNamespaceNameHashHashWithResponse(ctx context.Context, namespace string, name string, hash string, reqEditors ...RequestEditorFn) (*DeleteV1ArtifactNamespaceNameHashHashResponse, error) {
+	rsp, err := c.DeleteV1ArtifactNamespaceNameHashHash(ctx, namespace, name, hash, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV1ArtifactNamespaceNameHashHashResponse(rsp)
+}
+
+// GetV1ArtifactNamespaceNameHashHashWithResponse request returning *GetV1ArtifactNamespaceNameHashHashResponse
+func (c *ClientWithResponses) GetV1ArtifactNamespaceNameHashHashWithResponse(ctx context.Context, namespace string, name string, hash string, reqEditors ...RequestEditorFn) (*GetV1ArtifactNamespaceNameHashHashResponse, error) {
+	rsp, err := c.GetV1ArtifactNamespaceNameHashHash(ctx, namespace, name, hash, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1ArtifactNamespaceNameHashHashResponse(rsp)
+}
+
+// PatchV1ArtifactNamespaceNameHashHashWithBodyWithResponse request with arbitrary body returning *PatchV1ArtifactNamespaceNameHashHashResponse
+func (c *ClientWithResponses) PatchV1ArtifactNamespaceNameHashHashWithBodyWithResponse(ctx context.Context, namespace string, name string, hash string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1ArtifactNamespaceNameHashHashResponse, error) {
+	rsp, err := c.PatchV1ArtifactNamespaceNameHashHashWithBody(ctx, namespace, name, hash, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV1ArtifactNamespaceNameHashHashResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchV1ArtifactNamespaceNameHashHashWithResponse(ctx context.Context, namespace string, name string, hash string, body PatchV1ArtifactNamespaceNameHashHashJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1ArtifactNamespaceNameHashHashResponse, error) {
+	rsp, err := c.PatchV1ArtifactNamespaceNameHashHash(ctx, namespace, name, hash, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV1ArtifactNamespaceNameHashHashResponse(rsp)
+}
+
+// DeleteV1ArtifactNamespaceNameTagTagWithResponse request returning *DeleteV1ArtifactNamespaceNameTagTagResponse
+func (c *ClientWithResponses) DeleteV1ArtifactNamespaceNameTagTagWithResponse(ctx context.Context, namespace string, name string, tag string, reqEditors ...RequestEditorFn) (*DeleteV1ArtifactNamespaceNameTagTagResponse, error) {
+	rsp, err := c.DeleteV1ArtifactNamespaceNameTagTag(ctx, namespace, name, tag, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV1ArtifactNamespaceNameTagTagResponse(rsp)
+}
+
+// GetV1ArtifactNamespaceNameTagTagWithResponse request returning *GetV1ArtifactNamespaceNameTagTagResponse
+func (c *ClientWithResponses) GetV1ArtifactNamespaceNameTagTagWithResponse(ctx context.Context, namespace string, name string, tag string, reqEditors ...RequestEditorFn) (*GetV1ArtifactNamespaceNameTagTagResponse, error) {
+	rsp, err := c.GetV1ArtifactNamespaceNameTagTag(ctx, namespace, name, tag, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1ArtifactNamespaceNameTagTagResponse(rsp)
+}
+
+// PatchV1ArtifactNamespaceNameTagTagWithBodyWithResponse request with arbitrary body returning *PatchV1ArtifactNamespaceNameTagTagResponse
+func (c *ClientWithResponses) PatchV1ArtifactNamespaceNameTagTagWithBodyWithResponse(ctx context.Context, namespace string, name string, tag string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1ArtifactNamespaceNameTagTagResponse, error) {
+	rsp, err := c.PatchV1ArtifactNamespaceNameTagTagWithBody(ctx, namespace, name, tag, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV1ArtifactNamespaceNameTagTagResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchV1ArtifactNamespaceNameTagTagWithResponse(ctx context.Context, namespace string, name string, tag string, body PatchV1ArtifactNamespaceNameTagTagJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1ArtifactNamespaceNameTagTagResponse, error) {
+	rsp, err := c.PatchV1ArtifactNamespaceNameTagTag(ctx, namespace, name, tag, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV1ArtifactNamespaceNameTagTagResponse(rsp)
+}
+
+// GetV1QueueWithResponse request returning *GetV1QueueResponse
+func (c *ClientWithResponses) GetV1QueueWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1QueueResponse, error) {
+	rsp, err := c.GetV1Queue(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1QueueResponse(rsp)
+}
+
+// GetV1QueueNameWithResponse request returning *GetV1QueueNameResponse
+func (c *ClientWithResponses) GetV1QueueNameWithResponse(ctx context.Context, name string, params *GetV1QueueNameParams, reqEditors ...RequestEditorFn) (*GetV1QueueNameResponse, error) {
+	rsp, err := c.GetV1QueueName(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1QueueNameResponse(rsp)
+}
+
+// PostV1QueueNamePauseWithResponse request returning *PostV1QueueNamePauseResponse
+func (c *ClientWithResponses) PostV1QueueNamePauseWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*PostV1QueueNamePauseResponse, error) {
+	rsp, err := c.PostV1QueueNamePause(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1QueueNamePauseResponse(rsp)
+}
+
+// PostV1QueueNameResumeWithResponse request returning *PostV1QueueNameResumeResponse
+func (c *ClientWithResponses) PostV1QueueNameResumeWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*PostV1QueueNameResumeResponse, error) {
+	rsp, err := c.PostV1QueueNameResume(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1QueueNameResumeResponse(rsp)
+}
+
+// DeleteV1RbacPolicyWithBodyWithResponse request with arbitrary body returning *DeleteV1RbacPolicyResponse
+func (c *ClientWithResponses) DeleteV1RbacPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteV1RbacPolicyResponse, error) {
+	rsp, err := c.DeleteV1RbacPolicyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV1RbacPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeleteV1RbacPolicyWithResponse(ctx context.Context, body DeleteV1RbacPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteV1RbacPolicyResponse, error) {
+	rsp, err := c.DeleteV1RbacPolicy(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV1RbacPolicyResponse(rsp)
+}
+
+// GetV1RbacPolicyWithResponse request returning *GetV1RbacPolicyResponse
+func (c *ClientWithResponses) GetV1RbacPolicyWithResponse(ctx context.Context, params *GetV1RbacPolicyParams, reqEditors ...RequestEditorFn) (*GetV1RbacPolicyResponse, error) {
+	rsp, err := c.GetV1RbacPolicy(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1RbacPolicyResponse(rsp)
+}
+
+// PutV1RbacPolicyWithBodyWithResponse request with arbitrary body returning *PutV1RbacPolicyResponse
+func (c *ClientWithResponses) PutV1RbacPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1RbacPolicyResponse, error) {
+	rsp, err := c.PutV1RbacPolicyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV1RbacPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutV1RbacPolicyWithResponse(ctx context.Context, body PutV1RbacPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV1RbacPolicyResponse, error) {
+	rsp, err := c.PutV1RbacPolicy(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV1RbacPolicyResponse(rsp)
+}
+
+// GetV1RbacResourceGroupWithResponse request returning *GetV1RbacResourceGroupResponse
+func (c *ClientWithResponses) GetV1RbacResourceGroupWithResponse(ctx context.Context, params *GetV1RbacResourceGroupParams, reqEditors ...RequestEditorFn) (*GetV1RbacResourceGroupResponse, error) {
+	rsp, err := c.GetV1RbacResourceGroup(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1RbacResourceGroupResponse(rsp)
+}
+
+// DeleteV1RbacResourceGroupResourceGroupWithResponse request returning *DeleteV1RbacResourceGroupResourceGroupResponse
+func (c *ClientWithResponses) DeleteV1RbacResourceGroupResourceGroupWithResponse(ctx context.Context, resourceGroup string, reqEditors ...RequestEditorFn) (*DeleteV1RbacResourceGroupResourceGroupResponse, error) {
+	rsp, err := c.DeleteV1RbacResourceGroupResourceGroup(ctx, resourceGroup, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV1RbacResourceGroupResourceGroupResponse(rsp)
+}
+
+// GetV1RbacResourceGroupResourceGroupWithResponse request returning *GetV1RbacResourceGroupResourceGroupResponse
+func (c *ClientWithResponses) GetV1RbacResourceGroupResourceGroupWithResponse(ctx context.Context, resourceGroup string, reqEditors ...RequestEditorFn) (*GetV1RbacResourceGroupResourceGroupResponse, error) {
+	rsp, err := c.GetV1RbacResourceGroupResourceGroup(ctx, resourceGroup, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1RbacResourceGroupResourceGroupResponse(rsp)
+}
+
+// HeadV1RbacResourceGroupResourceGroupWithResponse request returning *HeadV1RbacResourceGroupResourceGroupResponse
+func (c *ClientWithResponses) HeadV1RbacResourceGroupResourceGroupWithResponse(ctx context.Context, resourceGroup string, reqEditors ...RequestEditorFn) (*HeadV1RbacResourceGroupResourceGroupResponse, error) {
+	rsp, err := c.HeadV1RbacResourceGroupResourceGroup(ctx, resourceGroup, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHeadV1RbacResourceGroupResourceGroupResponse(rsp)
+}
+
+// PutV1RbacResourceGroupResourceGroupWithBodyWithResponse request with arbitrary body returning *PutV1RbacResourceGroupResourceGroupResponse
+func (c *ClientWithResponses) PutV1RbacResourceGroupResourceGroupWithBodyWithResponse(ctx context.Context, resourceGroup string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1RbacResourceGroupResourceGroupResponse, error) {
+	rsp, err := c.PutV1RbacResourceGroupResourceGroupWithBody(ctx, resourceGroup, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV1RbacResourceGroupResourceGroupResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutV1RbacResourceGroupResourceGroupWithResponse(ctx context.Context, resourceGroup string, body PutV1RbacResourceGroupResourceGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV1RbacResourceGroupResourceGroupResponse, error) {
+	rsp, err := c.PutV1RbacResourceGroupResourceGroup(ctx, resourceGroup, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV1RbacResourceGroupResourceGroupResponse(rsp)
+}
+
+// GetV1RbacRoleWithResponse request returning *GetV1RbacRoleResponse
+func (c *ClientWithResponses) GetV1RbacRoleWithResponse(ctx context.Context, params *GetV1RbacRoleParams, reqEditors ...RequestEditorFn) (*GetV1RbacRoleResponse, error) {
+	rsp, err := c.GetV1RbacRole(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1RbacRoleResponse(rsp)
+}
+
+// DeleteV1RbacRoleRoleWithResponse request returning *DeleteV1RbacRoleRoleResponse
+func (c *ClientWithResponses) DeleteV1RbacRoleRoleWithResponse(ctx context.Context, role string, reqEditors ...RequestEditorFn) (*DeleteV1RbacRoleRoleResponse, error) {
+	rsp, err := c.DeleteV1RbacRoleRole(ctx, role, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV1RbacRoleRoleResponse(rsp)
+}
+
+// GetV1RbacRoleRoleWithResponse request returning *GetV1RbacRoleRoleResponse
+func (c *ClientWithResponses) GetV1RbacRoleRoleWithResponse(ctx context.Context, role string, reqEditors ...RequestEditorFn) (*GetV1RbacRoleRoleResponse, error) {
+	rsp, err := c.GetV1RbacRoleRole(ctx, role, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1RbacRoleRoleResponse(rsp)
+}
+
+// HeadV1RbacRoleRoleWithResponse request returning *HeadV1RbacRoleRoleResponse
+func (c *ClientWithResponses) HeadV1RbacRoleRoleWithResponse(ctx context.Context, role string, reqEditors ...RequestEditorFn) (*HeadV1RbacRoleRoleResponse, error) {
+	rsp, err := c.HeadV1RbacRoleRole(ctx, role, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHeadV1RbacRoleRoleResponse(rsp)
+}
+
+// PutV1RbacRoleRoleWithBodyWithResponse request with arbitrary body returning *PutV1RbacRoleRoleResponse
+func (c *ClientWithResponses) PutV1RbacRoleRoleWithBodyWithResponse(ctx context.Context, role string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1RbacRoleRoleResponse, error) {
+	rsp, err := c.PutV1RbacRoleRoleWithBody(ctx, role, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV1RbacRoleRoleResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutV1RbacRoleRoleWithResponse(ctx context.Context, role string, body PutV1RbacRoleRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV1RbacRoleRoleResponse, error) {
+	rsp, err := c.PutV1RbacRoleRole(ctx, role, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV1RbacRoleRoleResponse(rsp)
+}
+
+// GetV1ScheduleWithResponse request returning *GetV1ScheduleResponse
+func (c *ClientWithResponses) GetV1ScheduleWithResponse(ctx context.Context, params *GetV1ScheduleParams, reqEditors ...RequestEditorFn) (*GetV1ScheduleResponse, error) {
+	rsp, err := c.GetV1Schedule(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1ScheduleResponse(rsp)
+}
+
+// PostV1ScheduleWithBodyWithResponse request with arbitrary body returning *PostV1ScheduleResponse
+func (c *ClientWithResponses) PostV1ScheduleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1ScheduleResponse, error) {
+	rsp, err := c.PostV1ScheduleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1ScheduleResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV1ScheduleWithResponse(ctx context.Context, body PostV1ScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1ScheduleResponse, error) {
+	rsp, err := c.PostV1Schedule(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1ScheduleResponse(rsp)
+}
+
+// DeleteV1ScheduleIdWithResponse request returning *DeleteV1ScheduleIdResponse
+func (c *ClientWithResponses) DeleteV1ScheduleIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteV1ScheduleIdResponse, error) {
+	rsp, err := c.DeleteV1ScheduleId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV1ScheduleIdResponse(rsp)
+}
+
+// GetV1ScheduleIdWithResponse request returning *GetV1ScheduleIdResponse
+func (c *ClientWithResponses) GetV1ScheduleIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetV1ScheduleIdResponse, error) {
+	rsp, err := c.GetV1ScheduleId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1ScheduleIdResponse(rsp)
+}
+
+// PatchV1ScheduleIdWithBodyWithResponse request with arbitrary body returning *PatchV1ScheduleIdResponse
+func (c *ClientWithResponses) PatchV1ScheduleIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1ScheduleIdResponse, error) {
+	rsp, err := c.PatchV1ScheduleIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV1ScheduleIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchV1ScheduleIdWithResponse(ctx context.Context, id openapi_types.UUID, body PatchV1ScheduleIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1ScheduleIdResponse, error) {
+	rsp, err := c.PatchV1ScheduleId(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV1ScheduleIdResponse(rsp)
+}
+
+// GetV1ScheduleIdHistoryWithResponse request returning *GetV1ScheduleIdHistoryResponse
+func (c *ClientWithResponses) GetV1ScheduleIdHistoryWithResponse(ctx context.Context, id openapi_types.UUID, params *GetV1ScheduleIdHistoryParams, reqEditors ...RequestEditorFn) (*GetV1ScheduleIdHistoryResponse, error) {
+	rsp, err := c.GetV1ScheduleIdHistory(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1ScheduleIdHistoryResponse(rsp)
+}
+
+// PostV1ScheduleIdPauseWithResponse request returning *PostV1ScheduleIdPauseResponse
+func (c *ClientWithResponses) PostV1ScheduleIdPauseWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostV1ScheduleIdPauseResponse, error) {
+	rsp, err := c.PostV1ScheduleIdPause(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1ScheduleIdPauseResponse(rsp)
+}
+
+// PostV1ScheduleIdResumeWithResponse request returning *PostV1ScheduleIdResumeResponse
+func (c *ClientWithResponses) PostV1ScheduleIdResumeWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostV1ScheduleIdResumeResponse, error) {
+	rsp, err := c.PostV1ScheduleIdResume(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1ScheduleIdResumeResponse(rsp)
+}
+
+// GetV1TaskWithResponse request returning *GetV1TaskResponse
+func (c *ClientWithResponses) GetV1TaskWithResponse(ctx context.Context, params *GetV1TaskParams, reqEditors ...RequestEditorFn) (*GetV1TaskResponse, error) {
+	rsp, err := c.GetV1Task(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1TaskResponse(rsp)
+}
+
+// PostV1TaskWithBodyWithResponse request with arbitrary body returning *PostV1TaskResponse
+func (c *ClientWithResponses) PostV1TaskWithBodyWithResponse(ctx context.Context, params *PostV1TaskParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1TaskResponse, error) {
+	rsp, err := c.PostV1TaskWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1TaskResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV1TaskWithResponse(ctx context.Context, params *PostV1TaskParams, body PostV1TaskJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1TaskResponse, error) {
+	rsp, err := c.PostV1Task(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1TaskResponse(rsp)
+}
+
+// GetV1TaskGroupIdWithResponse request returning *GetV1TaskGroupIdResponse
+func (c *ClientWithResponses) GetV1TaskGroupIdWithResponse(ctx context.Context, id string, params *GetV1TaskGroupIdParams, reqEditors ...RequestEditorFn) (*GetV1TaskGroupIdResponse, error) {
+	rsp, err := c.GetV1TaskGroupId(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1TaskGroupIdResponse(rsp)
+}
+
+// PostV1TaskGroupIdCancelWithResponse request returning *PostV1TaskGroupIdCancelResponse
+func (c *ClientWithResponses) PostV1TaskGroupIdCancelWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostV1TaskGroupIdCancelResponse, error) {
+	rsp, err := c.PostV1TaskGroupIdCancel(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1TaskGroupIdCancelResponse(rsp)
+}
+
+// PostV1TaskBatchWithBodyWithResponse request with arbitrary body returning *PostV1TaskBatchResponse
+func (c *ClientWithResponses) PostV1TaskBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1TaskBatchResponse, error) {
+	rsp, err := c.PostV1TaskBatchWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1TaskBatchResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV1TaskBatchWithResponse(ctx context.Context, body PostV1TaskBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1TaskBatchResponse, error) {
+	rsp, err := c.PostV1TaskBatch(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1TaskBatchResponse(rsp)
+}
+
+// GetV1TaskBatchIdWithResponse request returning *GetV1TaskBatchIdResponse
+func (c *ClientWithResponses) GetV1TaskBatchIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetV1TaskBatchIdResponse, error) {
+	rsp, err := c.GetV1TaskBatchId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1TaskBatchIdResponse(rsp)
+}
+
+// PostV1TaskMapWithBodyWithResponse request with arbitrary body returning *PostV1TaskMapResponse
+func (c *ClientWithResponses) PostV1TaskMapWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1TaskMapResponse, error) {
+	rsp, err := c.PostV1TaskMapWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1TaskMapResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV1TaskMapWithResponse(ctx context.Context, body PostV1TaskMapJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1TaskMapResponse, error) {
+	rsp, err := c.PostV1TaskMap(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1TaskMapResponse(rsp)
+}
+
+// GetV1TaskMapIdWithResponse request returning *GetV1TaskMapIdResponse
+func (c *ClientWithResponses) GetV1TaskMapIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetV1TaskMapIdResponse, error) {
+	rsp, err := c.GetV1TaskMapId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1TaskMapIdResponse(rsp)
+}
+
+// PostV1TaskRetryWithBodyWithResponse request with arbitrary body returning *PostV1TaskRetryResponse
+func (c *ClientWithResponses) PostV1TaskRetryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1TaskRetryResponse, error) {
+	rsp, err := c.PostV1TaskRetryWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1TaskRetryResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV1TaskRetryWithResponse(ctx context.Context, body PostV1TaskRetryJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1TaskRetryResponse, error) {
+	rsp, err := c.PostV1TaskRetry(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1TaskRetryResponse(rsp)
+}
+
+// DeleteV1TaskIdWithResponse request returning *DeleteV1TaskIdResponse
+func (c *ClientWithResponses) DeleteV1TaskIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteV1TaskIdResponse, error) {
+	rsp, err := c.DeleteV1TaskId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV1TaskIdResponse(rsp)
+}
+
+// GetV1TaskIdWithResponse request returning *GetV1TaskIdResponse
+func (c *ClientWithResponses) GetV1TaskIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetV1TaskIdResponse, error) {
+	rsp, err := c.GetV1TaskId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1TaskIdResponse(rsp)
+}
+
+// GetV1TaskIdCallbackWithResponse request returning *GetV1TaskIdCallbackResponse
+func (c *ClientWithResponses) GetV1TaskIdCallbackWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetV1TaskIdCallbackResponse, error) {
+	rsp, err := c.GetV1TaskIdCallback(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1TaskIdCallbackResponse(rsp)
+}
+
+// PostV1TaskIdCancelWithResponse request returning *PostV1TaskIdCancelResponse
+func (c *ClientWithResponses) PostV1TaskIdCancelWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostV1TaskIdCancelResponse, error) {
+	rsp, err := c.PostV1TaskIdCancel(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1TaskIdCancelResponse(rsp)
+}
+
+// GetV1TaskIdLogsWithResponse request returning *GetV1TaskIdLogsResponse
+func (c *ClientWithResponses) GetV1TaskIdLogsWithResponse(ctx context.Context, id string, params *GetV1TaskIdLogsParams, reqEditors ...RequestEditorFn) (*GetV1TaskIdLogsResponse, error) {
+	rsp, err := c.GetV1TaskIdLogs(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1TaskIdLogsResponse(rsp)
+}
+
+// PostV1TaskIdLogsWithBodyWithResponse request with arbitrary body returning *PostV1TaskIdLogsResponse
+func (c *ClientWithResponses) PostV1TaskIdLogsWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1TaskIdLogsResponse, error) {
+	rsp, err := c.PostV1TaskIdLogsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1TaskIdLogsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV1TaskIdLogsWithResponse(ctx context.Context, id string, body PostV1TaskIdLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1TaskIdLogsResponse, error) {
+	rsp, err := c.PostV1TaskIdLogs(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1TaskIdLogsResponse(rsp)
+}
+
+// GetV1TaskIdLogsExportWithResponse request returning *GetV1TaskIdLogsExportResponse
+func (c *ClientWithResponses) GetV1TaskIdLogsExportWithResponse(ctx context.Context, id string, params *GetV1TaskIdLogsExportParams, reqEditors ...RequestEditorFn) (*GetV1TaskIdLogsExportResponse, error) {
+	rsp, err := c.GetV1TaskIdLogsExport(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1TaskIdLogsExportResponse(rsp)
+}
+
+// GetV1TaskIdLogsStreamWithResponse request returning *GetV1TaskIdLogsStreamResponse
+func (c *ClientWithResponses) GetV1TaskIdLogsStreamWithResponse(ctx context.Context, id string, params *GetV1TaskIdLogsStreamParams, reqEditors ...RequestEditorFn) (*GetV1TaskIdLogsStreamResponse, error) {
+	rsp, err := c.GetV1TaskIdLogsStream(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1TaskIdLogsStreamResponse(rsp)
+}
+
+// GetV1TaskIdResultWithResponse request returning *GetV1TaskIdResultResponse
+func (c *ClientWithResponses) GetV1TaskIdResultWithResponse(ctx context.Context, id string, params *GetV1TaskIdResultParams, reqEditors ...RequestEditorFn) (*GetV1TaskIdResultResponse, error) {
+	rsp, err := c.GetV1TaskIdResult(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1TaskIdResultResponse(rsp)
+}
+
+// GetV1TaskIdRetriesWithResponse request returning *GetV1TaskIdRetriesResponse
+func (c *ClientWithResponses) GetV1TaskIdRetriesWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetV1TaskIdRetriesResponse, error) {
+	rsp, err := c.GetV1TaskIdRetries(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1TaskIdRetriesResponse(rsp)
+}
+
+// PostV1TaskIdRetryWithResponse request returning *PostV1TaskIdRetryResponse
+func (c *ClientWithResponses) PostV1TaskIdRetryWithResponse(ctx context.Context, id string, params *PostV1TaskIdRetryParams, reqEditors ...RequestEditorFn) (*PostV1TaskIdRetryResponse, error) {
+	rsp, err := c.PostV1TaskIdRetry(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1TaskIdRetryResponse(rsp)
+}
+
+// GetV1TaskIdTransitionsWithResponse request returning *GetV1TaskIdTransitionsResponse
+func (c *ClientWithResponses) GetV1TaskIdTransitionsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetV1TaskIdTransitionsResponse, error) {
+	rsp, err := c.GetV1TaskIdTransitions(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1TaskIdTransitionsResponse(rsp)
+}
+
+// GetV1UserWithResponse request returning *GetV1UserResponse
+func (c *ClientWithResponses) GetV1UserWithResponse(ctx context.Context, params *GetV1UserParams, reqEditors ...RequestEditorFn) (*GetV1UserResponse, error) {
+	rsp, err := c.GetV1User(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetV1UserResponse(rsp)
 }
 
-type GetV1ArtifactRawNamespaceNameHashHashResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *GenericBadRequest
-	JSON404      *GenericNotFound
-	JSON413      *GenericTooLarge
+// DeleteV1UserMeWithResponse request returning *DeleteV1UserMeResponse
+func (c *ClientWithResponses) DeleteV1UserMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteV1UserMeResponse, error) {
+	rsp, err := c.DeleteV1UserMe(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV1UserMeResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetV1ArtifactRawNamespaceNameHashHashResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetV1UserMeWithResponse request returning *GetV1UserMeResponse
+func (c *ClientWithResponses) GetV1UserMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1UserMeResponse, error) {
+	rsp, err := c.GetV1UserMe(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetV1UserMeResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV1ArtifactRawNamespaceNameHashHashResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PatchV1UserMeWithBodyWithResponse request with arbitrary body returning *PatchV1UserMeResponse
+func (c *ClientWithResponses) PatchV1UserMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1UserMeResponse, error) {
+	rsp, err := c.PatchV1UserMeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePatchV1UserMeResponse(rsp)
 }
 
-type GetV1ArtifactRawNamespaceNameTagTagResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *GenericBadRequest
-	JSON404      *GenericNotFound
-	JSON413      *GenericTooLarge
+func (c *ClientWithResponses) PatchV1UserMeWithResponse(ctx context.Context, body PatchV1UserMeJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1UserMeResponse, error) {
+	rsp, err := c.PatchV1UserMe(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV1UserMeResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetV1ArtifactRawNamespaceNameTagTagResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteV1UserUsernameWithResponse request returning *DeleteV1UserUsernameResponse
+func (c *ClientWithResponses) DeleteV1UserUsernameWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*DeleteV1UserUsernameResponse, error) {
+	rsp, err := c.DeleteV1UserUsername(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeleteV1UserUsernameResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV1ArtifactRawNamespaceNameTagTagResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetV1UserUsernameWithResponse request returning *GetV1UserUsernameResponse
+func (c *ClientWithResponses) GetV1UserUsernameWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*GetV1UserUsernameResponse, error) {
+	rsp, err := c.GetV1UserUsername(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetV1UserUsernameResponse(rsp)
 }
 
-type GetV1ArtifactNamespaceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Artifact
-	JSON400      *GenericBadRequest
-	JSON413      *GenericTooLarge
+// HeadV1UserUsernameWithResponse request returning *HeadV1UserUsernameResponse
+func (c *ClientWithResponses) HeadV1UserUsernameWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*HeadV1UserUsernameResponse, error) {
+	rsp, err := c.HeadV1UserUsername(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHeadV1UserUsernameResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetV1ArtifactNamespaceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PatchV1UserUsernameWithBodyWithResponse request with arbitrary body returning *PatchV1UserUsernameResponse
+func (c *ClientWithResponses) PatchV1UserUsernameWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1UserUsernameResponse, error) {
+	rsp, err := c.PatchV1UserUsernameWithBody(ctx, username, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePatchV1UserUsernameResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV1ArtifactNamespaceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PatchV1UserUsernameWithResponse(ctx context.Context, username string, body PatchV1UserUsernameJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1UserUsernameResponse, error) {
+	rsp, err := c.PatchV1UserUsername(ctx, username, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePatchV1UserUsernameResponse(rsp)
 }
 
-type GetV1ArtifactNamespaceNameResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Artifact
-	JSON400      *GenericBadRequest
-	JSON413      *GenericTooLarge
+// PutV1UserUsernameWithBodyWithResponse request with arbitrary body returning *PutV1UserUsernameResponse
+func (c *ClientWithResponses) PutV1UserUsernameWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1UserUsernameResponse, error) {
+	rsp, err := c.PutV1UserUsernameWithBody(ctx, username, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV1UserUsernameResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetV1ArtifactNamespaceNameResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PutV1UserUsernameWithResponse(ctx context.Context, username string, body PutV1UserUsernameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV1UserUsernameResponse, error) {
+	rsp, err := c.PutV1UserUsername(ctx, username, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePutV1UserUsernameResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV1ArtifactNamespaceNameResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetV1WorkflowWithResponse request returning *GetV1WorkflowResponse
+func (c *ClientWithResponses) GetV1WorkflowWithResponse(ctx context.Context, params *GetV1WorkflowParams, reqEditors ...RequestEditorFn) (*GetV1WorkflowResponse, error) {
+	rsp, err := c.GetV1Workflow(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetV1WorkflowResponse(rsp)
 }
 
-type DeleteV1ArtifactNamespaceNameHashHashResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Artifact
-	JSON400      *GenericBadRequest
-	JSON404      *GenericNotFound
-	JSON413      *GenericTooLarge
+// PostV1WorkflowWithBodyWithResponse request with arbitrary body returning *PostV1WorkflowResponse
+func (c *ClientWithResponses) PostV1WorkflowWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1WorkflowResponse, error) {
+	rsp, err := c.PostV1WorkflowWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1WorkflowResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteV1ArtifactNamespaceNameHashHashResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostV1WorkflowWithResponse(ctx context.Context, body PostV1WorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1WorkflowResponse, error) {
+	rsp, err := c.PostV1Workflow(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostV1WorkflowResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteV1ArtifactNamespaceNameHashHashResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetV1WorkflowIdWithResponse request returning *GetV1WorkflowIdResponse
+func (c *ClientWithResponses) GetV1WorkflowIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetV1WorkflowIdResponse, error) {
+	rsp, err := c.GetV1WorkflowId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetV1WorkflowIdResponse(rsp)
+}
+
+// ParseGetV1ArtifactResponse parses an HTTP response from a GetV1ArtifactWithResponse call
+func ParseGetV1ArtifactResponse(rsp *http.Response) (*GetV1ArtifactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1ArtifactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Artifact
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	}
+
+	return response, nil
 }
 
-type GetV1ArtifactNamespaceNameHashHashResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Artifact
-	JSON400      *GenericBadRequest
-	JSON404      *GenericNotFound
-	JSON413      *GenericTooLarge
+// ParsePostV1ArtifactRawNamespaceNameResponse parses an HTTP response from a PostV1ArtifactRawNamespaceNameWithResponse call
+func ParsePostV1ArtifactRawNamespaceNameResponse(rsp *http.Response) (*PostV1ArtifactRawNamespaceNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1ArtifactRawNamespaceNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest UploadArtifactResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrGeneric
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetV1ArtifactNamespaceNameHashHashResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetV1ArtifactRawNamespaceNameHashHashResponse parses an HTTP response from a GetV1ArtifactRawNamespaceNameHashHashWithResponse call
+func ParseGetV1ArtifactRawNamespaceNameHashHashResponse(rsp *http.Response) (*GetV1ArtifactRawNamespaceNameHashHashResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV1ArtifactNamespaceNameHashHashResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetV1ArtifactRawNamespaceNameHashHashResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type PatchV1ArtifactNamespaceNameHashHashResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Artifact
-	JSON400      *GenericBadRequest
-	JSON404      *GenericNotFound
-	JSON413      *GenericTooLarge
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// Status returns HTTPResponse.Status
-func (r PatchV1ArtifactNamespaceNameHashHashResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchV1ArtifactNamespaceNameHashHashResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type DeleteV1ArtifactNamespaceNameTagTagResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Artifact
-	JSON400      *GenericBadRequest
-	JSON404      *GenericNotFound
-	JSON413      *GenericTooLarge
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteV1ArtifactNamespaceNameTagTagResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetV1ArtifactRawNamespaceNameTagTagResponse parses an HTTP response from a GetV1ArtifactRawNamespaceNameTagTagWithResponse call
+func ParseGetV1ArtifactRawNamespaceNameTagTagResponse(rsp *http.Response) (*GetV1ArtifactRawNamespaceNameTagTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteV1ArtifactNamespaceNameTagTagResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetV1ArtifactRawNamespaceNameTagTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetV1ArtifactNamespaceNameTagTagResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Artifact
-	JSON400      *GenericBadRequest
-	JSON404      *GenericNotFound
-	JSON413      *GenericTooLarge
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetV1ArtifactNamespaceNameTagTagResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV1ArtifactNamespaceNameTagTagResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type PatchV1ArtifactNamespaceNameTagTagResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Artifact
-	JSON400      *GenericBadRequest
-	JSON404      *GenericNotFound
-	JSON413      *GenericTooLarge
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PatchV1ArtifactNamespaceNameTagTagResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetV1ArtifactNamespaceResponse parses an HTTP response from a GetV1ArtifactNamespaceWithResponse call
+func ParseGetV1ArtifactNamespaceResponse(rsp *http.Response) (*GetV1ArtifactNamespaceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchV1ArtifactNamespaceNameTagTagResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetV1ArtifactNamespaceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeleteV1RbacPolicyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *GenericBadRequest
-	JSON409      *ErrGeneric
-	JSON413      *GenericTooLarge
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Artifact
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeleteV1RbacPolicyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteV1RbacPolicyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetV1RbacPolicyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]RBACPolicy
-	JSON413      *GenericTooLarge
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetV1RbacPolicyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetV1ArtifactNamespaceNameResponse parses an HTTP response from a GetV1ArtifactNamespaceNameWithResponse call
+func ParseGetV1ArtifactNamespaceNameResponse(rsp *http.Response) (*GetV1ArtifactNamespaceNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV1RbacPolicyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetV1ArtifactNamespaceNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type PutV1RbacPolicyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *GenericBadRequest
-	JSON404      *FieldError
-	JSON413      *GenericTooLarge
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Artifact
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// Status returns HTTPResponse.Status
-func (r PutV1RbacPolicyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutV1RbacPolicyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetV1RbacResourceGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]ResourceGroupResource
-	JSON413      *GenericTooLarge
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetV1RbacResourceGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteV1ArtifactNamespaceNameHashHashResponse parses an HTTP response from a DeleteV1ArtifactNamespaceNameHashHashWithResponse call
+func ParseDeleteV1ArtifactNamespaceNameHashHashResponse(rsp *http.Response) (*DeleteV1ArtifactNamespaceNameHashHashResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV1RbacResourceGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteV1ArtifactNamespaceNameHashHashResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
-
-type DeleteV1RbacResourceGroupResourceGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ResourceGroupResource
-	JSON400      *GenericBadRequest
-	JSON404      *GenericNotFound
-	JSON413      *GenericTooLarge
-}
 
-// Status returns HTTPResponse.Status
-func (r DeleteV1RbacResourceGroupResourceGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Artifact
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteV1RbacResourceGroupResourceGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-type GetV1RbacResourceGroupResourceGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ResourceGroupResource
-	JSON400      *GenericBadRequest
-	JSON404      *GenericNotFound
-	JSON413      *GenericTooLarge
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetV1RbacResourceGroupResourceGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV1RbacResourceGroupResourceGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type HeadV1RbacResourceGroupResourceGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON413      *GenericTooLarge
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r HeadV1RbacResourceGroupResourceGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetV1ArtifactNamespaceNameHashHashResponse parses an HTTP response from a GetV1ArtifactNamespaceNameHashHashWithResponse call
+func ParseGetV1ArtifactNamespaceNameHashHashResponse(rsp *http.Response) (*GetV1ArtifactNamespaceNameHashHashResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r HeadV1RbacResourceGroupResourceGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetV1ArtifactNamespaceNameHashHashResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type PutV1RbacResourceGroupResourceGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *ResourceGroupResource
-	JSON400      *GenericBadRequest
-	JSON409      *ErrGeneric
-	JSON413      *GenericTooLarge
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Artifact
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r PutV1RbacResourceGroupResourceGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutV1RbacResourceGroupResourceGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetV1RbacRoleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]RoleResource
-	JSON413      *GenericTooLarge
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetV1RbacRoleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParsePatchV1ArtifactNamespaceNameHashHashResponse parses an HTTP response from a PatchV1ArtifactNamespaceNameHashHashWithResponse call
+func ParsePatchV1ArtifactNamespaceNameHashHashResponse(rsp *http.Response) (*PatchV1ArtifactNamespaceNameHashHashResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV1RbacRoleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &PatchV1ArtifactNamespaceNameHashHashResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeleteV1RbacRoleRoleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *RoleResource
-	JSON400      *GenericBadRequest
-	JSON404      *GenericNotFound
-	JSON409      *ErrGeneric
-	JSON413      *GenericTooLarge
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Artifact
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeleteV1RbacRoleRoleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteV1RbacRoleRoleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetV1RbacRoleRoleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *RoleResource
-	JSON400      *GenericBadRequest
-	JSON404      *GenericNotFound
-	JSON413      *GenericTooLarge
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetV1RbacRoleRoleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteV1ArtifactNamespaceNameTagTagResponse parses an HTTP response from a DeleteV1ArtifactNamespaceNameTagTagWithResponse call
+func ParseDeleteV1ArtifactNamespaceNameTagTagResponse(rsp *http.Response) (*DeleteV1ArtifactNamespaceNameTagTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV1RbacRoleRoleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteV1ArtifactNamespaceNameTagTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
-
-type HeadV1RbacRoleRoleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON413      *GenericTooLarge
-}
 
-// Status returns HTTPResponse.Status
-func (r HeadV1RbacRoleRoleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Artifact
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r HeadV1RbacRoleRoleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-type PutV1RbacRoleRoleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *RoleResource
-	JSON400      *GenericBadRequest
-	JSON409      *ErrGeneric
-	JSON413      *GenericTooLarge
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// Status returns HTTPResponse.Status
-func (r PutV1RbacRoleRoleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutV1RbacRoleRoleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetV1TaskResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Task
-	JSON400      *GenericBadRequest
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetV1TaskResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetV1ArtifactNamespaceNameTagTagResponse parses an HTTP response from a GetV1ArtifactNamespaceNameTagTagWithResponse call
+func ParseGetV1ArtifactNamespaceNameTagTagResponse(rsp *http.Response) (*GetV1ArtifactNamespaceNameTagTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV1TaskResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetV1ArtifactNamespaceNameTagTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
-
-type PostV1TaskResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Task
-	JSON400      *GenericBadRequest
-	JSON413      *GenericTooLarge
-}
 
-// Status returns HTTPResponse.Status
-func (r PostV1TaskResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Artifact
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostV1TaskResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-type GetV1TaskIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Task
-	JSON400      *GenericBadRequest
-	JSON404      *GenericNotFound
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetV1TaskIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV1TaskIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetV1TaskIdLogsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]TaskLog
-	JSON400      *GenericBadRequest
-	JSON404      *GenericNotFound
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetV1TaskIdLogsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParsePatchV1ArtifactNamespaceNameTagTagResponse parses an HTTP response from a PatchV1ArtifactNamespaceNameTagTagWithResponse call
+func ParsePatchV1ArtifactNamespaceNameTagTagResponse(rsp *http.Response) (*PatchV1ArtifactNamespaceNameTagTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV1TaskIdLogsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &PatchV1ArtifactNamespaceNameTagTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetV1UserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]UserResponse
-	JSON400      *GenericBadRequest
-	JSON413      *GenericTooLarge
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Artifact
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetV1UserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV1UserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type DeleteV1UserMeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserResponse
-	JSON413      *GenericTooLarge
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteV1UserMeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetV1QueueResponse parses an HTTP response from a GetV1QueueWithResponse call
+func ParseGetV1QueueResponse(rsp *http.Response) (*GetV1QueueResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteV1UserMeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetV1QueueResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetV1UserMeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserResponse
-	JSON413      *GenericTooLarge
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []QueueStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetV1UserMeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV1UserMeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type PatchV1UserMeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserResponse
-	JSON400      *GenericBadRequest
-	JSON409      *ErrGeneric
-	JSON413      *GenericTooLarge
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PatchV1UserMeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetV1QueueNameResponse parses an HTTP response from a GetV1QueueNameWithResponse call
+func ParseGetV1QueueNameResponse(rsp *http.Response) (*GetV1QueueNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchV1UserMeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetV1QueueNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeleteV1UserUsernameResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserResponse
-	JSON400      *GenericBadRequest
-	JSON404      *GenericNotFound
-	JSON413      *GenericTooLarge
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest QueueStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeleteV1UserUsernameResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteV1UserUsernameResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetV1UserUsernameResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserResponse
-	JSON400      *GenericBadRequest
-	JSON404      *GenericNotFound
-	JSON413      *GenericTooLarge
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetV1UserUsernameResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParsePostV1QueueNamePauseResponse parses an HTTP response from a PostV1QueueNamePauseWithResponse call
+func ParsePostV1QueueNamePauseResponse(rsp *http.Response) (*PostV1QueueNamePauseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV1UserUsernameResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &PostV1QueueNamePauseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type HeadV1UserUsernameResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON413      *GenericTooLarge
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest QueueStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r HeadV1UserUsernameResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r HeadV1UserUsernameResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type PatchV1UserUsernameResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserResponse
-	JSON400      *GenericBadRequest
-	JSON404      *GenericNotFound
-	JSON409      *ErrGeneric
-	JSON413      *GenericTooLarge
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PatchV1UserUsernameResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParsePostV1QueueNameResumeResponse parses an HTTP response from a PostV1QueueNameResumeWithResponse call
+func ParsePostV1QueueNameResumeResponse(rsp *http.Response) (*PostV1QueueNameResumeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchV1UserUsernameResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &PostV1QueueNameResumeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type PutV1UserUsernameResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *UserResponse
-	JSON400      *GenericBadRequest
-	JSON409      *ErrGeneric
-	JSON413      *GenericTooLarge
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest QueueStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// Status returns HTTPResponse.Status
-func (r PutV1UserUsernameResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutV1UserUsernameResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-// GetV1ArtifactWithResponse request returning *GetV1ArtifactResponse
-func (c *ClientWithResponses) GetV1ArtifactWithResponse(ctx context.Context, params *GetV1ArtifactParams, reqEditors ...RequestEditorFn) (*GetV1ArtifactResponse, error) {
-	rsp, err := c.GetV1Artifact(ctx, params, reqEditors...)
+// ParseDeleteV1RbacPolicyResponse parses an HTTP response from a DeleteV1RbacPolicyWithResponse call
+func ParseDeleteV1RbacPolicyResponse(rsp *http.Response) (*DeleteV1RbacPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetV1ArtifactResponse(rsp)
-}
 
-// PostV1ArtifactRawNamespaceNameWithBodyWithResponse request with arbitrary body returning *PostV1ArtifactRawNamespaceNameResponse
-func (c *ClientWithResponses) PostV1ArtifactRawNamespaceNameWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1ArtifactRawNamespaceNameResponse, error) {
-	rsp, err := c.PostV1ArtifactRawNamespaceNameWithBody(ctx, namespace, name, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteV1RbacPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostV1ArtifactRawNamespaceNameResponse(rsp)
-}
 
-// GetV1ArtifactRawNamespaceNameHashHashWithResponse request returning *GetV1ArtifactRawNamespaceNameHashHashResponse
-func (c *ClientWithResponses) GetV1ArtifactRawNamespaceNameHashHashWithResponse(ctx context.Context, namespace string, name string, hash string, reqEditors ...RequestEditorFn) (*GetV1ArtifactRawNamespaceNameHashHashResponse, error) {
-	rsp, err := c.GetV1ArtifactRawNamespaceNameHashHash(ctx, namespace, name, hash, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetV1ArtifactRawNamespaceNameHashHashResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// GetV1ArtifactRawNamespaceNameTagTagWithResponse request returning *GetV1ArtifactRawNamespaceNameTagTagResponse
-func (c *ClientWithResponses) GetV1ArtifactRawNamespaceNameTagTagWithResponse(ctx context.Context, namespace string, name string, tag string, reqEditors ...RequestEditorFn) (*GetV1ArtifactRawNamespaceNameTagTagResponse, error) {
-	rsp, err := c.GetV1ArtifactRawNamespaceNameTagTag(ctx, namespace, name, tag, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetV1ArtifactRawNamespaceNameTagTagResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrGeneric
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
 
-// GetV1ArtifactNamespaceWithResponse request returning *GetV1ArtifactNamespaceResponse
-func (c *ClientWithResponses) GetV1ArtifactNamespaceWithResponse(ctx context.Context, namespace string, params *GetV1ArtifactNamespaceParams, reqEditors ...RequestEditorFn) (*GetV1ArtifactNamespaceResponse, error) {
-	rsp, err := c.GetV1ArtifactNamespace(ctx, namespace, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetV1ArtifactNamespaceResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
 
-// GetV1ArtifactNamespaceNameWithResponse request returning *GetV1ArtifactNamespaceNameResponse
-func (c *ClientWithResponses) GetV1ArtifactNamespaceNameWithResponse(ctx context.Context, namespace string, name string, params *GetV1ArtifactNamespaceNameParams, reqEditors ...RequestEditorFn) (*GetV1ArtifactNamespaceNameResponse, error) {
-	rsp, err := c.GetV1ArtifactNamespaceName(ctx, namespace, name, params, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseGetV1ArtifactNamespaceNameResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteV1ArtifactNamespaceNameHashHashWithResponse request returning *DeleteV1ArtifactNamespaceNameHashHashResponse
-func (c *ClientWithResponses) DeleteV1ArtifactNamespaceNameHashHashWithResponse(ctx context.Context, namespace string, name string, hash string, reqEditors ...RequestEditorFn) (*DeleteV1ArtifactNamespaceNameHashHashResponse, error) {
-	rsp, err := c.DeleteV1ArtifactNamespaceNameHashHash(ctx, namespace, name, hash, reqEditors...)
+// ParseGetV1RbacPolicyResponse parses an HTTP response from a GetV1RbacPolicyWithResponse call
+func ParseGetV1RbacPolicyResponse(rsp *http.Response) (*GetV1RbacPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteV1ArtifactNamespaceNameHashHashResponse(rsp)
-}
 
-// GetV1ArtifactNamespaceNameHashHashWithResponse request returning *GetV1ArtifactNamespaceNameHashHashResponse
-func (c *ClientWithResponses) GetV1ArtifactNamespaceNameHashHashWithResponse(ctx context.Context, namespace string, name string, hash string, reqEditors ...RequestEditorFn) (*GetV1ArtifactNamespaceNameHashHashResponse, error) {
-	rsp, err := c.GetV1ArtifactNamespaceNameHashHash(ctx, namespace, name, hash, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetV1RbacPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetV1ArtifactNamespaceNameHashHashResponse(rsp)
-}
 
-// PatchV1ArtifactNamespaceNameHashHashWithBodyWithResponse request with arbitrary body returning *PatchV1ArtifactNamespaceNameHashHashResponse
-func (c *ClientWithResponses) PatchV1ArtifactNamespaceNameHashHashWithBodyWithResponse(ctx context.Context, namespace string, name string, hash string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1ArtifactNamespaceNameHashHashResponse, error) {
-	rsp, err := c.PatchV1ArtifactNamespaceNameHashHashWithBody(ctx, namespace, name, hash, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RBACPolicy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
 	}
-	return ParsePatchV1ArtifactNamespaceNameHashHashResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PatchV1ArtifactNamespaceNameHashHashWithResponse(ctx context.Context, namespace string, name string, hash string, body PatchV1ArtifactNamespaceNameHashHashJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1ArtifactNamespaceNameHashHashResponse, error) {
-	rsp, err := c.PatchV1ArtifactNamespaceNameHashHash(ctx, namespace, name, hash, body, reqEditors...)
+// ParsePutV1RbacPolicyResponse parses an HTTP response from a PutV1RbacPolicyWithResponse call
+func ParsePutV1RbacPolicyResponse(rsp *http.Response) (*PutV1RbacPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchV1ArtifactNamespaceNameHashHashResponse(rsp)
-}
 
-// DeleteV1ArtifactNamespaceNameTagTagWithResponse request returning *DeleteV1ArtifactNamespaceNameTagTagResponse
-func (c *ClientWithResponses) DeleteV1ArtifactNamespaceNameTagTagWithResponse(ctx context.Context, namespace string, name string, tag string, reqEditors ...RequestEditorFn) (*DeleteV1ArtifactNamespaceNameTagTagResponse, error) {
-	rsp, err := c.DeleteV1ArtifactNamespaceNameTagTag(ctx, namespace, name, tag, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PutV1RbacPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteV1ArtifactNamespaceNameTagTagResponse(rsp)
-}
 
-// GetV1ArtifactNamespaceNameTagTagWithResponse request returning *GetV1ArtifactNamespaceNameTagTagResponse
-func (c *ClientWithResponses) GetV1ArtifactNamespaceNameTagTagWithResponse(ctx context.Context, namespace string, name string, tag string, reqEditors ...RequestEditorFn) (*GetV1ArtifactNamespaceNameTagTagResponse, error) {
-	rsp, err := c.GetV1ArtifactNamespaceNameTagTag(ctx, namespace, name, tag, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest FieldError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
 	}
-	return ParseGetV1ArtifactNamespaceNameTagTagResponse(rsp)
+
+	return response, nil
 }
 
-// PatchV1ArtifactNamespaceNameTagTagWithBodyWithResponse request with arbitrary body returning *PatchV1ArtifactNamespaceNameTagTagResponse
-func (c *ClientWithResponses) PatchV1ArtifactNamespaceNameTagTagWithBodyWithResponse(ctx context.Context, namespace string, name string, tag string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1ArtifactNamespaceNameTagTagResponse, error) {
-	rsp, err := c.PatchV1ArtifactNamespaceNameTagTagWithBody(ctx, namespace, name, tag, contentType, body, reqEditors...)
+// ParseGetV1RbacResourceGroupResponse parses an HTTP response from a GetV1RbacResourceGroupWithResponse call
+func ParseGetV1RbacResourceGroupResponse(rsp *http.Response) (*GetV1RbacResourceGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchV1ArtifactNamespaceNameTagTagResponse(rsp)
-}
 
-func (c *ClientWithResponses) PatchV1ArtifactNamespaceNameTagTagWithResponse(ctx context.Context, namespace string, name string, tag string, body PatchV1ArtifactNamespaceNameTagTagJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1ArtifactNamespaceNameTagTagResponse, error) {
-	rsp, err := c.PatchV1ArtifactNamespaceNameTagTag(ctx, namespace, name, tag, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetV1RbacResourceGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePatchV1ArtifactNamespaceNameTagTagResponse(rsp)
-}
 
-// DeleteV1RbacPolicyWithBodyWithResponse request with arbitrary body returning *DeleteV1RbacPolicyResponse
-func (c *ClientWithResponses) DeleteV1RbacPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteV1RbacPolicyResponse, error) {
-	rsp, err := c.DeleteV1RbacPolicyWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ResourceGroupResource
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
 	}
-	return ParseDeleteV1RbacPolicyResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) DeleteV1RbacPolicyWithResponse(ctx context.Context, body DeleteV1RbacPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteV1RbacPolicyResponse, error) {
-	rsp, err := c.DeleteV1RbacPolicy(ctx, body, reqEditors...)
+// ParseDeleteV1RbacResourceGroupResourceGroupResponse parses an HTTP response from a DeleteV1RbacResourceGroupResourceGroupWithResponse call
+func ParseDeleteV1RbacResourceGroupResourceGroupResponse(rsp *http.Response) (*DeleteV1RbacResourceGroupResourceGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteV1RbacPolicyResponse(rsp)
-}
 
-// GetV1RbacPolicyWithResponse request returning *GetV1RbacPolicyResponse
-func (c *ClientWithResponses) GetV1RbacPolicyWithResponse(ctx context.Context, params *GetV1RbacPolicyParams, reqEditors ...RequestEditorFn) (*GetV1RbacPolicyResponse, error) {
-	rsp, err := c.GetV1RbacPolicy(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteV1RbacResourceGroupResourceGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetV1RbacPolicyResponse(rsp)
-}
 
-// PutV1RbacPolicyWithBodyWithResponse request with arbitrary body returning *PutV1RbacPolicyResponse
-func (c *ClientWithResponses) PutV1RbacPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1RbacPolicyResponse, error) {
-	rsp, err := c.PutV1RbacPolicyWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResourceGroupResource
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
 	}
-	return ParsePutV1RbacPolicyResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutV1RbacPolicyWithResponse(ctx context.Context, body PutV1RbacPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV1RbacPolicyResponse, error) {
-	rsp, err := c.PutV1RbacPolicy(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePutV1RbacPolicyResponse(rsp)
+	return response, nil
 }
 
-// GetV1RbacResourceGroupWithResponse request returning *GetV1RbacResourceGroupResponse
-func (c *ClientWithResponses) GetV1RbacResourceGroupWithResponse(ctx context.Context, params *GetV1RbacResourceGroupParams, reqEditors ...RequestEditorFn) (*GetV1RbacResourceGroupResponse, error) {
-	rsp, err := c.GetV1RbacResourceGroup(ctx, params, reqEditors...)
+// ParseGetV1RbacResourceGroupResourceGroupResponse parses an HTTP response from a GetV1RbacResourceGroupResourceGroupWithResponse call
+func ParseGetV1RbacResourceGroupResourceGroupResponse(rsp *http.Response) (*GetV1RbacResourceGroupResourceGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetV1RbacResourceGroupResponse(rsp)
-}
 
-// DeleteV1RbacResourceGroupResourceGroupWithResponse request returning *DeleteV1RbacResourceGroupResourceGroupResponse
-func (c *ClientWithResponses) DeleteV1RbacResourceGroupResourceGroupWithResponse(ctx context.Context, resourceGroup string, reqEditors ...RequestEditorFn) (*DeleteV1RbacResourceGroupResourceGroupResponse, error) {
-	rsp, err := c.DeleteV1RbacResourceGroupResourceGroup(ctx, resourceGroup, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetV1RbacResourceGroupResourceGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteV1RbacResourceGroupResourceGroupResponse(rsp)
-}
 
-// GetV1RbacResourceGroupResourceGroupWithResponse request returning *GetV1RbacResourceGroupResourceGroupResponse
-func (c *ClientWithResponses) GetV1RbacResourceGroupResourceGroupWithResponse(ctx context.Context, resourceGroup string, reqEditors ...RequestEditorFn) (*GetV1RbacResourceGroupResourceGroupResponse, error) {
-	rsp, err := c.GetV1RbacResourceGroupResourceGroup(ctx, resourceGroup, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResourceGroupResource
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
 	}
-	return ParseGetV1RbacResourceGroupResourceGroupResponse(rsp)
+
+	return response, nil
 }
 
-// HeadV1RbacResourceGroupResourceGroupWithResponse request returning *HeadV1RbacResourceGroupResourceGroupResponse
-func (c *ClientWithResponses) HeadV1RbacResourceGroupResourceGroupWithResponse(ctx context.Context, resourceGroup string, reqEditors ...RequestEditorFn) (*HeadV1RbacResourceGroupResourceGroupResponse, error) {
-	rsp, err := c.HeadV1RbacResourceGroupResourceGroup(ctx, resourceGroup, reqEditors...)
+// ParseHeadV1RbacResourceGroupResourceGroupResponse parses an HTTP response from a HeadV1RbacResourceGroupResourceGroupWithResponse call
+func ParseHeadV1RbacResourceGroupResourceGroupResponse(rsp *http.Response) (*HeadV1RbacResourceGroupResourceGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseHeadV1RbacResourceGroupResourceGroupResponse(rsp)
-}
 
-// PutV1RbacResourceGroupResourceGroupWithBodyWithResponse request with arbitrary body returning *PutV1RbacResourceGroupResourceGroupResponse
-func (c *ClientWithResponses) PutV1RbacResourceGroupResourceGroupWithBodyWithResponse(ctx context.Context, resourceGroup string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1RbacResourceGroupResourceGroupResponse, error) {
-	rsp, err := c.PutV1RbacResourceGroupResourceGroupWithBody(ctx, resourceGroup, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &HeadV1RbacResourceGroupResourceGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePutV1RbacResourceGroupResourceGroupResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutV1RbacResourceGroupResourceGroupWithResponse(ctx context.Context, resourceGroup string, body PutV1RbacResourceGroupResourceGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV1RbacResourceGroupResourceGroupResponse, error) {
-	rsp, err := c.PutV1RbacResourceGroupResourceGroup(ctx, resourceGroup, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
 	}
-	return ParsePutV1RbacResourceGroupResourceGroupResponse(rsp)
+
+	return response, nil
 }
 
-// GetV1RbacRoleWithResponse request returning *GetV1RbacRoleResponse
-func (c *ClientWithResponses) GetV1RbacRoleWithResponse(ctx context.Context, params *GetV1RbacRoleParams, reqEditors ...RequestEditorFn) (*GetV1RbacRoleResponse, error) {
-	rsp, err := c.GetV1RbacRole(ctx, params, reqEditors...)
+// ParsePutV1RbacResourceGroupResourceGroupResponse parses an HTTP response from a PutV1RbacResourceGroupResourceGroupWithResponse call
+func ParsePutV1RbacResourceGroupResourceGroupResponse(rsp *http.Response) (*PutV1RbacResourceGroupResourceGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetV1RbacRoleResponse(rsp)
-}
 
-// DeleteV1RbacRoleRoleWithResponse request returning *DeleteV1RbacRoleRoleResponse
-func (c *ClientWithResponses) DeleteV1RbacRoleRoleWithResponse(ctx context.Context, role string, reqEditors ...RequestEditorFn) (*DeleteV1RbacRoleRoleResponse, error) {
-	rsp, err := c.DeleteV1RbacRoleRole(ctx, role, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PutV1RbacResourceGroupResourceGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteV1RbacRoleRoleResponse(rsp)
-}
 
-// GetV1RbacRoleRoleWithResponse request returning *GetV1RbacRoleRoleResponse
-func (c *ClientWithResponses) GetV1RbacRoleRoleWithResponse(ctx context.Context, role string, reqEditors ...RequestEditorFn) (*GetV1RbacRoleRoleResponse, error) {
-	rsp, err := c.GetV1RbacRoleRole(ctx, role, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ResourceGroupResource
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrGeneric
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
 	}
-	return ParseGetV1RbacRoleRoleResponse(rsp)
+
+	return response, nil
 }
 
-// HeadV1RbacRoleRoleWithResponse request returning *HeadV1RbacRoleRoleResponse
-func (c *ClientWithResponses) HeadV1RbacRoleRoleWithResponse(ctx context.Context, role string, reqEditors ...RequestEditorFn) (*HeadV1RbacRoleRoleResponse, error) {
-	rsp, err := c.HeadV1RbacRoleRole(ctx, role, reqEditors...)
+// ParseGetV1RbacRoleResponse parses an HTTP response from a GetV1RbacRoleWithResponse call
+func ParseGetV1RbacRoleResponse(rsp *http.Response) (*GetV1RbacRoleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseHeadV1RbacRoleRoleResponse(rsp)
-}
 
-// PutV1RbacRoleRoleWithBodyWithResponse request with arbitrary body returning *PutV1RbacRoleRoleResponse
-func (c *ClientWithResponses) PutV1RbacRoleRoleWithBodyWithResponse(ctx context.Context, role string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1RbacRoleRoleResponse, error) {
-	rsp, err := c.PutV1RbacRoleRoleWithBody(ctx, role, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetV1RbacRoleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePutV1RbacRoleRoleResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutV1RbacRoleRoleWithResponse(ctx context.Context, role string, body PutV1RbacRoleRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV1RbacRoleRoleResponse, error) {
-	rsp, err := c.PutV1RbacRoleRole(ctx, role, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RoleResource
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
 	}
-	return ParsePutV1RbacRoleRoleResponse(rsp)
+
+	return response, nil
 }
 
-// GetV1TaskWithResponse request returning *GetV1TaskResponse
-func (c *ClientWithResponses) GetV1TaskWithResponse(ctx context.Context, params *GetV1TaskParams, reqEditors ...RequestEditorFn) (*GetV1TaskResponse, error) {
-	rsp, err := c.GetV1Task(ctx, params, reqEditors...)
+// ParseDeleteV1RbacRoleRoleResponse parses an HTTP response from a DeleteV1RbacRoleRoleWithResponse call
+func ParseDeleteV1RbacRoleRoleResponse(rsp *http.Response) (*DeleteV1RbacRoleRoleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetV1TaskResponse(rsp)
-}
 
-// PostV1TaskWithBodyWithResponse request with arbitrary body returning *PostV1TaskResponse
-func (c *ClientWithResponses) PostV1TaskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1TaskResponse, error) {
-	rsp, err := c.PostV1TaskWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteV1RbacRoleRoleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostV1TaskResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostV1TaskWithResponse(ctx context.Context, body PostV1TaskJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1TaskResponse, error) {
-	rsp, err := c.PostV1Task(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RoleResource
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrGeneric
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
 	}
-	return ParsePostV1TaskResponse(rsp)
-}
 
-// GetV1TaskIdWithResponse request returning *GetV1TaskIdResponse
-func (c *ClientWithResponses) GetV1TaskIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetV1TaskIdResponse, error) {
-	rsp, err := c.GetV1TaskId(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetV1TaskIdResponse(rsp)
+	return response, nil
 }
 
-// GetV1TaskIdLogsWithResponse request returning *GetV1TaskIdLogsResponse
-func (c *ClientWithResponses) GetV1TaskIdLogsWithResponse(ctx context.Context, id string, params *GetV1TaskIdLogsParams, reqEditors ...RequestEditorFn) (*GetV1TaskIdLogsResponse, error) {
-	rsp, err := c.GetV1TaskIdLogs(ctx, id, params, reqEditors...)
+// ParseGetV1RbacRoleRoleResponse parses an HTTP response from a GetV1RbacRoleRoleWithResponse call
+func ParseGetV1RbacRoleRoleResponse(rsp *http.Response) (*GetV1RbacRoleRoleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetV1TaskIdLogsResponse(rsp)
-}
 
-// GetV1UserWithResponse request returning *GetV1UserResponse
-func (c *ClientWithResponses) GetV1UserWithResponse(ctx context.Context, params *GetV1UserParams, reqEditors ...RequestEditorFn) (*GetV1UserResponse, error) {
-	rsp, err := c.GetV1User(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetV1RbacRoleRoleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetV1UserResponse(rsp)
-}
 
-// DeleteV1UserMeWithResponse request returning *DeleteV1UserMeResponse
-func (c *ClientWithResponses) DeleteV1UserMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteV1UserMeResponse, error) {
-	rsp, err := c.DeleteV1UserMe(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteV1UserMeResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RoleResource
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// GetV1UserMeWithResponse request returning *GetV1UserMeResponse
-func (c *ClientWithResponses) GetV1UserMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1UserMeResponse, error) {
-	rsp, err := c.GetV1UserMe(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetV1UserMeResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// PatchV1UserMeWithBodyWithResponse request with arbitrary body returning *PatchV1UserMeResponse
-func (c *ClientWithResponses) PatchV1UserMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1UserMeResponse, error) {
-	rsp, err := c.PatchV1UserMeWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePatchV1UserMeResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-func (c *ClientWithResponses) PatchV1UserMeWithResponse(ctx context.Context, body PatchV1UserMeJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1UserMeResponse, error) {
-	rsp, err := c.PatchV1UserMe(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePatchV1UserMeResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
 
-// DeleteV1UserUsernameWithResponse request returning *DeleteV1UserUsernameResponse
-func (c *ClientWithResponses) DeleteV1UserUsernameWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*DeleteV1UserUsernameResponse, error) {
-	rsp, err := c.DeleteV1UserUsername(ctx, username, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseDeleteV1UserUsernameResponse(rsp)
+
+	return response, nil
 }
 
-// GetV1UserUsernameWithResponse request returning *GetV1UserUsernameResponse
-func (c *ClientWithResponses) GetV1UserUsernameWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*GetV1UserUsernameResponse, error) {
-	rsp, err := c.GetV1UserUsername(ctx, username, reqEditors...)
+// ParseHeadV1RbacRoleRoleResponse parses an HTTP response from a HeadV1RbacRoleRoleWithResponse call
+func ParseHeadV1RbacRoleRoleResponse(rsp *http.Response) (*HeadV1RbacRoleRoleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetV1UserUsernameResponse(rsp)
-}
 
-// HeadV1UserUsernameWithResponse request returning *HeadV1UserUsernameResponse
-func (c *ClientWithResponses) HeadV1UserUsernameWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*HeadV1UserUsernameResponse, error) {
-	rsp, err := c.HeadV1UserUsername(ctx, username, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &HeadV1RbacRoleRoleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseHeadV1UserUsernameResponse(rsp)
-}
 
-// PatchV1UserUsernameWithBodyWithResponse request with arbitrary body returning *PatchV1UserUsernameResponse
-func (c *ClientWithResponses) PatchV1UserUsernameWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1UserUsernameResponse, error) {
-	rsp, err := c.PatchV1UserUsernameWithBody(ctx, username, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
 	}
-	return ParsePatchV1UserUsernameResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PatchV1UserUsernameWithResponse(ctx context.Context, username string, body PatchV1UserUsernameJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1UserUsernameResponse, error) {
-	rsp, err := c.PatchV1UserUsername(ctx, username, body, reqEditors...)
+// ParsePutV1RbacRoleRoleResponse parses an HTTP response from a PutV1RbacRoleRoleWithResponse call
+func ParsePutV1RbacRoleRoleResponse(rsp *http.Response) (*PutV1RbacRoleRoleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchV1UserUsernameResponse(rsp)
-}
 
-// PutV1UserUsernameWithBodyWithResponse request with arbitrary body returning *PutV1UserUsernameResponse
-func (c *ClientWithResponses) PutV1UserUsernameWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1UserUsernameResponse, error) {
-	rsp, err := c.PutV1UserUsernameWithBody(ctx, username, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PutV1RbacRoleRoleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePutV1UserUsernameResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutV1UserUsernameWithResponse(ctx context.Context, username string, body PutV1UserUsernameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV1UserUsernameResponse, error) {
-	rsp, err := c.PutV1UserUsername(ctx, username, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest RoleResource
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrGeneric
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest GenericTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
 	}
-	return ParsePutV1UserUsernameResponse(rsp)
+
+	return response, nil
 }
 
-// ParseGetV1ArtifactResponse parses an HTTP response from a GetV1ArtifactWithResponse call
-func ParseGetV1ArtifactResponse(rsp *http.Response) (*GetV1ArtifactResponse, error) {
+// ParseGetV1ScheduleResponse parses an HTTP response from a GetV1ScheduleWithResponse call
+func ParseGetV1ScheduleResponse(rsp *http.Response) (*GetV1ScheduleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV1ArtifactResponse{
+	response := &GetV1ScheduleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Artifact
+		var dest []Schedule
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4727,34 +10160,27 @@ func ParseGetV1ArtifactResponse(rsp *http.Response) (*GetV1ArtifactResponse, err
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest GenericTooLarge
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON413 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParsePostV1ArtifactRawNamespaceNameResponse parses an HTTP response from a PostV1ArtifactRawNamespaceNameWithResponse call
-func ParsePostV1ArtifactRawNamespaceNameResponse(rsp *http.Response) (*PostV1ArtifactRawNamespaceNameResponse, error) {
+// ParsePostV1ScheduleResponse parses an HTTP response from a PostV1ScheduleWithResponse call
+func ParsePostV1ScheduleResponse(rsp *http.Response) (*PostV1ScheduleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostV1ArtifactRawNamespaceNameResponse{
+	response := &PostV1ScheduleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest UploadArtifactResponse
+		var dest Schedule
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4774,32 +10200,39 @@ func ParsePostV1ArtifactRawNamespaceNameResponse(rsp *http.Response) (*PostV1Art
 		}
 		response.JSON409 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest GenericTooLarge
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest FieldError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON413 = &dest
+		response.JSON422 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetV1ArtifactRawNamespaceNameHashHashResponse parses an HTTP response from a GetV1ArtifactRawNamespaceNameHashHashWithResponse call
-func ParseGetV1ArtifactRawNamespaceNameHashHashResponse(rsp *http.Response) (*GetV1ArtifactRawNamespaceNameHashHashResponse, error) {
+// ParseDeleteV1ScheduleIdResponse parses an HTTP response from a DeleteV1ScheduleIdWithResponse call
+func ParseDeleteV1ScheduleIdResponse(rsp *http.Response) (*DeleteV1ScheduleIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV1ArtifactRawNamespaceNameHashHashResponse{
+	response := &DeleteV1ScheduleIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Schedule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest GenericBadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4814,32 +10247,32 @@ func ParseGetV1ArtifactRawNamespaceNameHashHashResponse(rsp *http.Response) (*Ge
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest GenericTooLarge
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON413 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseGetV1ArtifactRawNamespaceNameTagTagResponse parses an HTTP response from a GetV1ArtifactRawNamespaceNameTagTagWithResponse call
-func ParseGetV1ArtifactRawNamespaceNameTagTagResponse(rsp *http.Response) (*GetV1ArtifactRawNamespaceNameTagTagResponse, error) {
+// ParseGetV1ScheduleIdResponse parses an HTTP response from a GetV1ScheduleIdWithResponse call
+func ParseGetV1ScheduleIdResponse(rsp *http.Response) (*GetV1ScheduleIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV1ArtifactRawNamespaceNameTagTagResponse{
+	response := &GetV1ScheduleIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Schedule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest GenericBadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4854,74 +10287,81 @@ func ParseGetV1ArtifactRawNamespaceNameTagTagResponse(rsp *http.Response) (*GetV
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest GenericTooLarge
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON413 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseGetV1ArtifactNamespaceResponse parses an HTTP response from a GetV1ArtifactNamespaceWithResponse call
-func ParseGetV1ArtifactNamespaceResponse(rsp *http.Response) (*GetV1ArtifactNamespaceResponse, error) {
+// ParsePatchV1ScheduleIdResponse parses an HTTP response from a PatchV1ScheduleIdWithResponse call
+func ParsePatchV1ScheduleIdResponse(rsp *http.Response) (*PatchV1ScheduleIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV1ArtifactNamespaceResponse{
+	response := &PatchV1ScheduleIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Artifact
+		var dest Schedule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest GenericBadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrGeneric
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON409 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest GenericTooLarge
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest FieldError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON413 = &dest
+		response.JSON422 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetV1ArtifactNamespaceNameResponse parses an HTTP response from a GetV1ArtifactNamespaceNameWithResponse call
-func ParseGetV1ArtifactNamespaceNameResponse(rsp *http.Response) (*GetV1ArtifactNamespaceNameResponse, error) {
+// ParseGetV1ScheduleIdHistoryResponse parses an HTTP response from a GetV1ScheduleIdHistoryWithResponse call
+func ParseGetV1ScheduleIdHistoryResponse(rsp *http.Response) (*GetV1ScheduleIdHistoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV1ArtifactNamespaceNameResponse{
+	response := &GetV1ScheduleIdHistoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Artifact
+		var dest []ScheduleRun
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4934,34 +10374,34 @@ func ParseGetV1ArtifactNamespaceNameResponse(rsp *http.Response) (*GetV1Artifact
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest GenericTooLarge
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON413 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeleteV1ArtifactNamespaceNameHashHashResponse parses an HTTP response from a DeleteV1ArtifactNamespaceNameHashHashWithResponse call
-func ParseDeleteV1ArtifactNamespaceNameHashHashResponse(rsp *http.Response) (*DeleteV1ArtifactNamespaceNameHashHashResponse, error) {
+// ParsePostV1ScheduleIdPauseResponse parses an HTTP response from a PostV1ScheduleIdPauseWithResponse call
+func ParsePostV1ScheduleIdPauseResponse(rsp *http.Response) (*PostV1ScheduleIdPauseResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteV1ArtifactNamespaceNameHashHashResponse{
+	response := &PostV1ScheduleIdPauseResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Artifact
+		var dest Schedule
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4981,34 +10421,27 @@ func ParseDeleteV1ArtifactNamespaceNameHashHashResponse(rsp *http.Response) (*De
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest GenericTooLarge
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON413 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseGetV1ArtifactNamespaceNameHashHashResponse parses an HTTP response from a GetV1ArtifactNamespaceNameHashHashWithResponse call
-func ParseGetV1ArtifactNamespaceNameHashHashResponse(rsp *http.Response) (*GetV1ArtifactNamespaceNameHashHashResponse, error) {
+// ParsePostV1ScheduleIdResumeResponse parses an HTTP response from a PostV1ScheduleIdResumeWithResponse call
+func ParsePostV1ScheduleIdResumeResponse(rsp *http.Response) (*PostV1ScheduleIdResumeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV1ArtifactNamespaceNameHashHashResponse{
+	response := &PostV1ScheduleIdResumeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Artifact
+		var dest Schedule
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5028,34 +10461,27 @@ func ParseGetV1ArtifactNamespaceNameHashHashResponse(rsp *http.Response) (*GetV1
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest GenericTooLarge
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON413 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParsePatchV1ArtifactNamespaceNameHashHashResponse parses an HTTP response from a PatchV1ArtifactNamespaceNameHashHashWithResponse call
-func ParsePatchV1ArtifactNamespaceNameHashHashResponse(rsp *http.Response) (*PatchV1ArtifactNamespaceNameHashHashResponse, error) {
+// ParseGetV1TaskResponse parses an HTTP response from a GetV1TaskWithResponse call
+func ParseGetV1TaskResponse(rsp *http.Response) (*GetV1TaskResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchV1ArtifactNamespaceNameHashHashResponse{
+	response := &GetV1TaskResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Artifact
+		var dest []Task
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5068,46 +10494,39 @@ func ParsePatchV1ArtifactNamespaceNameHashHashResponse(rsp *http.Response) (*Pat
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest GenericNotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest GenericTooLarge
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON413 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseDeleteV1ArtifactNamespaceNameTagTagResponse parses an HTTP response from a DeleteV1ArtifactNamespaceNameTagTagWithResponse call
-func ParseDeleteV1ArtifactNamespaceNameTagTagResponse(rsp *http.Response) (*DeleteV1ArtifactNamespaceNameTagTagResponse, error) {
+// ParsePostV1TaskResponse parses an HTTP response from a PostV1TaskWithResponse call
+func ParsePostV1TaskResponse(rsp *http.Response) (*PostV1TaskResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteV1ArtifactNamespaceNameTagTagResponse{
+	response := &PostV1TaskResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Artifact
+		var dest Task
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Task
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest GenericBadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5115,12 +10534,12 @@ func ParseDeleteV1ArtifactNamespaceNameTagTagResponse(rsp *http.Response) (*Dele
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest GenericNotFound
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrGeneric
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON409 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
 		var dest GenericTooLarge
@@ -5129,27 +10548,34 @@ func ParseDeleteV1ArtifactNamespaceNameTagTagResponse(rsp *http.Response) (*Dele
 		}
 		response.JSON413 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest FieldError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetV1ArtifactNamespaceNameTagTagResponse parses an HTTP response from a GetV1ArtifactNamespaceNameTagTagWithResponse call
-func ParseGetV1ArtifactNamespaceNameTagTagResponse(rsp *http.Response) (*GetV1ArtifactNamespaceNameTagTagResponse, error) {
+// ParseGetV1TaskGroupIdResponse parses an HTTP response from a GetV1TaskGroupIdWithResponse call
+func ParseGetV1TaskGroupIdResponse(rsp *http.Response) (*GetV1TaskGroupIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV1ArtifactNamespaceNameTagTagResponse{
+	response := &GetV1TaskGroupIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Artifact
+		var dest TaskGroup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5169,34 +10595,27 @@ func ParseGetV1ArtifactNamespaceNameTagTagResponse(rsp *http.Response) (*GetV1Ar
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest GenericTooLarge
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON413 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParsePatchV1ArtifactNamespaceNameTagTagResponse parses an HTTP response from a PatchV1ArtifactNamespaceNameTagTagWithResponse call
-func ParsePatchV1ArtifactNamespaceNameTagTagResponse(rsp *http.Response) (*PatchV1ArtifactNamespaceNameTagTagResponse, error) {
+// ParsePostV1TaskGroupIdCancelResponse parses an HTTP response from a PostV1TaskGroupIdCancelWithResponse call
+func ParsePostV1TaskGroupIdCancelResponse(rsp *http.Response) (*PostV1TaskGroupIdCancelResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchV1ArtifactNamespaceNameTagTagResponse{
+	response := &PostV1TaskGroupIdCancelResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Artifact
+		var dest CancelTaskGroupResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5216,45 +10635,38 @@ func ParsePatchV1ArtifactNamespaceNameTagTagResponse(rsp *http.Response) (*Patch
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest GenericTooLarge
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON413 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseDeleteV1RbacPolicyResponse parses an HTTP response from a DeleteV1RbacPolicyWithResponse call
-func ParseDeleteV1RbacPolicyResponse(rsp *http.Response) (*DeleteV1RbacPolicyResponse, error) {
+// ParsePostV1TaskBatchResponse parses an HTTP response from a PostV1TaskBatchWithResponse call
+func ParsePostV1TaskBatchResponse(rsp *http.Response) (*PostV1TaskBatchResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteV1RbacPolicyResponse{
+	response := &PostV1TaskBatchResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest GenericBadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreateTaskBatchResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON201 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ErrGeneric
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
 		var dest GenericTooLarge
@@ -5268,66 +10680,73 @@ func ParseDeleteV1RbacPolicyResponse(rsp *http.Response) (*DeleteV1RbacPolicyRes
 	return response, nil
 }
 
-// ParseGetV1RbacPolicyResponse parses an HTTP response from a GetV1RbacPolicyWithResponse call
-func ParseGetV1RbacPolicyResponse(rsp *http.Response) (*GetV1RbacPolicyResponse, error) {
+// ParseGetV1TaskBatchIdResponse parses an HTTP response from a GetV1TaskBatchIdWithResponse call
+func ParseGetV1TaskBatchIdResponse(rsp *http.Response) (*GetV1TaskBatchIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV1RbacPolicyResponse{
+	response := &GetV1TaskBatchIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []RBACPolicy
+		var dest TaskBatch
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest GenericTooLarge
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON413 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParsePutV1RbacPolicyResponse parses an HTTP response from a PutV1RbacPolicyWithResponse call
-func ParsePutV1RbacPolicyResponse(rsp *http.Response) (*PutV1RbacPolicyResponse, error) {
+// ParsePostV1TaskMapResponse parses an HTTP response from a PostV1TaskMapWithResponse call
+func ParsePostV1TaskMapResponse(rsp *http.Response) (*PostV1TaskMapResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutV1RbacPolicyResponse{
+	response := &PostV1TaskMapResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest GenericBadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TaskMap
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON201 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest FieldError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
 		var dest GenericTooLarge
@@ -5336,60 +10755,74 @@ func ParsePutV1RbacPolicyResponse(rsp *http.Response) (*PutV1RbacPolicyResponse,
 		}
 		response.JSON413 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest FieldError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetV1RbacResourceGroupResponse parses an HTTP response from a GetV1RbacResourceGroupWithResponse call
-func ParseGetV1RbacResourceGroupResponse(rsp *http.Response) (*GetV1RbacResourceGroupResponse, error) {
+// ParseGetV1TaskMapIdResponse parses an HTTP response from a GetV1TaskMapIdWithResponse call
+func ParseGetV1TaskMapIdResponse(rsp *http.Response) (*GetV1TaskMapIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV1RbacResourceGroupResponse{
+	response := &GetV1TaskMapIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []ResourceGroupResource
+		var dest TaskMap
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest GenericTooLarge
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON413 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeleteV1RbacResourceGroupResourceGroupResponse parses an HTTP response from a DeleteV1RbacResourceGroupResourceGroupWithResponse call
-func ParseDeleteV1RbacResourceGroupResourceGroupResponse(rsp *http.Response) (*DeleteV1RbacResourceGroupResourceGroupResponse, error) {
+// ParsePostV1TaskRetryResponse parses an HTTP response from a PostV1TaskRetryWithResponse call
+func ParsePostV1TaskRetryResponse(rsp *http.Response) (*PostV1TaskRetryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteV1RbacResourceGroupResourceGroupResponse{
+	response := &PostV1TaskRetryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResourceGroupResource
+		var dest RetryTasksResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5402,41 +10835,27 @@ func ParseDeleteV1RbacResourceGroupResourceGroupResponse(rsp *http.Response) (*D
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest GenericNotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest GenericTooLarge
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON413 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseGetV1RbacResourceGroupResourceGroupResponse parses an HTTP response from a GetV1RbacResourceGroupResourceGroupWithResponse call
-func ParseGetV1RbacResourceGroupResourceGroupResponse(rsp *http.Response) (*GetV1RbacResourceGroupResourceGroupResponse, error) {
+// ParseDeleteV1TaskIdResponse parses an HTTP response from a DeleteV1TaskIdWithResponse call
+func ParseDeleteV1TaskIdResponse(rsp *http.Response) (*DeleteV1TaskIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV1RbacResourceGroupResourceGroupResponse{
+	response := &DeleteV1TaskIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResourceGroupResource
+		var dest Task
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5456,140 +10875,114 @@ func ParseGetV1RbacResourceGroupResourceGroupResponse(rsp *http.Response) (*GetV
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest GenericTooLarge
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON413 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseHeadV1RbacResourceGroupResourceGroupResponse parses an HTTP response from a HeadV1RbacResourceGroupResourceGroupWithResponse call
-func ParseHeadV1RbacResourceGroupResourceGroupResponse(rsp *http.Response) (*HeadV1RbacResourceGroupResourceGroupResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &HeadV1RbacResourceGroupResourceGroupResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest GenericTooLarge
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrGeneric
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON413 = &dest
+		response.JSON409 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParsePutV1RbacResourceGroupResourceGroupResponse parses an HTTP response from a PutV1RbacResourceGroupResourceGroupWithResponse call
-func ParsePutV1RbacResourceGroupResourceGroupResponse(rsp *http.Response) (*PutV1RbacResourceGroupResourceGroupResponse, error) {
+// ParseGetV1TaskIdResponse parses an HTTP response from a GetV1TaskIdWithResponse call
+func ParseGetV1TaskIdResponse(rsp *http.Response) (*GetV1TaskIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutV1RbacResourceGroupResourceGroupResponse{
+	response := &GetV1TaskIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ResourceGroupResource
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Task
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest GenericBadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ErrGeneric
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest GenericTooLarge
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON413 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetV1RbacRoleResponse parses an HTTP response from a GetV1RbacRoleWithResponse call
-func ParseGetV1RbacRoleResponse(rsp *http.Response) (*GetV1RbacRoleResponse, error) {
+// ParseGetV1TaskIdCallbackResponse parses an HTTP response from a GetV1TaskIdCallbackWithResponse call
+func ParseGetV1TaskIdCallbackResponse(rsp *http.Response) (*GetV1TaskIdCallbackResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV1RbacRoleResponse{
+	response := &GetV1TaskIdCallbackResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []RoleResource
+		var dest TaskCallback
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest GenericTooLarge
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON413 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeleteV1RbacRoleRoleResponse parses an HTTP response from a DeleteV1RbacRoleRoleWithResponse call
-func ParseDeleteV1RbacRoleRoleResponse(rsp *http.Response) (*DeleteV1RbacRoleRoleResponse, error) {
+// ParsePostV1TaskIdCancelResponse parses an HTTP response from a PostV1TaskIdCancelWithResponse call
+func ParsePostV1TaskIdCancelResponse(rsp *http.Response) (*PostV1TaskIdCancelResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteV1RbacRoleRoleResponse{
+	response := &PostV1TaskIdCancelResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RoleResource
+		var dest Task
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5616,34 +11009,27 @@ func ParseDeleteV1RbacRoleRoleResponse(rsp *http.Response) (*DeleteV1RbacRoleRol
 		}
 		response.JSON409 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest GenericTooLarge
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON413 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseGetV1RbacRoleRoleResponse parses an HTTP response from a GetV1RbacRoleRoleWithResponse call
-func ParseGetV1RbacRoleRoleResponse(rsp *http.Response) (*GetV1RbacRoleRoleResponse, error) {
+// ParseGetV1TaskIdLogsResponse parses an HTTP response from a GetV1TaskIdLogsWithResponse call
+func ParseGetV1TaskIdLogsResponse(rsp *http.Response) (*GetV1TaskIdLogsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV1RbacRoleRoleResponse{
+	response := &GetV1TaskIdLogsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RoleResource
+		var dest []TaskLog
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5663,32 +11049,39 @@ func ParseGetV1RbacRoleRoleResponse(rsp *http.Response) (*GetV1RbacRoleRoleRespo
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest GenericTooLarge
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON413 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseHeadV1RbacRoleRoleResponse parses an HTTP response from a HeadV1RbacRoleRoleWithResponse call
-func ParseHeadV1RbacRoleRoleResponse(rsp *http.Response) (*HeadV1RbacRoleRoleResponse, error) {
+// ParsePostV1TaskIdLogsResponse parses an HTTP response from a PostV1TaskIdLogsWithResponse call
+func ParsePostV1TaskIdLogsResponse(rsp *http.Response) (*PostV1TaskIdLogsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &HeadV1RbacRoleRoleResponse{
+	response := &PostV1TaskIdLogsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
 		var dest GenericTooLarge
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5701,69 +11094,88 @@ func ParseHeadV1RbacRoleRoleResponse(rsp *http.Response) (*HeadV1RbacRoleRoleRes
 	return response, nil
 }
 
-// ParsePutV1RbacRoleRoleResponse parses an HTTP response from a PutV1RbacRoleRoleWithResponse call
-func ParsePutV1RbacRoleRoleResponse(rsp *http.Response) (*PutV1RbacRoleRoleResponse, error) {
+// ParseGetV1TaskIdLogsExportResponse parses an HTTP response from a GetV1TaskIdLogsExportWithResponse call
+func ParseGetV1TaskIdLogsExportResponse(rsp *http.Response) (*GetV1TaskIdLogsExportResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutV1RbacRoleRoleResponse{
+	response := &GetV1TaskIdLogsExportResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest RoleResource
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest GenericBadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ErrGeneric
+	}
+
+	return response, nil
+}
+
+// ParseGetV1TaskIdLogsStreamResponse parses an HTTP response from a GetV1TaskIdLogsStreamWithResponse call
+func ParseGetV1TaskIdLogsStreamResponse(rsp *http.Response) (*GetV1TaskIdLogsStreamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1TaskIdLogsStreamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest GenericTooLarge
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON413 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetV1TaskResponse parses an HTTP response from a GetV1TaskWithResponse call
-func ParseGetV1TaskResponse(rsp *http.Response) (*GetV1TaskResponse, error) {
+// ParseGetV1TaskIdResultResponse parses an HTTP response from a GetV1TaskIdResultWithResponse call
+func ParseGetV1TaskIdResultResponse(rsp *http.Response) (*GetV1TaskIdResultResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV1TaskResponse{
+	response := &GetV1TaskIdResultResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Task
+		var dest TaskResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5776,31 +11188,48 @@ func ParseGetV1TaskResponse(rsp *http.Response) (*GetV1TaskResponse, error) {
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrGeneric
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (application/x-protobuf) unsupported
+
 	}
 
 	return response, nil
 }
 
-// ParsePostV1TaskResponse parses an HTTP response from a PostV1TaskWithResponse call
-func ParsePostV1TaskResponse(rsp *http.Response) (*PostV1TaskResponse, error) {
+// ParseGetV1TaskIdRetriesResponse parses an HTTP response from a GetV1TaskIdRetriesWithResponse call
+func ParseGetV1TaskIdRetriesResponse(rsp *http.Response) (*GetV1TaskIdRetriesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostV1TaskResponse{
+	response := &GetV1TaskIdRetriesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Task
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TaskRetry
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest GenericBadRequest
@@ -5809,27 +11238,27 @@ func ParsePostV1TaskResponse(rsp *http.Response) (*PostV1TaskResponse, error) {
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest GenericTooLarge
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON413 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetV1TaskIdResponse parses an HTTP response from a GetV1TaskIdWithResponse call
-func ParseGetV1TaskIdResponse(rsp *http.Response) (*GetV1TaskIdResponse, error) {
+// ParsePostV1TaskIdRetryResponse parses an HTTP response from a PostV1TaskIdRetryWithResponse call
+func ParsePostV1TaskIdRetryResponse(rsp *http.Response) (*PostV1TaskIdRetryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV1TaskIdResponse{
+	response := &PostV1TaskIdRetryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5856,27 +11285,34 @@ func ParseGetV1TaskIdResponse(rsp *http.Response) (*GetV1TaskIdResponse, error)
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrGeneric
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetV1TaskIdLogsResponse parses an HTTP response from a GetV1TaskIdLogsWithResponse call
-func ParseGetV1TaskIdLogsResponse(rsp *http.Response) (*GetV1TaskIdLogsResponse, error) {
+// ParseGetV1TaskIdTransitionsResponse parses an HTTP response from a GetV1TaskIdTransitionsWithResponse call
+func ParseGetV1TaskIdTransitionsResponse(rsp *http.Response) (*GetV1TaskIdTransitionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV1TaskIdLogsResponse{
+	response := &GetV1TaskIdTransitionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []TaskLog
+		var dest []TaskTransition
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6274,3 +11710,116 @@ func ParsePutV1UserUsernameResponse(rsp *http.Response) (*PutV1UserUsernameRespo
 
 	return response, nil
 }
+
+// ParseGetV1WorkflowResponse parses an HTTP response from a GetV1WorkflowWithResponse call
+func ParseGetV1WorkflowResponse(rsp *http.Response) (*GetV1WorkflowResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1WorkflowResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Workflow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1WorkflowResponse parses an HTTP response from a PostV1WorkflowWithResponse call
+func ParsePostV1WorkflowResponse(rsp *http.Response) (*PostV1WorkflowResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1WorkflowResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Workflow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest FieldError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1WorkflowIdResponse parses an HTTP response from a GetV1WorkflowIdWithResponse call
+func ParseGetV1WorkflowIdResponse(rsp *http.Response) (*GetV1WorkflowIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1WorkflowIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Workflow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
@@ -40,8 +40,63 @@ type AppConfig struct {
 		Maximum int `mapstructure:"maximum" validate:"required,numeric,min=1"`
 	} `mapstructure:"pagination" validate:"required"`
 
+	// Weight of each queue, keyed by queue name. Workers process queues with a
+	// higher weight more often. The default queue must always be configured.
+	Queues map[string]int `mapstructure:"queues" validate:"required,min=1,dive,keys,required,endkeys,min=1"`
+
 	Retry struct {
-		MaxRetries int    `mapstructure:"max_retries" validate:"required,numeric,min=0"`
-		Retention  string `mapstructure:"retention"   validate:"required"`
+		MaxRetries  int    `mapstructure:"max_retries"  validate:"required,numeric,min=0"`
+		Retention   string `mapstructure:"retention"    validate:"required"`
+		Timeout     string `mapstructure:"timeout"      validate:"required"`
+		MaxTimeout  string `mapstructure:"max_timeout"  validate:"required"`
+		MaxDeadline string `mapstructure:"max_deadline" validate:"required"`
 	} `mapstructure:"retry" validate:"required"`
+
+	Callback struct {
+		Secret       string `mapstructure:"secret"`
+		MaxAttempts  int    `mapstructure:"max_attempts"  validate:"required,numeric,min=1"`
+		Backoff      string `mapstructure:"backoff"       validate:"required"`
+		Timeout      string `mapstructure:"timeout"       validate:"required"`
+		PollInterval string `mapstructure:"poll_interval" validate:"required"`
+		// Host names and networks (CIDR) callbacks may be sent to in addition to
+		// public addresses. Internal addresses are rejected otherwise.
+		AllowedTargets []string `mapstructure:"allowed_targets"`
+	} `mapstructure:"callback" validate:"required"`
+
+	Scheduling struct {
+		MaxHorizon   string `mapstructure:"max_horizon"   validate:"required"`
+		SyncInterval string `mapstructure:"sync_interval" validate:"required"`
+	} `mapstructure:"scheduling" validate:"required"`
+
+	Idempotency struct {
+		Window string `mapstructure:"window" validate:"required"`
+	} `mapstructure:"idempotency" validate:"required"`
+
+	Tasks struct {
+		RestrictVisibility bool `mapstructure:"restrict_visibility"`
+	} `mapstructure:"tasks"`
+
+	Batch struct {
+		MaxSize int `mapstructure:"max_size" validate:"required,numeric,min=1"`
+	} `mapstructure:"batch" validate:"required"`
+
+	Logs struct {
+		MaxBatchSize int   `mapstructure:"max_batch_size" validate:"required,numeric,min=1"`
+		MaxEntrySize int   `mapstructure:"max_entry_size" validate:"required,numeric,min=1"`
+		MaxTaskSize  int64 `mapstructure:"max_task_size"  validate:"required,numeric,min=1"`
+		// Age after which the logs of finished tasks are deleted. The retention
+		// of tasks (retry.retention) is used if empty.
+		Retention       string `mapstructure:"retention"`
+		CleanupInterval string `mapstructure:"cleanup_interval" validate:"required"`
+	} `mapstructure:"logs" validate:"required"`
+
+	Workflow struct {
+		PollInterval string `mapstructure:"poll_interval" validate:"required"`
+	} `mapstructure:"workflow" validate:"required"`
+
+	History struct {
+		SyncInterval string `mapstructure:"sync_interval" validate:"required"`
+		// Maximum number of task pages scanned per sync
+		ScanPages int `mapstructure:"scan_pages" validate:"required,numeric,min=1"`
+	} `mapstructure:"history" validate:"required"`
 }
@@ -0,0 +1,375 @@
+package main
+
+import (
+	"api-server/client"
+	"api-server/config"
+	"api-server/orm"
+	pb "api-server/proto_gen"
+	"api-server/queue"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/EnclaveRunner/shareddeps/auth"
+	"github.com/EnclaveRunner/shareddeps/utils"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+const (
+	redisAddr   = "localhost:6379"
+	databaseDSN = "host=localhost port=5432 user=enclave_user " +
+		"password=enclave_password dbname=enclave_db sslmode=disable"
+)
+
+// taskFixture enqueues tasks into the queue of the server under test and
+// inspects its database, bypassing the registry that task submission needs.
+type taskFixture struct {
+	queueClient queue.QueueClient
+	inspector   *asynq.Inspector
+	db          *gorm.DB
+}
+
+func newTaskFixture(t *testing.T) *taskFixture {
+	t.Helper()
+
+	dbGorm, err := gorm.Open(postgres.Open(databaseDSN), &gorm.Config{})
+	require.NoError(t, err)
+	db := orm.NewDB(auth.AuthModule{}, dbGorm)
+
+	cfg := &config.AppConfig{Queues: map[string]int{queue.TaskQueueDefault: 1}}
+	cfg.Redis.Host = "localhost"
+	cfg.Redis.Port = 6379
+
+	inspector := asynq.NewInspector(asynq.RedisClientOpt{Addr: redisAddr})
+	t.Cleanup(func() { _ = inspector.Close() })
+
+	return &taskFixture{
+		queueClient: queue.NewQueueClient(cfg, &db),
+		inspector:   inspector,
+		db:          dbGorm,
+	}
+}
+
+// enqueue enqueues a task submitted by the admin and returns its id.
+func (f *taskFixture) enqueue(t *testing.T, opts ...asynq.Option) string {
+	t.Helper()
+
+	task := &pb.Task{Function: &pb.FunctionIdentifier{
+		Artifact: &pb.ArtifactIdentifier{
+			Package: &pb.PackageName{Namespace: "test", Name: "integration"},
+			Identifier: &pb.ArtifactIdentifier_VersionHash{
+				VersionHash: "abc123",
+			},
+		},
+		Interface: "api",
+		Name:      "run",
+	}}
+
+	opts = append(opts, asynq.MaxRetry(3))
+	taskInfo, err := f.queueClient.EnqueueTask(
+		t.Context(),
+		task,
+		queue.TaskMetadata{SubmittedBy: adminUsername},
+		opts...,
+	)
+	require.NoError(t, err)
+
+	return taskInfo.ID
+}
+
+// archived enqueues a task and archives it right away.
+func (f *taskFixture) archived(t *testing.T) string {
+	t.Helper()
+
+	id := f.enqueue(t)
+	require.NoError(t, f.inspector.ArchiveTask(queue.TaskQueueDefault, id))
+
+	return id
+}
+
+// dropped enqueues a task and removes it from the queue, leaving only its
+// record in the task history.
+func (f *taskFixture) dropped(t *testing.T) string {
+	t.Helper()
+
+	id := f.enqueue(t)
+	require.NoError(t, f.inspector.DeleteTask(queue.TaskQueueDefault, id))
+
+	return id
+}
+
+// active enqueues a task and starts a worker processing it until it is
+// canceled, like a runner does.
+func (f *taskFixture) active(t *testing.T) string {
+	t.Helper()
+
+	id := f.enqueue(t)
+
+	started := make(chan string, 1)
+	worker := asynq.NewServer(
+		asynq.RedisClientOpt{Addr: redisAddr},
+		asynq.Config{
+			Concurrency:     1,
+			Queues:          map[string]int{queue.TaskQueueDefault: 1},
+			ShutdownTimeout: time.Second,
+			LogLevel:        asynq.FatalLevel,
+		},
+	)
+	err := worker.Start(asynq.HandlerFunc(
+		func(ctx context.Context, task *asynq.Task) error {
+			started <- task.ResultWriter().TaskID()
+			<-ctx.Done()
+
+			return ctx.Err()
+		},
+	))
+	require.NoError(t, err)
+	t.Cleanup(worker.Shutdown)
+
+	select {
+	case startedID := <-started:
+		require.Equal(t, id, startedID)
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "task was not started")
+	}
+
+	return id
+}
+
+func (f *taskFixture) countRows(t *testing.T, model any, id string) int64 {
+	t.Helper()
+
+	var count int64
+	err := f.db.Model(model).Where("task_id = ?", id).Count(&count).Error
+	require.NoError(t, err)
+
+	return count
+}
+
+func deleteTask(t *testing.T, id string) {
+	t.Helper()
+
+	_, _ = c.DeleteV1TaskIdWithResponse(t.Context(), id)
+}
+
+//nolint:paralleltest // The worker of active tasks would process other tasks
+func TestCancelTask(t *testing.T) {
+	f := newTaskFixture(t)
+
+	tests := []struct {
+		name          string
+		task          func(t *testing.T) string
+		expectedCode  int
+		expectedState string
+	}{
+		{
+			name:         "unknown",
+			task:         func(*testing.T) string { return "unknown-task" },
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:          "pending",
+			task:          func(t *testing.T) string { return f.enqueue(t) },
+			expectedCode:  http.StatusOK,
+			expectedState: asynq.TaskStateArchived.String(),
+		},
+		{
+			name: "scheduled",
+			task: func(t *testing.T) string {
+				return f.enqueue(t, asynq.ProcessIn(time.Hour))
+			},
+			expectedCode:  http.StatusOK,
+			expectedState: asynq.TaskStateArchived.String(),
+		},
+		{
+			name:          "active",
+			task:          f.active,
+			expectedCode:  http.StatusOK,
+			expectedState: asynq.TaskStateArchived.String(),
+		},
+		{
+			name:         "archived",
+			task:         f.archived,
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name:         "dropped by the queue",
+			task:         f.dropped,
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := tt.task(t)
+			defer deleteTask(t, id)
+
+			resp, err := c.PostV1TaskIdCancelWithResponse(t.Context(), id)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedCode, resp.StatusCode())
+
+			if tt.expectedCode == http.StatusOK {
+				assert.Equal(t, tt.expectedState, resp.JSON200.Status.State)
+
+				taskInfo, err := f.inspector.GetTaskInfo(queue.TaskQueueDefault, id)
+				require.NoError(t, err)
+				assert.Equal(
+					t,
+					asynq.TaskStateArchived,
+					taskInfo.State,
+					"a canceled task must not be processed again",
+				)
+
+				var record orm.Task
+				require.NoError(t, f.db.First(&record, "id = ?", id).Error)
+				assert.Equal(
+					t,
+					tt.expectedState,
+					record.State,
+					"listings show the canceled state right away",
+				)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest // The worker of active tasks would process other tasks
+func TestDeleteTask(t *testing.T) {
+	f := newTaskFixture(t)
+
+	tests := []struct {
+		name         string
+		task         func(t *testing.T) string
+		expectedCode int
+	}{
+		{
+			name:         "unknown",
+			task:         func(*testing.T) string { return "unknown-task" },
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "pending",
+			task:         func(t *testing.T) string { return f.enqueue(t) },
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "archived",
+			task:         f.archived,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "active",
+			task:         f.active,
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name:         "dropped by the queue",
+			task:         f.dropped,
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := tt.task(t)
+
+			resp, err := c.DeleteV1TaskIdWithResponse(t.Context(), id)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedCode, resp.StatusCode())
+
+			if tt.expectedCode == http.StatusConflict {
+				_, _ = c.PostV1TaskIdCancelWithResponse(t.Context(), id)
+				deleteTask(t, id)
+
+				return
+			}
+
+			getResp, err := c.GetV1TaskIdWithResponse(t.Context(), id)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusNotFound, getResp.StatusCode())
+		})
+	}
+}
+
+//nolint:paralleltest // Runs alongside the sequential task tests
+func TestDeleteTaskRemovesData(t *testing.T) {
+	f := newTaskFixture(t)
+
+	for name, task := range map[string]func(t *testing.T) string{
+		"in the queue":         func(t *testing.T) string { return f.enqueue(t) },
+		"dropped by the queue": f.dropped,
+	} {
+		t.Run(name, func(t *testing.T) {
+			id := task(t)
+
+			logsResp, err := c.PostV1TaskIdLogsWithResponse(
+				t.Context(),
+				id,
+				client.PostV1TaskIdLogsJSONRequestBody{
+					Logs: []client.TaskLogEntry{
+						{Level: "info", Issuer: "runner", Message: "started"},
+						{Level: "info", Issuer: "runner", Message: "finished"},
+					},
+				},
+			)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusNoContent, logsResp.StatusCode())
+			require.Equal(t, int64(2), f.countRows(t, &orm.TaskLog{}, id))
+
+			resp, err := c.DeleteV1TaskIdWithResponse(t.Context(), id)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, resp.StatusCode())
+
+			assert.Zero(t, f.countRows(t, &orm.TaskLog{}, id))
+			assert.Zero(t, f.countRows(t, &orm.TaskTransition{}, id))
+		})
+	}
+}
+
+//nolint:paralleltest // Runs alongside the sequential task tests
+func TestRetryTaskResetRetries(t *testing.T) {
+	f := newTaskFixture(t)
+
+	id := f.archived(t)
+	defer deleteTask(t, id)
+
+	resp, err := c.PostV1TaskIdRetryWithResponse(
+		t.Context(),
+		id,
+		&client.PostV1TaskIdRetryParams{ResetRetries: utils.Ptr(true)},
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode())
+
+	copied := resp.JSON200
+	defer deleteTask(t, copied.Id)
+	assert.NotEqual(t, id, copied.Id, "the copy gets an id of its own")
+	assert.Equal(t, &id, copied.RequeuedFrom)
+	assert.Equal(t, asynq.TaskStatePending.String(), copied.Status.State)
+	assert.Zero(t, copied.Status.Retries)
+
+	_, err = f.inspector.GetTaskInfo(queue.TaskQueueDefault, id)
+	require.ErrorIs(t, err, asynq.ErrTaskNotFound, "the original is replaced")
+
+	original, err := c.GetV1TaskIdWithResponse(t.Context(), id)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, original.StatusCode())
+	assert.Equal(t, &copied.Id, original.JSON200.RequeuedAs)
+
+	retries, err := c.GetV1TaskIdRetriesWithResponse(t.Context(), id)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, retries.StatusCode())
+	require.Len(t, *retries.JSON200, 1)
+	assert.Equal(t, adminUsername, (*retries.JSON200)[0].TriggeredBy)
+	assert.True(t, (*retries.JSON200)[0].ResetRetries)
+
+	deleted, err := c.DeleteV1TaskIdWithResponse(t.Context(), id)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, deleted.StatusCode())
+	assert.Zero(t, f.countRows(t, &orm.TaskRetry{}, id))
+}
@@ -26,6 +26,7 @@ func TestMain(m *testing.M) {
 	_ = os.Setenv("ENCLAVE_ADMIN_PASSWORD", adminPassword)
 	_ = os.Setenv("ENCLAVE_ADMIN_DISPLAY_NAME", adminDisplayName)
 	_ = os.Setenv("ENCLAVE_DATABASE_HOST", "localhost")
+	_ = os.Setenv("ENCLAVE_REDIS_HOST", "localhost")
 	_ = os.Setenv("ENCLAVE_RETRY_RETENTION", "24h")
 
 	go main()
@@ -66,6 +67,7 @@ func TestMain(m *testing.M) {
 	_ = os.Unsetenv("ENCLAVE_ADMIN_PASSWORD")
 	_ = os.Unsetenv("ENCLAVE_ADMIN_DISPLAY_NAME")
 	_ = os.Unsetenv("ENCLAVE_DATABASE_HOST")
+	_ = os.Unsetenv("ENCLAVE_REDIS_HOST")
 	_ = os.Unsetenv("ENCLAVE_RETRY_RETENTION")
 
 	os.Exit(code)
@@ -2,10 +2,14 @@ package main
 
 import (
 	"api-server/api"
+	"api-server/callback"
 	"api-server/config"
 	"api-server/orm"
 	proto_gen "api-server/proto_gen"
 	"api-server/queue"
+	"api-server/scheduler"
+	"api-server/workflow"
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -45,9 +49,47 @@ func main() {
 		//nolint:mnd // Arbitrary defaults for maximum pagination size
 		{Key: "pagination.maximum", Value: 100},
 
+		{Key: "queues", Value: map[string]int{queue.TaskQueueDefault: 1}},
+
 		//nolint:mnd // Default max retries for task
 		{Key: "retry.max_retries", Value: 3},
 		{Key: "retry.retention", Value: "24h"},
+		{Key: "retry.timeout", Value: "30m"},
+		{Key: "retry.max_timeout", Value: "12h"},
+		{Key: "retry.max_deadline", Value: "720h"},
+
+		{Key: "callback.secret", Value: ""},
+		//nolint:mnd // Default number of callback delivery attempts
+		{Key: "callback.max_attempts", Value: 5},
+		{Key: "callback.backoff", Value: "10s"},
+		{Key: "callback.timeout", Value: "10s"},
+		{Key: "callback.poll_interval", Value: "5s"},
+		{Key: "callback.allowed_targets", Value: []string{}},
+
+		{Key: "scheduling.max_horizon", Value: "720h"},
+		{Key: "scheduling.sync_interval", Value: "15s"},
+
+		{Key: "history.sync_interval", Value: "30s"},
+		//nolint:mnd // Default number of task pages scanned per history sync
+		{Key: "history.scan_pages", Value: 10},
+
+		{Key: "workflow.poll_interval", Value: "5s"},
+
+		{Key: "tasks.restrict_visibility", Value: false},
+
+		{Key: "idempotency.window", Value: "24h"},
+
+		//nolint:mnd // Arbitrary default for the maximum number of tasks per batch
+		{Key: "batch.max_size", Value: 10000},
+
+		//nolint:mnd // Arbitrary default for the maximum number of logs per batch
+		{Key: "logs.max_batch_size", Value: 1000},
+		//nolint:mnd // Arbitrary default for the maximum size of a log message (64 KiB)
+		{Key: "logs.max_entry_size", Value: 64 << 10},
+		//nolint:mnd // Arbitrary default for the maximum size of the logs of a task (16 MiB)
+		{Key: "logs.max_task_size", Value: 16 << 20},
+		{Key: "logs.retention", Value: ""},
+		{Key: "logs.cleanup_interval", Value: "1h"},
 	}
 
 	// load config and create server
@@ -88,7 +130,7 @@ func main() {
 	queueClient := queue.NewQueueClient(cfg, &db)
 
 	// Migrate RBAC policies, resource groups and roles
-	MigrateRBAC(authModule)
+	MigrateRBAC(authModule, queueClient.Queues())
 
 	registryClient := proto_gen.NewRegistryServiceClient(
 		shareddeps.InitGRPCClient(
@@ -103,6 +145,53 @@ func main() {
 			Msg("Failed to parse retention duration (invalid format)")
 	}
 
+	defaultTimeout, err := time.ParseDuration(cfg.Retry.Timeout)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Failed to parse default task timeout (invalid format)")
+	}
+
+	maxTimeout, err := time.ParseDuration(cfg.Retry.MaxTimeout)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Failed to parse maximum task timeout (invalid format)")
+	}
+
+	if defaultTimeout <= 0 || defaultTimeout > maxTimeout {
+		log.Fatal().
+			Msg("Default task timeout must be positive and not exceed the maximum task timeout")
+	}
+
+	maxDeadline, err := time.ParseDuration(cfg.Retry.MaxDeadline)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Failed to parse maximum task deadline (invalid format)")
+	}
+
+	maxScheduleHorizon, err := time.ParseDuration(cfg.Scheduling.MaxHorizon)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Failed to parse maximum scheduling horizon (invalid format)")
+	}
+
+	idempotencyWindow, err := time.ParseDuration(cfg.Idempotency.Window)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Failed to parse idempotency window (invalid format)")
+	}
+
+	callbackTargets, err := callback.NewTargetPolicy(
+		cfg.Callback.AllowedTargets,
+	)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to parse allowed callback targets")
+	}
+
 	if cfg.Pagination.Default > cfg.Pagination.Maximum {
 		log.Fatal().
 			Msg("Default pagination size cannot be greater than maximum pagination size")
@@ -112,23 +201,62 @@ func main() {
 		db,
 		cfg.Retry.MaxRetries,
 		retentionDuration,
+		defaultTimeout,
+		maxTimeout,
+		maxDeadline,
+		maxScheduleHorizon,
+		idempotencyWindow,
+		cfg.Batch.MaxSize,
+		cfg.Logs.MaxBatchSize,
+		cfg.Logs.MaxEntrySize,
+		cfg.Logs.MaxTaskSize,
+		cfg.Tasks.RestrictVisibility,
+		callbackTargets,
 		queueClient,
 		registryClient,
 	)
 	handler := api.NewStrictHandler(server, nil)
 	api.RegisterHandlers(ginServer, handler)
 
+	// Deliver task completion callbacks in the background
+	dispatcher := callback.NewDispatcher(
+		cfg,
+		db,
+		queueClient,
+		callbackTargets,
+	)
+	go dispatcher.Run(context.Background())
+
+	// Keep the durable task history in sync with the queue
+	historySyncer := queue.NewHistorySyncer(cfg, &db, queueClient)
+	go historySyncer.Run(context.Background())
+
+	// Delete logs of finished tasks after their retention
+	logJanitor := queue.NewLogJanitor(cfg, &db)
+	go logJanitor.Run(context.Background())
+
+	// Enqueue tasks of recurring schedules in the background
+	taskScheduler := scheduler.NewScheduler(cfg, db, queueClient, registryClient)
+	go taskScheduler.Run(context.Background())
+
+	// Enqueue the nodes of workflows as their predecessors complete
+	workflowEngine := workflow.NewEngine(cfg, db, queueClient)
+	go workflowEngine.Run(context.Background())
+
 	shareddeps.StartRESTServer(cfg, ginServer)
 }
 
-// Init needed and default RBAC policies, resource groups and roles
-func MigrateRBAC(authModule auth.AuthModule) {
+// Init needed and default RBAC policies, resource groups and roles. Every queue
+// except the default queue gets a role whose members may submit tasks to it.
+func MigrateRBAC(authModule auth.AuthModule, queues []string) {
 	resourceGroups := []string{
 		"self_INTERNAL",
 		"users",
 		"rbac",
 		"artifacts",
 		"tasks",
+		"task_logs_ingest",
+		"queues",
 	}
 
 	userGroups := []string{
@@ -137,6 +265,9 @@ func MigrateRBAC(authModule auth.AuthModule) {
 		"rbac",
 		"artifacts",
 		"tasks",
+		"queues",
+		api.AllTasksGroup,
+		api.LogIngestGroup,
 	}
 
 	// Define resource to group mappings
@@ -164,6 +295,33 @@ func MigrateRBAC(authModule auth.AuthModule) {
 		{"/v1/task", "tasks"},
 		{"/v1/task/:id", "tasks"},
 		{"/v1/task/:id/logs", "tasks"},
+		{"/v1/task/:id/logs", "task_logs_ingest"},
+		{"/v1/task/:id/logs/stream", "tasks"},
+		{"/v1/task/:id/logs/export", "tasks"},
+		{"/v1/task/retry", "tasks"},
+		{"/v1/task/batch", "tasks"},
+		{"/v1/task/batch/:id", "tasks"},
+		{"/v1/task/map", "tasks"},
+		{"/v1/task/map/:id", "tasks"},
+		{"/v1/task-group/:id", "tasks"},
+		{"/v1/task-group/:id/cancel", "tasks"},
+		{"/v1/task/:id/cancel", "tasks"},
+		{"/v1/task/:id/retry", "tasks"},
+		{"/v1/task/:id/result", "tasks"},
+		{"/v1/task/:id/callback", "tasks"},
+		{"/v1/task/:id/transitions", "tasks"},
+		{"/v1/task/:id/retries", "tasks"},
+		{"/v1/schedule", "tasks"},
+		{"/v1/schedule/:id", "tasks"},
+		{"/v1/schedule/:id/pause", "tasks"},
+		{"/v1/schedule/:id/resume", "tasks"},
+		{"/v1/schedule/:id/history", "tasks"},
+		{"/v1/workflow", "tasks"},
+		{"/v1/workflow/:id", "tasks"},
+		{"/v1/queue", "queues"},
+		{"/v1/queue/:name", "queues"},
+		{"/v1/queue/:name/pause", "queues"},
+		{"/v1/queue/:name/resume", "queues"},
 	}
 
 	// Define policies
@@ -180,6 +338,19 @@ func MigrateRBAC(authModule auth.AuthModule) {
 		{"rbac", "rbac", "*"},
 		{"artifacts", "artifacts", "*"},
 		{"tasks", "tasks", "*"},
+		{"queues", "queues", "*"},
+		{api.AllTasksGroup, "tasks", "*"},
+		{api.LogIngestGroup, "task_logs_ingest", "POST"},
+	}
+
+	for _, name := range queues {
+		if name == queue.TaskQueueDefault {
+			continue
+		}
+
+		// Queue roles grant no resource permissions. Their membership is checked
+		// when a task is submitted to the queue.
+		userGroups = append(userGroups, api.QueueGroup(name))
 	}
 
 	// Create resource groups
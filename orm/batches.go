@@ -0,0 +1,79 @@
+package orm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func (db *DB) CreateTaskBatch(ctx context.Context, batch *TaskBatch) error {
+	err := gorm.G[TaskBatch](db.dbGorm).Create(ctx, batch)
+	if err != nil {
+		return &DatabaseError{err}
+	}
+
+	return nil
+}
+
+func (db *DB) GetTaskBatch(
+	ctx context.Context,
+	id uuid.UUID,
+) (*TaskBatch, error) {
+	batch, err := gorm.G[TaskBatch](db.dbGorm).Where("id = ?", id).First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &NotFoundError{"Task batch " + id.String()}
+		}
+
+		return nil, &DatabaseError{err}
+	}
+
+	return &batch, nil
+}
+
+// SetTaskBatchSubmitted stores how many tasks of a batch were enqueued.
+func (db *DB) SetTaskBatchSubmitted(
+	ctx context.Context,
+	id uuid.UUID,
+	submitted int,
+) error {
+	_, err := gorm.G[TaskBatch](db.dbGorm).
+		Where("id = ?", id).
+		Update(ctx, "submitted", submitted)
+	if err != nil {
+		return &DatabaseError{err}
+	}
+
+	return nil
+}
+
+// CountTaskStatesOfBatch returns the number of recorded tasks of a batch per
+// state. Tasks replaced by a copy are only counted as their copy.
+func (db *DB) CountTaskStatesOfBatch(
+	ctx context.Context,
+	id uuid.UUID,
+) (map[string]int, error) {
+	var rows []struct {
+		State string
+		Count int
+	}
+
+	err := db.dbGorm.WithContext(ctx).
+		Model(&Task{}).
+		Select("state, count(*) AS count").
+		Where("batch_id = ? AND requeued_as = ''", id.String()).
+		Group("state").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, &DatabaseError{err}
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.State] = row.Count
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,164 @@
+package orm
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CreateCallback registers the callback of a task before the task is enqueued.
+// The first delivery attempt is postponed by delay so that the dispatcher
+// does not report the task as missing before it was enqueued.
+func (db *DB) CreateCallback(
+	ctx context.Context,
+	taskID, url string,
+	delay time.Duration,
+) error {
+	err := gorm.G[Callback](db.dbGorm).Create(ctx, &Callback{
+		TaskID:        taskID,
+		URL:           url,
+		Status:        CallbackStatusPending,
+		NextAttemptAt: time.Now().Add(delay),
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return &ConflictError{"Callback for task " + taskID}
+		}
+
+		return &DatabaseError{err}
+	}
+
+	return nil
+}
+
+// DeleteCallback removes the callback of a task, e.g. because the task could
+// not be enqueued after its callback was registered.
+func (db *DB) DeleteCallback(ctx context.Context, taskID string) error {
+	_, err := gorm.G[Callback](db.dbGorm).
+		Where("task_id = ?", taskID).
+		Delete(ctx)
+	if err != nil {
+		return &DatabaseError{err}
+	}
+
+	return nil
+}
+
+func (db *DB) GetCallbackOfTask(
+	ctx context.Context,
+	taskID string,
+) (*Callback, error) {
+	callback, err := gorm.G[Callback](db.dbGorm).
+		Where(&Callback{TaskID: taskID}).
+		First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &NotFoundError{"Callback of task " + taskID}
+		}
+
+		return nil, &DatabaseError{err}
+	}
+
+	return &callback, nil
+}
+
+// GetDueCallbacks returns up to limit pending callbacks whose next attempt is
+// due, oldest first.
+func (db *DB) GetDueCallbacks(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]Callback, error) {
+	callbacks, err := gorm.G[Callback](db.dbGorm).
+		Where("status = ? AND next_attempt_at <= ?", CallbackStatusPending, now).
+		Order("next_attempt_at").
+		Limit(limit).
+		Find(ctx)
+	if err != nil {
+		return nil, &DatabaseError{err}
+	}
+
+	return callbacks, nil
+}
+
+// DeferCallback postpones the next attempt of the pending callback of a task,
+// e.g. because the task did not reach a final state yet.
+func (db *DB) DeferCallback(
+	ctx context.Context,
+	taskID string,
+	nextAttemptAt time.Time,
+) error {
+	_, err := gorm.G[Callback](db.dbGorm).
+		Where("task_id = ? AND status = ?", taskID, CallbackStatusPending).
+		Update(ctx, "next_attempt_at", nextAttemptAt)
+	if err != nil {
+		return &DatabaseError{err}
+	}
+
+	return nil
+}
+
+// RecordCallbackAttempt stores the outcome of a delivery attempt and the
+// updated callback state in a single transaction.
+func (db *DB) RecordCallbackAttempt(
+	ctx context.Context,
+	callback *Callback,
+	delivery *CallbackDelivery,
+) error {
+	err := db.dbGorm.Transaction(func(tx *gorm.DB) error {
+		err := gorm.G[CallbackDelivery](tx).Create(ctx, delivery)
+		if err != nil {
+			return &DatabaseError{err}
+		}
+
+		err = tx.WithContext(ctx).Save(callback).Error
+		if err != nil {
+			return &DatabaseError{err}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return &GenericError{err}
+	}
+
+	return nil
+}
+
+func (db *DB) GetCallbackDeliveriesOfTask(
+	ctx context.Context,
+	taskID string,
+) ([]CallbackDelivery, error) {
+	deliveries, err := gorm.G[CallbackDelivery](db.dbGorm).
+		Where(&CallbackDelivery{TaskID: taskID}).
+		Order("round, attempt").
+		Find(ctx)
+	if err != nil {
+		return nil, &DatabaseError{err}
+	}
+
+	return deliveries, nil
+}
+
+// RearmCallback schedules a new delivery of the callback of a task, e.g. after
+// the task was retried and will reach a final state again. The delivery starts
+// a new round with a fresh number of attempts, so that the attempts of earlier
+// rounds stay distinguishable. Tasks without a callback are ignored.
+func (db *DB) RearmCallback(ctx context.Context, taskID string) error {
+	err := db.dbGorm.WithContext(ctx).
+		Model(&Callback{}).
+		Where("task_id = ?", taskID).
+		Updates(map[string]any{
+			"status":          CallbackStatusPending,
+			"attempts":        0,
+			"round":           gorm.Expr("round + 1"),
+			"next_attempt_at": time.Now(),
+		}).Error
+	if err != nil {
+		return &DatabaseError{err}
+	}
+
+	return nil
+}
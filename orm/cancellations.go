@@ -0,0 +1,54 @@
+package orm
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// CreateTaskCancellation marks an active task as canceled. Marking a task
+// twice is not an error.
+func (db *DB) CreateTaskCancellation(
+	ctx context.Context,
+	taskID, queue string,
+) error {
+	err := gorm.G[TaskCancellation](db.dbGorm).Create(ctx, &TaskCancellation{
+		TaskID: taskID,
+		Queue:  queue,
+	})
+	if err != nil && !errors.Is(err, gorm.ErrDuplicatedKey) {
+		return &DatabaseError{err}
+	}
+
+	return nil
+}
+
+// GetTaskCancellations returns all canceled tasks that were not seen stopped
+// yet. As only active tasks are marked, there are at most as many as tasks are
+// processed at once.
+func (db *DB) GetTaskCancellations(
+	ctx context.Context,
+) ([]TaskCancellation, error) {
+	cancellations, err := gorm.G[TaskCancellation](db.dbGorm).
+		Order("created_at").
+		Find(ctx)
+	if err != nil {
+		return nil, &DatabaseError{err}
+	}
+
+	return cancellations, nil
+}
+
+// DeleteTaskCancellation removes the cancellation mark of a task once it
+// stopped or is retried.
+func (db *DB) DeleteTaskCancellation(ctx context.Context, taskID string) error {
+	_, err := gorm.G[TaskCancellation](db.dbGorm).
+		Where("task_id = ?", taskID).
+		Delete(ctx)
+	if err != nil {
+		return &DatabaseError{err}
+	}
+
+	return nil
+}
@@ -28,6 +28,14 @@ func (e *ConflictError) Error() string {
 	return "Conflict error for: " + e.Conflict
 }
 
+type LimitExceededError struct {
+	Limit string
+}
+
+func (e *LimitExceededError) Error() string {
+	return "Limit exceeded for: " + e.Limit
+}
+
 type GenericError struct {
 	Inner error
 }
@@ -0,0 +1,83 @@
+package orm
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CreateIdempotencyKey stores a new idempotency key. Keys older than window are
+// expired and removed beforehand, so an expired key can be used again. If the
+// user already holds the key, a ConflictError is returned.
+func (db *DB) CreateIdempotencyKey(
+	ctx context.Context,
+	key *IdempotencyKey,
+	window time.Duration,
+) error {
+	err := db.dbGorm.Transaction(func(tx *gorm.DB) error {
+		_, err := gorm.G[IdempotencyKey](tx).
+			Where("created_at < ?", time.Now().Add(-window)).
+			Delete(ctx)
+		if err != nil {
+			return &DatabaseError{err}
+		}
+
+		err = gorm.G[IdempotencyKey](tx).Create(ctx, key)
+		if err != nil {
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				return &ConflictError{"Idempotency key " + key.Key}
+			}
+
+			return &DatabaseError{err}
+		}
+
+		return nil
+	})
+	if err != nil {
+		var errConflict *ConflictError
+		if errors.As(err, &errConflict) {
+			return errConflict
+		}
+
+		return &GenericError{err}
+	}
+
+	return nil
+}
+
+// GetIdempotencyKey returns the idempotency key of a user.
+func (db *DB) GetIdempotencyKey(
+	ctx context.Context,
+	submittedBy, key string,
+) (*IdempotencyKey, error) {
+	idempotencyKey, err := gorm.G[IdempotencyKey](db.dbGorm).
+		Where("key = ? AND submitted_by = ?", key, submittedBy).
+		First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &NotFoundError{"Idempotency key " + key}
+		}
+
+		return nil, &DatabaseError{err}
+	}
+
+	return &idempotencyKey, nil
+}
+
+// DeleteIdempotencyKey releases the idempotency key of a user, e.g. when the
+// request holding it failed.
+func (db *DB) DeleteIdempotencyKey(
+	ctx context.Context,
+	submittedBy, key string,
+) error {
+	_, err := gorm.G[IdempotencyKey](db.dbGorm).
+		Where("key = ? AND submitted_by = ?", key, submittedBy).
+		Delete(ctx)
+	if err != nil {
+		return &DatabaseError{err}
+	}
+
+	return nil
+}
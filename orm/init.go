@@ -49,7 +49,26 @@ func InitDB(cfg *config.AppConfig) *gorm.DB {
 	log.Debug().Msg("Successfully connected to the database")
 
 	// Run database migrations
-	err = db.AutoMigrate(&User{}, &Auth_Basic{}, &TaskLog{})
+	err = db.AutoMigrate(
+		&User{},
+		&Auth_Basic{},
+		&TaskLog{},
+		&Callback{},
+		&CallbackDelivery{},
+		&TaskRetry{},
+		&TaskCancellation{},
+		&Schedule{},
+		&ScheduleRun{},
+		&Task{},
+		&TaskTransition{},
+		&IdempotencyKey{},
+		&TaskBatch{},
+		&Workflow{},
+		&WorkflowNode{},
+		&WorkflowEdge{},
+		&TaskMap{},
+		&TaskMapElement{},
+	)
 	if err != nil {
 		log.Fatal().Err(err).Msg("Failed to migrate database")
 	}
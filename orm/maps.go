@@ -0,0 +1,205 @@
+package orm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Number of map elements inserted per statement
+const mapElementBatchSize = 1000
+
+// CreateTaskMap stores a task map together with a waiting element for each of
+// its list elements.
+func (db *DB) CreateTaskMap(ctx context.Context, taskMap *TaskMap) error {
+	err := db.dbGorm.Transaction(func(tx *gorm.DB) error {
+		err := gorm.G[TaskMap](tx).Create(ctx, taskMap)
+		if err != nil {
+			return &DatabaseError{err}
+		}
+
+		if taskMap.Total == 0 {
+			return nil
+		}
+
+		elements := make([]TaskMapElement, taskMap.Total)
+		for i := range elements {
+			elements[i] = TaskMapElement{
+				MapID: taskMap.ID,
+				Index: i,
+				State: TaskMapElementStateWaiting,
+			}
+		}
+
+		err = gorm.G[TaskMapElement](tx).
+			CreateInBatches(ctx, &elements, mapElementBatchSize)
+		if err != nil {
+			return &DatabaseError{err}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return &GenericError{err}
+	}
+
+	return nil
+}
+
+func (db *DB) GetTaskMap(ctx context.Context, id uuid.UUID) (*TaskMap, error) {
+	taskMap, err := gorm.G[TaskMap](db.dbGorm).Where("id = ?", id).First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &NotFoundError{"Task map " + id.String()}
+		}
+
+		return nil, &DatabaseError{err}
+	}
+
+	return &taskMap, nil
+}
+
+// GetRunningTaskMaps returns up to limit task maps that did not finish yet,
+// ordered by id and starting after afterID.
+func (db *DB) GetRunningTaskMaps(
+	ctx context.Context,
+	afterID uuid.UUID,
+	limit int,
+) ([]TaskMap, error) {
+	taskMaps, err := gorm.G[TaskMap](db.dbGorm).
+		Where("state = ? AND id > ?", TaskMapStateRunning, afterID).
+		Order("id").
+		Limit(limit).
+		Find(ctx)
+	if err != nil {
+		return nil, &DatabaseError{err}
+	}
+
+	return taskMaps, nil
+}
+
+// FinishTaskMap stores the final state of a task map together with its
+// collected result or the reason it failed.
+func (db *DB) FinishTaskMap(ctx context.Context, taskMap *TaskMap) error {
+	err := db.dbGorm.WithContext(ctx).
+		Model(&TaskMap{}).
+		Where("id = ?", taskMap.ID).
+		Select("State", "Result", "Error", "UpdatedAt").
+		Updates(taskMap).
+		Error
+	if err != nil {
+		return &DatabaseError{err}
+	}
+
+	return nil
+}
+
+// GetTaskMapElements returns the elements of a task map ordered by index.
+func (db *DB) GetTaskMapElements(
+	ctx context.Context,
+	id uuid.UUID,
+) ([]TaskMapElement, error) {
+	elements, err := gorm.G[TaskMapElement](db.dbGorm).
+		Where("map_id = ?", id).
+		Order("index").
+		Find(ctx)
+	if err != nil {
+		return nil, &DatabaseError{err}
+	}
+
+	return elements, nil
+}
+
+// GetTaskMapElementsInState returns up to limit elements of a task map that
+// are in the given state, ordered by index. The results of the elements are
+// not loaded.
+func (db *DB) GetTaskMapElementsInState(
+	ctx context.Context,
+	id uuid.UUID,
+	state string,
+	limit int,
+) ([]TaskMapElement, error) {
+	elements, err := gorm.G[TaskMapElement](db.dbGorm).
+		Omit("result").
+		Where("map_id = ? AND state = ?", id, state).
+		Order("index").
+		Limit(limit).
+		Find(ctx)
+	if err != nil {
+		return nil, &DatabaseError{err}
+	}
+
+	return elements, nil
+}
+
+// CountTaskMapElementStates returns the number of elements of a task map per
+// state.
+func (db *DB) CountTaskMapElementStates(
+	ctx context.Context,
+	id uuid.UUID,
+) (map[string]int, error) {
+	var rows []struct {
+		State string
+		Count int
+	}
+
+	err := db.dbGorm.WithContext(ctx).
+		Model(&TaskMapElement{}).
+		Select("state, count(*) AS count").
+		Where("map_id = ?", id).
+		Group("state").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, &DatabaseError{err}
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.State] = row.Count
+	}
+
+	return counts, nil
+}
+
+// UpdateTaskMapElement stores the state, task, result and error of an element
+// if the element is still in previousState. It reports whether the element
+// was updated, so that concurrent updates by other instances are detected.
+func (db *DB) UpdateTaskMapElement(
+	ctx context.Context,
+	element *TaskMapElement,
+	previousState string,
+) (bool, error) {
+	result := db.dbGorm.WithContext(ctx).
+		Model(&TaskMapElement{}).
+		Where(
+			"map_id = ? AND index = ? AND state = ?",
+			element.MapID,
+			element.Index,
+			previousState,
+		).
+		Select("State", "TaskID", "Result", "Error", "UpdatedAt").
+		Updates(element)
+	if result.Error != nil {
+		return false, &DatabaseError{result.Error}
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// SkipWaitingTaskMapElements marks all elements of a task map that were not
+// enqueued yet as skipped.
+func (db *DB) SkipWaitingTaskMapElements(
+	ctx context.Context,
+	id uuid.UUID,
+) error {
+	_, err := gorm.G[TaskMapElement](db.dbGorm).
+		Where("map_id = ? AND state = ?", id, TaskMapElementStateWaiting).
+		Update(ctx, "state", TaskMapElementStateSkipped)
+	if err != nil {
+		return &DatabaseError{err}
+	}
+
+	return nil
+}
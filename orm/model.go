@@ -17,15 +17,326 @@ type Auth_Basic struct {
 }
 
 type TaskLog struct {
-	ID        uuid.UUID `gorm:"primaryKey;type:uuid;default:gen_random_uuid()" json:"id"`
-	TaskID    string    `gorm:"not null"                                       json:"task_id"`
-	Timestamp time.Time `gorm:"not null;autoCreateTime"                        json:"timestamp"`
-	Level     string    `gorm:"not null"                                       json:"level"`
-	Issuer    string    `gorm:"not null"                                       json:"issuer"`
-	Message   string    `gorm:"not null"                                       json:"message"`
+	ID        uuid.UUID `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"          json:"id"`
+	TaskID    string    `gorm:"not null;index:idx_task_log_time;index:idx_task_log_seq" json:"task_id"`
+	Timestamp time.Time `gorm:"not null;autoCreateTime;index:idx_task_log_time"         json:"timestamp"`
+	Level     string    `gorm:"not null"                                                json:"level"`
+	Issuer    string    `gorm:"not null"                                                json:"issuer"`
+	Message   string    `gorm:"not null"                                                json:"message"`
+	// Sequence number in the order the logs were stored, which may differ from
+	// the order of their timestamps if batches arrive late
+	Seq int64 `gorm:"autoIncrement;not null;index:idx_task_log_seq" json:"-"`
 }
 
 // TableName specifies the table name for TaskLog
 func (TaskLog) TableName() string {
 	return "task_logs"
 }
+
+const (
+	CallbackStatusPending   = "pending"
+	CallbackStatusDelivered = "delivered"
+	CallbackStatusFailed    = "failed"
+)
+
+type Callback struct {
+	TaskID        string    `gorm:"primaryKey;not null"     json:"task_id"`
+	URL           string    `gorm:"not null"                json:"url"`
+	Status        string    `gorm:"not null;index"          json:"status"`
+	Attempts      int       `gorm:"not null;default:0"      json:"attempts"`
+	Round         int       `gorm:"not null;default:1"      json:"round"`
+	NextAttemptAt time.Time `gorm:"not null;index"          json:"next_attempt_at"`
+	CreatedAt     time.Time `gorm:"not null;autoCreateTime" json:"created_at"`
+}
+
+// TableName specifies the table name for Callback
+func (Callback) TableName() string {
+	return "callbacks"
+}
+
+type CallbackDelivery struct {
+	ID         uuid.UUID `gorm:"primaryKey;type:uuid;default:gen_random_uuid()" json:"id"`
+	TaskID     string    `gorm:"not null;index"                                 json:"task_id"`
+	Round      int       `gorm:"not null;default:1"                             json:"round"`
+	Attempt    int       `gorm:"not null"                                       json:"attempt"`
+	Timestamp  time.Time `gorm:"not null;autoCreateTime"                        json:"timestamp"`
+	StatusCode int       `gorm:"not null;default:0"                             json:"status_code"`
+	Error      string    `gorm:"not null;default:''"                            json:"error"`
+	Success    bool      `gorm:"not null"                                       json:"success"`
+}
+
+// TableName specifies the table name for CallbackDelivery
+func (CallbackDelivery) TableName() string {
+	return "callback_deliveries"
+}
+
+type TaskRetry struct {
+	ID           uuid.UUID `gorm:"primaryKey;type:uuid;default:gen_random_uuid()" json:"id"`
+	TaskID       string    `gorm:"not null;index"                                 json:"task_id"`
+	TriggeredBy  string    `gorm:"not null"                                       json:"triggered_by"`
+	ResetRetries bool      `gorm:"not null"                                       json:"reset_retries"`
+	Timestamp    time.Time `gorm:"not null;autoCreateTime"                        json:"timestamp"`
+}
+
+// TableName specifies the table name for TaskRetry
+func (TaskRetry) TableName() string {
+	return "task_retries"
+}
+
+// TaskCancellation marks an active task that was canceled. asynq retries a
+// canceled task like a failed one, so the task is archived once it stopped.
+type TaskCancellation struct {
+	TaskID    string    `gorm:"primaryKey;not null"          json:"task_id"`
+	Queue     string    `gorm:"not null"                     json:"queue"`
+	CreatedAt time.Time `gorm:"not null;autoCreateTime;index" json:"created_at"`
+}
+
+// TableName specifies the table name for TaskCancellation
+func (TaskCancellation) TableName() string {
+	return "task_cancellations"
+}
+
+// Schedule enqueues the serialized task proto in Task whenever its cron
+// expression fires.
+type Schedule struct {
+	ID             uuid.UUID `gorm:"primaryKey;type:uuid;default:gen_random_uuid()" json:"id"`
+	Name           string    `gorm:"not null;uniqueIndex"                           json:"name"`
+	CronExpression string    `gorm:"not null"                                       json:"cron_expression"`
+	Timezone       string    `gorm:"not null;default:'UTC'"                         json:"timezone"`
+	Task           []byte    `gorm:"not null"                                       json:"task"`
+	Retries        int       `gorm:"not null"                                       json:"retries"`
+	Retention      string    `gorm:"not null"                                       json:"retention"`
+	Timeout        string    `gorm:"not null;default:''"                            json:"timeout"`
+	Queue          string    `gorm:"not null;default:'default'"                     json:"queue"`
+	Paused         bool      `gorm:"not null;default:false"                         json:"paused"`
+	CreatedBy      string    `gorm:"not null"                                       json:"created_by"`
+	CreatedAt      time.Time `gorm:"not null;autoCreateTime"                        json:"created_at"`
+	UpdatedAt      time.Time `gorm:"not null;autoUpdateTime"                        json:"updated_at"`
+}
+
+// TableName specifies the table name for Schedule
+func (Schedule) TableName() string {
+	return "schedules"
+}
+
+type ScheduleRun struct {
+	ID          uuid.UUID `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"  json:"id"`
+	ScheduleID  uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_schedule_run" json:"schedule_id"`
+	ScheduledAt time.Time `gorm:"not null;uniqueIndex:idx_schedule_run"           json:"scheduled_at"`
+	TaskID      string    `gorm:"not null;default:''"                             json:"task_id"`
+	Error       string    `gorm:"not null;default:''"                             json:"error"`
+}
+
+// TableName specifies the table name for ScheduleRun
+func (ScheduleRun) TableName() string {
+	return "schedule_runs"
+}
+
+// TaskStateExpired is the state of tasks that vanished from the queue before
+// they were seen in a final state
+const TaskStateExpired = "expired"
+
+// TaskStateFailed is the state of completed tasks whose function returned the
+// err case of a WIT result
+const TaskStateFailed = "failed"
+
+// Task is the durable record of a task. It outlives the task in the queue,
+// which is dropped once its retention expired.
+type Task struct {
+	ID            string     `gorm:"primaryKey;not null"                                        json:"id"`
+	Queue         string     `gorm:"not null"                                                   json:"queue"`
+	Namespace     string     `gorm:"not null;index:idx_task_source"                             json:"namespace"`
+	Package       string     `gorm:"not null;index:idx_task_source"                             json:"package"`
+	Interface     string     `gorm:"not null"                                                   json:"interface"`
+	Function      string     `gorm:"not null"                                                   json:"function"`
+	Tag           string     `gorm:"not null;default:''"                                        json:"tag"`
+	VersionHash   string     `gorm:"not null;default:''"                                        json:"version_hash"`
+	Payload       []byte     `gorm:"not null"                                                   json:"payload"`
+	State         string     `gorm:"not null;index:idx_task_state_created"                      json:"state"`
+	MaxRetry      int        `gorm:"not null"                                                   json:"max_retry"`
+	Retried       int        `gorm:"not null;default:0"                                         json:"retried"`
+	Retention     string     `gorm:"not null"                                                   json:"retention"`
+	Timeout       string     `gorm:"not null;default:''"                                        json:"timeout"`
+	Deadline      *time.Time `gorm:"default:null"                                               json:"deadline"`
+	LastError     string     `gorm:"not null;default:''"                                        json:"last_error"`
+	Result        []byte     `gorm:"default:null"                                               json:"result"`
+	LastFailedAt  *time.Time `gorm:"default:null"                                               json:"last_failed_at"`
+	NextProcessAt *time.Time `gorm:"default:null"                                               json:"next_process_at"`
+	CompletedAt   *time.Time `gorm:"default:null;index"                                         json:"completed_at"`
+	SubmittedBy   string     `gorm:"not null;default:'';index"                                  json:"submitted_by"`
+	BatchID       string     `gorm:"not null;default:'';index"                                  json:"batch_id"`
+	GroupID       string     `gorm:"not null;default:'';index"                                  json:"group_id"`
+	RequeuedFrom  string     `gorm:"not null;default:''"                                        json:"requeued_from"`
+	RequeuedAs    string     `gorm:"not null;default:''"                                        json:"requeued_as"`
+	CreatedAt     time.Time  `gorm:"not null;autoCreateTime;index;index:idx_task_state_created" json:"created_at"`
+	UpdatedAt     time.Time  `gorm:"not null;autoUpdateTime"                                    json:"updated_at"`
+}
+
+// TableName specifies the table name for Task
+func (Task) TableName() string {
+	return "tasks"
+}
+
+type TaskTransition struct {
+	ID        uuid.UUID `gorm:"primaryKey;type:uuid;default:gen_random_uuid()" json:"id"`
+	TaskID    string    `gorm:"not null;index"                                 json:"task_id"`
+	FromState string    `gorm:"not null;default:''"                            json:"from_state"`
+	ToState   string    `gorm:"not null"                                       json:"to_state"`
+	Timestamp time.Time `gorm:"not null;autoCreateTime"                        json:"timestamp"`
+}
+
+// TableName specifies the table name for TaskTransition
+func (TaskTransition) TableName() string {
+	return "task_transitions"
+}
+
+// IdempotencyKey maps a key chosen by a user to the task created by the first
+// request carrying it.
+type IdempotencyKey struct {
+	Key         string    `gorm:"primaryKey;not null"           json:"key"`
+	SubmittedBy string    `gorm:"primaryKey;not null"           json:"submitted_by"`
+	TaskID      string    `gorm:"not null"                      json:"task_id"`
+	RequestHash string    `gorm:"not null"                      json:"request_hash"`
+	CreatedAt   time.Time `gorm:"not null;autoCreateTime;index" json:"created_at"`
+}
+
+// TableName specifies the table name for IdempotencyKey
+func (IdempotencyKey) TableName() string {
+	return "idempotency_keys"
+}
+
+// TaskBatch is a set of tasks submitted with a single request.
+type TaskBatch struct {
+	ID          uuid.UUID `gorm:"primaryKey;type:uuid;default:gen_random_uuid()" json:"id"`
+	SubmittedBy string    `gorm:"not null"                                       json:"submitted_by"`
+	Total       int       `gorm:"not null"                                       json:"total"`
+	Submitted   int       `gorm:"not null;default:0"                             json:"submitted"`
+	CreatedAt   time.Time `gorm:"not null;autoCreateTime"                        json:"created_at"`
+}
+
+// TableName specifies the table name for TaskBatch
+func (TaskBatch) TableName() string {
+	return "task_batches"
+}
+
+const (
+	WorkflowStateRunning   = "running"
+	WorkflowStateCompleted = "completed"
+	WorkflowStateFailed    = "failed"
+)
+
+// Workflow is a directed acyclic graph of tasks. Each node is enqueued once
+// all of its predecessors completed and receives their results as parameters.
+type Workflow struct {
+	ID        uuid.UUID `gorm:"primaryKey;type:uuid;default:gen_random_uuid()" json:"id"`
+	Name      string    `gorm:"not null"                                       json:"name"`
+	State     string    `gorm:"not null;index"                                 json:"state"`
+	CreatedBy string    `gorm:"not null;index"                                 json:"created_by"`
+	CreatedAt time.Time `gorm:"not null;autoCreateTime"                        json:"created_at"`
+	UpdatedAt time.Time `gorm:"not null;autoUpdateTime"                        json:"updated_at"`
+}
+
+// TableName specifies the table name for Workflow
+func (Workflow) TableName() string {
+	return "workflows"
+}
+
+const (
+	WorkflowNodeStateWaiting   = "waiting"
+	WorkflowNodeStateEnqueued  = "enqueued"
+	WorkflowNodeStateCompleted = "completed"
+	WorkflowNodeStateFailed    = "failed"
+	WorkflowNodeStateSkipped   = "skipped"
+)
+
+// WorkflowNode is a single task of a workflow. Task holds the serialized task
+// proto without the parameters passed in by the predecessors of the node.
+type WorkflowNode struct {
+	WorkflowID uuid.UUID `gorm:"primaryKey;type:uuid;not null" json:"workflow_id"`
+	Name       string    `gorm:"primaryKey;not null"           json:"name"`
+	Task       []byte    `gorm:"not null"                      json:"task"`
+	Retries    int       `gorm:"not null"                      json:"retries"`
+	Retention  string    `gorm:"not null"                      json:"retention"`
+	Timeout    string    `gorm:"not null;default:''"           json:"timeout"`
+	Queue      string    `gorm:"not null;default:'default'"    json:"queue"`
+	State      string    `gorm:"not null"                      json:"state"`
+	TaskID     string    `gorm:"not null;default:''"           json:"task_id"`
+	Result     []byte    `gorm:"default:null"                  json:"result"`
+	Error      string    `gorm:"not null;default:''"           json:"error"`
+	UpdatedAt  time.Time `gorm:"not null;autoUpdateTime"       json:"updated_at"`
+}
+
+// TableName specifies the table name for WorkflowNode
+func (WorkflowNode) TableName() string {
+	return "workflow_nodes"
+}
+
+// WorkflowEdge passes the result of node FromNode to node ToNode. Position is
+// the index of the parameter the result is passed as.
+type WorkflowEdge struct {
+	WorkflowID uuid.UUID `gorm:"primaryKey;type:uuid;not null" json:"workflow_id"`
+	FromNode   string    `gorm:"primaryKey;not null"           json:"from_node"`
+	ToNode     string    `gorm:"primaryKey;not null"           json:"to_node"`
+	Position   int       `gorm:"not null"                      json:"position"`
+}
+
+// TableName specifies the table name for WorkflowEdge
+func (WorkflowEdge) TableName() string {
+	return "workflow_edges"
+}
+
+const (
+	TaskMapStateRunning   = "running"
+	TaskMapStateCompleted = "completed"
+	TaskMapStateFailed    = "failed"
+)
+
+// TaskMap runs a task once for every element of a list parameter and collects
+// the results in a list. Task holds the serialized task proto with the whole
+// list as parameter.
+type TaskMap struct {
+	ID          uuid.UUID `gorm:"primaryKey;type:uuid;default:gen_random_uuid()" json:"id"`
+	Task        []byte    `gorm:"not null"                                       json:"task"`
+	Parameter   int       `gorm:"not null"                                       json:"parameter"`
+	Total       int       `gorm:"not null"                                       json:"total"`
+	Concurrency int       `gorm:"not null"                                       json:"concurrency"`
+	Retries     int       `gorm:"not null"                                       json:"retries"`
+	Retention   string    `gorm:"not null"                                       json:"retention"`
+	Timeout     string    `gorm:"not null;default:''"                            json:"timeout"`
+	Queue       string    `gorm:"not null;default:'default'"                     json:"queue"`
+	State       string    `gorm:"not null;index"                                 json:"state"`
+	Result      []byte    `gorm:"default:null"                                   json:"result"`
+	Error       string    `gorm:"not null;default:''"                            json:"error"`
+	CreatedBy   string    `gorm:"not null;index"                                 json:"created_by"`
+	CreatedAt   time.Time `gorm:"not null;autoCreateTime"                        json:"created_at"`
+	UpdatedAt   time.Time `gorm:"not null;autoUpdateTime"                        json:"updated_at"`
+}
+
+// TableName specifies the table name for TaskMap
+func (TaskMap) TableName() string {
+	return "task_maps"
+}
+
+const (
+	TaskMapElementStateWaiting   = "waiting"
+	TaskMapElementStateEnqueued  = "enqueued"
+	TaskMapElementStateCompleted = "completed"
+	TaskMapElementStateFailed    = "failed"
+	TaskMapElementStateSkipped   = "skipped"
+)
+
+// TaskMapElement is the task processing a single element of a TaskMap.
+type TaskMapElement struct {
+	MapID     uuid.UUID `gorm:"primaryKey;type:uuid;not null" json:"map_id"`
+	Index     int       `gorm:"primaryKey;not null"           json:"index"`
+	State     string    `gorm:"not null"                      json:"state"`
+	TaskID    string    `gorm:"not null;default:''"           json:"task_id"`
+	Result    []byte    `gorm:"default:null"                  json:"result"`
+	Error     string    `gorm:"not null;default:''"           json:"error"`
+	UpdatedAt time.Time `gorm:"not null;autoUpdateTime"       json:"updated_at"`
+}
+
+// TableName specifies the table name for TaskMapElement
+func (TaskMapElement) TableName() string {
+	return "task_map_elements"
+}
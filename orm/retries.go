@@ -0,0 +1,56 @@
+package orm
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// Number of rows inserted per statement when recording bulk retries
+const retryBatchSize = 100
+
+// RecordTaskRetries stores who triggered a retry of the given tasks.
+func (db *DB) RecordTaskRetries(
+	ctx context.Context,
+	taskIDs []string,
+	triggeredBy string,
+	resetRetries bool,
+) error {
+	if len(taskIDs) == 0 {
+		return nil
+	}
+
+	retries := make([]TaskRetry, 0, len(taskIDs))
+	for _, taskID := range taskIDs {
+		retries = append(retries, TaskRetry{
+			TaskID:       taskID,
+			TriggeredBy:  triggeredBy,
+			ResetRetries: resetRetries,
+		})
+	}
+
+	err := gorm.G[TaskRetry](
+		db.dbGorm,
+	).CreateInBatches(ctx, &retries, retryBatchSize)
+	if err != nil {
+		return &DatabaseError{err}
+	}
+
+	return nil
+}
+
+// GetRetriesOfTask returns the recorded retries of a task, oldest first.
+func (db *DB) GetRetriesOfTask(
+	ctx context.Context,
+	taskID string,
+) ([]TaskRetry, error) {
+	retries, err := gorm.G[TaskRetry](db.dbGorm).
+		Where("task_id = ?", taskID).
+		Order("timestamp").
+		Find(ctx)
+	if err != nil {
+		return nil, &DatabaseError{err}
+	}
+
+	return retries, nil
+}
@@ -0,0 +1,142 @@
+package orm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func (db *DB) CreateSchedule(ctx context.Context, schedule *Schedule) error {
+	err := gorm.G[Schedule](db.dbGorm).Create(ctx, schedule)
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return &ConflictError{"Schedule with name " + schedule.Name}
+		}
+
+		return &DatabaseError{err}
+	}
+
+	return nil
+}
+
+func (db *DB) GetSchedule(
+	ctx context.Context,
+	id uuid.UUID,
+) (*Schedule, error) {
+	schedule, err := gorm.G[Schedule](db.dbGorm).
+		Where("id = ?", id).
+		First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &NotFoundError{"Schedule " + id.String()}
+		}
+
+		return nil, &DatabaseError{err}
+	}
+
+	return &schedule, nil
+}
+
+// ListSchedules returns all schedules. If createdBy is set, only the schedules
+// created by that user are returned.
+func (db *DB) ListSchedules(
+	ctx context.Context,
+	createdBy *string,
+) ([]Schedule, error) {
+	query := gorm.G[Schedule](db.dbGorm).Order("name")
+	if createdBy != nil {
+		query = query.Where("created_by = ?", *createdBy)
+	}
+
+	schedules, err := query.Find(ctx)
+	if err != nil {
+		return nil, &DatabaseError{err}
+	}
+
+	return schedules, nil
+}
+
+// UpdateSchedule stores all fields of an existing schedule.
+func (db *DB) UpdateSchedule(ctx context.Context, schedule *Schedule) error {
+	err := db.dbGorm.WithContext(ctx).Save(schedule).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return &ConflictError{"Schedule with name " + schedule.Name}
+		}
+
+		return &DatabaseError{err}
+	}
+
+	return nil
+}
+
+// DeleteSchedule removes a schedule together with its run history and returns
+// the deleted schedule.
+func (db *DB) DeleteSchedule(
+	ctx context.Context,
+	id uuid.UUID,
+) (*Schedule, error) {
+	schedule, err := db.GetSchedule(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.dbGorm.Transaction(func(tx *gorm.DB) error {
+		_, err := gorm.G[ScheduleRun](tx).Where("schedule_id = ?", id).Delete(ctx)
+		if err != nil {
+			return &DatabaseError{err}
+		}
+
+		_, err = gorm.G[Schedule](tx).Where("id = ?", id).Delete(ctx)
+		if err != nil {
+			return &DatabaseError{err}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, &GenericError{err}
+	}
+
+	return schedule, nil
+}
+
+// RecordScheduleRun stores a run of a schedule. A run that was already recorded
+// for the same point in time (e.g. by another instance) results in a
+// ConflictError.
+func (db *DB) RecordScheduleRun(ctx context.Context, run *ScheduleRun) error {
+	err := gorm.G[ScheduleRun](db.dbGorm).Create(ctx, run)
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return &ConflictError{
+				"Run of schedule " + run.ScheduleID.String() + " at " +
+					run.ScheduledAt.String(),
+			}
+		}
+
+		return &DatabaseError{err}
+	}
+
+	return nil
+}
+
+// GetScheduleRuns returns the runs of a schedule, newest first.
+func (db *DB) GetScheduleRuns(
+	ctx context.Context,
+	id uuid.UUID,
+	limit, offset int,
+) ([]ScheduleRun, error) {
+	runs, err := gorm.G[ScheduleRun](db.dbGorm).
+		Where("schedule_id = ?", id).
+		Order("scheduled_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(ctx)
+	if err != nil {
+		return nil, &DatabaseError{err}
+	}
+
+	return runs, nil
+}
@@ -2,17 +2,603 @@ package orm
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
-func (db *DB) GetLogsOfTask(ctx context.Context, id string) ([]TaskLog, error) {
-	logs, err := gorm.G[TaskLog](db.dbGorm).Where(&TaskLog{
-		TaskID: id,
-	}).Find(ctx)
+// LogFilter restricts the logs returned by ListLogsOfTask. Nil fields are not
+// applied, the time range is inclusive and Search matches a substring of the
+// message, ignoring case.
+type LogFilter struct {
+	Level  *string
+	Issuer *string
+	Since  *time.Time
+	Until  *time.Time
+	Search *string
+}
+
+// likeEscaper escapes the wildcards of LIKE patterns.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// where returns the SQL condition selecting the logs of a task matching the
+// filter and its arguments.
+func (filter *LogFilter) where(taskID string) (query string, args []any) {
+	conditions := []string{"task_id = ?"}
+	args = []any{taskID}
+
+	add := func(condition string, value any) {
+		conditions = append(conditions, condition)
+		args = append(args, value)
+	}
+
+	if filter.Level != nil {
+		add("level = ?", *filter.Level)
+	}
+	if filter.Issuer != nil {
+		add("issuer = ?", *filter.Issuer)
+	}
+	if filter.Since != nil {
+		add(`"timestamp" >= ?`, *filter.Since)
+	}
+	if filter.Until != nil {
+		add(`"timestamp" <= ?`, *filter.Until)
+	}
+	if filter.Search != nil {
+		add("message ILIKE ?", "%"+likeEscaper.Replace(*filter.Search)+"%")
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+// ListLogsOfTask returns up to limit logs of a task matching the filter,
+// skipping offset logs. Logs are ordered by time, oldest first if ascending
+// is set and newest first otherwise. The total number of logs matching the
+// filter is returned as well.
+func (db *DB) ListLogsOfTask(
+	ctx context.Context,
+	id string,
+	filter *LogFilter,
+	ascending bool,
+	limit, offset int,
+) ([]TaskLog, int64, error) {
+	conditions, args := filter.where(id)
+	query := gorm.G[TaskLog](db.dbGorm).Where(conditions, args...)
+
+	total, err := query.Count(ctx, "*")
+	if err != nil {
+		return nil, 0, &DatabaseError{err}
+	}
+
+	order := `"timestamp" DESC, id DESC`
+	if ascending {
+		order = `"timestamp", id`
+	}
+
+	logs, err := query.Order(order).Limit(limit).Offset(offset).Find(ctx)
 	if err != nil {
-		return nil, &GenericError{Inner: err}
+		return nil, 0, &DatabaseError{err}
+	}
+
+	return logs, total, nil
+}
+
+// AppendLogsOfTask stores a batch of logs of a task. If the messages of all
+// logs of the task would exceed maxSize bytes, no log is stored and a
+// LimitExceededError is returned.
+func (db *DB) AppendLogsOfTask(
+	ctx context.Context,
+	id string,
+	logs []TaskLog,
+	maxSize int64,
+) error {
+	err := db.dbGorm.Transaction(func(tx *gorm.DB) error {
+		// Serialize appends to the same task, so that concurrent batches cannot
+		// exceed the limit together
+		err := tx.WithContext(ctx).
+			Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "task_logs:"+id).
+			Error
+		if err != nil {
+			return &DatabaseError{err}
+		}
+
+		var size int64
+		err = tx.WithContext(ctx).
+			Model(&TaskLog{}).
+			Select("COALESCE(SUM(octet_length(message)), 0)").
+			Where("task_id = ?", id).
+			Scan(&size).Error
+		if err != nil {
+			return &DatabaseError{err}
+		}
+
+		for i := range logs {
+			logs[i].TaskID = id
+			size += int64(len(logs[i].Message))
+		}
+
+		if size > maxSize {
+			return &LimitExceededError{"Logs of task " + id}
+		}
+
+		err = gorm.G[TaskLog](tx).CreateInBatches(ctx, &logs, len(logs))
+		if err != nil {
+			return &DatabaseError{err}
+		}
+
+		return nil
+	})
+	if err != nil {
+		var errLimit *LimitExceededError
+		if errors.As(err, &errLimit) {
+			return errLimit
+		}
+
+		return &GenericError{err}
+	}
+
+	return nil
+}
+
+// DeleteLogsBefore removes the logs written before the given time in batches of
+// batchSize logs. Logs of tasks whose record is not in one of the given final
+// states are kept. The number of removed logs is returned.
+func (db *DB) DeleteLogsBefore(
+	ctx context.Context,
+	before time.Time,
+	finalStates []string,
+	batchSize int,
+) (int, error) {
+	total := 0
+	for {
+		deleted, err := gorm.G[TaskLog](db.dbGorm).
+			Where(
+				`id IN (SELECT id FROM task_logs WHERE "timestamp" < ? AND `+
+					`task_id NOT IN (SELECT id FROM tasks WHERE state NOT IN ?) LIMIT ?)`,
+				before,
+				finalStates,
+				batchSize,
+			).
+			Delete(ctx)
+		if err != nil {
+			return total, &DatabaseError{err}
+		}
+
+		total += deleted
+		if deleted < batchSize {
+			return total, nil
+		}
+	}
+}
+
+// LogCursor points at a log entry. Logs are exported from oldest to newest,
+// so the following logs are the ones after it.
+type LogCursor struct {
+	Timestamp time.Time
+	ID        uuid.UUID
+}
+
+// GetLogsOfTaskAfter returns up to limit logs of a task, oldest first,
+// starting after the cursor (if given).
+func (db *DB) GetLogsOfTaskAfter(
+	ctx context.Context,
+	id string,
+	after *LogCursor,
+	limit int,
+) ([]TaskLog, error) {
+	query := gorm.G[TaskLog](db.dbGorm).Where("task_id = ?", id)
+	if after != nil {
+		query = query.Where(
+			`("timestamp", id) > (?, ?)`,
+			after.Timestamp,
+			after.ID,
+		)
+	}
+
+	logs, err := query.Order(`"timestamp", id`).Limit(limit).Find(ctx)
+	if err != nil {
+		return nil, &DatabaseError{err}
 	}
 
 	return logs, nil
 }
+
+// GetLogsOfTaskSince returns up to limit logs of a task in the order they were
+// stored, starting after the log with the sequence number afterSeq. Logs of a
+// task are stored one batch after the other, so logs following a returned one
+// cannot show up later.
+func (db *DB) GetLogsOfTaskSince(
+	ctx context.Context,
+	id string,
+	afterSeq int64,
+	limit int,
+) ([]TaskLog, error) {
+	logs, err := gorm.G[TaskLog](db.dbGorm).
+		Where("task_id = ? AND seq > ?", id, afterSeq).
+		Order("seq").
+		Limit(limit).
+		Find(ctx)
+	if err != nil {
+		return nil, &DatabaseError{err}
+	}
+
+	return logs, nil
+}
+
+// DeleteTaskData removes all records associated with a task, i.e. its history,
+// logs, callback delivery state, cancellation and retries.
+func (db *DB) DeleteTaskData(ctx context.Context, id string) error {
+	err := db.dbGorm.Transaction(func(tx *gorm.DB) error {
+		_, err := gorm.G[TaskLog](tx).Where("task_id = ?", id).Delete(ctx)
+		if err != nil {
+			return &DatabaseError{err}
+		}
+
+		_, err = gorm.G[TaskTransition](tx).Where("task_id = ?", id).Delete(ctx)
+		if err != nil {
+			return &DatabaseError{err}
+		}
+
+		_, err = gorm.G[Task](tx).Where("id = ?", id).Delete(ctx)
+		if err != nil {
+			return &DatabaseError{err}
+		}
+
+		_, err = gorm.G[CallbackDelivery](tx).Where("task_id = ?", id).Delete(ctx)
+		if err != nil {
+			return &DatabaseError{err}
+		}
+
+		_, err = gorm.G[Callback](tx).Where("task_id = ?", id).Delete(ctx)
+		if err != nil {
+			return &DatabaseError{err}
+		}
+
+		_, err = gorm.G[TaskCancellation](tx).Where("task_id = ?", id).Delete(ctx)
+		if err != nil {
+			return &DatabaseError{err}
+		}
+
+		_, err = gorm.G[TaskRetry](tx).Where("task_id = ?", id).Delete(ctx)
+		if err != nil {
+			return &DatabaseError{err}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return &GenericError{err}
+	}
+
+	return nil
+}
+
+// CreateTask records a newly enqueued task together with its initial state.
+func (db *DB) CreateTask(ctx context.Context, task *Task) error {
+	err := db.dbGorm.Transaction(func(tx *gorm.DB) error {
+		err := gorm.G[Task](tx).Create(ctx, task)
+		if err != nil {
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				return &ConflictError{"Task " + task.ID}
+			}
+
+			return &DatabaseError{err}
+		}
+
+		err = gorm.G[TaskTransition](tx).Create(ctx, &TaskTransition{
+			TaskID:  task.ID,
+			ToState: task.State,
+		})
+		if err != nil {
+			return &DatabaseError{err}
+		}
+
+		return nil
+	})
+	if err != nil {
+		var errConflict *ConflictError
+		if errors.As(err, &errConflict) {
+			return errConflict
+		}
+
+		return &GenericError{err}
+	}
+
+	return nil
+}
+
+// UpdateTask stores the current state of a task. If the state changed from
+// previousState, the transition is recorded as well.
+func (db *DB) UpdateTask(
+	ctx context.Context,
+	task *Task,
+	previousState string,
+) error {
+	err := db.dbGorm.Transaction(func(tx *gorm.DB) error {
+		err := tx.WithContext(ctx).Save(task).Error
+		if err != nil {
+			return &DatabaseError{err}
+		}
+
+		if task.State == previousState {
+			return nil
+		}
+
+		err = gorm.G[TaskTransition](tx).Create(ctx, &TaskTransition{
+			TaskID:    task.ID,
+			FromState: previousState,
+			ToState:   task.State,
+		})
+		if err != nil {
+			return &DatabaseError{err}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return &GenericError{err}
+	}
+
+	return nil
+}
+
+// SetTaskRequeuedAs links the record of a task to the copy it was replaced by.
+// An empty copyID removes the link.
+func (db *DB) SetTaskRequeuedAs(
+	ctx context.Context,
+	id, copyID string,
+) error {
+	_, err := gorm.G[Task](db.dbGorm).
+		Where("id = ?", id).
+		Update(ctx, "requeued_as", copyID)
+	if err != nil {
+		return &DatabaseError{err}
+	}
+
+	return nil
+}
+
+func (db *DB) GetTask(ctx context.Context, id string) (*Task, error) {
+	task, err := gorm.G[Task](db.dbGorm).Where("id = ?", id).First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &NotFoundError{"Task " + id}
+		}
+
+		return nil, &DatabaseError{err}
+	}
+
+	return &task, nil
+}
+
+// GetTasks returns the records of all tasks with one of the given ids.
+func (db *DB) GetTasks(ctx context.Context, ids []string) ([]Task, error) {
+	if len(ids) == 0 {
+		return []Task{}, nil
+	}
+
+	tasks, err := gorm.G[Task](db.dbGorm).Where("id IN ?", ids).Find(ctx)
+	if err != nil {
+		return nil, &DatabaseError{err}
+	}
+
+	return tasks, nil
+}
+
+// TaskFilter restricts the tasks returned by ListTasks. Nil fields are not
+// applied, time ranges are inclusive.
+type TaskFilter struct {
+	State           *string
+	Namespace       *string
+	Package         *string
+	Interface       *string
+	Function        *string
+	Tag             *string
+	VersionHash     *string
+	SubmittedBy     *string
+	GroupID         *string
+	CreatedAfter    *time.Time
+	CreatedBefore   *time.Time
+	CompletedAfter  *time.Time
+	CompletedBefore *time.Time
+
+	// Any of the given states, if not empty
+	States []string
+	// Whether the task was replaced by a copy with reset retries
+	Requeued *bool
+}
+
+// where returns the SQL condition selecting the tasks matching the filter and
+// its arguments.
+func (filter *TaskFilter) where() (query string, args []any) {
+	conditions := []string{"TRUE"}
+	args = []any{}
+
+	add := func(condition string, value any) {
+		conditions = append(conditions, condition)
+		args = append(args, value)
+	}
+
+	for _, equal := range []struct {
+		column string
+		value  *string
+	}{
+		{"state", filter.State},
+		{"namespace", filter.Namespace},
+		{"package", filter.Package},
+		{"interface", filter.Interface},
+		{"function", filter.Function},
+		{"tag", filter.Tag},
+		{"version_hash", filter.VersionHash},
+		{"submitted_by", filter.SubmittedBy},
+		{"group_id", filter.GroupID},
+	} {
+		if equal.value != nil {
+			add(equal.column+" = ?", *equal.value)
+		}
+	}
+
+	if len(filter.States) > 0 {
+		add("state IN ?", filter.States)
+	}
+	if filter.Requeued != nil {
+		add("(requeued_as <> '') = ?", *filter.Requeued)
+	}
+	if filter.CreatedAfter != nil {
+		add("created_at >= ?", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		add("created_at <= ?", *filter.CreatedBefore)
+	}
+	if filter.CompletedAfter != nil {
+		add("completed_at >= ?", *filter.CompletedAfter)
+	}
+	if filter.CompletedBefore != nil {
+		add("completed_at <= ?", *filter.CompletedBefore)
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+// TaskCursor points at the last task of a page. Tasks are ordered from newest
+// to oldest, so the next page starts right after it.
+type TaskCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// ListTasks returns up to limit tasks matching the filter, newest first,
+// starting after the cursor (if given) and skipping offset tasks. The total
+// number of tasks matching the filter is returned as well.
+func (db *DB) ListTasks(
+	ctx context.Context,
+	filter *TaskFilter,
+	after *TaskCursor,
+	limit, offset int,
+) ([]Task, int64, error) {
+	conditions, args := filter.where()
+	query := gorm.G[Task](db.dbGorm).Where(conditions, args...)
+
+	total, err := query.Count(ctx, "*")
+	if err != nil {
+		return nil, 0, &DatabaseError{err}
+	}
+
+	if after != nil {
+		query = query.Where("(created_at, id) < (?, ?)", after.CreatedAt, after.ID)
+	}
+
+	tasks, err := query.
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(ctx)
+	if err != nil {
+		return nil, 0, &DatabaseError{err}
+	}
+
+	return tasks, total, nil
+}
+
+// CountTaskStates returns the number of tasks matching the filter per state.
+func (db *DB) CountTaskStates(
+	ctx context.Context,
+	filter *TaskFilter,
+) (map[string]int, error) {
+	var rows []struct {
+		State string
+		Count int
+	}
+
+	conditions, args := filter.where()
+	err := db.dbGorm.WithContext(ctx).
+		Model(&Task{}).
+		Select("state, count(*) AS count").
+		Where(conditions, args...).
+		Group("state").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, &DatabaseError{err}
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.State] = row.Count
+	}
+
+	return counts, nil
+}
+
+// FindTaskStates returns up to limit tasks matching the filter, oldest first
+// and starting after the cursor (if given). Only the id, state, last error and
+// creation time of the tasks are loaded.
+func (db *DB) FindTaskStates(
+	ctx context.Context,
+	filter *TaskFilter,
+	after *TaskCursor,
+	limit int,
+) ([]Task, error) {
+	conditions, args := filter.where()
+	query := gorm.G[Task](db.dbGorm).
+		Select("id", "state", "last_error", "created_at").
+		Where(conditions, args...)
+
+	if after != nil {
+		query = query.Where("(created_at, id) > (?, ?)", after.CreatedAt, after.ID)
+	}
+
+	tasks, err := query.
+		Order("created_at, id").
+		Limit(limit).
+		Find(ctx)
+	if err != nil {
+		return nil, &DatabaseError{err}
+	}
+
+	return tasks, nil
+}
+
+// GetUnfinishedTasks returns up to limit tasks created before createdBefore
+// whose record is not in one of the given final states, ordered by id and
+// starting after the task with id afterID.
+func (db *DB) GetUnfinishedTasks(
+	ctx context.Context,
+	finalStates []string,
+	createdBefore time.Time,
+	afterID string,
+	limit int,
+) ([]Task, error) {
+	tasks, err := gorm.G[Task](db.dbGorm).
+		Where(
+			"state NOT IN ? AND created_at < ? AND id > ?",
+			finalStates,
+			createdBefore,
+			afterID,
+		).
+		Order("id").
+		Limit(limit).
+		Find(ctx)
+	if err != nil {
+		return nil, &DatabaseError{err}
+	}
+
+	return tasks, nil
+}
+
+func (db *DB) GetTransitionsOfTask(
+	ctx context.Context,
+	id string,
+) ([]TaskTransition, error) {
+	transitions, err := gorm.G[TaskTransition](db.dbGorm).
+		Where("task_id = ?", id).
+		Order("timestamp").
+		Find(ctx)
+	if err != nil {
+		return nil, &DatabaseError{err}
+	}
+
+	return transitions, nil
+}
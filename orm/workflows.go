@@ -0,0 +1,226 @@
+package orm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CreateWorkflow stores a workflow together with its nodes and edges. The id
+// of the workflow is assigned to the nodes and edges.
+func (db *DB) CreateWorkflow(
+	ctx context.Context,
+	workflow *Workflow,
+	nodes []WorkflowNode,
+	edges []WorkflowEdge,
+) error {
+	err := db.dbGorm.Transaction(func(tx *gorm.DB) error {
+		err := gorm.G[Workflow](tx).Create(ctx, workflow)
+		if err != nil {
+			return &DatabaseError{err}
+		}
+
+		for i := range nodes {
+			nodes[i].WorkflowID = workflow.ID
+		}
+
+		for i := range edges {
+			edges[i].WorkflowID = workflow.ID
+		}
+
+		err = gorm.G[WorkflowNode](tx).CreateInBatches(ctx, &nodes, len(nodes))
+		if err != nil {
+			return &DatabaseError{err}
+		}
+
+		if len(edges) == 0 {
+			return nil
+		}
+
+		err = gorm.G[WorkflowEdge](tx).CreateInBatches(ctx, &edges, len(edges))
+		if err != nil {
+			return &DatabaseError{err}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return &GenericError{err}
+	}
+
+	return nil
+}
+
+func (db *DB) GetWorkflow(
+	ctx context.Context,
+	id uuid.UUID,
+) (*Workflow, error) {
+	workflow, err := gorm.G[Workflow](db.dbGorm).Where("id = ?", id).First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &NotFoundError{"Workflow " + id.String()}
+		}
+
+		return nil, &DatabaseError{err}
+	}
+
+	return &workflow, nil
+}
+
+// ListWorkflows returns all workflows, newest first. If createdBy is set, only
+// the workflows of that user are returned.
+func (db *DB) ListWorkflows(
+	ctx context.Context,
+	createdBy *string,
+) ([]Workflow, error) {
+	query := gorm.G[Workflow](db.dbGorm).Order("created_at DESC, id")
+	if createdBy != nil {
+		query = query.Where("created_by = ?", *createdBy)
+	}
+
+	workflows, err := query.Find(ctx)
+	if err != nil {
+		return nil, &DatabaseError{err}
+	}
+
+	return workflows, nil
+}
+
+// GetRunningWorkflows returns up to limit workflows that did not finish yet,
+// ordered by id and starting after the workflow with id afterID.
+func (db *DB) GetRunningWorkflows(
+	ctx context.Context,
+	afterID uuid.UUID,
+	limit int,
+) ([]Workflow, error) {
+	workflows, err := gorm.G[Workflow](db.dbGorm).
+		Where("state = ? AND id > ?", WorkflowStateRunning, afterID).
+		Order("id").
+		Limit(limit).
+		Find(ctx)
+	if err != nil {
+		return nil, &DatabaseError{err}
+	}
+
+	return workflows, nil
+}
+
+// SetWorkflowState stores the state of a workflow.
+func (db *DB) SetWorkflowState(
+	ctx context.Context,
+	id uuid.UUID,
+	state string,
+) error {
+	_, err := gorm.G[Workflow](db.dbGorm).
+		Where("id = ?", id).
+		Update(ctx, "state", state)
+	if err != nil {
+		return &DatabaseError{err}
+	}
+
+	return nil
+}
+
+func (db *DB) GetWorkflowNodes(
+	ctx context.Context,
+	id uuid.UUID,
+) ([]WorkflowNode, error) {
+	nodes, err := gorm.G[WorkflowNode](db.dbGorm).
+		Where("workflow_id = ?", id).
+		Order("name").
+		Find(ctx)
+	if err != nil {
+		return nil, &DatabaseError{err}
+	}
+
+	return nodes, nil
+}
+
+// GetWorkflowNodeStates returns the nodes of a workflow ordered by name
+// without their task, which is only needed to start a node.
+func (db *DB) GetWorkflowNodeStates(
+	ctx context.Context,
+	id uuid.UUID,
+) ([]WorkflowNode, error) {
+	nodes, err := gorm.G[WorkflowNode](db.dbGorm).
+		Omit("task").
+		Where("workflow_id = ?", id).
+		Order("name").
+		Find(ctx)
+	if err != nil {
+		return nil, &DatabaseError{err}
+	}
+
+	return nodes, nil
+}
+
+// GetWorkflowNodeTask returns the serialized task of a node of a workflow.
+func (db *DB) GetWorkflowNodeTask(
+	ctx context.Context,
+	id uuid.UUID,
+	name string,
+) ([]byte, error) {
+	node, err := gorm.G[WorkflowNode](db.dbGorm).
+		Select("task").
+		Where("workflow_id = ? AND name = ?", id, name).
+		First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &NotFoundError{
+				"Node " + name + " of workflow " + id.String(),
+			}
+		}
+
+		return nil, &DatabaseError{err}
+	}
+
+	return node.Task, nil
+}
+
+// GetWorkflowEdges returns the edges of a workflow ordered by the node they
+// lead to and the position of the passed parameter.
+func (db *DB) GetWorkflowEdges(
+	ctx context.Context,
+	id uuid.UUID,
+) ([]WorkflowEdge, error) {
+	edges, err := gorm.G[WorkflowEdge](db.dbGorm).
+		Where("workflow_id = ?", id).
+		Order("to_node, position").
+		Find(ctx)
+	if err != nil {
+		return nil, &DatabaseError{err}
+	}
+
+	return edges, nil
+}
+
+// UpdateWorkflowNode stores the state, task, result and error of a node if
+// the node is still in previousState. It reports whether the node was
+// updated, so that concurrent updates by other instances are detected.
+func (db *DB) UpdateWorkflowNode(
+	ctx context.Context,
+	node *WorkflowNode,
+	previousState string,
+) (bool, error) {
+	result := db.dbGorm.WithContext(ctx).
+		Model(&WorkflowNode{}).
+		Where(
+			"workflow_id = ? AND name = ? AND state = ?",
+			node.WorkflowID,
+			node.Name,
+			previousState,
+		).
+		Updates(map[string]any{
+			"state":   node.State,
+			"task_id": node.TaskID,
+			"result":  node.Result,
+			"error":   node.Error,
+		})
+	if result.Error != nil {
+		return false, &DatabaseError{result.Error}
+	}
+
+	return result.RowsAffected > 0, nil
+}
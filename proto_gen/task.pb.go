@@ -914,6 +914,8 @@ type Task struct {
 	Parameters           []*Val                 `protobuf:"bytes,2,rep,name=parameters,proto3" json:"parameters,omitempty"`
 	Arguments            []string               `protobuf:"bytes,3,rep,name=arguments,proto3" json:"arguments,omitempty"`
 	EnvironmentVariables []*EnvironmentVariable `protobuf:"bytes,4,rep,name=environment_variables,json=environmentVariables,proto3" json:"environment_variables,omitempty"`
+	Callback             string                 `protobuf:"bytes,5,opt,name=callback,proto3" json:"callback,omitempty"` // URL notified when the task completes or is archived
+	Tag                  string                 `protobuf:"bytes,6,opt,name=tag,proto3" json:"tag,omitempty"`           // Tag the artifact was referenced by before it was pinned to its version hash
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -976,6 +978,20 @@ func (x *Task) GetEnvironmentVariables() []*EnvironmentVariable {
 	return nil
 }
 
+func (x *Task) GetCallback() string {
+	if x != nil {
+		return x.Callback
+	}
+	return ""
+}
+
+func (x *Task) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
 var File_task_proto protoreflect.FileDescriptor
 
 const file_task_proto_rawDesc = "" +
@@ -1037,14 +1053,16 @@ const file_task_proto_rawDesc = "" +
 	"\x05flags\x18\x01 \x03(\tR\x05flags\"=\n" +
 	"\x13EnvironmentVariable\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value\"\xd5\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\x83\x02\n" +
 	"\x04Task\x124\n" +
 	"\bfunction\x18\x01 \x01(\v2\x18.task.FunctionIdentifierR\bfunction\x12)\n" +
 	"\n" +
 	"parameters\x18\x02 \x03(\v2\t.task.ValR\n" +
 	"parameters\x12\x1c\n" +
 	"\targuments\x18\x03 \x03(\tR\targuments\x12N\n" +
-	"\x15environment_variables\x18\x04 \x03(\v2\x19.task.EnvironmentVariableR\x14environmentVariablesB\fZ\n" +
+	"\x15environment_variables\x18\x04 \x03(\v2\x19.task.EnvironmentVariableR\x14environmentVariables\x12\x1a\n" +
+	"\bcallback\x18\x05 \x01(\tR\bcallback\x12\x10\n" +
+	"\x03tag\x18\x06 \x01(\tR\x03tagB\fZ\n" +
 	"proto_gen/b\x06proto3"
 
 var (
@@ -14,6 +14,20 @@ func (e *TaskNotFoundError) Is(target error) bool {
 	return ok
 }
 
+type GroupNotFoundError struct {
+	Id string
+}
+
+func (e *GroupNotFoundError) Error() string {
+	return "Task group not found: " + e.Id
+}
+
+func (e *GroupNotFoundError) Is(target error) bool {
+	_, ok := target.(*GroupNotFoundError)
+
+	return ok
+}
+
 type GenericError struct {
 	Inner error
 }
@@ -25,3 +39,32 @@ func (e *GenericError) Error() string {
 func (e *GenericError) Unwrap() error {
 	return e.Inner
 }
+
+type TaskStateConflictError struct {
+	Id    string
+	State string
+}
+
+func (e *TaskStateConflictError) Error() string {
+	return "Task " + e.Id + " cannot be modified in state " + e.State
+}
+
+func (e *TaskStateConflictError) Is(target error) bool {
+	_, ok := target.(*TaskStateConflictError)
+
+	return ok
+}
+
+type QueueNotFoundError struct {
+	Name string
+}
+
+func (e *QueueNotFoundError) Error() string {
+	return "Queue not found: " + e.Name
+}
+
+func (e *QueueNotFoundError) Is(target error) bool {
+	_, ok := target.(*QueueNotFoundError)
+
+	return ok
+}
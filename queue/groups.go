@@ -0,0 +1,215 @@
+package queue
+
+import (
+	"api-server/orm"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+// States of tasks that are still going to be processed
+var unfinishedStates = []string{
+	asynq.TaskStateActive.String(),
+	asynq.TaskStatePending.String(),
+	asynq.TaskStateScheduled.String(),
+	asynq.TaskStateRetry.String(),
+	asynq.TaskStateAggregating.String(),
+}
+
+// GroupMember is the current state of a task of a group.
+type GroupMember struct {
+	ID        string
+	State     string
+	LastError string
+}
+
+// GroupStatus aggregates the states of the tasks of a group.
+type GroupStatus struct {
+	Total    int
+	Finished int
+	// Number of tasks per state
+	States map[string]int
+	// A page of the tasks that reached a final state other than completed, e.g.
+	// archived after failing or being canceled
+	Failed []GroupMember
+	// Position after the last failed task, set if there may be more
+	NextFailed *orm.TaskCursor
+}
+
+// Progress returns the share of tasks of the group that reached a final state.
+func (status *GroupStatus) Progress() float64 {
+	if status.Total == 0 {
+		return 0
+	}
+
+	return float64(status.Finished) / float64(status.Total)
+}
+
+// GroupStatus returns the aggregate status of all tasks of a group together
+// with up to limit of its failed tasks, starting after the cursor (if given).
+// If submittedBy is set, only the tasks of that user are taken into account.
+func (q *QueueClient) GroupStatus(
+	ctx context.Context,
+	group string,
+	submittedBy *string,
+	after *orm.TaskCursor,
+	limit int,
+) (*GroupStatus, error) {
+	filter := groupFilter(group, submittedBy)
+
+	states, err := q.db.CountTaskStates(ctx, &filter)
+	if err != nil {
+		return nil, &GenericError{err}
+	}
+
+	if len(states) == 0 {
+		return nil, &GroupNotFoundError{Id: group}
+	}
+
+	status := aggregateGroup(states)
+
+	filter.States = failedStates()
+	failed, err := q.db.FindTaskStates(ctx, &filter, after, limit)
+	if err != nil {
+		return nil, &GenericError{err}
+	}
+
+	status.Failed = make([]GroupMember, len(failed))
+	for i := range failed {
+		status.Failed[i] = GroupMember{
+			ID:        failed[i].ID,
+			State:     failed[i].State,
+			LastError: failed[i].LastError,
+		}
+	}
+
+	if len(failed) > 0 && len(failed) == limit {
+		last := failed[len(failed)-1]
+		status.NextFailed = &orm.TaskCursor{
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		}
+	}
+
+	return status, nil
+}
+
+// CancelGroup cancels all tasks of a group that did not reach a final state
+// yet and returns their ids. If submittedBy is set, only the tasks of that user
+// are canceled. Tasks finishing during the cancellation are skipped. Active
+// tasks are signaled first and then waited for together, like CancelTask
+// waits for a single one.
+func (q *QueueClient) CancelGroup(
+	ctx context.Context,
+	group string,
+	submittedBy *string,
+) ([]string, error) {
+	filter := groupFilter(group, submittedBy)
+
+	states, err := q.db.CountTaskStates(ctx, &filter)
+	if err != nil {
+		return nil, &GenericError{err}
+	}
+
+	if len(states) == 0 {
+		return nil, &GroupNotFoundError{Id: group}
+	}
+
+	canceled := []string{}
+	active := []*asynq.TaskInfo{}
+
+	// Canceled tasks leave the filter, the cursor keeps the position anyway
+	filter.States = unfinishedStates
+	var after *orm.TaskCursor
+	for {
+		members, err := q.db.FindTaskStates(ctx, &filter, after, listPageSize)
+		if err != nil {
+			return canceled, &GenericError{err}
+		}
+
+		for _, member := range members {
+			taskInfo, err := q.GetTask(member.ID)
+			if err == nil {
+				err = q.cancel(ctx, taskInfo)
+			}
+			if err != nil {
+				if errors.Is(err, &TaskNotFoundError{}) ||
+					errors.Is(err, &TaskStateConflictError{}) {
+					continue
+				}
+
+				return canceled, err
+			}
+
+			canceled = append(canceled, member.ID)
+			if taskInfo.State == asynq.TaskStateActive {
+				active = append(active, taskInfo)
+			} else {
+				_, _ = q.refreshRecord(ctx, taskInfo.Queue, taskInfo.ID)
+			}
+		}
+
+		if len(members) < listPageSize {
+			break
+		}
+
+		last := members[len(members)-1]
+		after = &orm.TaskCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	deadline := time.Now().Add(cancelSettleTimeout)
+	for _, taskInfo := range active {
+		_, err := q.settleCanceled(ctx, taskInfo, deadline)
+		if err != nil && !errors.Is(err, &TaskNotFoundError{}) {
+			return canceled, err
+		}
+	}
+
+	return canceled, nil
+}
+
+// groupFilter selects the tasks of a group as recorded in the task history.
+// The records are kept in sync with the queue by the HistorySyncer and hold
+// the reported state of their tasks. Tasks replaced by a copy are left out,
+// their copy is a member instead.
+func groupFilter(group string, submittedBy *string) orm.TaskFilter {
+	requeued := false
+
+	return orm.TaskFilter{
+		GroupID:     &group,
+		SubmittedBy: submittedBy,
+		Requeued:    &requeued,
+	}
+}
+
+// failedStates returns the final states other than completed.
+func failedStates() []string {
+	failed := make([]string, 0, len(finalStates))
+	for _, state := range finalStates {
+		if state != asynq.TaskStateCompleted.String() {
+			failed = append(failed, state)
+		}
+	}
+
+	return failed
+}
+
+// aggregateGroup aggregates the number of tasks of a group per state. The
+// failed tasks are left to the caller.
+func aggregateGroup(states map[string]int) *GroupStatus {
+	status := &GroupStatus{
+		States: states,
+		Failed: []GroupMember{},
+	}
+
+	for state, count := range states {
+		status.Total += count
+		if IsFinalState(state) {
+			status.Finished += count
+		}
+	}
+
+	return status
+}
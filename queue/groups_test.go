@@ -0,0 +1,45 @@
+package queue
+
+import (
+	"api-server/orm"
+	"testing"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregateGroup(t *testing.T) {
+	t.Parallel()
+	status := aggregateGroup(map[string]int{
+		asynq.TaskStateActive.String():    1,
+		asynq.TaskStateCompleted.String(): 2,
+		asynq.TaskStateArchived.String():  1,
+		orm.TaskStateExpired:              1,
+		orm.TaskStateFailed:               1,
+	})
+
+	assert.Equal(t, 6, status.Total)
+	assert.Equal(t, 5, status.Finished)
+	assert.InDelta(t, 5.0/6, status.Progress(), 0.0001)
+	assert.Equal(t, 1, status.States[asynq.TaskStateActive.String()])
+	assert.Equal(t, 1, status.States[orm.TaskStateFailed])
+	assert.Empty(t, status.Failed, "failed tasks are loaded separately")
+}
+
+func TestGroupStatusProgressEmpty(t *testing.T) {
+	t.Parallel()
+	status := aggregateGroup(map[string]int{})
+
+	assert.Zero(t, status.Progress())
+	assert.Empty(t, status.Failed)
+}
+
+func TestFailedStates(t *testing.T) {
+	t.Parallel()
+
+	assert.ElementsMatch(t, []string{
+		asynq.TaskStateArchived.String(),
+		orm.TaskStateExpired,
+		orm.TaskStateFailed,
+	}, failedStates())
+}
@@ -0,0 +1,421 @@
+package queue
+
+import (
+	"api-server/config"
+	"api-server/orm"
+	pb "api-server/proto_gen"
+	"api-server/utils"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+)
+
+// States in which the record of a task is no longer updated if the task
+// disappears from the queue
+var finalStates = []string{
+	asynq.TaskStateCompleted.String(),
+	asynq.TaskStateArchived.String(),
+	orm.TaskStateExpired,
+	orm.TaskStateFailed,
+}
+
+// IsFinalState reports whether a task in the given state is not processed
+// anymore.
+func IsFinalState(state string) bool {
+	return slices.Contains(finalStates, state)
+}
+
+// HistorySyncer mirrors the state of all tasks in the queue into their durable
+// records, so that tasks can still be served after the queue dropped them.
+// The queue is scanned a configurable number of pages per sync, so a full pass
+// may take several syncs. Scanned tasks are recorded if they are not yet (e.g.
+// because recording failed on enqueue) and their records are updated. After
+// each full pass, the unfinished records of tasks that were not seen are looked
+// up one by one and marked as expired once they vanished from the queue.
+// Changes made through the API update the records right away.
+type HistorySyncer struct {
+	db           *orm.DB
+	queueClient  QueueClient
+	syncInterval time.Duration
+	// Maximum number of task pages scanned per sync
+	scanPages int
+	// Position of the scan
+	cursor scanCursor
+	// Start of the current scan pass and the ids of the tasks seen during it
+	passStart time.Time
+	seen      map[string]struct{}
+}
+
+func NewHistorySyncer(
+	cfg *config.AppConfig,
+	db *orm.DB,
+	queueClient QueueClient,
+) *HistorySyncer {
+	syncInterval, err := time.ParseDuration(cfg.History.SyncInterval)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Failed to parse task history sync interval (invalid format)")
+	}
+
+	return &HistorySyncer{
+		db:           db,
+		queueClient:  queueClient,
+		syncInterval: syncInterval,
+		scanPages:    cfg.History.ScanPages,
+		passStart:    time.Now(),
+		seen:         map[string]struct{}{},
+	}
+}
+
+// Run synchronizes the task history until the context is canceled.
+func (h *HistorySyncer) Run(ctx context.Context) {
+	ticker := time.NewTicker(h.syncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			h.settleCancellations(ctx)
+			h.scan(ctx)
+		}
+	}
+}
+
+// settleCancellations archives canceled tasks that were still active when
+// their cancellation returned and stopped meanwhile.
+func (h *HistorySyncer) settleCancellations(ctx context.Context) {
+	cancellations, err := h.db.GetTaskCancellations(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load canceled tasks")
+
+		return
+	}
+
+	for _, cancellation := range cancellations {
+		task, err := h.queueClient.GetTaskInQueue(
+			cancellation.Queue,
+			cancellation.TaskID,
+		)
+		if errors.Is(err, &TaskNotFoundError{}) {
+			err = h.db.DeleteTaskCancellation(ctx, cancellation.TaskID)
+		} else if err == nil {
+			_, err = h.queueClient.ArchiveCanceled(ctx, task)
+		}
+
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("id", cancellation.TaskID).
+				Msg("Failed to archive canceled task")
+		}
+	}
+}
+
+// reconcile updates the records of unfinished tasks that were not seen during
+// the last scan pass and marks those that are no longer in the queue as
+// expired. Tasks recorded during the pass are left to the next one, as the
+// pass may have scanned their state before they were enqueued.
+func (h *HistorySyncer) reconcile(ctx context.Context) {
+	afterID := ""
+	for {
+		records, err := h.db.GetUnfinishedTasks(
+			ctx,
+			finalStates,
+			h.passStart,
+			afterID,
+			listPageSize,
+		)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to load unfinished task records")
+
+			return
+		}
+
+		for i := range records {
+			if _, ok := h.seen[records[i].ID]; ok {
+				continue
+			}
+
+			h.refresh(ctx, &records[i])
+		}
+
+		if len(records) < listPageSize {
+			return
+		}
+
+		afterID = records[len(records)-1].ID
+	}
+}
+
+// refresh looks up the task of a single record and updates the record, or
+// marks it as expired if the task is no longer in the queue.
+func (h *HistorySyncer) refresh(ctx context.Context, record *orm.Task) {
+	previousState := record.State
+
+	task, err := h.queueClient.GetTaskInQueue(record.Queue, record.ID)
+	switch {
+	case err == nil:
+		if !updateTaskRecord(record, task) {
+			return
+		}
+	case errors.Is(err, &TaskNotFoundError{}):
+		record.State = orm.TaskStateExpired
+		record.NextProcessAt = nil
+	default:
+		// The task may still be in the queue, try again after the next pass
+		log.Error().
+			Err(err).
+			Str("id", record.ID).
+			Msg("Failed to look up task for history")
+
+		return
+	}
+
+	err = h.db.UpdateTask(ctx, record, previousState)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", record.ID).
+			Msg("Failed to update task record")
+	}
+}
+
+// scan syncs the next scanPages pages of tasks in the queue,
+// continuing where the previous scan stopped. Once a pass over the whole queue
+// is complete, the records of tasks not seen during it are reconciled.
+func (h *HistorySyncer) scan(ctx context.Context) {
+	queues := h.queueClient.Queues()
+
+	for range h.scanPages {
+		if h.cursor.clamp(len(queues)) {
+			h.reconcile(ctx)
+			h.passStart = time.Now()
+			h.seen = map[string]struct{}{}
+		}
+
+		state := listableStates[h.cursor.state]
+
+		tasks, err := h.queueClient.TaskPage(
+			queues[h.cursor.queue],
+			state,
+			h.cursor.page,
+		)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to sync task history")
+
+			return
+		}
+
+		for _, task := range tasks {
+			h.seen[task.ID] = struct{}{}
+		}
+
+		err = h.syncPage(ctx, tasks)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to sync task history")
+
+			return
+		}
+
+		h.cursor.advance(len(tasks) == listPageSize)
+	}
+}
+
+// scanCursor is the position of the HistorySyncer in the queue, iterating the
+// pages of all listable states of all queues.
+type scanCursor struct {
+	queue int
+	state int
+	page  int
+}
+
+// advance moves the cursor to the next page, or to the first page of the next
+// state if the current page was the last one.
+func (c *scanCursor) advance(fullPage bool) {
+	if fullPage {
+		c.page++
+
+		return
+	}
+
+	c.page = 1
+	c.state++
+	if c.state == len(listableStates) {
+		c.state = 0
+		c.queue++
+	}
+}
+
+// clamp restarts the cursor once it passed the last of the given number of
+// queues and reports whether it did, i.e. whether a pass is complete.
+func (c *scanCursor) clamp(queues int) bool {
+	if c.page < 1 {
+		c.page = 1
+	}
+
+	if c.queue >= queues {
+		*c = scanCursor{page: 1}
+
+		return true
+	}
+
+	return false
+}
+
+// syncPage creates or updates the records of a page of tasks in the queue.
+func (h *HistorySyncer) syncPage(
+	ctx context.Context,
+	tasks []*asynq.TaskInfo,
+) error {
+	ids := make([]string, len(tasks))
+	for i, task := range tasks {
+		ids[i] = task.ID
+	}
+
+	records, err := h.db.GetTasks(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to load task records: %w", err)
+	}
+
+	recordsByID := make(map[string]*orm.Task, len(records))
+	for i := range records {
+		recordsByID[records[i].ID] = &records[i]
+	}
+
+	for _, task := range tasks {
+		record, ok := recordsByID[task.ID]
+		if !ok {
+			h.create(ctx, task)
+
+			continue
+		}
+
+		previousState := record.State
+		if !updateTaskRecord(record, task) {
+			continue
+		}
+
+		err := h.db.UpdateTask(ctx, record, previousState)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("id", task.ID).
+				Msg("Failed to update task record")
+		}
+	}
+
+	return nil
+}
+
+func (h *HistorySyncer) create(ctx context.Context, task *asynq.TaskInfo) {
+	var payload pb.Task
+	err := proto.Unmarshal(task.Payload, &payload)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", task.ID).
+			Msg("Failed to unmarshal task for history")
+
+		return
+	}
+
+	record := newTaskRecord(task, &payload)
+
+	err = h.db.CreateTask(ctx, &record)
+	if err != nil {
+		log.Error().Err(err).Str("id", task.ID).Msg("Failed to record task")
+	}
+}
+
+// newTaskRecord creates the durable record of a task.
+func newTaskRecord(task *asynq.TaskInfo, payload *pb.Task) orm.Task {
+	metadata := MetadataOf(task)
+	record := orm.Task{
+		ID:           task.ID,
+		Queue:        task.Queue,
+		Payload:      task.Payload,
+		MaxRetry:     task.MaxRetry,
+		Retention:    task.Retention.String(),
+		Deadline:     utils.TimeOrNil(task.Deadline),
+		SubmittedBy:  metadata.SubmittedBy,
+		BatchID:      metadata.BatchID,
+		GroupID:      metadata.GroupID,
+		RequeuedFrom: metadata.RequeuedFrom,
+	}
+	if task.Timeout > 0 {
+		record.Timeout = task.Timeout.String()
+	}
+
+	if payload.Function != nil {
+		record.Interface = payload.Function.Interface
+		record.Function = payload.Function.Name
+
+		artifact := payload.Function.Artifact
+		if artifact != nil && artifact.Package != nil {
+			record.Namespace = artifact.Package.Namespace
+			record.Package = artifact.Package.Name
+		}
+
+		switch identifier := artifact.GetIdentifier().(type) {
+		case *pb.ArtifactIdentifier_Tag:
+			record.Tag = identifier.Tag
+		case *pb.ArtifactIdentifier_VersionHash:
+			// Pinned artifacts keep the tag they were submitted with
+			record.Tag = payload.Tag
+			record.VersionHash = identifier.VersionHash
+		}
+	}
+
+	updateTaskRecord(&record, task)
+
+	return record
+}
+
+// updateTaskRecord copies the current state of a task into its record and
+// reports whether anything changed.
+func updateTaskRecord(record *orm.Task, task *asynq.TaskInfo) bool {
+	// The queue reports the current time as next process time of pending
+	// tasks, only a planned process time is worth recording.
+	var nextProcessAt time.Time
+	if task.State == asynq.TaskStateScheduled ||
+		task.State == asynq.TaskStateRetry {
+		nextProcessAt = task.NextProcessAt
+	}
+
+	state := ReportedState(task.State.String(), task.Result)
+	changed := record.State != state ||
+		record.Retried != task.Retried ||
+		record.LastError != task.LastErr ||
+		!equalTime(record.NextProcessAt, nextProcessAt) ||
+		!equalTime(record.CompletedAt, task.CompletedAt) ||
+		(record.Result == nil && task.Result != nil)
+
+	record.State = state
+	record.Retried = task.Retried
+	record.LastError = task.LastErr
+	record.LastFailedAt = utils.TimeOrNil(task.LastFailedAt)
+	record.NextProcessAt = utils.TimeOrNil(nextProcessAt)
+	record.CompletedAt = utils.TimeOrNil(task.CompletedAt)
+	if task.Result != nil {
+		record.Result = task.Result
+	}
+
+	return changed
+}
+
+func equalTime(recorded *time.Time, t time.Time) bool {
+	if recorded == nil {
+		return t.IsZero()
+	}
+
+	return recorded.Equal(t)
+}
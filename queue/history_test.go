@@ -0,0 +1,141 @@
+package queue
+
+import (
+	pb "api-server/proto_gen"
+	"testing"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTaskID = "task-1"
+
+func TestNewTaskRecord(t *testing.T) {
+	t.Parallel()
+	payload := &pb.Task{
+		Function: &pb.FunctionIdentifier{
+			Artifact: &pb.ArtifactIdentifier{
+				Package:    &pb.PackageName{Namespace: "acme", Name: "billing"},
+				Identifier: &pb.ArtifactIdentifier_Tag{Tag: "v2"},
+			},
+			Interface: "api",
+			Name:      "run",
+		},
+	}
+	processAt := time.Date(2025, 3, 10, 2, 0, 0, 0, time.UTC)
+
+	record := newTaskRecord(&asynq.TaskInfo{
+		ID:            testTaskID,
+		Queue:         TaskQueueDefault,
+		State:         asynq.TaskStateScheduled,
+		MaxRetry:      3,
+		Retention:     time.Hour,
+		Timeout:       10 * time.Minute,
+		NextProcessAt: processAt,
+		Headers:       map[string]string{HeaderSubmittedBy: "alice"},
+	}, payload)
+
+	assert.Equal(t, testTaskID, record.ID)
+	assert.Equal(t, "acme", record.Namespace)
+	assert.Equal(t, "billing", record.Package)
+	assert.Equal(t, "api", record.Interface)
+	assert.Equal(t, "run", record.Function)
+	assert.Equal(t, "v2", record.Tag)
+	assert.Empty(t, record.VersionHash)
+	assert.Equal(t, "alice", record.SubmittedBy)
+	assert.Equal(t, "scheduled", record.State)
+	assert.Equal(t, "1h0m0s", record.Retention)
+	assert.Equal(t, "10m0s", record.Timeout)
+	assert.Nil(t, record.Deadline)
+	require.NotNil(t, record.NextProcessAt)
+	assert.True(t, processAt.Equal(*record.NextProcessAt))
+	assert.Nil(t, record.CompletedAt)
+}
+
+func TestUpdateTaskRecord(t *testing.T) {
+	t.Parallel()
+	record := newTaskRecord(&asynq.TaskInfo{
+		ID:    testTaskID,
+		State: asynq.TaskStatePending,
+	}, &pb.Task{})
+
+	assert.False(
+		t,
+		updateTaskRecord(&record, &asynq.TaskInfo{
+			ID:            testTaskID,
+			State:         asynq.TaskStatePending,
+			NextProcessAt: time.Now(),
+		}),
+		"the next process time of pending tasks must be ignored",
+	)
+	assert.Nil(t, record.NextProcessAt)
+
+	completedAt := time.Date(2025, 3, 10, 2, 0, 0, 0, time.UTC)
+	assert.True(t, updateTaskRecord(&record, &asynq.TaskInfo{
+		ID:          testTaskID,
+		State:       asynq.TaskStateCompleted,
+		CompletedAt: completedAt,
+		Result:      []byte("done"),
+	}))
+	assert.Equal(t, "completed", record.State)
+	assert.Equal(t, []byte("done"), record.Result)
+	require.NotNil(t, record.CompletedAt)
+	assert.True(t, completedAt.Equal(*record.CompletedAt))
+
+	assert.False(t, updateTaskRecord(&record, &asynq.TaskInfo{
+		ID:          testTaskID,
+		State:       asynq.TaskStateCompleted,
+		CompletedAt: completedAt,
+		Result:      []byte("done"),
+	}))
+}
+
+func TestNewTaskRecordPinned(t *testing.T) {
+	t.Parallel()
+	payload := &pb.Task{
+		Function: &pb.FunctionIdentifier{
+			Artifact: &pb.ArtifactIdentifier{
+				Package: &pb.PackageName{Namespace: "acme", Name: "billing"},
+				Identifier: &pb.ArtifactIdentifier_VersionHash{
+					VersionHash: "abc123",
+				},
+			},
+		},
+		Tag: "v2",
+	}
+
+	record := newTaskRecord(&asynq.TaskInfo{
+		ID:    testTaskID,
+		State: asynq.TaskStatePending,
+	}, payload)
+
+	assert.Equal(t, "v2", record.Tag)
+	assert.Equal(t, "abc123", record.VersionHash)
+}
+
+func TestScanCursor(t *testing.T) {
+	t.Parallel()
+	var cursor scanCursor
+
+	assert.False(t, cursor.clamp(2))
+	assert.Equal(t, scanCursor{page: 1}, cursor)
+
+	cursor.advance(true)
+	assert.Equal(t, scanCursor{page: 2}, cursor)
+
+	cursor.advance(false)
+	assert.Equal(t, scanCursor{state: 1, page: 1}, cursor)
+
+	for range len(listableStates) - 1 {
+		cursor.advance(false)
+	}
+	assert.Equal(t, scanCursor{queue: 1, page: 1}, cursor)
+
+	for range listableStates {
+		cursor.advance(false)
+	}
+	assert.True(t, cursor.clamp(2), "a pass over all queues is complete")
+	assert.Equal(t, scanCursor{page: 1}, cursor)
+}
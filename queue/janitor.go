@@ -0,0 +1,79 @@
+package queue
+
+import (
+	"api-server/config"
+	"api-server/orm"
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Maximum number of logs deleted at once
+const logCleanupBatchSize = 10000
+
+// LogJanitor deletes the logs of finished tasks once they are older than the
+// log retention. Logs of tasks that are still processed are kept.
+type LogJanitor struct {
+	db              *orm.DB
+	retention       time.Duration
+	cleanupInterval time.Duration
+}
+
+func NewLogJanitor(cfg *config.AppConfig, db *orm.DB) *LogJanitor {
+	retention := cfg.Logs.Retention
+	if retention == "" {
+		retention = cfg.Retry.Retention
+	}
+
+	retentionDuration, err := time.ParseDuration(retention)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Failed to parse log retention (invalid format)")
+	}
+
+	cleanupInterval, err := time.ParseDuration(cfg.Logs.CleanupInterval)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Failed to parse log cleanup interval (invalid format)")
+	}
+
+	return &LogJanitor{
+		db:              db,
+		retention:       retentionDuration,
+		cleanupInterval: cleanupInterval,
+	}
+}
+
+// Run deletes expired logs until the context is canceled.
+func (j *LogJanitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			j.cleanup(ctx)
+		}
+	}
+}
+
+func (j *LogJanitor) cleanup(ctx context.Context) {
+	deleted, err := j.db.DeleteLogsBefore(
+		ctx,
+		time.Now().Add(-j.retention),
+		finalStates,
+		logCleanupBatchSize,
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to delete expired task logs")
+	}
+
+	if deleted > 0 {
+		log.Info().Int("deleted", deleted).Msg("Deleted expired task logs")
+	}
+}
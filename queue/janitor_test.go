@@ -0,0 +1,24 @@
+package queue
+
+import (
+	"api-server/config"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLogJanitorRetention(t *testing.T) {
+	t.Parallel()
+	cfg := &config.AppConfig{}
+	cfg.Retry.Retention = "24h"
+	cfg.Logs.CleanupInterval = "1h"
+
+	janitor := NewLogJanitor(cfg, nil)
+	assert.Equal(t, 24*time.Hour, janitor.retention, "tied to task retention")
+	assert.Equal(t, time.Hour, janitor.cleanupInterval)
+
+	cfg.Logs.Retention = "168h"
+	janitor = NewLogJanitor(cfg, nil)
+	assert.Equal(t, 168*time.Hour, janitor.retention)
+}
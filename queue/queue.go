@@ -4,22 +4,62 @@ import (
 	"api-server/config"
 	"api-server/orm"
 	pb "api-server/proto_gen"
+	"cmp"
+	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
 	"google.golang.org/protobuf/proto"
 )
 
 const (
 	TaskTypeNormal   = "job:normal"
 	TaskQueueDefault = "default"
+	// Task header holding the name of the user that submitted the task
+	HeaderSubmittedBy = "submitted-by"
+	// Task header holding the id of the batch the task was submitted with
+	HeaderBatchID = "batch-id"
+	// Task header holding the id of the group the task was submitted to
+	HeaderGroupID = "group-id"
+	// Task header holding the id of the task a requeued task replaces
+	HeaderRequeuedFrom = "requeued-from"
+	// Number of tasks enqueued concurrently by EnqueueTasks
+	enqueueConcurrency = 16
+	// Page size used when iterating over all tasks of a state
+	listPageSize = 100
+	// Delay of the first delivery attempt of a callback registered before its
+	// task is enqueued, outlasting the enqueue request
+	callbackRegistrationDelay = time.Minute
+	// Time a canceled active task is waited for to stop
+	cancelSettleTimeout = 5 * time.Second
+	// Interval in which a canceled active task is checked while waiting
+	cancelPollInterval = 100 * time.Millisecond
 )
 
+// States whose tasks are listed when iterating over the queue. Aggregating
+// tasks can only be listed per group and are not used.
+var listableStates = []asynq.TaskState{
+	asynq.TaskStateActive,
+	asynq.TaskStatePending,
+	asynq.TaskStateScheduled,
+	asynq.TaskStateRetry,
+	asynq.TaskStateArchived,
+	asynq.TaskStateCompleted,
+}
+
 type QueueClient struct {
 	client    *asynq.Client
 	inspector *asynq.Inspector
 	db        *orm.DB
+	// Names of the configured queues, highest priority first
+	queues []string
 }
 
 func NewQueueClient(cfg *config.AppConfig, db *orm.DB) QueueClient {
@@ -28,15 +68,90 @@ func NewQueueClient(cfg *config.AppConfig, db *orm.DB) QueueClient {
 		DB:   cfg.Redis.DB,
 	}
 
+	if _, ok := cfg.Queues[TaskQueueDefault]; !ok {
+		log.Fatal().
+			Str("queue", TaskQueueDefault).
+			Msg("Default queue is missing in the queue configuration")
+	}
+
 	return QueueClient{
 		client:    asynq.NewClient(redisOpt),
 		inspector: asynq.NewInspector(redisOpt),
 		db:        db,
+		queues:    queuesByPriority(cfg.Queues),
+	}
+}
+
+// Queues returns the names of all configured queues, highest priority first.
+func (q *QueueClient) Queues() []string {
+	return slices.Clone(q.queues)
+}
+
+// HasQueue reports whether a queue with the given name is configured.
+func (q *QueueClient) HasQueue(name string) bool {
+	return slices.Contains(q.queues, name)
+}
+
+// TaskMetadata describes the origin of a task. It is stored in the task
+// headers, so that it is available for tasks recorded by the HistorySyncer as
+// well.
+type TaskMetadata struct {
+	SubmittedBy  string
+	BatchID      string
+	GroupID      string
+	RequeuedFrom string
+}
+
+// MetadataOf returns the metadata a task was enqueued with.
+func MetadataOf(task *asynq.TaskInfo) TaskMetadata {
+	return TaskMetadata{
+		SubmittedBy:  task.Headers[HeaderSubmittedBy],
+		BatchID:      task.Headers[HeaderBatchID],
+		GroupID:      task.Headers[HeaderGroupID],
+		RequeuedFrom: task.Headers[HeaderRequeuedFrom],
+	}
+}
+
+func (metadata TaskMetadata) headers() map[string]string {
+	headers := map[string]string{}
+	if metadata.SubmittedBy != "" {
+		headers[HeaderSubmittedBy] = metadata.SubmittedBy
+	}
+	if metadata.BatchID != "" {
+		headers[HeaderBatchID] = metadata.BatchID
+	}
+	if metadata.GroupID != "" {
+		headers[HeaderGroupID] = metadata.GroupID
+	}
+	if metadata.RequeuedFrom != "" {
+		headers[HeaderRequeuedFrom] = metadata.RequeuedFrom
 	}
+
+	return headers
+}
+
+// EnqueueRequest is a single task to enqueue with EnqueueTasks.
+type EnqueueRequest struct {
+	Task     *pb.Task
+	Metadata TaskMetadata
+	Options  []asynq.Option
 }
 
+// EnqueueResult is the outcome of enqueueing a single task with EnqueueTasks.
+// Either Info or Err is set.
+type EnqueueResult struct {
+	Info *asynq.TaskInfo
+	Err  error
+}
+
+// EnqueueTask enqueues a task and records it in the task history. A failure
+// to record the task is only logged, the task is picked up by the
+// HistorySyncer later on. The callback of the task is registered before
+// enqueueing it and removed again if the task cannot be enqueued.
 func (q *QueueClient) EnqueueTask(
+	ctx context.Context,
 	task *pb.Task,
+	metadata TaskMetadata,
 	opts ...asynq.Option,
 ) (*asynq.TaskInfo, error) {
 	payload, err := proto.Marshal(task)
@@ -46,72 +161,678 @@ func (q *QueueClient) EnqueueTask(
 		}
 	}
 
-	queueTask := asynq.NewTask(TaskTypeNormal, payload, opts...)
+	if task.Callback != "" {
+		// The id of the task is needed to register its callback beforehand
+		id, ok := taskIDOf(opts)
+		if !ok {
+			id = uuid.NewString()
+			opts = append(opts, asynq.TaskID(id))
+		}
+
+		err = q.db.CreateCallback(
+			ctx,
+			id,
+			task.Callback,
+			callbackRegistrationDelay,
+		)
+		if err != nil {
+			var errConflict *orm.ConflictError
+			if errors.As(err, &errConflict) {
+				// Callbacks are only stored for enqueued tasks
+				return nil, &GenericError{asynq.ErrTaskIDConflict}
+			}
+
+			return nil, &GenericError{
+				fmt.Errorf("failed to register task callback: %w", err),
+			}
+		}
+	}
+
+	queueTask := asynq.NewTaskWithHeaders(
+		TaskTypeNormal,
+		payload,
+		metadata.headers(),
+		opts...,
+	)
+
 	taskInfo, err := q.client.Enqueue(queueTask, opts...)
 	if err != nil {
+		if task.Callback != "" {
+			id, _ := taskIDOf(opts)
+			q.removeCallback(ctx, id)
+		}
+
 		return nil, &GenericError{err}
 	}
 
+	if task.Callback != "" {
+		// Deliver the callback as soon as the task finished instead of after
+		// the registration delay
+		err = q.db.DeferCallback(ctx, taskInfo.ID, time.Now())
+		if err != nil {
+			log.Warn().
+				Err(err).
+				Str("id", taskInfo.ID).
+				Msg("Failed to arm task callback, its delivery is delayed")
+		}
+	}
+
+	record := newTaskRecord(taskInfo, task)
+	err = q.db.CreateTask(ctx, &record)
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("id", taskInfo.ID).
+			Msg("Failed to record enqueued task in task history")
+	}
+
 	return taskInfo, nil
 }
 
+// taskIDOf returns the id set by the options of a task, if any.
+func taskIDOf(opts []asynq.Option) (string, bool) {
+	for _, opt := range slices.Backward(opts) {
+		if opt.Type() == asynq.TaskIDOpt {
+			id, ok := opt.Value().(string)
+
+			return id, ok
+		}
+	}
+
+	return "", false
+}
+
+// PinArtifact replaces the tag the artifact of a task is referenced by with
+// the version hash the tag currently points to, so that retries run the same
+// code even if the tag is moved. The tag is kept for display.
+func PinArtifact(task *pb.Task, versionHash string) {
+	tag, ok := task.Function.Artifact.Identifier.(*pb.ArtifactIdentifier_Tag)
+	if !ok || versionHash == "" {
+		return
+	}
+
+	task.Tag = tag.Tag
+	task.Function.Artifact.Identifier = &pb.ArtifactIdentifier_VersionHash{
+		VersionHash: versionHash,
+	}
+}
+
+// EnqueueTasks enqueues many tasks concurrently, keeping several requests to
+// the queue and the task history in flight at once. The results are returned
+// in the order of the requests, a failing task does not affect the others.
+func (q *QueueClient) EnqueueTasks(
+	ctx context.Context,
+	requests []EnqueueRequest,
+) []EnqueueResult {
+	results := make([]EnqueueResult, len(requests))
+	slots := make(chan struct{}, enqueueConcurrency)
+
+	var wg sync.WaitGroup
+	for i := range requests {
+		slots <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-slots }()
+
+			results[i].Info, results[i].Err = q.EnqueueTask(
+				ctx,
+				requests[i].Task,
+				requests[i].Metadata,
+				requests[i].Options...,
+			)
+		})
+	}
+	wg.Wait()
+
+	return results
+}
+
+// GetTask looks up a task in all configured queues.
 func (q *QueueClient) GetTask(id string) (*asynq.TaskInfo, error) {
-	taskInfo, err := q.inspector.GetTaskInfo(TaskQueueDefault, id)
-	if err != nil {
-		if errors.Is(err, asynq.ErrTaskNotFound) {
-			return nil, &TaskNotFoundError{
-				Id: id,
+	for _, queue := range q.queues {
+		taskInfo, err := q.inspector.GetTaskInfo(queue, id)
+		if err == nil {
+			return taskInfo, nil
+		}
+
+		// Queues that never saw a task are unknown to asynq
+		if !errors.Is(err, asynq.ErrTaskNotFound) &&
+			!errors.Is(err, asynq.ErrQueueNotFound) {
+			return nil, &GenericError{
+				err,
 			}
 		}
+	}
+
+	return nil, &TaskNotFoundError{
+		Id: id,
+	}
+}
+
+// GetTaskInQueue looks up a task in the given queue only.
+func (q *QueueClient) GetTaskInQueue(
+	queue, id string,
+) (*asynq.TaskInfo, error) {
+	taskInfo, err := q.inspector.GetTaskInfo(queue, id)
+	if errors.Is(err, asynq.ErrTaskNotFound) ||
+		errors.Is(err, asynq.ErrQueueNotFound) {
+		return nil, &TaskNotFoundError{Id: id}
+	}
+
+	if err != nil {
+		return nil, &GenericError{err}
+	}
+
+	return taskInfo, nil
+}
+
+// TaskPage returns a page of listPageSize tasks of a queue in the given state,
+// starting with page 1. A page with less than listPageSize tasks is the last
+// one.
+func (q *QueueClient) TaskPage(
+	queue string,
+	state asynq.TaskState,
+	page int,
+) ([]*asynq.TaskInfo, error) {
+	var list func(string, ...asynq.ListOption) ([]*asynq.TaskInfo, error)
 
+	switch state {
+	case asynq.TaskStateActive:
+		list = q.inspector.ListActiveTasks
+	case asynq.TaskStatePending:
+		list = q.inspector.ListPendingTasks
+	case asynq.TaskStateScheduled:
+		list = q.inspector.ListScheduledTasks
+	case asynq.TaskStateRetry:
+		list = q.inspector.ListRetryTasks
+	case asynq.TaskStateArchived:
+		list = q.inspector.ListArchivedTasks
+	case asynq.TaskStateCompleted:
+		list = q.inspector.ListCompletedTasks
+	case asynq.TaskStateAggregating:
+		return nil, &TaskStateConflictError{Id: "*", State: state.String()}
+	default:
 		return nil, &GenericError{
-			err,
+			fmt.Errorf("cannot list tasks in unknown state %d", state),
+		}
+	}
+
+	tasks, err := list(queue, asynq.PageSize(listPageSize), asynq.Page(page))
+	if errors.Is(err, asynq.ErrQueueNotFound) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, &GenericError{err}
+	}
+
+	return tasks, nil
+}
+
+// CancelTask stops a task from being processed. Tasks that did not start yet
+// are archived. Active tasks are sent a cancellation signal and are archived
+// once they stopped, as asynq would retry them otherwise. They are waited for
+// up to cancelSettleTimeout, tasks still active then are archived by the
+// HistorySyncer later on. Tasks that already reached a final state cannot be
+// canceled.
+func (q *QueueClient) CancelTask(
+	ctx context.Context,
+	id string,
+) (*asynq.TaskInfo, error) {
+	taskInfo, err := q.GetTask(id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = q.cancel(ctx, taskInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if taskInfo.State != asynq.TaskStateActive {
+		return q.refreshRecord(ctx, taskInfo.Queue, id)
+	}
+
+	return q.settleCanceled(ctx, taskInfo, time.Now().Add(cancelSettleTimeout))
+}
+
+// cancel archives a task that did not start yet, or marks an active task as
+// canceled and sends it a cancellation signal.
+func (q *QueueClient) cancel(ctx context.Context, taskInfo *asynq.TaskInfo) error {
+	var err error
+	switch taskInfo.State {
+	case asynq.TaskStateActive:
+		err = q.db.CreateTaskCancellation(ctx, taskInfo.ID, taskInfo.Queue)
+		if err != nil {
+			return &GenericError{err}
+		}
+
+		err = q.inspector.CancelProcessing(taskInfo.ID)
+	case asynq.TaskStatePending,
+		asynq.TaskStateScheduled,
+		asynq.TaskStateRetry,
+		asynq.TaskStateAggregating:
+		err = q.inspector.ArchiveTask(taskInfo.Queue, taskInfo.ID)
+	default:
+		return &TaskStateConflictError{
+			Id:    taskInfo.ID,
+			State: taskInfo.State.String(),
+		}
+	}
+
+	if err != nil {
+		if errors.Is(err, asynq.ErrTaskNotFound) {
+			return &TaskNotFoundError{Id: taskInfo.ID}
 		}
+
+		return &GenericError{err}
+	}
+
+	return nil
+}
+
+// settleCanceled waits until a canceled active task stopped or the deadline
+// passed and returns its state then. A stopped task is archived by
+// ArchiveCanceled.
+func (q *QueueClient) settleCanceled(
+	ctx context.Context,
+	canceled *asynq.TaskInfo,
+	deadline time.Time,
+) (*asynq.TaskInfo, error) {
+	for {
+		taskInfo, err := q.GetTaskInQueue(canceled.Queue, canceled.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if taskInfo.State != asynq.TaskStateActive {
+			return q.ArchiveCanceled(ctx, taskInfo)
+		}
+
+		if time.Now().After(deadline) {
+			return taskInfo, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return taskInfo, nil
+		case <-time.After(cancelPollInterval):
+		}
+	}
+}
+
+// ArchiveCanceled archives a canceled task that stopped but is going to be
+// processed again and removes its cancellation mark. Tasks that are still
+// active are left as they are. The current state of the task is returned.
+func (q *QueueClient) ArchiveCanceled(
+	ctx context.Context,
+	taskInfo *asynq.TaskInfo,
+) (*asynq.TaskInfo, error) {
+	switch taskInfo.State {
+	case asynq.TaskStateActive:
+		return taskInfo, nil
+	case asynq.TaskStatePending,
+		asynq.TaskStateScheduled,
+		asynq.TaskStateRetry:
+		err := q.inspector.ArchiveTask(taskInfo.Queue, taskInfo.ID)
+		if err != nil && !errors.Is(err, asynq.ErrTaskNotFound) {
+			return nil, &GenericError{err}
+		}
+
+		taskInfo, err = q.refreshRecord(ctx, taskInfo.Queue, taskInfo.ID)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		// Tasks that reached a final state only lose their mark
+	}
+
+	err := q.db.DeleteTaskCancellation(ctx, taskInfo.ID)
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("id", taskInfo.ID).
+			Msg("Failed to remove cancellation mark of stopped task")
 	}
 
 	return taskInfo, nil
 }
 
-func (q *QueueClient) GetAllTasks() ([]*asynq.TaskInfo, error) {
-	allTasks := []*asynq.TaskInfo{}
-	// Int max
-	pageSize := asynq.PageSize(int(^uint(0) >> 1))
+// DeleteTask removes a task from the queue and returns its last known state.
+// Active tasks have to be canceled before they can be deleted.
+func (q *QueueClient) DeleteTask(id string) (*asynq.TaskInfo, error) {
+	taskInfo, err := q.GetTask(id)
+	if err != nil {
+		return nil, err
+	}
 
-	tasks, err := q.inspector.ListActiveTasks(TaskQueueDefault, pageSize)
+	if taskInfo.State == asynq.TaskStateActive {
+		return nil, &TaskStateConflictError{
+			Id:    id,
+			State: taskInfo.State.String(),
+		}
+	}
+
+	err = q.inspector.DeleteTask(taskInfo.Queue, id)
 	if err != nil {
+		if errors.Is(err, asynq.ErrTaskNotFound) {
+			return nil, &TaskNotFoundError{Id: id}
+		}
+
 		return nil, &GenericError{err}
 	}
-	allTasks = append(allTasks, tasks...)
 
-	tasks, err = q.inspector.ListArchivedTasks(TaskQueueDefault, pageSize)
+	return taskInfo, nil
+}
+
+// RetryTask moves an archived or retrying task back to pending so that it is
+// processed again and returns it. If resetRetries is set, the task is replaced
+// by a copy with a new id, the same payload and options and a fresh retry
+// counter, and the copy is returned instead.
+func (q *QueueClient) RetryTask(
+	ctx context.Context,
+	id string,
+	resetRetries bool,
+) (*asynq.TaskInfo, error) {
+	taskInfo, err := q.GetTask(id)
 	if err != nil {
-		return nil, &GenericError{err}
+		return nil, err
 	}
-	allTasks = append(allTasks, tasks...)
 
-	tasks, err = q.inspector.ListCompletedTasks(TaskQueueDefault, pageSize)
+	if taskInfo.State != asynq.TaskStateArchived &&
+		taskInfo.State != asynq.TaskStateRetry {
+		return nil, &TaskStateConflictError{
+			Id:    id,
+			State: taskInfo.State.String(),
+		}
+	}
+
+	// A task canceled while it was active must not be archived again
+	err = q.db.DeleteTaskCancellation(ctx, id)
 	if err != nil {
 		return nil, &GenericError{err}
 	}
-	allTasks = append(allTasks, tasks...)
 
-	tasks, err = q.inspector.ListPendingTasks(TaskQueueDefault, pageSize)
+	if resetRetries {
+		return q.requeue(ctx, taskInfo)
+	}
+
+	err = q.inspector.RunTask(taskInfo.Queue, id)
 	if err != nil {
+		if errors.Is(err, asynq.ErrTaskNotFound) {
+			return nil, &TaskNotFoundError{Id: id}
+		}
+
 		return nil, &GenericError{err}
 	}
-	allTasks = append(allTasks, tasks...)
 
-	tasks, err = q.inspector.ListRetryTasks(TaskQueueDefault, pageSize)
+	return q.refreshRecord(ctx, taskInfo.Queue, id)
+}
+
+// RetryTasks retries all tasks in the given state (archived or retry) that
+// satisfy match and returns the ids of the retried tasks. A nil match selects
+// every task in the state.
+func (q *QueueClient) RetryTasks(
+	ctx context.Context,
+	state asynq.TaskState,
+	match func(*asynq.TaskInfo) bool,
+	resetRetries bool,
+) ([]string, error) {
+	if state != asynq.TaskStateArchived && state != asynq.TaskStateRetry {
+		return nil, &TaskStateConflictError{Id: "*", State: state.String()}
+	}
+
+	// Only the ids are kept, the tasks are paged through. Retrying while paging
+	// would shift the pages, so the tasks are retried afterwards.
+	ids := []string{}
+	queues := map[string]string{}
+	for _, queue := range q.queues {
+		err := q.forEachTaskPageInState(
+			queue,
+			state,
+			func(tasks []*asynq.TaskInfo) error {
+				for _, task := range tasks {
+					if match == nil || match(task) {
+						ids = append(ids, task.ID)
+						queues[task.ID] = task.Queue
+					}
+				}
+
+				return nil
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if match == nil && !resetRetries {
+		// Nothing to filter, let asynq move the whole set at once. Tasks that
+		// entered the state after listing are retried as well but are not part
+		// of the returned ids.
+		for _, queue := range q.queues {
+			err := q.runAll(queue, state)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, id := range ids {
+			_, _ = q.refreshRecord(ctx, queues[id], id)
+		}
+
+		return ids, nil
+	}
+
+	retried := make([]string, 0, len(ids))
+	for _, id := range ids {
+		_, err := q.RetryTask(ctx, id, resetRetries)
+		if err != nil {
+			// The task might have changed state or been removed meanwhile
+			if errors.Is(err, &TaskNotFoundError{}) ||
+				errors.Is(err, &TaskStateConflictError{}) {
+				continue
+			}
+
+			return retried, err
+		}
+
+		retried = append(retried, id)
+	}
+
+	return retried, nil
+}
+
+// refreshRecord looks up a task changed through the API and updates its record
+// right away, so that listings reflect the change before the HistorySyncer
+// scans the task. A record that cannot be updated is only logged, the
+// HistorySyncer catches up later on.
+func (q *QueueClient) refreshRecord(
+	ctx context.Context,
+	queue, id string,
+) (*asynq.TaskInfo, error) {
+	taskInfo, err := q.GetTaskInQueue(queue, id)
 	if err != nil {
-		return nil, &GenericError{err}
+		return nil, err
+	}
+
+	record, err := q.db.GetTask(ctx, id)
+	if err == nil {
+		previousState := record.State
+		if updateTaskRecord(record, taskInfo) {
+			err = q.db.UpdateTask(ctx, record, previousState)
+		}
+	}
+
+	var errNotFound *orm.NotFoundError
+	if err != nil && !errors.As(err, &errNotFound) {
+		log.Warn().
+			Err(err).
+			Str("id", id).
+			Msg("Failed to update task record, it is updated by the next sync")
 	}
-	allTasks = append(allTasks, tasks...)
 
-	tasks, err = q.inspector.ListScheduledTasks(TaskQueueDefault, pageSize)
+	return taskInfo, nil
+}
+
+// removeCallback removes the callback of a task that could not be enqueued.
+// A callback that cannot be removed is reported as failed by the dispatcher
+// once it does not find the task.
+func (q *QueueClient) removeCallback(ctx context.Context, id string) {
+	err := q.db.DeleteCallback(context.WithoutCancel(ctx), id)
 	if err != nil {
-		return nil, &GenericError{err}
+		log.Error().
+			Err(err).
+			Str("id", id).
+			Msg("Failed to remove callback of task that was not enqueued")
+	}
+}
+
+// runAll moves all archived or retrying tasks of a queue back to pending.
+func (q *QueueClient) runAll(queue string, state asynq.TaskState) error {
+	var err error
+	if state == asynq.TaskStateArchived {
+		_, err = q.inspector.RunAllArchivedTasks(queue)
+	} else {
+		_, err = q.inspector.RunAllRetryTasks(queue)
+	}
+
+	if err != nil && !errors.Is(err, asynq.ErrQueueNotFound) {
+		return &GenericError{err}
+	}
+
+	return nil
+}
+
+// requeue replaces a task by an identical copy with a reset retry counter.
+// asynq rejects a copy with the id of a task that is still stored, so the copy
+// is enqueued under a new id and the record of the original is linked to it.
+// The original is only removed once the copy exists; if that fails, the copy is
+// discarded again and the original is kept.
+func (q *QueueClient) requeue(
+	ctx context.Context,
+	taskInfo *asynq.TaskInfo,
+) (*asynq.TaskInfo, error) {
+	var task pb.Task
+	err := proto.Unmarshal(taskInfo.Payload, &task)
+	if err != nil {
+		return nil, &GenericError{
+			fmt.Errorf("failed to unmarshal task proto: %w", err),
+		}
+	}
+
+	metadata := MetadataOf(taskInfo)
+	metadata.RequeuedFrom = taskInfo.ID
+
+	copied, err := q.EnqueueTask(
+		ctx,
+		&task,
+		metadata,
+		requeueOptions(taskInfo)...,
+	)
+	if err != nil {
+		return nil, err
 	}
-	allTasks = append(allTasks, tasks...)
 
-	return allTasks, nil
+	err = q.db.SetTaskRequeuedAs(ctx, taskInfo.ID, copied.ID)
+	if err == nil {
+		err = q.inspector.DeleteTask(taskInfo.Queue, taskInfo.ID)
+		if errors.Is(err, asynq.ErrTaskNotFound) {
+			// Removed concurrently, the copy takes its place all the same
+			err = nil
+		}
+	}
+
+	if err != nil {
+		q.discardCopy(ctx, taskInfo.ID, copied)
+
+		return nil, &GenericError{
+			fmt.Errorf("failed to replace task by its copy: %w", err),
+		}
+	}
+
+	return copied, nil
+}
+
+// discardCopy removes the copy of a task that could not replace the original.
+// A copy that cannot be removed is logged, it runs next to the original then.
+func (q *QueueClient) discardCopy(
+	ctx context.Context,
+	originalID string,
+	copied *asynq.TaskInfo,
+) {
+	ctx = context.WithoutCancel(ctx)
+
+	err := q.inspector.DeleteTask(copied.Queue, copied.ID)
+	if err == nil {
+		err = q.db.DeleteTaskData(ctx, copied.ID)
+	}
+	if err == nil {
+		err = q.db.SetTaskRequeuedAs(ctx, originalID, "")
+	}
+
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", originalID).
+			Str("copy", copied.ID).
+			Msg("Failed to discard copy of task that was not requeued")
+	}
+}
+
+// requeueOptions returns the options to enqueue a copy of a task with. Like
+// tasks retried without resetting their retries, the copy is processed right
+// away; its deadline still applies. The copy gets an id of its own.
+func requeueOptions(taskInfo *asynq.TaskInfo) []asynq.Option {
+	opts := []asynq.Option{
+		asynq.Queue(taskInfo.Queue),
+		asynq.MaxRetry(taskInfo.MaxRetry),
+		asynq.Retention(taskInfo.Retention),
+	}
+	if taskInfo.Timeout > 0 {
+		opts = append(opts, asynq.Timeout(taskInfo.Timeout))
+	}
+	if !taskInfo.Deadline.IsZero() {
+		opts = append(opts, asynq.Deadline(taskInfo.Deadline))
+	}
+
+	return opts
+}
+
+func (q *QueueClient) forEachTaskPageInState(
+	queue string,
+	state asynq.TaskState,
+	fn func([]*asynq.TaskInfo) error,
+) error {
+	for page := 1; ; page++ {
+		pageTasks, err := q.TaskPage(queue, state, page)
+		if err != nil {
+			return err
+		}
+
+		if len(pageTasks) > 0 {
+			err = fn(pageTasks)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(pageTasks) < listPageSize {
+			return nil
+		}
+	}
+}
+
+// queuesByPriority orders the names of the configured queues by descending
+// weight. Queues of equal weight are ordered by name.
+func queuesByPriority(weights map[string]int) []string {
+	queues := slices.Collect(maps.Keys(weights))
+	slices.SortFunc(queues, func(a, b string) int {
+		if weights[a] != weights[b] {
+			return cmp.Compare(weights[b], weights[a])
+		}
+
+		return cmp.Compare(a, b)
+	})
+
+	return queues
 }
@@ -0,0 +1,106 @@
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testQueueCritical = "critical"
+
+func TestQueuesByPriority(t *testing.T) {
+	t.Parallel()
+	queues := queuesByPriority(map[string]int{
+		"bulk":            1,
+		TaskQueueDefault:  3,
+		testQueueCritical: 6,
+		"batch":           1,
+	})
+
+	assert.Equal(
+		t,
+		[]string{testQueueCritical, TaskQueueDefault, "batch", "bulk"},
+		queues,
+	)
+}
+
+func TestHasQueue(t *testing.T) {
+	t.Parallel()
+	client := QueueClient{queues: []string{TaskQueueDefault, testQueueCritical}}
+
+	assert.True(t, client.HasQueue(testQueueCritical))
+	assert.False(t, client.HasQueue("bulk"))
+}
+
+func TestTaskPageUnlistableStates(t *testing.T) {
+	t.Parallel()
+	client := QueueClient{queues: []string{TaskQueueDefault}}
+
+	_, err := client.TaskPage(TaskQueueDefault, asynq.TaskStateAggregating, 1)
+	require.ErrorIs(t, err, &TaskStateConflictError{})
+
+	_, err = client.TaskPage(TaskQueueDefault, asynq.TaskState(0), 1)
+	var generic *GenericError
+	require.ErrorAs(t, err, &generic)
+}
+
+func TestTaskMetadataHeaders(t *testing.T) {
+	t.Parallel()
+	metadata := TaskMetadata{
+		SubmittedBy: "alice",
+		BatchID:     "batch-1",
+		GroupID:     "sweep-1",
+	}
+
+	headers := metadata.headers()
+	assert.Equal(t, metadata, MetadataOf(&asynq.TaskInfo{Headers: headers}))
+	assert.Empty(t, TaskMetadata{}.headers(), "empty metadata is not stored")
+}
+
+func TestTaskIDOf(t *testing.T) {
+	t.Parallel()
+
+	_, ok := taskIDOf([]asynq.Option{asynq.MaxRetry(3)})
+	assert.False(t, ok)
+
+	id, ok := taskIDOf([]asynq.Option{
+		asynq.TaskID("first"),
+		asynq.MaxRetry(3),
+		asynq.TaskID("second"),
+	})
+	assert.True(t, ok)
+	assert.Equal(t, "second", id, "later options take precedence")
+}
+
+func TestRequeueOptions(t *testing.T) {
+	t.Parallel()
+	deadline := time.Now().Add(time.Hour)
+
+	opts := requeueOptions(&asynq.TaskInfo{
+		ID:        "task",
+		Queue:     testQueueCritical,
+		MaxRetry:  5,
+		Retention: time.Minute,
+		Timeout:   time.Second,
+		Deadline:  deadline,
+	})
+
+	values := map[asynq.OptionType]any{}
+	for _, opt := range opts {
+		values[opt.Type()] = opt.Value()
+	}
+
+	assert.Equal(t, map[asynq.OptionType]any{
+		asynq.QueueOpt:     testQueueCritical,
+		asynq.MaxRetryOpt:  5,
+		asynq.RetentionOpt: time.Minute,
+		asynq.TimeoutOpt:   time.Second,
+		asynq.DeadlineOpt:  deadline,
+	}, values)
+
+	opts = requeueOptions(&asynq.TaskInfo{ID: "task", Queue: TaskQueueDefault})
+	assert.Len(t, opts, 3, "unset timeouts and deadlines are not carried over")
+}
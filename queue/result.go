@@ -0,0 +1,43 @@
+package queue
+
+import (
+	"api-server/orm"
+	pb "api-server/proto_gen"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"google.golang.org/protobuf/proto"
+)
+
+// DecodeResult decodes the result written by a task, which is the value
+// returned by its function serialized as pb.Val.
+func DecodeResult(result []byte) (*pb.Val, error) {
+	var value pb.Val
+	err := proto.Unmarshal(result, &value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode task result: %w", err)
+	}
+
+	return &value, nil
+}
+
+// ReportedState returns the state a task is reported in. Completed tasks
+// whose function returned the err case of a WIT result are reported as
+// failed.
+func ReportedState(state string, result []byte) string {
+	if state != asynq.TaskStateCompleted.String() || result == nil {
+		return state
+	}
+
+	value, err := DecodeResult(result)
+	if err != nil {
+		return state
+	}
+
+	if returned, ok := value.GetValue().(*pb.Val_ResultVal); ok &&
+		!returned.ResultVal.GetIsOk() {
+		return orm.TaskStateFailed
+	}
+
+	return state
+}
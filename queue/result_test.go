@@ -0,0 +1,70 @@
+package queue
+
+import (
+	pb "api-server/proto_gen"
+	"testing"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func serializedResult(t *testing.T, isOk bool) []byte {
+	t.Helper()
+
+	result, err := proto.Marshal(&pb.Val{Value: &pb.Val_ResultVal{
+		ResultVal: &pb.ResultVal{
+			IsOk:  isOk,
+			Value: &pb.Val{Value: &pb.Val_StringVal{StringVal: "message"}},
+		},
+	}})
+	require.NoError(t, err)
+
+	return result
+}
+
+func TestReportedState(t *testing.T) {
+	t.Parallel()
+	completed := asynq.TaskStateCompleted.String()
+
+	assert.Equal(
+		t,
+		"failed",
+		ReportedState(completed, serializedResult(t, false)),
+	)
+	assert.Equal(
+		t,
+		completed,
+		ReportedState(completed, serializedResult(t, true)),
+	)
+	assert.Equal(t, completed, ReportedState(completed, nil))
+	assert.Equal(
+		t,
+		completed,
+		ReportedState(completed, []byte{0xff}),
+		"undecodable results are reported as they are",
+	)
+	assert.Equal(
+		t,
+		"archived",
+		ReportedState("archived", serializedResult(t, false)),
+		"only completed tasks are reported as failed",
+	)
+}
+
+func TestUpdateTaskRecordFailed(t *testing.T) {
+	t.Parallel()
+	record := newTaskRecord(&asynq.TaskInfo{
+		ID:    testTaskID,
+		State: asynq.TaskStateActive,
+	}, &pb.Task{})
+
+	assert.True(t, updateTaskRecord(&record, &asynq.TaskInfo{
+		ID:     testTaskID,
+		State:  asynq.TaskStateCompleted,
+		Result: serializedResult(t, false),
+	}))
+	assert.Equal(t, "failed", record.State)
+	assert.True(t, IsFinalState(record.State))
+}
@@ -0,0 +1,106 @@
+package queue
+
+import (
+	"slices"
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+// QueueInfo returns the current statistics of a configured queue. Queues that
+// never held a task are unknown to asynq and reported as empty.
+func (q *QueueClient) QueueInfo(name string) (*asynq.QueueInfo, error) {
+	known, err := q.knownQueue(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !known {
+		return &asynq.QueueInfo{Queue: name, Timestamp: time.Now()}, nil
+	}
+
+	info, err := q.inspector.GetQueueInfo(name)
+	if err != nil {
+		return nil, &GenericError{err}
+	}
+
+	return info, nil
+}
+
+// QueueHistory returns the statistics of a configured queue for the given
+// number of days, newest first. Queues that never held a task have no
+// history.
+func (q *QueueClient) QueueHistory(
+	name string,
+	days int,
+) ([]*asynq.DailyStats, error) {
+	known, err := q.knownQueue(name)
+	if err != nil || !known {
+		return nil, err
+	}
+
+	history, err := q.inspector.History(name, days)
+	if err != nil {
+		return nil, &GenericError{err}
+	}
+
+	return history, nil
+}
+
+// PauseQueue stops the processing of the tasks of a configured queue. Pausing
+// a paused queue has no effect.
+func (q *QueueClient) PauseQueue(name string) error {
+	if !q.HasQueue(name) {
+		return &QueueNotFoundError{Name: name}
+	}
+
+	err := q.inspector.PauseQueue(name)
+	if err != nil {
+		// asynq fails if the queue is already paused
+		info, infoErr := q.QueueInfo(name)
+		if infoErr == nil && info.Paused {
+			return nil
+		}
+
+		return &GenericError{err}
+	}
+
+	return nil
+}
+
+// ResumeQueue continues the processing of the tasks of a paused queue.
+// Resuming a queue that is not paused has no effect.
+func (q *QueueClient) ResumeQueue(name string) error {
+	if !q.HasQueue(name) {
+		return &QueueNotFoundError{Name: name}
+	}
+
+	err := q.inspector.UnpauseQueue(name)
+	if err != nil {
+		// asynq fails if the queue is not paused
+		info, infoErr := q.QueueInfo(name)
+		if infoErr == nil && !info.Paused {
+			return nil
+		}
+
+		return &GenericError{err}
+	}
+
+	return nil
+}
+
+// knownQueue reports whether a configured queue is known to asynq, i.e.
+// whether it ever held a task. A QueueNotFoundError is returned for queues
+// that are not configured.
+func (q *QueueClient) knownQueue(name string) (bool, error) {
+	if !q.HasQueue(name) {
+		return false, &QueueNotFoundError{Name: name}
+	}
+
+	queues, err := q.inspector.Queues()
+	if err != nil {
+		return false, &GenericError{err}
+	}
+
+	return slices.Contains(queues, name), nil
+}
@@ -0,0 +1,13 @@
+package scheduler
+
+import "errors"
+
+// ErrTimezoneInExpression is returned when a cron expression carries its own
+// timezone prefix instead of using the timezone of the schedule
+var ErrTimezoneInExpression = errors.New(
+	"cron expression must not contain a timezone, set the timezone field instead",
+)
+
+// ErrArtifactNotFound is returned when the artifact referenced by the task of a
+// schedule no longer exists in the registry
+var ErrArtifactNotFound = errors.New("artifact of schedule not found")
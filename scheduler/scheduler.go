@@ -0,0 +1,322 @@
+package scheduler
+
+import (
+	"api-server/config"
+	"api-server/orm"
+	pb "api-server/proto_gen"
+	"api-server/queue"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/robfig/cron/v3"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Interval in which the schedules are checked for due runs
+const tickInterval = time.Second
+
+var parser = cron.NewParser(
+	cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
+)
+
+// Scheduler enqueues the tasks of all active schedules whenever their cron
+// expression fires. Schedules are reloaded from the database periodically,
+// and every schedule is read again right before a run so that pausing or
+// deleting it takes effect immediately.
+//
+// Runs are enqueued with a task id derived from the schedule and the time of
+// the run, so multiple instances of the api-server can run a scheduler without
+// enqueueing a run twice. Tags referenced by schedules are pinned to the version
+// hash they point to whenever a run is enqueued.
+type Scheduler struct {
+	db             orm.DB
+	queueClient    queue.QueueClient
+	registryClient pb.RegistryServiceClient
+	syncInterval   time.Duration
+	entries        map[uuid.UUID]*entry
+}
+
+type entry struct {
+	updatedAt time.Time
+	schedule  cron.Schedule
+	next      time.Time
+}
+
+func NewScheduler(
+	cfg *config.AppConfig,
+	db orm.DB,
+	queueClient queue.QueueClient,
+	registryClient pb.RegistryServiceClient,
+) *Scheduler {
+	syncInterval, err := time.ParseDuration(cfg.Scheduling.SyncInterval)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Failed to parse schedule sync interval (invalid format)")
+	}
+
+	return &Scheduler{
+		db:             db,
+		queueClient:    queueClient,
+		registryClient: registryClient,
+		syncInterval:   syncInterval,
+		entries:        map[uuid.UUID]*entry{},
+	}
+}
+
+// Parse parses a standard five field cron expression (or a descriptor such as
+// @daily) which is evaluated in the given IANA timezone.
+func Parse(expression, timezone string) (cron.Schedule, error) {
+	if strings.HasPrefix(expression, "TZ=") ||
+		strings.HasPrefix(expression, "CRON_TZ=") {
+		return nil, ErrTimezoneInExpression
+	}
+
+	_, err := time.LoadLocation(timezone)
+	if err != nil || strings.ContainsAny(timezone, " \t") {
+		return nil, fmt.Errorf("invalid timezone %q: %w", timezone, err)
+	}
+
+	schedule, err := parser.Parse("CRON_TZ=" + timezone + " " + expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression: %w", err)
+	}
+
+	return schedule, nil
+}
+
+// RunTaskID returns the id of the task enqueued by the run of a schedule at the
+// given time.
+func RunTaskID(scheduleID uuid.UUID, scheduledAt time.Time) string {
+	return uuid.NewSHA1(
+		scheduleID,
+		[]byte(scheduledAt.UTC().Format(time.RFC3339)),
+	).String()
+}
+
+// Run enqueues due schedules until the context is canceled.
+func (s *Scheduler) Run(ctx context.Context) {
+	s.sync(ctx, time.Now())
+
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+	syncTicker := time.NewTicker(s.syncInterval)
+	defer syncTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-syncTicker.C:
+			s.sync(ctx, now)
+		case now := <-ticker.C:
+			for id, scheduledAt := range s.due(now) {
+				go s.run(ctx, id, scheduledAt)
+			}
+		}
+	}
+}
+
+// sync reloads the active schedules from the database. Entries of unchanged
+// schedules are kept so that their next run is not skipped.
+func (s *Scheduler) sync(ctx context.Context, now time.Time) {
+	schedules, err := s.db.ListSchedules(ctx, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load schedules")
+
+		return
+	}
+
+	entries := make(map[uuid.UUID]*entry, len(schedules))
+	for i := range schedules {
+		schedule := &schedules[i]
+		if schedule.Paused {
+			continue
+		}
+
+		existing, ok := s.entries[schedule.ID]
+		if ok && existing.updatedAt.Equal(schedule.UpdatedAt) {
+			entries[schedule.ID] = existing
+
+			continue
+		}
+
+		cronSchedule, err := Parse(schedule.CronExpression, schedule.Timezone)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("id", schedule.ID.String()).
+				Msg("Failed to parse cron expression of schedule")
+
+			continue
+		}
+
+		entries[schedule.ID] = &entry{
+			updatedAt: schedule.UpdatedAt,
+			schedule:  cronSchedule,
+			next:      cronSchedule.Next(now),
+		}
+	}
+
+	s.entries = entries
+}
+
+// due returns the schedules whose next run is due at now together with the
+// time of that run and advances them to their following run.
+func (s *Scheduler) due(now time.Time) map[uuid.UUID]time.Time {
+	due := map[uuid.UUID]time.Time{}
+	for id, entry := range s.entries {
+		if entry.next.IsZero() || entry.next.After(now) {
+			continue
+		}
+
+		due[id] = entry.next
+		entry.next = entry.schedule.Next(now)
+	}
+
+	return due
+}
+
+func (s *Scheduler) run(
+	ctx context.Context,
+	id uuid.UUID,
+	scheduledAt time.Time,
+) {
+	schedule, err := s.db.GetSchedule(ctx, id)
+	if err != nil {
+		var errNotFound *orm.NotFoundError
+		if !errors.As(err, &errNotFound) {
+			log.Error().
+				Err(err).
+				Str("id", id.String()).
+				Msg("Failed to load schedule")
+		}
+
+		return
+	}
+
+	if schedule.Paused {
+		return
+	}
+
+	run := &orm.ScheduleRun{
+		ScheduleID:  schedule.ID,
+		ScheduledAt: scheduledAt,
+	}
+
+	taskID, err := s.enqueue(ctx, schedule, RunTaskID(schedule.ID, scheduledAt))
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		// Another instance already enqueued this run
+		return
+	}
+
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("id", schedule.ID.String()).
+			Msg("Failed to enqueue task of schedule")
+
+		run.Error = err.Error()
+	} else {
+		run.TaskID = taskID
+		log.Debug().
+			Str("id", schedule.ID.String()).
+			Str("task_id", taskID).
+			Msg("Enqueued task of schedule")
+	}
+
+	err = s.db.RecordScheduleRun(ctx, run)
+	if err != nil {
+		var errConflict *orm.ConflictError
+		if errors.As(err, &errConflict) {
+			return
+		}
+
+		log.Error().
+			Err(err).
+			Str("id", schedule.ID.String()).
+			Msg("Failed to record schedule run")
+	}
+}
+
+func (s *Scheduler) enqueue(
+	ctx context.Context,
+	schedule *orm.Schedule,
+	taskID string,
+) (string, error) {
+	var task pb.Task
+	err := proto.Unmarshal(schedule.Task, &task)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal task of schedule: %w", err)
+	}
+
+	err = s.pin(ctx, &task)
+	if err != nil {
+		return "", err
+	}
+
+	retention, err := time.ParseDuration(schedule.Retention)
+	if err != nil {
+		return "", fmt.Errorf("invalid retention of schedule: %w", err)
+	}
+
+	opts := []asynq.Option{
+		asynq.TaskID(taskID),
+		asynq.Queue(schedule.Queue),
+		asynq.MaxRetry(schedule.Retries),
+		asynq.Retention(retention),
+	}
+
+	// Schedules created before timeouts were supported use the queue default
+	if schedule.Timeout != "" {
+		timeout, err := time.ParseDuration(schedule.Timeout)
+		if err != nil {
+			return "", fmt.Errorf("invalid timeout of schedule: %w", err)
+		}
+
+		opts = append(opts, asynq.Timeout(timeout))
+	}
+
+	taskInfo, err := s.queueClient.EnqueueTask(
+		ctx,
+		&task,
+		queue.TaskMetadata{SubmittedBy: schedule.CreatedBy},
+		opts...,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	return taskInfo.ID, nil
+}
+
+// pin resolves the tag the task of a schedule references to the version hash
+// it currently points to, so that the run and its retries execute the same
+// code even if the tag is moved meanwhile.
+func (s *Scheduler) pin(ctx context.Context, task *pb.Task) error {
+	_, ok := task.GetFunction().GetArtifact().GetIdentifier().(*pb.ArtifactIdentifier_Tag)
+	if !ok {
+		return nil
+	}
+
+	artifact, err := s.registryClient.GetArtifact(ctx, task.Function.Artifact)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return ErrArtifactNotFound
+		}
+
+		return fmt.Errorf("failed to get artifact: %w", err)
+	}
+
+	queue.PinArtifact(task, artifact.GetVersionHash())
+
+	return nil
+}
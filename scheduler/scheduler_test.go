@@ -0,0 +1,192 @@
+package scheduler
+
+import (
+	pb "api-server/proto_gen"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// taggingRegistry resolves the tags it knows to their version hashes.
+type taggingRegistry struct {
+	pb.RegistryServiceClient
+
+	tags map[string]string
+}
+
+func (r *taggingRegistry) GetArtifact(
+	_ context.Context,
+	identifier *pb.ArtifactIdentifier,
+	_ ...grpc.CallOption,
+) (*pb.Artifact, error) {
+	versionHash, ok := r.tags[identifier.GetTag()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "artifact not found")
+	}
+
+	return &pb.Artifact{VersionHash: versionHash}, nil
+}
+
+func taskWithArtifact(identifier *pb.ArtifactIdentifier) *pb.Task {
+	identifier.Package = &pb.PackageName{Namespace: "acme", Name: "billing"}
+
+	return &pb.Task{Function: &pb.FunctionIdentifier{
+		Artifact:  identifier,
+		Interface: "api",
+		Name:      "run",
+	}}
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	from := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		expression  string
+		timezone    string
+		expectError bool
+		expected    time.Time
+	}{
+		{
+			name:       "daily in UTC",
+			expression: "30 2 * * *",
+			timezone:   time.UTC.String(),
+			expected:   time.Date(2025, 3, 11, 2, 30, 0, 0, time.UTC),
+		},
+		{
+			name:       "daily in other timezone",
+			expression: "0 2 * * *",
+			timezone:   "Europe/Berlin",
+			// 02:00 CET is 01:00 UTC
+			expected: time.Date(2025, 3, 11, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "descriptor",
+			expression: "@hourly",
+			timezone:   time.UTC.String(),
+			expected:   time.Date(2025, 3, 10, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "invalid expression",
+			expression:  "61 * * * *",
+			timezone:    time.UTC.String(),
+			expectError: true,
+		},
+		{
+			name:        "seconds are not supported",
+			expression:  "0 0 2 * * *",
+			timezone:    time.UTC.String(),
+			expectError: true,
+		},
+		{
+			name:        "unknown timezone",
+			expression:  "0 2 * * *",
+			timezone:    "Mars/Olympus",
+			expectError: true,
+		},
+		{
+			name:        "timezone in expression",
+			expression:  "CRON_TZ=UTC 0 2 * * *",
+			timezone:    time.UTC.String(),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			schedule, err := Parse(tt.expression, tt.timezone)
+
+			if tt.expectError {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(
+				t,
+				tt.expected.Equal(schedule.Next(from)),
+				"expected %s, got %s",
+				tt.expected,
+				schedule.Next(from),
+			)
+		})
+	}
+}
+
+func TestRunTaskID(t *testing.T) {
+	t.Parallel()
+	scheduleID := uuid.New()
+	scheduledAt := time.Date(2025, 3, 10, 2, 0, 0, 0, time.UTC)
+
+	id := RunTaskID(scheduleID, scheduledAt)
+	assert.Equal(
+		t,
+		id,
+		RunTaskID(scheduleID, scheduledAt.In(time.FixedZone("X", 3600))),
+		"the id must not depend on the location of the time",
+	)
+	assert.NotEqual(t, id, RunTaskID(scheduleID, scheduledAt.Add(time.Minute)))
+	assert.NotEqual(t, id, RunTaskID(uuid.New(), scheduledAt))
+}
+
+func TestDue(t *testing.T) {
+	t.Parallel()
+	cronSchedule, err := Parse("*/5 * * * *", time.UTC.String())
+	require.NoError(t, err)
+
+	start := time.Date(2025, 3, 10, 12, 1, 0, 0, time.UTC)
+	id := uuid.New()
+	s := &Scheduler{entries: map[uuid.UUID]*entry{
+		id: {schedule: cronSchedule, next: cronSchedule.Next(start)},
+	}}
+
+	assert.Empty(t, s.due(start.Add(time.Minute)))
+
+	now := time.Date(2025, 3, 10, 12, 5, 1, 0, time.UTC)
+	due := s.due(now)
+	require.Len(t, due, 1)
+	assert.Equal(t, time.Date(2025, 3, 10, 12, 5, 0, 0, time.UTC), due[id])
+
+	assert.Empty(t, s.due(now), "a run must only be due once")
+	assert.Equal(
+		t,
+		time.Date(2025, 3, 10, 12, 10, 0, 0, time.UTC),
+		s.entries[id].next,
+	)
+}
+
+func TestPin(t *testing.T) {
+	t.Parallel()
+	s := &Scheduler{registryClient: &taggingRegistry{
+		tags: map[string]string{"v2": "abc123"},
+	}}
+
+	task := taskWithArtifact(&pb.ArtifactIdentifier{
+		Identifier: &pb.ArtifactIdentifier_Tag{Tag: "v2"},
+	})
+	require.NoError(t, s.pin(t.Context(), task))
+	assert.Equal(t, "abc123", task.Function.Artifact.GetVersionHash())
+	assert.Equal(t, "v2", task.Tag, "the run must record its tag")
+
+	task = taskWithArtifact(&pb.ArtifactIdentifier{
+		Identifier: &pb.ArtifactIdentifier_VersionHash{VersionHash: "def456"},
+	})
+	require.NoError(t, s.pin(t.Context(), task))
+	assert.Equal(t, "def456", task.Function.Artifact.GetVersionHash())
+	assert.Empty(t, task.Tag)
+
+	task = taskWithArtifact(&pb.ArtifactIdentifier{
+		Identifier: &pb.ArtifactIdentifier_Tag{Tag: "v3"},
+	})
+	require.ErrorIs(t, s.pin(t.Context(), task), ErrArtifactNotFound)
+}
@@ -87,6 +87,9 @@ type Spec struct {
 	// Args corresponds to the JSON schema field "args".
 	Args []string `json:"args,omitempty" yaml:"args,omitempty" mapstructure:"args,omitempty"`
 
+	// Time (RFC3339) after which the task is no longer processed
+	Deadline *string `json:"deadline,omitempty" yaml:"deadline,omitempty" mapstructure:"deadline,omitempty"`
+
 	// Env corresponds to the JSON schema field "env".
 	Env []EnvVariable `json:"env,omitempty" yaml:"env,omitempty" mapstructure:"env,omitempty"`
 
@@ -102,6 +105,9 @@ type Spec struct {
 	// The identifier of the function to execute. Format:
 	// <namespace>:<name>/<interface>/<function>@<<version>|hash:<versionHash>>
 	Source string `json:"source" yaml:"source" mapstructure:"source"`
+
+	// Maximum run time of a single task attempt
+	Timeout *string `json:"timeout,omitempty" yaml:"timeout,omitempty" mapstructure:"timeout,omitempty"`
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
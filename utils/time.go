@@ -0,0 +1,12 @@
+package utils
+
+import "time"
+
+// TimeOrNil maps the zero time, used by the queue for unset times, to nil.
+func TimeOrNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
@@ -0,0 +1,1000 @@
+package wit
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Sections of the component binary format, see
+// https://github.com/WebAssembly/component-model/blob/main/design/mvp/Binary.md
+const (
+	sectionComponent = 0x04
+	sectionInstance  = 0x05
+	sectionAlias     = 0x06
+	sectionType      = 0x07
+	sectionCanon     = 0x08
+	sectionImport    = 0x0a
+	sectionExport    = 0x0b
+)
+
+// Sorts of the items in the index spaces of a component
+const (
+	sortCore      = 0x00
+	sortFunc      = 0x01
+	sortValue     = 0x02
+	sortType      = 0x03
+	sortComponent = 0x04
+	sortInstance  = 0x05
+)
+
+// Kinds of instance definitions
+const (
+	instanceInstantiate = 0x00
+	instanceExports     = 0x01
+)
+
+// Targets of aliases
+const (
+	aliasExport     = 0x00
+	aliasCoreExport = 0x01
+	aliasOuter      = 0x02
+)
+
+// Canonical function definitions
+const (
+	canonLift              = 0x00
+	canonLower             = 0x01
+	canonResourceNew       = 0x02
+	canonResourceDrop      = 0x03
+	canonResourceRep       = 0x04
+	canonResourceDropAsync = 0x07
+)
+
+// Options of canonical functions
+const (
+	optionUTF8       = 0x00
+	optionUTF16      = 0x01
+	optionLatin1     = 0x02
+	optionMemory     = 0x03
+	optionRealloc    = 0x04
+	optionPostReturn = 0x05
+	optionAsync      = 0x06
+	optionCallback   = 0x07
+)
+
+// Kinds of extern descriptions of imports and exports
+const (
+	externModule    = 0x00
+	externFunc      = 0x01
+	externValue     = 0x02
+	externType      = 0x03
+	externComponent = 0x04
+	externInstance  = 0x05
+
+	// Bounds of values and types
+	boundEq       = 0x00
+	boundResource = 0x01
+)
+
+// Declarations of component and instance types
+const (
+	declType   = 0x01
+	declAlias  = 0x02
+	declImport = 0x03
+	declExport = 0x04
+)
+
+// Encodings of type definitions
+const (
+	typeRecord    = 0x72
+	typeVariant   = 0x71
+	typeList      = 0x70
+	typeTuple     = 0x6f
+	typeFlags     = 0x6e
+	typeEnum      = 0x6d
+	typeOption    = 0x6b
+	typeResult    = 0x6a
+	typeOwn       = 0x69
+	typeBorrow    = 0x68
+	typeFunc      = 0x40
+	typeComponent = 0x41
+	typeInstance  = 0x42
+	typeAsyncFunc = 0x43
+	typeResource  = 0x3f
+
+	// Resources are followed by their representation, which is always i32
+	resourceLength = 2
+
+	// Function results are a single type or a vector of named types
+	resultSingle = 0x00
+	// Exports may ascribe a type
+	exportAscribed = 0x01
+)
+
+// Length of the magic number, version and layer of a component
+const preambleLength = 8
+
+// Preamble of all components: magic number, version 0x0d and layer 1
+var preamble = []byte{0x00, 0x61, 0x73, 0x6d, 0x0d, 0x00, 0x01, 0x00}
+
+// Component holds the types of the items a WebAssembly component exports.
+type Component struct {
+	exports map[string]*definition
+}
+
+// definition is an item in an index space. Value types, functions,
+// instances and components are described by their type, instances and
+// components by the items they export.
+type definition struct {
+	valueType *Type
+	resource  bool
+	function  *Func
+	exports   map[string]*definition
+}
+
+// scope holds the index spaces of a component or a component or instance type
+// declaration. Outer aliases refer to the index spaces of the parents.
+type scope struct {
+	parent     *scope
+	types      []*definition
+	funcs      []*definition
+	instances  []*definition
+	components []*definition
+}
+
+// Decode decodes the types of the exports of a component binary.
+func Decode(binary []byte) (*Component, error) {
+	// Core modules share the magic number but are version 1, layer 0
+	if len(binary) < preambleLength ||
+		!bytes.Equal(binary[:preambleLength], preamble) {
+		return nil, ErrNotComponent
+	}
+
+	exports, err := (&scope{}).decodeComponent(
+		&reader{data: binary[preambleLength:]},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Component{exports: exports}, nil
+}
+
+// Function returns the signature of a function exported by an interface of
+// the component. Interfaces are matched by their name without package and
+// version, e.g. api matches the export enclave:demo/api@0.1.0.
+func (c *Component) Function(iface, name string) (*Func, error) {
+	for _, exportName := range slices.Sorted(maps.Keys(c.exports)) {
+		exported := c.exports[exportName]
+		if exported.exports == nil || interfaceName(exportName) != iface {
+			continue
+		}
+
+		function := exported.exports[name]
+		if function == nil || function.function == nil {
+			return nil, fmt.Errorf("%w: %s/%s", ErrFunctionNotFound, iface, name)
+		}
+
+		return function.function, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrInterfaceNotFound, iface)
+}
+
+// interfaceName strips the package and the version of an interface name.
+func interfaceName(exportName string) string {
+	name, _, _ := strings.Cut(exportName, "@")
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+
+	return name
+}
+
+// decodeComponent decodes the sections of a component following the preamble
+// and returns its exports.
+func (s *scope) decodeComponent(r *reader) (map[string]*definition, error) {
+	exports := map[string]*definition{}
+	for !r.done() {
+		id, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+
+		size, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := r.bytes(int(size))
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.decodeSection(id, &reader{data: content}, exports)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return exports, nil
+}
+
+// decodeSection decodes a section of a component. Sections that do not
+// define component level types, functions, instances or components are
+// skipped.
+func (s *scope) decodeSection(
+	id byte,
+	r *reader,
+	exports map[string]*definition,
+) error {
+	switch id {
+	case sectionComponent:
+		if len(r.data) < preambleLength ||
+			!bytes.Equal(r.data[:preambleLength], preamble) {
+			return fmt.Errorf("%w: nested component preamble", ErrMalformed)
+		}
+
+		r.pos = preambleLength
+		nested, err := (&scope{parent: s}).decodeComponent(r)
+		if err != nil {
+			return err
+		}
+
+		s.components = append(s.components, &definition{exports: nested})
+
+		return nil
+	case sectionInstance:
+		return r.each(s.decodeInstance)
+	case sectionAlias:
+		return r.each(s.decodeAlias)
+	case sectionType:
+		return r.each(func(r *reader) error {
+			def, err := s.decodeDefType(r)
+			if err != nil {
+				return err
+			}
+
+			s.types = append(s.types, def)
+
+			return nil
+		})
+	case sectionCanon:
+		return r.each(s.decodeCanon)
+	case sectionImport:
+		return r.each(func(r *reader) error {
+			_, err := r.name()
+			if err != nil {
+				return err
+			}
+
+			sort, def, err := s.decodeExternDesc(r)
+			if err != nil {
+				return err
+			}
+
+			s.add(sort, def)
+
+			return nil
+		})
+	case sectionExport:
+		return r.each(func(r *reader) error {
+			return s.decodeExport(r, exports)
+		})
+	default:
+		return nil
+	}
+}
+
+func (s *scope) decodeInstance(r *reader) error {
+	kind, err := r.byte()
+	if err != nil {
+		return err
+	}
+
+	switch kind {
+	case instanceInstantiate:
+		// Instantiation of a component, the instance exports its exports
+		index, err := r.u32()
+		if err != nil {
+			return err
+		}
+
+		component, err := s.lookup(sortComponent, index)
+		if err != nil {
+			return err
+		}
+
+		err = r.each(func(r *reader) error {
+			_, err := r.str()
+			if err != nil {
+				return err
+			}
+
+			_, _, err = r.sortIndex()
+
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		s.instances = append(s.instances, &definition{exports: component.exports})
+
+		return nil
+	case instanceExports:
+		// Instance made up of inline exports
+		instance := &definition{exports: map[string]*definition{}}
+		err := r.each(func(r *reader) error {
+			name, err := r.name()
+			if err != nil {
+				return err
+			}
+
+			sort, index, err := r.sortIndex()
+			if err != nil || sort == sortCore {
+				return err
+			}
+
+			instance.exports[name], err = s.lookup(sort, index)
+
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		s.instances = append(s.instances, instance)
+
+		return nil
+	default:
+		return fmt.Errorf("%w: instance kind 0x%02x", ErrUnsupported, kind)
+	}
+}
+
+func (s *scope) decodeAlias(r *reader) error {
+	sort, err := r.sort()
+	if err != nil {
+		return err
+	}
+
+	target, err := r.byte()
+	if err != nil {
+		return err
+	}
+
+	var def *definition
+	switch target {
+	case aliasExport:
+		// Export of an instance
+		index, err := r.u32()
+		if err != nil {
+			return err
+		}
+
+		name, err := r.str()
+		if err != nil {
+			return err
+		}
+
+		instance, err := s.lookup(sortInstance, index)
+		if err != nil {
+			return err
+		}
+
+		def = instance.exports[name]
+		if def == nil {
+			// Exports of instances whose type is unknown are not described
+			def = &definition{}
+		}
+	case aliasCoreExport:
+		// Export of a core instance
+		_, err := r.u32()
+		if err != nil {
+			return err
+		}
+
+		_, err = r.str()
+
+		return err
+	case aliasOuter:
+		// Item of an enclosing component
+		count, err := r.u32()
+		if err != nil {
+			return err
+		}
+
+		index, err := r.u32()
+		if err != nil {
+			return err
+		}
+
+		outer := s
+		for range count {
+			outer = outer.parent
+			if outer == nil {
+				return fmt.Errorf("%w: outer alias", ErrMalformed)
+			}
+		}
+
+		def, err = outer.lookup(sort, index)
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("%w: alias target 0x%02x", ErrUnsupported, target)
+	}
+
+	s.add(sort, def)
+
+	return nil
+}
+
+func (s *scope) decodeCanon(r *reader) error {
+	kind, err := r.byte()
+	if err != nil {
+		return err
+	}
+
+	switch kind {
+	case canonLift:
+		// Lift of a core function, defines a component function
+		_, err := r.bytes(1)
+		if err != nil {
+			return err
+		}
+
+		_, err = r.u32()
+		if err != nil {
+			return err
+		}
+
+		err = r.each(skipCanonOption)
+		if err != nil {
+			return err
+		}
+
+		index, err := r.u32()
+		if err != nil {
+			return err
+		}
+
+		def, err := s.lookup(sortType, index)
+		if err != nil {
+			return err
+		}
+
+		s.funcs = append(s.funcs, def)
+
+		return nil
+	case canonLower:
+		// Lower of a component function, defines a core function
+		_, err := r.bytes(1)
+		if err != nil {
+			return err
+		}
+
+		_, err = r.u32()
+		if err != nil {
+			return err
+		}
+
+		return r.each(skipCanonOption)
+	case canonResourceNew, canonResourceDrop, canonResourceRep,
+		canonResourceDropAsync:
+		// Resource builtins, define core functions
+		_, err := r.u32()
+
+		return err
+	default:
+		return fmt.Errorf("%w: canonical function 0x%02x", ErrUnsupported, kind)
+	}
+}
+
+func skipCanonOption(r *reader) error {
+	option, err := r.byte()
+	if err != nil {
+		return err
+	}
+
+	switch option {
+	case optionUTF8, optionUTF16, optionLatin1, optionAsync:
+		// String encodings and async take no immediate
+		return nil
+	case optionMemory, optionRealloc, optionPostReturn, optionCallback:
+		// Memory, realloc, post-return and callback take an index
+		_, err := r.u32()
+
+		return err
+	default:
+		return fmt.Errorf("%w: canonical option 0x%02x", ErrUnsupported, option)
+	}
+}
+
+func (s *scope) decodeExport(r *reader, exports map[string]*definition) error {
+	name, err := r.name()
+	if err != nil {
+		return err
+	}
+
+	sort, index, err := r.sortIndex()
+	if err != nil {
+		return err
+	}
+
+	var def *definition
+	if sort != sortCore {
+		def, err = s.lookup(sort, index)
+		if err != nil {
+			return err
+		}
+	}
+
+	hasType, err := r.byte()
+	if err != nil {
+		return err
+	}
+
+	if hasType == exportAscribed {
+		// The ascribed type describes the export as seen from outside
+		_, ascribed, err := s.decodeExternDesc(r)
+		if err != nil {
+			return err
+		}
+
+		if ascribed != nil {
+			def = ascribed
+		}
+	}
+
+	if sort == sortCore {
+		return nil
+	}
+
+	exports[name] = def
+	s.add(sort, def)
+
+	return nil
+}
+
+// decodeExternDesc decodes the description of an imported or exported item.
+func (s *scope) decodeExternDesc(r *reader) (byte, *definition, error) {
+	sort, err := r.byte()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	switch sort {
+	case externModule:
+		// Core module
+		_, err := r.bytes(1)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		_, err = r.u32()
+
+		return sortCore, nil, err
+	case externFunc, externComponent, externInstance:
+		// Function, component or instance of a given type
+		index, err := r.u32()
+		if err != nil {
+			return 0, nil, err
+		}
+
+		def, err := s.lookup(sortType, index)
+
+		return externSort(sort), def, err
+	case externValue:
+		// Value
+		bound, err := r.byte()
+		if err != nil {
+			return 0, nil, err
+		}
+
+		if bound == boundEq {
+			_, err = r.u32()
+		} else {
+			_, err = s.decodeValType(r)
+		}
+
+		return sortValue, &definition{}, err
+	case externType:
+		// Type, equal to another type or a fresh resource
+		bound, err := r.byte()
+		if err != nil {
+			return 0, nil, err
+		}
+
+		if bound == boundResource {
+			return sortType, &definition{resource: true}, nil
+		}
+
+		index, err := r.u32()
+		if err != nil {
+			return 0, nil, err
+		}
+
+		def, err := s.lookup(sortType, index)
+
+		return sortType, def, err
+	default:
+		return 0, nil, fmt.Errorf("%w: extern kind 0x%02x", ErrUnsupported, sort)
+	}
+}
+
+// externSort returns the sort of the item of an extern description.
+func externSort(kind byte) byte {
+	switch kind {
+	case externFunc:
+		return sortFunc
+	case externComponent:
+		return sortComponent
+	default:
+		return sortInstance
+	}
+}
+
+// decodeDeclarations decodes the declarations of a component or instance
+// type and returns the exported items.
+func (s *scope) decodeDeclarations(r *reader) (map[string]*definition, error) {
+	declarations := &scope{parent: s}
+	exports := map[string]*definition{}
+	err := r.each(func(r *reader) error {
+		kind, err := r.byte()
+		if err != nil {
+			return err
+		}
+
+		switch kind {
+		case declType:
+			def, err := declarations.decodeDefType(r)
+			if err != nil {
+				return err
+			}
+
+			declarations.types = append(declarations.types, def)
+
+			return nil
+		case declAlias:
+			return declarations.decodeAlias(r)
+		case declImport, declExport:
+			// Import or export
+			name, err := r.name()
+			if err != nil {
+				return err
+			}
+
+			sort, def, err := declarations.decodeExternDesc(r)
+			if err != nil {
+				return err
+			}
+
+			if kind == declExport {
+				exports[name] = def
+			}
+
+			declarations.add(sort, def)
+
+			return nil
+		default:
+			return fmt.Errorf("%w: declaration 0x%02x", ErrUnsupported, kind)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return exports, nil
+}
+
+// decodeDefType decodes a type definition.
+func (s *scope) decodeDefType(r *reader) (*definition, error) {
+	kind, err := r.peek()
+	if err != nil {
+		return nil, err
+	}
+
+	switch kind {
+	case typeFunc, typeAsyncFunc:
+		// Synchronous or asynchronous function
+		r.pos++
+		function, err := s.decodeFuncType(r)
+		if err != nil {
+			return nil, err
+		}
+
+		return &definition{function: function}, nil
+	case typeComponent, typeInstance:
+		// Component or instance
+		r.pos++
+		exports, err := s.decodeDeclarations(r)
+		if err != nil {
+			return nil, err
+		}
+
+		return &definition{exports: exports}, nil
+	case typeResource:
+		// Resource with optional destructor
+		_, err := r.bytes(resourceLength)
+		if err != nil {
+			return nil, err
+		}
+
+		err = r.optional(func(r *reader) error {
+			_, err := r.u32()
+
+			return err
+		})
+
+		return &definition{resource: true}, err
+	default:
+		valueType, err := s.decodeDefValType(r)
+		if err != nil {
+			return nil, err
+		}
+
+		return &definition{valueType: valueType}, nil
+	}
+}
+
+func (s *scope) decodeFuncType(r *reader) (*Func, error) {
+	function := &Func{}
+	err := r.each(func(r *reader) error {
+		name, err := r.str()
+		if err != nil {
+			return err
+		}
+
+		valueType, err := s.decodeValType(r)
+		if err != nil {
+			return err
+		}
+
+		function.Params = append(function.Params, Param{name, valueType})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.byte()
+	if err != nil {
+		return nil, err
+	}
+
+	if results == resultSingle {
+		_, err = s.decodeValType(r)
+
+		return function, err
+	}
+
+	// Named results of older encodings, empty if there is no result
+	err = r.each(func(r *reader) error {
+		_, err := r.str()
+		if err != nil {
+			return err
+		}
+
+		_, err = s.decodeValType(r)
+
+		return err
+	})
+
+	return function, err
+}
+
+// decodeDefValType decodes the definition of a value type.
+//
+//nolint:gocyclo,cyclop,funlen // One case per type constructor
+func (s *scope) decodeDefValType(r *reader) (*Type, error) {
+	kind, err := r.byte()
+	if err != nil {
+		return nil, err
+	}
+
+	if primitive, ok := primitives[kind]; ok {
+		return &Type{Kind: primitive}, nil
+	}
+
+	switch kind {
+	case typeRecord:
+		fields, err := s.decodeFields(r, false)
+
+		return &Type{Kind: KindRecord, Fields: fields}, err
+	case typeVariant:
+		cases, err := s.decodeFields(r, true)
+
+		return &Type{Kind: KindVariant, Fields: cases}, err
+	case typeList:
+		elem, err := s.decodeValType(r)
+
+		return &Type{Kind: KindList, Elem: elem}, err
+	case typeTuple:
+		var items []*Type
+		err := r.each(func(r *reader) error {
+			item, err := s.decodeValType(r)
+			items = append(items, item)
+
+			return err
+		})
+
+		return &Type{Kind: KindTuple, Items: items}, err
+	case typeFlags, typeEnum:
+		var names []string
+		err := r.each(func(r *reader) error {
+			name, err := r.str()
+			names = append(names, name)
+
+			return err
+		})
+
+		if kind == typeFlags {
+			return &Type{Kind: KindFlags, Names: names}, err
+		}
+
+		return &Type{Kind: KindEnum, Names: names}, err
+	case typeOption:
+		elem, err := s.decodeValType(r)
+
+		return &Type{Kind: KindOption, Elem: elem}, err
+	case typeResult:
+		result := &Type{Kind: KindResult}
+		err := r.optional(func(r *reader) error {
+			var err error
+			result.Ok, err = s.decodeValType(r)
+
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		err = r.optional(func(r *reader) error {
+			var err error
+			result.Err, err = s.decodeValType(r)
+
+			return err
+		})
+
+		return result, err
+	case typeOwn, typeBorrow:
+		// Handle of a resource
+		_, err := r.u32()
+		if kind == typeOwn {
+			return &Type{Kind: KindOwn}, err
+		}
+
+		return &Type{Kind: KindBorrow}, err
+	default:
+		return nil, fmt.Errorf("%w: value type 0x%02x", ErrUnsupported, kind)
+	}
+}
+
+// decodeFields decodes the fields of a record or the cases of a variant.
+func (s *scope) decodeFields(r *reader, cases bool) ([]Field, error) {
+	var fields []Field
+	err := r.each(func(r *reader) error {
+		name, err := r.str()
+		if err != nil {
+			return err
+		}
+
+		field := Field{Name: name}
+		if cases {
+			err = r.optional(func(r *reader) error {
+				field.Type, err = s.decodeValType(r)
+
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+			// Cases used to refine other cases, this is no longer supported
+			_, err = r.bytes(1)
+		} else {
+			field.Type, err = s.decodeValType(r)
+		}
+
+		fields = append(fields, field)
+
+		return err
+	})
+
+	return fields, err
+}
+
+// decodeValType decodes a primitive type or a reference to a defined value
+// type.
+func (s *scope) decodeValType(r *reader) (*Type, error) {
+	kind, err := r.peek()
+	if err != nil {
+		return nil, err
+	}
+
+	if primitive, ok := primitives[kind]; ok {
+		r.pos++
+
+		return &Type{Kind: primitive}, nil
+	}
+
+	index, err := r.s33()
+	if err != nil {
+		return nil, err
+	}
+
+	if index < 0 || index >= int64(len(s.types)) ||
+		s.types[index].valueType == nil {
+		return nil, fmt.Errorf("%w: value type %d", ErrMalformed, index)
+	}
+
+	return s.types[index].valueType, nil
+}
+
+// Encodings of the primitive value types
+var primitives = map[byte]Kind{
+	0x7f: KindBool,
+	0x7e: KindS8,
+	0x7d: KindU8,
+	0x7c: KindS16,
+	0x7b: KindU16,
+	0x7a: KindS32,
+	0x79: KindU32,
+	0x78: KindS64,
+	0x77: KindU64,
+	0x76: KindF32,
+	0x75: KindF64,
+	0x74: KindChar,
+	0x73: KindString,
+}
+
+// add appends an item to the index space of its sort. Core items and values
+// are not tracked.
+func (s *scope) add(sort byte, def *definition) {
+	switch sort {
+	case sortFunc:
+		s.funcs = append(s.funcs, def)
+	case sortType:
+		s.types = append(s.types, def)
+	case sortComponent:
+		s.components = append(s.components, def)
+	case sortInstance:
+		s.instances = append(s.instances, def)
+	}
+}
+
+// lookup returns an item of an index space. Values are not tracked and
+// returned without description.
+func (s *scope) lookup(sort byte, index uint32) (*definition, error) {
+	var space []*definition
+	switch sort {
+	case sortFunc:
+		space = s.funcs
+	case sortType:
+		space = s.types
+	case sortComponent:
+		space = s.components
+	case sortInstance:
+		space = s.instances
+	case sortValue:
+		return &definition{}, nil
+	default:
+		return nil, fmt.Errorf("%w: sort 0x%02x", ErrUnsupported, sort)
+	}
+
+	if int(index) >= len(space) {
+		return nil, fmt.Errorf(
+			"%w: index %d of sort 0x%02x",
+			ErrMalformed,
+			index,
+			sort,
+		)
+	}
+
+	return space[index], nil
+}
@@ -0,0 +1,190 @@
+package wit
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Helpers encoding the parts of component binaries used by the tests
+
+func leb(n int) []byte {
+	var b []byte
+	for {
+		c := byte(n & 0x7f)
+		n >>= 7
+		if n == 0 {
+			return append(b, c)
+		}
+
+		b = append(b, c|0x80)
+	}
+}
+
+func str(s string) []byte {
+	return append(leb(len(s)), s...)
+}
+
+func vec(items ...[]byte) []byte {
+	return append(leb(len(items)), slices.Concat(items...)...)
+}
+
+func section(id byte, items ...[]byte) []byte {
+	content := vec(items...)
+
+	return slices.Concat([]byte{id}, leb(len(content)), content)
+}
+
+func component(sections ...[]byte) []byte {
+	return slices.Concat(append([][]byte{preamble}, sections...)...)
+}
+
+// point is record point { x: s32, y: s32 }
+var point = slices.Concat(
+	[]byte{0x72}, vec(slices.Concat(str("x"), []byte{0x7a}),
+		slices.Concat(str("y"), []byte{0x7a})),
+)
+
+// liftedComponent exports the interface enclave:demo/api@0.1.0 with the
+// function move(p: point, names: list<string>, steps: u8) -> string.
+func liftedComponent() []byte {
+	return component(
+		// Core sections are skipped
+		[]byte{0x01, 0x02, 0x00, 0x00},
+		section(sectionType,
+			point,
+			[]byte{0x70, 0x73},
+			slices.Concat(
+				[]byte{0x40},
+				vec(
+					slices.Concat(str("p"), []byte{0x00}),
+					slices.Concat(str("names"), []byte{0x01}),
+					slices.Concat(str("steps"), []byte{0x7d}),
+				),
+				[]byte{0x00, 0x73},
+			),
+		),
+		section(sectionCanon,
+			slices.Concat([]byte{0x00, 0x00, 0x00},
+				vec([]byte{0x00}, []byte{0x03, 0x00}), []byte{0x02}),
+		),
+		section(sectionInstance,
+			slices.Concat([]byte{0x01}, vec(
+				slices.Concat([]byte{0x00}, str("move"), []byte{0x01, 0x00}),
+				slices.Concat([]byte{0x00}, str("point"), []byte{0x03, 0x00}),
+			)),
+		),
+		section(sectionExport,
+			slices.Concat([]byte{0x01}, str("enclave:demo/api"), str("0.1.0"),
+				[]byte{0x05, 0x00, 0x00}),
+		),
+	)
+}
+
+// importedComponent re-exports an imported instance whose type declares
+// the function greet(name: string, shout: option<bool>).
+func importedComponent() []byte {
+	return component(
+		section(sectionType,
+			slices.Concat([]byte{0x42}, vec(
+				slices.Concat([]byte{0x01, 0x6b, 0x7f}),
+				slices.Concat(
+					[]byte{0x04, 0x00},
+					str("flag"),
+					[]byte{0x03, 0x00, 0x00},
+				),
+				slices.Concat([]byte{0x01, 0x40}, vec(
+					slices.Concat(str("name"), []byte{0x73}),
+					slices.Concat(str("shout"), []byte{0x01}),
+				), []byte{0x01, 0x00}),
+				slices.Concat([]byte{0x04, 0x00}, str("greet"), []byte{0x01, 0x02}),
+			)),
+		),
+		section(sectionImport,
+			slices.Concat([]byte{0x00}, str("enclave:demo/greeter"),
+				[]byte{0x05, 0x00}),
+		),
+		section(sectionExport,
+			slices.Concat([]byte{0x00}, str("enclave:demo/greeter"),
+				[]byte{0x05, 0x00, 0x00}),
+		),
+	)
+}
+
+func TestDecodeLifted(t *testing.T) {
+	t.Parallel()
+
+	decoded, err := Decode(liftedComponent())
+	require.NoError(t, err)
+
+	function, err := decoded.Function("api", "move")
+	require.NoError(t, err)
+	require.Len(t, function.Params, 3)
+	assert.Equal(t, "p", function.Params[0].Name)
+	assert.Equal(t, KindRecord, function.Params[0].Type.Kind)
+	assert.Equal(t, []Field{
+		{"x", &Type{Kind: KindS32}}, {"y", &Type{Kind: KindS32}},
+	}, function.Params[0].Type.Fields)
+	assert.Equal(t, "list<string>", function.Params[1].Type.String())
+	assert.Equal(t, KindU8, function.Params[2].Type.Kind)
+
+	_, err = decoded.Function("api", "jump")
+	require.ErrorIs(t, err, ErrFunctionNotFound)
+
+	_, err = decoded.Function("api", "point")
+	require.ErrorIs(t, err, ErrFunctionNotFound, "types are no functions")
+
+	_, err = decoded.Function("other", "move")
+	require.ErrorIs(t, err, ErrInterfaceNotFound)
+}
+
+func TestDecodeImported(t *testing.T) {
+	t.Parallel()
+
+	decoded, err := Decode(importedComponent())
+	require.NoError(t, err)
+
+	function, err := decoded.Function("greeter", "greet")
+	require.NoError(t, err)
+	require.Len(t, function.Params, 2)
+	assert.Equal(t, "string", function.Params[0].Type.String())
+	assert.Equal(t, "option<bool>", function.Params[1].Type.String())
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := Decode([]byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00})
+	require.ErrorIs(t, err, ErrNotComponent, "core modules are no components")
+
+	_, err = Decode([]byte("not wasm"))
+	require.ErrorIs(t, err, ErrNotComponent)
+
+	valid := liftedComponent()
+	for i := preambleLength; i < len(valid); i++ {
+		assert.NotPanics(t, func() {
+			_, err := Decode(valid[:i])
+			if err != nil {
+				assert.ErrorIs(t, err, ErrMalformed, "truncated at %d", i)
+			}
+		})
+	}
+
+	_, err = Decode(component(section(sectionType, []byte{0x40, 0xff})))
+	require.ErrorIs(t, err, ErrMalformed)
+
+	_, err = Decode(component(section(sectionType, []byte{0x70, 0x05})))
+	require.ErrorIs(t, err, ErrMalformed, "undefined type index")
+
+	_, err = Decode(component(section(sectionType, []byte{0x50})))
+	require.ErrorIs(t, err, ErrUnsupported)
+}
+
+func TestInterfaceName(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "api", interfaceName("enclave:demo/api@0.1.0"))
+	assert.Equal(t, "api", interfaceName("enclave:demo/api"))
+	assert.Equal(t, "api", interfaceName("api"))
+}
@@ -0,0 +1,450 @@
+package wit
+
+import (
+	pb "api-server/proto_gen"
+	"fmt"
+	"math"
+	"slices"
+	"unicode/utf8"
+)
+
+// Conform checks that the parameters match the signature of the function and
+// returns them converted to the declared types.
+func (f *Func) Conform(params []*pb.Val) ([]*pb.Val, error) {
+	if len(params) != len(f.Params) {
+		return nil, fmt.Errorf(
+			"%w: expected %d, got %d", ErrArity, len(f.Params), len(params),
+		)
+	}
+
+	conformed := make([]*pb.Val, len(params))
+	for i, param := range params {
+		var err error
+		conformed[i], err = Conform(param, f.Params[i].Type)
+		if err != nil {
+			return nil, &ParamError{Index: i, Name: f.Params[i].Name, Err: err}
+		}
+	}
+
+	return conformed, nil
+}
+
+// Conform checks that a value matches a type and returns it converted to the
+// type. Plain values, which carry no WIT type, are converted to the type if
+// they can express it: integral numbers to integers, numbers to floats,
+// single character strings to chars, lists to tuples, strings to enums, lists
+// of strings to flags and values to option some. Record fields are ordered as
+// declared.
+//
+//nolint:gocyclo,cyclop,funlen // One case per WIT type
+func Conform(v *pb.Val, t *Type) (*pb.Val, error) {
+	switch t.Kind {
+	case KindBool:
+		if _, ok := v.GetValue().(*pb.Val_BoolVal); ok {
+			return v, nil
+		}
+	case KindS8, KindS16, KindS32, KindS64, KindU8, KindU16, KindU32, KindU64:
+		return conformInteger(v, t)
+	case KindF32:
+		switch val := v.GetValue().(type) {
+		case *pb.Val_F32Val:
+			return v, nil
+		case *pb.Val_F64Val:
+			if math.Abs(val.F64Val) <= math.MaxFloat32 {
+				return &pb.Val{Value: &pb.Val_F32Val{F32Val: float32(val.F64Val)}}, nil
+			}
+		}
+	case KindF64:
+		if _, ok := v.GetValue().(*pb.Val_F64Val); ok {
+			return v, nil
+		}
+	case KindChar:
+		switch val := v.GetValue().(type) {
+		case *pb.Val_CharVal:
+			return v, nil
+		case *pb.Val_StringVal:
+			char, size := utf8.DecodeRuneInString(val.StringVal)
+			if size > 0 && size == len(val.StringVal) && char != utf8.RuneError {
+				//nolint:gosec // Decoded runes are not negative
+				return &pb.Val{Value: &pb.Val_CharVal{CharVal: uint32(char)}}, nil
+			}
+		}
+	case KindString:
+		if _, ok := v.GetValue().(*pb.Val_StringVal); ok {
+			return v, nil
+		}
+	case KindList:
+		if val, ok := v.GetValue().(*pb.Val_ListVal); ok {
+			values, err := conformElements(val.ListVal.GetValues(), func(int) *Type {
+				return t.Elem
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return &pb.Val{Value: &pb.Val_ListVal{
+				ListVal: &pb.ListVal{Values: values},
+			}}, nil
+		}
+	case KindTuple:
+		return conformTuple(v, t)
+	case KindOption:
+		return conformOption(v, t)
+	case KindResult:
+		return conformResult(v, t)
+	case KindRecord:
+		return conformRecord(v, t)
+	case KindVariant:
+		return conformVariant(v, t)
+	case KindEnum:
+		var name string
+		switch val := v.GetValue().(type) {
+		case *pb.Val_EnumVal:
+			name = val.EnumVal
+		case *pb.Val_StringVal:
+			name = val.StringVal
+		default:
+			return nil, mismatch(v, t)
+		}
+
+		if !slices.Contains(t.Names, name) {
+			return nil, fmt.Errorf("%w: unknown case %s", ErrTypeMismatch, name)
+		}
+
+		return &pb.Val{Value: &pb.Val_EnumVal{EnumVal: name}}, nil
+	case KindFlags:
+		return conformFlags(v, t)
+	case KindOwn, KindBorrow:
+		return nil, fmt.Errorf(
+			"%w: resources cannot be passed as parameters", ErrTypeMismatch,
+		)
+	}
+
+	return nil, mismatch(v, t)
+}
+
+func mismatch(v *pb.Val, t *Type) error {
+	return fmt.Errorf("%w: expected %s, got %s", ErrTypeMismatch, t, kindOf(v))
+}
+
+// Ranges of the integer types
+//
+//nolint:mnd // Sizes are given by the types
+var integerRanges = map[Kind]struct{ min, max float64 }{
+	KindS8:  {math.MinInt8, math.MaxInt8},
+	KindS16: {math.MinInt16, math.MaxInt16},
+	KindS32: {math.MinInt32, math.MaxInt32},
+	KindS64: {math.MinInt64, math.Ldexp(1, 63)},
+	KindU8:  {0, math.MaxUint8},
+	KindU16: {0, math.MaxUint16},
+	KindU32: {0, math.MaxUint32},
+	KindU64: {0, math.Ldexp(1, 64)},
+}
+
+// conformInteger converts plain numbers to integers. Integers of other types
+// are rejected.
+func conformInteger(v *pb.Val, t *Type) (*pb.Val, error) {
+	if kindOf(v) == t.Kind {
+		return v, nil
+	}
+
+	var n float64
+	switch val := v.GetValue().(type) {
+	case *pb.Val_S64Val:
+		n = float64(val.S64Val)
+	case *pb.Val_F64Val:
+		n = val.F64Val
+	default:
+		return nil, mismatch(v, t)
+	}
+
+	limits := integerRanges[t.Kind]
+	// The upper limits of 64 bit integers are exclusive as they are not
+	// representable
+	exclusive := t.Kind == KindS64 || t.Kind == KindU64
+	if n != math.Trunc(n) || n < limits.min || n > limits.max ||
+		(exclusive && n == limits.max) {
+		return nil, fmt.Errorf("%w: %v is not a valid %s", ErrTypeMismatch, n, t)
+	}
+
+	if val, ok := v.GetValue().(*pb.Val_S64Val); ok && t.Kind == KindU64 {
+		// Avoid the float conversion for integers
+		//nolint:gosec // Negative integers were rejected
+		return &pb.Val{Value: &pb.Val_U64Val{U64Val: uint64(val.S64Val)}}, nil
+	}
+
+	return integerVal(t.Kind, n), nil
+}
+
+// integerVal converts an integral number in the range of the kind.
+func integerVal(kind Kind, n float64) *pb.Val {
+	switch kind {
+	case KindS8:
+		return &pb.Val{Value: &pb.Val_S8Val{S8Val: int32(n)}}
+	case KindS16:
+		return &pb.Val{Value: &pb.Val_S16Val{S16Val: int32(n)}}
+	case KindS32:
+		return &pb.Val{Value: &pb.Val_S32Val{S32Val: int32(n)}}
+	case KindU8:
+		return &pb.Val{Value: &pb.Val_U8Val{U8Val: uint32(n)}}
+	case KindU16:
+		return &pb.Val{Value: &pb.Val_U16Val{U16Val: uint32(n)}}
+	case KindU32:
+		return &pb.Val{Value: &pb.Val_U32Val{U32Val: uint32(n)}}
+	case KindU64:
+		return &pb.Val{Value: &pb.Val_U64Val{U64Val: uint64(n)}}
+	default:
+		return &pb.Val{Value: &pb.Val_S64Val{S64Val: int64(n)}}
+	}
+}
+
+func conformElements(
+	values []*pb.Val,
+	typeOf func(i int) *Type,
+) ([]*pb.Val, error) {
+	conformed := make([]*pb.Val, len(values))
+	for i, value := range values {
+		var err error
+		conformed[i], err = Conform(value, typeOf(i))
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+
+	return conformed, nil
+}
+
+func conformTuple(v *pb.Val, t *Type) (*pb.Val, error) {
+	var values []*pb.Val
+	switch val := v.GetValue().(type) {
+	case *pb.Val_TupleVal:
+		values = val.TupleVal.GetValues()
+	case *pb.Val_ListVal:
+		values = val.ListVal.GetValues()
+	default:
+		return nil, mismatch(v, t)
+	}
+
+	if len(values) != len(t.Items) {
+		return nil, fmt.Errorf(
+			"%w: expected %d elements, got %d",
+			ErrTypeMismatch, len(t.Items), len(values),
+		)
+	}
+
+	conformed, err := conformElements(values, func(i int) *Type {
+		return t.Items[i]
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Val{Value: &pb.Val_TupleVal{
+		TupleVal: &pb.TupleVal{Values: conformed},
+	}}, nil
+}
+
+// conformOption converts values which are not options to option some.
+func conformOption(v *pb.Val, t *Type) (*pb.Val, error) {
+	payload := v
+	if val, ok := v.GetValue().(*pb.Val_OptionVal); ok {
+		payload = val.OptionVal.GetValue()
+	}
+
+	if payload == nil {
+		return v, nil
+	}
+
+	conformed, err := Conform(payload, t.Elem)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Val{Value: &pb.Val_OptionVal{
+		OptionVal: &pb.OptionVal{Value: conformed},
+	}}, nil
+}
+
+// conformPayload conforms the payload of a result or variant case, which must
+// be present exactly if the case has a payload type.
+func conformPayload(payload *pb.Val, t *Type) (*pb.Val, error) {
+	switch {
+	case t == nil && payload == nil:
+		return nil, nil //nolint:nilnil // A missing payload is a valid result
+	case t == nil:
+		return nil, fmt.Errorf("%w: unexpected payload", ErrTypeMismatch)
+	case payload == nil:
+		return nil, fmt.Errorf("%w: missing payload %s", ErrTypeMismatch, t)
+	default:
+		return Conform(payload, t)
+	}
+}
+
+func conformResult(v *pb.Val, t *Type) (*pb.Val, error) {
+	val, ok := v.GetValue().(*pb.Val_ResultVal)
+	if !ok {
+		return nil, mismatch(v, t)
+	}
+
+	payloadType, name := t.Err, "err"
+	if val.ResultVal.GetIsOk() {
+		payloadType, name = t.Ok, "ok"
+	}
+
+	payload, err := conformPayload(val.ResultVal.GetValue(), payloadType)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	return &pb.Val{Value: &pb.Val_ResultVal{
+		ResultVal: &pb.ResultVal{IsOk: val.ResultVal.GetIsOk(), Value: payload},
+	}}, nil
+}
+
+// conformRecord matches the fields of a record by name and orders them as
+// declared.
+func conformRecord(v *pb.Val, t *Type) (*pb.Val, error) {
+	val, ok := v.GetValue().(*pb.Val_RecordVal)
+	if !ok {
+		return nil, mismatch(v, t)
+	}
+
+	values := make(map[string]*pb.Val, len(val.RecordVal.GetFields()))
+	for _, field := range val.RecordVal.GetFields() {
+		values[field.GetName()] = field.GetValue()
+	}
+
+	fields := make([]*pb.RecordField, len(t.Fields))
+	for i, field := range t.Fields {
+		value, ok := values[field.Name]
+		if !ok {
+			return nil, fmt.Errorf(
+				"%w: missing field %s",
+				ErrTypeMismatch,
+				field.Name,
+			)
+		}
+
+		conformed, err := Conform(value, field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+
+		fields[i] = &pb.RecordField{Name: field.Name, Value: conformed}
+		delete(values, field.Name)
+	}
+
+	for name := range values {
+		return nil, fmt.Errorf("%w: unknown field %s", ErrTypeMismatch, name)
+	}
+
+	return &pb.Val{Value: &pb.Val_RecordVal{
+		RecordVal: &pb.RecordVal{Fields: fields},
+	}}, nil
+}
+
+func conformVariant(v *pb.Val, t *Type) (*pb.Val, error) {
+	val, ok := v.GetValue().(*pb.Val_VariantVal)
+	if !ok {
+		return nil, mismatch(v, t)
+	}
+
+	name := val.VariantVal.GetName()
+	i := slices.IndexFunc(t.Fields, func(field Field) bool {
+		return field.Name == name
+	})
+	if i < 0 {
+		return nil, fmt.Errorf("%w: unknown case %s", ErrTypeMismatch, name)
+	}
+
+	payload, err := conformPayload(val.VariantVal.GetValue(), t.Fields[i].Type)
+	if err != nil {
+		return nil, fmt.Errorf("case %s: %w", name, err)
+	}
+
+	return &pb.Val{Value: &pb.Val_VariantVal{
+		VariantVal: &pb.VariantVal{Name: name, Value: payload},
+	}}, nil
+}
+
+// conformFlags converts lists of strings to flags.
+func conformFlags(v *pb.Val, t *Type) (*pb.Val, error) {
+	var flags []string
+	switch val := v.GetValue().(type) {
+	case *pb.Val_FlagsVal:
+		flags = val.FlagsVal.GetFlags()
+	case *pb.Val_ListVal:
+		for _, element := range val.ListVal.GetValues() {
+			flag, ok := element.GetValue().(*pb.Val_StringVal)
+			if !ok {
+				return nil, mismatch(v, t)
+			}
+
+			flags = append(flags, flag.StringVal)
+		}
+	default:
+		return nil, mismatch(v, t)
+	}
+
+	for _, flag := range flags {
+		if !slices.Contains(t.Names, flag) {
+			return nil, fmt.Errorf("%w: unknown flag %s", ErrTypeMismatch, flag)
+		}
+	}
+
+	return &pb.Val{Value: &pb.Val_FlagsVal{
+		FlagsVal: &pb.FlagsVal{Flags: flags},
+	}}, nil
+}
+
+// kindOf returns the kind of a value.
+//
+//nolint:gocyclo,cyclop // One case per WIT type
+func kindOf(v *pb.Val) Kind {
+	switch v.GetValue().(type) {
+	case *pb.Val_BoolVal:
+		return KindBool
+	case *pb.Val_S8Val:
+		return KindS8
+	case *pb.Val_U8Val:
+		return KindU8
+	case *pb.Val_S16Val:
+		return KindS16
+	case *pb.Val_U16Val:
+		return KindU16
+	case *pb.Val_S32Val:
+		return KindS32
+	case *pb.Val_U32Val:
+		return KindU32
+	case *pb.Val_S64Val:
+		return KindS64
+	case *pb.Val_U64Val:
+		return KindU64
+	case *pb.Val_F32Val:
+		return KindF32
+	case *pb.Val_F64Val:
+		return KindF64
+	case *pb.Val_CharVal:
+		return KindChar
+	case *pb.Val_StringVal:
+		return KindString
+	case *pb.Val_ListVal:
+		return KindList
+	case *pb.Val_TupleVal:
+		return KindTuple
+	case *pb.Val_OptionVal:
+		return KindOption
+	case *pb.Val_ResultVal:
+		return KindResult
+	case *pb.Val_RecordVal:
+		return KindRecord
+	case *pb.Val_VariantVal:
+		return KindVariant
+	case *pb.Val_EnumVal:
+		return KindEnum
+	case *pb.Val_FlagsVal:
+		return KindFlags
+	default:
+		return "none"
+	}
+}
@@ -0,0 +1,185 @@
+package wit
+
+import (
+	pb "api-server/proto_gen"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func f64(f float64) *pb.Val {
+	return &pb.Val{Value: &pb.Val_F64Val{F64Val: f}}
+}
+
+func text(s string) *pb.Val {
+	return &pb.Val{Value: &pb.Val_StringVal{StringVal: s}}
+}
+
+func list(values ...*pb.Val) *pb.Val {
+	return &pb.Val{Value: &pb.Val_ListVal{ListVal: &pb.ListVal{Values: values}}}
+}
+
+func record(fields ...*pb.RecordField) *pb.Val {
+	return &pb.Val{Value: &pb.Val_RecordVal{
+		RecordVal: &pb.RecordVal{Fields: fields},
+	}}
+}
+
+func TestConformPlainValues(t *testing.T) {
+	t.Parallel()
+
+	conformed, err := Conform(f64(255), &Type{Kind: KindU8})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(255), conformed.GetU8Val())
+
+	conformed, err = Conform(f64(-3), &Type{Kind: KindS16})
+	require.NoError(t, err)
+	assert.Equal(t, int32(-3), conformed.GetS16Val())
+
+	conformed, err = Conform(f64(1.5), &Type{Kind: KindF32})
+	require.NoError(t, err)
+	assert.InDelta(t, float32(1.5), conformed.GetF32Val(), 0)
+
+	conformed, err = Conform(text("ß"), &Type{Kind: KindChar})
+	require.NoError(t, err)
+	assert.Equal(t, uint32('ß'), conformed.GetCharVal())
+
+	conformed, err = Conform(
+		list(f64(1), text("a")),
+		&Type{Kind: KindTuple, Items: []*Type{{Kind: KindU32}, {Kind: KindString}}},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), conformed.GetTupleVal().GetValues()[0].GetU32Val())
+
+	conformed, err = Conform(
+		f64(2), &Type{Kind: KindOption, Elem: &Type{Kind: KindU8}},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), conformed.GetOptionVal().GetValue().GetU8Val())
+
+	conformed, err = Conform(
+		text("red"), &Type{Kind: KindEnum, Names: []string{"red", "green"}},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "red", conformed.GetEnumVal())
+
+	conformed, err = Conform(
+		list(text("write")),
+		&Type{Kind: KindFlags, Names: []string{"read", "write"}},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"write"}, conformed.GetFlagsVal().GetFlags())
+}
+
+func TestConformRecord(t *testing.T) {
+	t.Parallel()
+	point := &Type{Kind: KindRecord, Fields: []Field{
+		{"y", &Type{Kind: KindS32}}, {"x", &Type{Kind: KindS32}},
+	}}
+
+	conformed, err := Conform(record(
+		&pb.RecordField{Name: "x", Value: f64(1)},
+		&pb.RecordField{Name: "y", Value: f64(2)},
+	), point)
+	require.NoError(t, err)
+	fields := conformed.GetRecordVal().GetFields()
+	require.Len(t, fields, 2)
+	assert.Equal(t, "y", fields[0].GetName(), "fields are ordered as declared")
+	assert.Equal(t, int32(2), fields[0].GetValue().GetS32Val())
+
+	_, err = Conform(record(&pb.RecordField{Name: "x", Value: f64(1)}), point)
+	require.ErrorIs(t, err, ErrTypeMismatch, "missing field")
+
+	_, err = Conform(record(
+		&pb.RecordField{Name: "x", Value: f64(1)},
+		&pb.RecordField{Name: "y", Value: f64(2)},
+		&pb.RecordField{Name: "z", Value: f64(3)},
+	), point)
+	require.ErrorIs(t, err, ErrTypeMismatch, "unknown field")
+
+	_, err = Conform(record(
+		&pb.RecordField{Name: "x", Value: f64(1)},
+		&pb.RecordField{Name: "y", Value: text("2")},
+	), point)
+	require.ErrorIs(t, err, ErrTypeMismatch)
+	assert.Contains(t, err.Error(), "field y")
+}
+
+func TestConformMismatch(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		value *pb.Val
+		typ   *Type
+	}{
+		{"fraction", f64(1.5), &Type{Kind: KindU8}},
+		{"overflow", f64(256), &Type{Kind: KindU8}},
+		{"negative", f64(-1), &Type{Kind: KindU64}},
+		{"u64 overflow", f64(1 << 64), &Type{Kind: KindU64}},
+		{
+			"typed integer",
+			&pb.Val{Value: &pb.Val_U8Val{U8Val: 1}},
+			&Type{Kind: KindU32},
+		},
+		{"string", text("1"), &Type{Kind: KindS32}},
+		{"chars", text("ab"), &Type{Kind: KindChar}},
+		{
+			"tuple length",
+			list(f64(1)),
+			&Type{Kind: KindTuple, Items: []*Type{{Kind: KindU8}, {Kind: KindU8}}},
+		},
+		{
+			"element",
+			list(f64(1), text("a")),
+			&Type{Kind: KindList, Elem: &Type{Kind: KindF64}},
+		},
+		{"enum case", text("blue"), &Type{Kind: KindEnum, Names: []string{"red"}}},
+		{"flag", list(text("x")), &Type{Kind: KindFlags, Names: []string{"read"}}},
+		{
+			"result payload",
+			&pb.Val{Value: &pb.Val_ResultVal{ResultVal: &pb.ResultVal{IsOk: true}}},
+			&Type{Kind: KindResult, Ok: &Type{Kind: KindString}},
+		},
+		{
+			"variant case",
+			&pb.Val{Value: &pb.Val_VariantVal{VariantVal: &pb.VariantVal{
+				Name: "square",
+			}}},
+			&Type{Kind: KindVariant, Fields: []Field{{Name: "circle"}}},
+		},
+		{"resource", f64(1), &Type{Kind: KindOwn}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Conform(test.value, test.typ)
+			require.ErrorIs(t, err, ErrTypeMismatch)
+		})
+	}
+}
+
+func TestFuncConform(t *testing.T) {
+	t.Parallel()
+	function := &Func{Params: []Param{
+		{"name", &Type{Kind: KindString}},
+		{"count", &Type{Kind: KindU32}},
+	}}
+
+	conformed, err := function.Conform([]*pb.Val{text("a"), f64(3)})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), conformed[1].GetU32Val())
+
+	_, err = function.Conform([]*pb.Val{text("a")})
+	require.ErrorIs(t, err, ErrArity)
+
+	_, err = function.Conform([]*pb.Val{text("a"), text("3")})
+	require.ErrorIs(t, err, ErrTypeMismatch)
+
+	var paramErr *ParamError
+	require.ErrorAs(t, err, &paramErr)
+	assert.Equal(t, 1, paramErr.Index)
+	assert.Equal(t, "count", paramErr.Name)
+}
@@ -0,0 +1,44 @@
+package wit
+
+import (
+	"errors"
+	"strconv"
+)
+
+var (
+	// ErrNotComponent is returned when a binary is not a WebAssembly component
+	ErrNotComponent = errors.New("not a WebAssembly component")
+	// ErrMalformed is returned when a component binary cannot be decoded
+	ErrMalformed = errors.New("malformed component")
+	// ErrUnsupported is returned when a component uses encodings whose types
+	// cannot be decoded
+	ErrUnsupported = errors.New("unsupported component encoding")
+	// ErrInterfaceNotFound is returned when a component does not export an
+	// interface
+	ErrInterfaceNotFound = errors.New("interface not exported")
+	// ErrFunctionNotFound is returned when an interface does not export a
+	// function
+	ErrFunctionNotFound = errors.New("function not exported")
+	// ErrArity is returned when a function is passed the wrong number of
+	// parameters
+	ErrArity = errors.New("wrong number of parameters")
+	// ErrTypeMismatch is returned when a value does not match its type
+	ErrTypeMismatch = errors.New("type mismatch")
+)
+
+// ParamError is returned when a parameter does not match the type of the
+// function parameter.
+type ParamError struct {
+	Index int
+	Name  string
+	Err   error
+}
+
+func (e *ParamError) Error() string {
+	return "parameter " + strconv.Itoa(e.Index) + " (" + e.Name + "): " +
+		e.Err.Error()
+}
+
+func (e *ParamError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,201 @@
+package wit
+
+import "fmt"
+
+// Bits of the payload of a LEB128 byte
+const (
+	lebPayloadBits = 7
+	lebPayload     = 0x7f
+	lebContinue    = 0x80
+	lebSign        = 0x40
+	maxU32Bytes    = 5
+	maxS33Bytes    = 5
+)
+
+// Names of imports and exports may be followed by a version
+const nameVersioned = 0x01
+
+// Flags of optional elements
+const (
+	optionalAbsent  = 0x00
+	optionalPresent = 0x01
+)
+
+// reader reads the encodings of the component binary format. All reads are
+// bounds checked, so that malformed binaries fail with ErrMalformed.
+type reader struct {
+	data []byte
+	pos  int
+}
+
+func (r *reader) done() bool {
+	return r.pos >= len(r.data)
+}
+
+func (r *reader) peek() (byte, error) {
+	if r.done() {
+		return 0, fmt.Errorf("%w: unexpected end", ErrMalformed)
+	}
+
+	return r.data[r.pos], nil
+}
+
+func (r *reader) byte() (byte, error) {
+	b, err := r.peek()
+	if err != nil {
+		return 0, err
+	}
+
+	r.pos++
+
+	return b, nil
+}
+
+func (r *reader) bytes(n int) ([]byte, error) {
+	if n < 0 || n > len(r.data)-r.pos {
+		return nil, fmt.Errorf("%w: unexpected end", ErrMalformed)
+	}
+
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+
+	return b, nil
+}
+
+// u32 reads an unsigned LEB128 integer.
+func (r *reader) u32() (uint32, error) {
+	var value uint64
+	for i := range maxU32Bytes {
+		b, err := r.byte()
+		if err != nil {
+			return 0, err
+		}
+
+		value |= uint64(b&lebPayload) << (lebPayloadBits * i)
+		if b&lebContinue == 0 {
+			if value > uint64(^uint32(0)) {
+				return 0, fmt.Errorf("%w: integer too large", ErrMalformed)
+			}
+
+			return uint32(value), nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: integer too large", ErrMalformed)
+}
+
+// s33 reads a signed LEB128 integer of 33 bits as used for type indices.
+func (r *reader) s33() (int64, error) {
+	var value int64
+	for i := range maxS33Bytes {
+		b, err := r.byte()
+		if err != nil {
+			return 0, err
+		}
+
+		value |= int64(b&lebPayload) << (lebPayloadBits * i)
+		if b&lebContinue == 0 {
+			if b&lebSign != 0 {
+				value |= -1 << (lebPayloadBits * (i + 1))
+			}
+
+			return value, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: integer too large", ErrMalformed)
+}
+
+// str reads a length prefixed UTF-8 string.
+func (r *reader) str() (string, error) {
+	length, err := r.u32()
+	if err != nil {
+		return "", err
+	}
+
+	b, err := r.bytes(int(length))
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// name reads the name of an import or export. Names may carry a version
+// suffix, which is skipped.
+func (r *reader) name() (string, error) {
+	kind, err := r.byte()
+	if err != nil {
+		return "", err
+	}
+
+	name, err := r.str()
+	if err != nil {
+		return "", err
+	}
+
+	if kind == nameVersioned {
+		_, err = r.str()
+	}
+
+	return name, err
+}
+
+// sort reads the sort of an item, the kind of core items is skipped.
+func (r *reader) sort() (byte, error) {
+	sort, err := r.byte()
+	if err != nil {
+		return 0, err
+	}
+
+	if sort == sortCore {
+		_, err = r.byte()
+	}
+
+	return sort, err
+}
+
+func (r *reader) sortIndex() (sort byte, index uint32, err error) {
+	sort, err = r.sort()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	index, err = r.u32()
+
+	return sort, index, err
+}
+
+// each reads a vector, calling read for each of its elements.
+func (r *reader) each(read func(r *reader) error) error {
+	count, err := r.u32()
+	if err != nil {
+		return err
+	}
+
+	for range count {
+		err = read(r)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// optional reads an optional element, calling read if it is present.
+func (r *reader) optional(read func(r *reader) error) error {
+	present, err := r.byte()
+	if err != nil {
+		return err
+	}
+
+	switch present {
+	case optionalAbsent:
+		return nil
+	case optionalPresent:
+		return read(r)
+	default:
+		return fmt.Errorf("%w: optional 0x%02x", ErrMalformed, present)
+	}
+}
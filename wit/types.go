@@ -0,0 +1,94 @@
+package wit
+
+import "strings"
+
+// Kind of a WIT value type
+type Kind string
+
+const (
+	KindBool    Kind = "bool"
+	KindS8      Kind = "s8"
+	KindU8      Kind = "u8"
+	KindS16     Kind = "s16"
+	KindU16     Kind = "u16"
+	KindS32     Kind = "s32"
+	KindU32     Kind = "u32"
+	KindS64     Kind = "s64"
+	KindU64     Kind = "u64"
+	KindF32     Kind = "f32"
+	KindF64     Kind = "f64"
+	KindChar    Kind = "char"
+	KindString  Kind = "string"
+	KindList    Kind = "list"
+	KindTuple   Kind = "tuple"
+	KindOption  Kind = "option"
+	KindResult  Kind = "result"
+	KindRecord  Kind = "record"
+	KindVariant Kind = "variant"
+	KindEnum    Kind = "enum"
+	KindFlags   Kind = "flags"
+	KindOwn     Kind = "own"
+	KindBorrow  Kind = "borrow"
+)
+
+// Type is a WIT value type.
+type Type struct {
+	Kind Kind
+	// Element type of lists and options
+	Elem *Type
+	// Item types of tuples
+	Items []*Type
+	// Fields of records and cases of variants. Cases without payload have no
+	// type.
+	Fields []Field
+	// Cases of enums and flags
+	Names []string
+	// Payload types of results, nil if the case has no payload
+	Ok, Err *Type
+}
+
+// Field is a named field of a record or a case of a variant.
+type Field struct {
+	Name string
+	Type *Type
+}
+
+// Param is a named parameter of a function.
+type Param struct {
+	Name string
+	Type *Type
+}
+
+// Func is the signature of a function.
+type Func struct {
+	Params []Param
+}
+
+// String returns the WIT notation of the type.
+func (t *Type) String() string {
+	switch t.Kind {
+	case KindList:
+		return "list<" + t.Elem.String() + ">"
+	case KindOption:
+		return "option<" + t.Elem.String() + ">"
+	case KindTuple:
+		items := make([]string, len(t.Items))
+		for i, item := range t.Items {
+			items[i] = item.String()
+		}
+
+		return "tuple<" + strings.Join(items, ", ") + ">"
+	case KindResult:
+		return "result<" + payloadString(t.Ok) + ", " + payloadString(t.Err) + ">"
+	default:
+		return string(t.Kind)
+	}
+}
+
+func payloadString(t *Type) string {
+	if t == nil {
+		return "_"
+	}
+
+	return t.String()
+}
@@ -0,0 +1,399 @@
+package workflow
+
+import (
+	"api-server/config"
+	"api-server/orm"
+	pb "api-server/proto_gen"
+	"api-server/queue"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+)
+
+// Number of running workflows or task maps loaded at once
+const runningPageSize = 100
+
+// errConcurrentUpdate is returned when a node was updated by another instance
+// in the meantime. The workflow is advanced again on the next poll.
+var errConcurrentUpdate = errors.New("workflow node was updated concurrently")
+
+// Engine advances all running workflows and task maps. Whenever the task of a
+// node finishes, its result is stored and all nodes whose predecessors
+// completed are enqueued with the results of their predecessors as leading
+// parameters.
+// Nodes depending on a failed node are skipped. The elements of a task map are
+// enqueued as long as fewer than its concurrency are in flight.
+//
+// The task of a node is enqueued with an id derived from the workflow and the
+// node name and nodes are only updated if they are still in the state they
+// were read in, so multiple instances of the api-server can run an engine.
+type Engine struct {
+	db           orm.DB
+	queueClient  queue.QueueClient
+	pollInterval time.Duration
+}
+
+func NewEngine(
+	cfg *config.AppConfig,
+	db orm.DB,
+	queueClient queue.QueueClient,
+) *Engine {
+	pollInterval, err := time.ParseDuration(cfg.Workflow.PollInterval)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Failed to parse workflow poll interval (invalid format)")
+	}
+
+	return &Engine{
+		db:           db,
+		queueClient:  queueClient,
+		pollInterval: pollInterval,
+	}
+}
+
+// NodeTaskID returns the id of the task enqueued for a node of a workflow.
+func NodeTaskID(workflowID uuid.UUID, node string) string {
+	return uuid.NewSHA1(workflowID, []byte(node)).String()
+}
+
+// Run advances the running workflows and task maps until the context is
+// canceled.
+func (e *Engine) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			e.advanceAll(ctx)
+			e.advanceMaps(ctx)
+		}
+	}
+}
+
+// advanceAll advances the running workflows page by page.
+func (e *Engine) advanceAll(ctx context.Context) {
+	afterID := uuid.Nil
+	for {
+		workflows, err := e.db.GetRunningWorkflows(
+			ctx,
+			afterID,
+			runningPageSize,
+		)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to load running workflows")
+
+			return
+		}
+
+		for i := range workflows {
+			err := e.advance(ctx, &workflows[i])
+			if err != nil && !errors.Is(err, errConcurrentUpdate) {
+				log.Error().
+					Err(err).
+					Str("id", workflows[i].ID.String()).
+					Msg("Failed to advance workflow")
+			}
+		}
+
+		if len(workflows) < runningPageSize {
+			return
+		}
+
+		afterID = workflows[len(workflows)-1].ID
+	}
+}
+
+func (e *Engine) advance(ctx context.Context, workflow *orm.Workflow) error {
+	nodes, err := e.db.GetWorkflowNodeStates(ctx, workflow.ID)
+	if err != nil {
+		return fmt.Errorf("failed to load nodes: %w", err)
+	}
+
+	edges, err := e.db.GetWorkflowEdges(ctx, workflow.ID)
+	if err != nil {
+		return fmt.Errorf("failed to load edges: %w", err)
+	}
+
+	byName := make(map[string]*orm.WorkflowNode, len(nodes))
+	for i := range nodes {
+		byName[nodes[i].Name] = &nodes[i]
+	}
+
+	for i := range nodes {
+		if nodes[i].State != orm.WorkflowNodeStateEnqueued {
+			continue
+		}
+
+		err := e.collect(ctx, &nodes[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	ready, skipped := plan(nodes, edges)
+	for _, name := range skipped {
+		node := byName[name]
+		err := e.update(ctx, node, func() {
+			node.State = orm.WorkflowNodeStateSkipped
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, name := range ready {
+		err := e.start(ctx, workflow, byName, edges, byName[name])
+		if err != nil {
+			return err
+		}
+	}
+
+	state := workflowState(nodes)
+	if state == orm.WorkflowStateRunning {
+		return nil
+	}
+
+	err = e.db.SetWorkflowState(ctx, workflow.ID, state)
+	if err != nil {
+		return fmt.Errorf("failed to store workflow state: %w", err)
+	}
+
+	log.Debug().
+		Str("id", workflow.ID.String()).
+		Str("state", state).
+		Msg("Workflow finished")
+
+	return nil
+}
+
+// outcome is the final state of a task with its result or its last error.
+type outcome struct {
+	finished  bool
+	completed bool
+	result    []byte
+	lastError string
+}
+
+// taskOutcome looks up the task in the queue and in the task history if the
+// queue already dropped it. Tasks replaced by a copy with reset retries are
+// followed to their copy.
+func (e *Engine) taskOutcome(ctx context.Context, id string) (outcome, error) {
+	var state, lastError string
+	var result []byte
+
+	taskInfo, err := e.queueClient.GetTask(id)
+	switch {
+	case err == nil:
+		state = queue.ReportedState(taskInfo.State.String(), taskInfo.Result)
+		lastError = taskInfo.LastErr
+		result = taskInfo.Result
+	case errors.Is(err, &queue.TaskNotFoundError{}):
+		record, err := e.db.GetTask(ctx, id)
+		if err != nil {
+			var errNotFound *orm.NotFoundError
+			if !errors.As(err, &errNotFound) {
+				return outcome{}, fmt.Errorf("failed to load task: %w", err)
+			}
+
+			state = orm.TaskStateExpired
+			lastError = "task was deleted"
+		} else if record.RequeuedAs != "" {
+			return e.taskOutcome(ctx, record.RequeuedAs)
+		} else {
+			state = record.State
+			lastError = record.LastError
+			result = record.Result
+		}
+	default:
+		return outcome{}, fmt.Errorf("failed to load task: %w", err)
+	}
+
+	if !queue.IsFinalState(state) {
+		return outcome{}, nil
+	}
+
+	if lastError == "" {
+		lastError = "task reached state " + state
+	}
+
+	return outcome{
+		finished:  true,
+		completed: state == asynq.TaskStateCompleted.String(),
+		result:    result,
+		lastError: lastError,
+	}, nil
+}
+
+// collect stores the outcome of the task of an enqueued node once the task
+// reached a final state.
+func (e *Engine) collect(ctx context.Context, node *orm.WorkflowNode) error {
+	taskOutcome, err := e.taskOutcome(ctx, node.TaskID)
+	if err != nil || !taskOutcome.finished {
+		return err
+	}
+
+	return e.update(ctx, node, func() {
+		if taskOutcome.completed {
+			node.State = orm.WorkflowNodeStateCompleted
+			node.Result = taskOutcome.result
+
+			return
+		}
+
+		node.State = orm.WorkflowNodeStateFailed
+		node.Error = taskOutcome.lastError
+	})
+}
+
+// start enqueues the task of a node whose predecessors all completed.
+func (e *Engine) start(
+	ctx context.Context,
+	workflow *orm.Workflow,
+	byName map[string]*orm.WorkflowNode,
+	edges []orm.WorkflowEdge,
+	node *orm.WorkflowNode,
+) error {
+	template, err := e.db.GetWorkflowNodeTask(ctx, workflow.ID, node.Name)
+	if err != nil {
+		return fmt.Errorf("failed to load task of node: %w", err)
+	}
+
+	var task pb.Task
+	err = proto.Unmarshal(template, &task)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal task of node: %w", err)
+	}
+
+	// Edges are ordered by position
+	parameters := []*pb.Val{}
+	for _, edge := range edges {
+		if edge.ToNode != node.Name {
+			continue
+		}
+
+		var value pb.Val
+		err := proto.Unmarshal(byName[edge.FromNode].Result, &value)
+		if err != nil {
+			return e.update(ctx, node, func() {
+				node.State = orm.WorkflowNodeStateFailed
+				node.Error = fmt.Sprintf(
+					"result of node %s is not a value: %s",
+					edge.FromNode,
+					err.Error(),
+				)
+			})
+		}
+
+		parameters = append(parameters, &value)
+	}
+	task.Parameters = append(parameters, task.Parameters...)
+
+	taskID := NodeTaskID(workflow.ID, node.Name)
+	opts, err := taskOptions(
+		taskID,
+		node.Queue,
+		node.Retries,
+		node.Retention,
+		node.Timeout,
+	)
+	if err != nil {
+		return fmt.Errorf("invalid options of node %s: %w", node.Name, err)
+	}
+
+	err = e.enqueue(ctx, &task, workflow.CreatedBy, opts...)
+	if err != nil {
+		return err
+	}
+
+	return e.update(ctx, node, func() {
+		node.State = orm.WorkflowNodeStateEnqueued
+		node.TaskID = taskID
+	})
+}
+
+// enqueue enqueues a task with a fixed id. A task enqueued before, e.g. by
+// another instance, is not enqueued again.
+func (e *Engine) enqueue(
+	ctx context.Context,
+	task *pb.Task,
+	submittedBy string,
+	opts ...asynq.Option,
+) error {
+	_, err := e.queueClient.EnqueueTask(
+		ctx,
+		task,
+		queue.TaskMetadata{SubmittedBy: submittedBy},
+		opts...,
+	)
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	return nil
+}
+
+// update applies change to a node and stores it unless another instance
+// updated the node in the meantime.
+func (e *Engine) update(
+	ctx context.Context,
+	node *orm.WorkflowNode,
+	change func(),
+) error {
+	previousState := node.State
+	change()
+
+	updated, err := e.db.UpdateWorkflowNode(ctx, node, previousState)
+	if err != nil {
+		return fmt.Errorf("failed to update node %s: %w", node.Name, err)
+	}
+
+	if !updated {
+		return errConcurrentUpdate
+	}
+
+	return nil
+}
+
+// taskOptions returns the options of a stored task enqueued with the given id.
+func taskOptions(
+	taskID, queueName string,
+	retries int,
+	retention, timeout string,
+) ([]asynq.Option, error) {
+	retentionDuration, err := time.ParseDuration(retention)
+	if err != nil {
+		return nil, fmt.Errorf("invalid retention: %w", err)
+	}
+
+	opts := []asynq.Option{
+		asynq.TaskID(taskID),
+		asynq.Queue(queueName),
+		asynq.MaxRetry(retries),
+		asynq.Retention(retentionDuration),
+	}
+
+	if timeout != "" {
+		timeoutDuration, err := time.ParseDuration(timeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timeout: %w", err)
+		}
+
+		opts = append(opts, asynq.Timeout(timeoutDuration))
+	}
+
+	return opts, nil
+}
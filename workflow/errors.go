@@ -0,0 +1,25 @@
+package workflow
+
+import "errors"
+
+var (
+	// ErrNoNodes is returned when a workflow does not contain any node
+	ErrNoNodes = errors.New("workflow must contain at least one node")
+	// ErrNodeNameRequired is returned when a node has an empty name
+	ErrNodeNameRequired = errors.New("node name must not be empty")
+	// ErrDuplicateNode is returned when two nodes share the same name
+	ErrDuplicateNode = errors.New("duplicate node name")
+	// ErrUnknownDependency is returned when a node depends on a node that is
+	// not part of the workflow
+	ErrUnknownDependency = errors.New("unknown dependency")
+	// ErrDuplicateDependency is returned when a node lists a dependency twice
+	ErrDuplicateDependency = errors.New("duplicate dependency")
+	// ErrCycle is returned when the dependencies of a workflow form a cycle
+	ErrCycle = errors.New("workflow dependencies contain a cycle")
+	// ErrMapParameterMissing is returned when the parameter a task map is
+	// mapped over does not exist
+	ErrMapParameterMissing = errors.New("mapped parameter does not exist")
+	// ErrMapParameterNotList is returned when the parameter a task map is
+	// mapped over is not a list
+	ErrMapParameterNotList = errors.New("mapped parameter is not a list")
+)
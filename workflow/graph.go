@@ -0,0 +1,180 @@
+package workflow
+
+import (
+	"api-server/orm"
+	"fmt"
+	"slices"
+)
+
+// Node is a node of a workflow together with the names of the nodes whose
+// results it receives, in the order of its parameters.
+type Node struct {
+	Name      string
+	DependsOn []string
+}
+
+// CheckGraph verifies that the nodes of a workflow form a directed acyclic
+// graph with uniquely named nodes.
+func CheckGraph(nodes []Node) error {
+	if len(nodes) == 0 {
+		return ErrNoNodes
+	}
+
+	// Number of unfinished dependencies per node
+	pending := make(map[string]int, len(nodes))
+	for _, node := range nodes {
+		if node.Name == "" {
+			return ErrNodeNameRequired
+		}
+
+		if _, ok := pending[node.Name]; ok {
+			return fmt.Errorf("%w: %s", ErrDuplicateNode, node.Name)
+		}
+
+		pending[node.Name] = len(node.DependsOn)
+	}
+
+	successors := map[string][]string{}
+	for _, node := range nodes {
+		for i, dependency := range node.DependsOn {
+			if _, ok := pending[dependency]; !ok {
+				return fmt.Errorf(
+					"%w %s of node %s",
+					ErrUnknownDependency,
+					dependency,
+					node.Name,
+				)
+			}
+
+			if slices.Contains(node.DependsOn[:i], dependency) {
+				return fmt.Errorf(
+					"%w %s of node %s",
+					ErrDuplicateDependency,
+					dependency,
+					node.Name,
+				)
+			}
+
+			successors[dependency] = append(successors[dependency], node.Name)
+		}
+	}
+
+	// Remove nodes without unfinished dependencies until none are left
+	ready := []string{}
+	for _, node := range nodes {
+		if pending[node.Name] == 0 {
+			ready = append(ready, node.Name)
+		}
+	}
+
+	visited := 0
+	for len(ready) > 0 {
+		name := ready[len(ready)-1]
+		ready = ready[:len(ready)-1]
+		visited++
+
+		for _, successor := range successors[name] {
+			pending[successor]--
+			if pending[successor] == 0 {
+				ready = append(ready, successor)
+			}
+		}
+	}
+
+	if visited != len(nodes) {
+		return ErrCycle
+	}
+
+	return nil
+}
+
+// Edges returns the edges passing the results of the dependencies of a node
+// to it.
+func Edges(node Node) []orm.WorkflowEdge {
+	edges := make([]orm.WorkflowEdge, len(node.DependsOn))
+	for i, dependency := range node.DependsOn {
+		edges[i] = orm.WorkflowEdge{
+			FromNode: dependency,
+			ToNode:   node.Name,
+			Position: i,
+		}
+	}
+
+	return edges
+}
+
+// plan returns the waiting nodes whose predecessors all completed and the
+// waiting nodes that can never run because a predecessor failed or was
+// skipped. Both are ordered like the given nodes.
+func plan(
+	nodes []orm.WorkflowNode,
+	edges []orm.WorkflowEdge,
+) (ready, skipped []string) {
+	states := make(map[string]string, len(nodes))
+	for i := range nodes {
+		states[nodes[i].Name] = nodes[i].State
+	}
+
+	predecessors := map[string][]string{}
+	for _, edge := range edges {
+		predecessors[edge.ToNode] = append(
+			predecessors[edge.ToNode],
+			edge.FromNode,
+		)
+	}
+
+	blocked := func(name string) bool {
+		return slices.ContainsFunc(predecessors[name], func(from string) bool {
+			return states[from] == orm.WorkflowNodeStateFailed ||
+				states[from] == orm.WorkflowNodeStateSkipped
+		})
+	}
+
+	// Skipping a node blocks its successors as well
+	for changed := true; changed; {
+		changed = false
+		for i := range nodes {
+			name := nodes[i].Name
+			if states[name] == orm.WorkflowNodeStateWaiting && blocked(name) {
+				states[name] = orm.WorkflowNodeStateSkipped
+				skipped = append(skipped, name)
+				changed = true
+			}
+		}
+	}
+
+	for i := range nodes {
+		name := nodes[i].Name
+		if states[name] != orm.WorkflowNodeStateWaiting {
+			continue
+		}
+
+		if !slices.ContainsFunc(predecessors[name], func(from string) bool {
+			return states[from] != orm.WorkflowNodeStateCompleted
+		}) {
+			ready = append(ready, name)
+		}
+	}
+
+	return ready, skipped
+}
+
+// workflowState derives the state of a workflow from the states of its nodes.
+func workflowState(nodes []orm.WorkflowNode) string {
+	completed := true
+	for i := range nodes {
+		switch nodes[i].State {
+		case orm.WorkflowNodeStateWaiting, orm.WorkflowNodeStateEnqueued:
+			return orm.WorkflowStateRunning
+		case orm.WorkflowNodeStateCompleted:
+		default:
+			completed = false
+		}
+	}
+
+	if completed {
+		return orm.WorkflowStateCompleted
+	}
+
+	return orm.WorkflowStateFailed
+}
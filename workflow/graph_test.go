@@ -0,0 +1,138 @@
+package workflow
+
+import (
+	"api-server/orm"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Diamond shaped workflow: a feeds b and c, d needs both
+var diamond = []Node{
+	{Name: "a"},
+	{Name: "b", DependsOn: []string{"a"}},
+	{Name: "c", DependsOn: []string{"a"}},
+	{Name: "d", DependsOn: []string{"b", "c"}},
+}
+
+func TestCheckGraph(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, CheckGraph(diamond))
+
+	tests := []struct {
+		name  string
+		nodes []Node
+		err   error
+	}{
+		{"empty", []Node{}, ErrNoNodes},
+		{"unnamed", []Node{{}}, ErrNodeNameRequired},
+		{"duplicate", []Node{{Name: "a"}, {Name: "a"}}, ErrDuplicateNode},
+		{
+			"unknown dependency",
+			[]Node{{Name: "a", DependsOn: []string{"z"}}},
+			ErrUnknownDependency,
+		},
+		{
+			"duplicate dependency",
+			[]Node{{Name: "a"}, {Name: "b", DependsOn: []string{"a", "a"}}},
+			ErrDuplicateDependency,
+		},
+		{
+			"self loop",
+			[]Node{{Name: "a", DependsOn: []string{"a"}}},
+			ErrCycle,
+		},
+		{
+			"cycle",
+			[]Node{
+				{Name: "a"},
+				{Name: "b", DependsOn: []string{"a", "c"}},
+				{Name: "c", DependsOn: []string{"b"}},
+			},
+			ErrCycle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.ErrorIs(t, CheckGraph(tt.nodes), tt.err)
+		})
+	}
+}
+
+func diamondNodes(states ...string) ([]orm.WorkflowNode, []orm.WorkflowEdge) {
+	nodes := make([]orm.WorkflowNode, len(diamond))
+	edges := make([]orm.WorkflowEdge, 0, len(diamond))
+	for i, node := range diamond {
+		nodes[i] = orm.WorkflowNode{Name: node.Name, State: states[i]}
+		edges = append(edges, Edges(node)...)
+	}
+
+	return nodes, edges
+}
+
+func TestPlan(t *testing.T) {
+	t.Parallel()
+	waiting := orm.WorkflowNodeStateWaiting
+	completed := orm.WorkflowNodeStateCompleted
+
+	ready, skipped := plan(diamondNodes(waiting, waiting, waiting, waiting))
+	assert.Equal(t, []string{"a"}, ready)
+	assert.Empty(t, skipped)
+
+	ready, skipped = plan(diamondNodes(completed, waiting, waiting, waiting))
+	assert.Equal(t, []string{"b", "c"}, ready)
+	assert.Empty(t, skipped)
+
+	ready, _ = plan(diamondNodes(
+		completed,
+		completed,
+		orm.WorkflowNodeStateEnqueued,
+		waiting,
+	))
+	assert.Empty(t, ready, "d waits for c")
+
+	ready, skipped = plan(diamondNodes(
+		orm.WorkflowNodeStateFailed,
+		waiting,
+		waiting,
+		waiting,
+	))
+	assert.Empty(t, ready)
+	assert.ElementsMatch(t, []string{"b", "c", "d"}, skipped)
+}
+
+func TestWorkflowState(t *testing.T) {
+	t.Parallel()
+	completed := orm.WorkflowNodeStateCompleted
+
+	nodes, _ := diamondNodes(completed, completed, completed, completed)
+	assert.Equal(t, orm.WorkflowStateCompleted, workflowState(nodes))
+
+	nodes, _ = diamondNodes(
+		completed,
+		orm.WorkflowNodeStateFailed,
+		completed,
+		orm.WorkflowNodeStateSkipped,
+	)
+	assert.Equal(t, orm.WorkflowStateFailed, workflowState(nodes))
+
+	nodes, _ = diamondNodes(
+		completed,
+		orm.WorkflowNodeStateFailed,
+		orm.WorkflowNodeStateEnqueued,
+		orm.WorkflowNodeStateSkipped,
+	)
+	assert.Equal(t, orm.WorkflowStateRunning, workflowState(nodes))
+}
+
+func TestEdges(t *testing.T) {
+	t.Parallel()
+	edges := Edges(diamond[3])
+
+	require.Len(t, edges, 2)
+	assert.Equal(t, orm.WorkflowEdge{FromNode: "b", ToNode: "d"}, edges[0])
+	assert.Equal(t, 1, edges[1].Position)
+}
@@ -0,0 +1,331 @@
+package workflow
+
+import (
+	"api-server/orm"
+	pb "api-server/proto_gen"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+)
+
+// MapElementTaskID returns the id of the task enqueued for an element of a task
+// map.
+func MapElementTaskID(mapID uuid.UUID, index int) string {
+	return uuid.NewSHA1(mapID, []byte(strconv.Itoa(index))).String()
+}
+
+// ListParameter returns the elements of the list passed as the given parameter
+// of a task.
+func ListParameter(task *pb.Task, parameter int) ([]*pb.Val, error) {
+	if parameter < 0 || parameter >= len(task.GetParameters()) {
+		return nil, fmt.Errorf("%w: %d", ErrMapParameterMissing, parameter)
+	}
+
+	list, ok := task.GetParameters()[parameter].GetValue().(*pb.Val_ListVal)
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrMapParameterNotList, parameter)
+	}
+
+	return list.ListVal.GetValues(), nil
+}
+
+// advanceMaps advances the running task maps page by page.
+func (e *Engine) advanceMaps(ctx context.Context) {
+	afterID := uuid.Nil
+	for {
+		taskMaps, err := e.db.GetRunningTaskMaps(ctx, afterID, runningPageSize)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to load running task maps")
+
+			return
+		}
+
+		for i := range taskMaps {
+			err := e.advanceMap(ctx, &taskMaps[i])
+			if err != nil && !errors.Is(err, errConcurrentUpdate) {
+				log.Error().
+					Err(err).
+					Str("id", taskMaps[i].ID.String()).
+					Msg("Failed to advance task map")
+			}
+		}
+
+		if len(taskMaps) < runningPageSize {
+			return
+		}
+
+		afterID = taskMaps[len(taskMaps)-1].ID
+	}
+}
+
+// advanceMap collects the elements in flight and enqueues waiting ones up to
+// the concurrency of the map. Only the elements in flight and those about to
+// be enqueued are loaded, the others are only counted.
+func (e *Engine) advanceMap(ctx context.Context, taskMap *orm.TaskMap) error {
+	enqueued, err := e.db.GetTaskMapElementsInState(
+		ctx,
+		taskMap.ID,
+		orm.TaskMapElementStateEnqueued,
+		taskMap.Concurrency,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to load enqueued elements: %w", err)
+	}
+
+	for i := range enqueued {
+		err := e.collectElement(ctx, &enqueued[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	states, err := e.db.CountTaskMapElementStates(ctx, taskMap.ID)
+	if err != nil {
+		return fmt.Errorf("failed to count elements: %w", err)
+	}
+
+	slots, state := mapPlan(states, taskMap.Total, taskMap.Concurrency)
+	switch state {
+	case orm.TaskMapStateCompleted:
+		return e.completeMap(ctx, taskMap)
+	case orm.TaskMapStateFailed:
+		return e.failMap(ctx, taskMap)
+	}
+
+	if slots == 0 {
+		return nil
+	}
+
+	waiting, err := e.db.GetTaskMapElementsInState(
+		ctx,
+		taskMap.ID,
+		orm.TaskMapElementStateWaiting,
+		slots,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to load waiting elements: %w", err)
+	}
+
+	var template pb.Task
+	err = proto.Unmarshal(taskMap.Task, &template)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal task of map: %w", err)
+	}
+
+	list, err := ListParameter(&template, taskMap.Parameter)
+	if err != nil {
+		return err
+	}
+
+	for i := range waiting {
+		err := e.startElement(ctx, taskMap, &template, list, &waiting[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// collectElement stores the outcome of the task of an enqueued element once
+// the task reached a final state.
+func (e *Engine) collectElement(
+	ctx context.Context,
+	element *orm.TaskMapElement,
+) error {
+	taskOutcome, err := e.taskOutcome(ctx, element.TaskID)
+	if err != nil || !taskOutcome.finished {
+		return err
+	}
+
+	return e.updateElement(ctx, element, func() {
+		if taskOutcome.completed {
+			element.State = orm.TaskMapElementStateCompleted
+			element.Result = taskOutcome.result
+
+			return
+		}
+
+		element.State = orm.TaskMapElementStateFailed
+		element.Error = taskOutcome.lastError
+	})
+}
+
+// startElement enqueues the task processing a single element of the list.
+func (e *Engine) startElement(
+	ctx context.Context,
+	taskMap *orm.TaskMap,
+	template *pb.Task,
+	list []*pb.Val,
+	element *orm.TaskMapElement,
+) error {
+	if element.Index >= len(list) {
+		return fmt.Errorf("%w: %d", ErrMapParameterMissing, element.Index)
+	}
+
+	task := proto.CloneOf(template)
+	task.Parameters[taskMap.Parameter] = list[element.Index]
+
+	taskID := MapElementTaskID(taskMap.ID, element.Index)
+	opts, err := taskOptions(
+		taskID,
+		taskMap.Queue,
+		taskMap.Retries,
+		taskMap.Retention,
+		taskMap.Timeout,
+	)
+	if err != nil {
+		return fmt.Errorf("invalid options of task map: %w", err)
+	}
+
+	err = e.enqueue(ctx, task, taskMap.CreatedBy, opts...)
+	if err != nil {
+		return err
+	}
+
+	return e.updateElement(ctx, element, func() {
+		element.State = orm.TaskMapElementStateEnqueued
+		element.TaskID = taskID
+	})
+}
+
+// completeMap collects the results of all elements into a list in the order
+// of the elements.
+func (e *Engine) completeMap(ctx context.Context, taskMap *orm.TaskMap) error {
+	elements, err := e.db.GetTaskMapElements(ctx, taskMap.ID)
+	if err != nil {
+		return fmt.Errorf("failed to load elements: %w", err)
+	}
+
+	result, err := collectResults(elements)
+	if err != nil {
+		taskMap.State = orm.TaskMapStateFailed
+		taskMap.Error = err.Error()
+	} else {
+		taskMap.State = orm.TaskMapStateCompleted
+		taskMap.Result = result
+	}
+
+	err = e.db.FinishTaskMap(ctx, taskMap)
+	if err != nil {
+		return fmt.Errorf("failed to store task map result: %w", err)
+	}
+
+	return nil
+}
+
+// failMap skips all elements that were not enqueued yet and marks the map as
+// failed with the error of its first failed element. Elements that are
+// already in flight are not canceled.
+func (e *Engine) failMap(ctx context.Context, taskMap *orm.TaskMap) error {
+	err := e.db.SkipWaitingTaskMapElements(ctx, taskMap.ID)
+	if err != nil {
+		return fmt.Errorf("failed to skip waiting elements: %w", err)
+	}
+
+	failed, err := e.db.GetTaskMapElementsInState(
+		ctx,
+		taskMap.ID,
+		orm.TaskMapElementStateFailed,
+		1,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to load failed element: %w", err)
+	}
+
+	if len(failed) > 0 {
+		taskMap.Error = fmt.Sprintf(
+			"element %d failed: %s",
+			failed[0].Index,
+			failed[0].Error,
+		)
+	}
+
+	taskMap.State = orm.TaskMapStateFailed
+
+	err = e.db.FinishTaskMap(ctx, taskMap)
+	if err != nil {
+		return fmt.Errorf("failed to store task map state: %w", err)
+	}
+
+	return nil
+}
+
+// updateElement applies change to an element and stores it unless another
+// instance updated the element in the meantime.
+func (e *Engine) updateElement(
+	ctx context.Context,
+	element *orm.TaskMapElement,
+	change func(),
+) error {
+	previousState := element.State
+	change()
+
+	updated, err := e.db.UpdateTaskMapElement(ctx, element, previousState)
+	if err != nil {
+		return fmt.Errorf("failed to update element %d: %w", element.Index, err)
+	}
+
+	if !updated {
+		return errConcurrentUpdate
+	}
+
+	return nil
+}
+
+// mapPlan returns the number of waiting elements to enqueue without exceeding
+// the concurrency and the state of the map, given the number of its elements
+// per state. A map fails as soon as one of its elements failed.
+func mapPlan(
+	states map[string]int,
+	total int,
+	concurrency int,
+) (slots int, state string) {
+	if states[orm.TaskMapElementStateFailed] > 0 ||
+		states[orm.TaskMapElementStateSkipped] > 0 {
+		return 0, orm.TaskMapStateFailed
+	}
+
+	if states[orm.TaskMapElementStateCompleted] == total {
+		return 0, orm.TaskMapStateCompleted
+	}
+
+	slots = min(
+		concurrency-states[orm.TaskMapElementStateEnqueued],
+		states[orm.TaskMapElementStateWaiting],
+	)
+
+	return max(slots, 0), orm.TaskMapStateRunning
+}
+
+// collectResults returns the serialized list of the results of all elements.
+func collectResults(elements []orm.TaskMapElement) ([]byte, error) {
+	values := make([]*pb.Val, len(elements))
+	for i := range elements {
+		var value pb.Val
+		err := proto.Unmarshal(elements[i].Result, &value)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"result of element %d is not a value: %w",
+				elements[i].Index,
+				err,
+			)
+		}
+
+		values[i] = &value
+	}
+
+	result, err := proto.Marshal(&pb.Val{
+		Value: &pb.Val_ListVal{ListVal: &pb.ListVal{Values: values}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal result list: %w", err)
+	}
+
+	return result, nil
+}
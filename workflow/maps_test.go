@@ -0,0 +1,108 @@
+package workflow
+
+import (
+	"api-server/orm"
+	pb "api-server/proto_gen"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func elementsInStates(states ...string) []orm.TaskMapElement {
+	elements := make([]orm.TaskMapElement, len(states))
+	for i, state := range states {
+		elements[i] = orm.TaskMapElement{Index: i, State: state}
+	}
+
+	return elements
+}
+
+func TestMapPlan(t *testing.T) {
+	t.Parallel()
+	waiting := orm.TaskMapElementStateWaiting
+	enqueued := orm.TaskMapElementStateEnqueued
+	completed := orm.TaskMapElementStateCompleted
+
+	slots, state := mapPlan(map[string]int{waiting: 4}, 4, 2)
+	assert.Equal(t, 2, slots)
+	assert.Equal(t, orm.TaskMapStateRunning, state)
+
+	slots, state = mapPlan(
+		map[string]int{completed: 1, enqueued: 1, waiting: 2},
+		4,
+		2,
+	)
+	assert.Equal(t, 1, slots, "in-flight elements count against the cap")
+	assert.Equal(t, orm.TaskMapStateRunning, state)
+
+	slots, state = mapPlan(map[string]int{completed: 2, waiting: 1}, 3, 2)
+	assert.Equal(t, 1, slots, "no more than the waiting elements are started")
+	assert.Equal(t, orm.TaskMapStateRunning, state)
+
+	slots, state = mapPlan(
+		map[string]int{
+			completed:                     1,
+			orm.TaskMapElementStateFailed: 1,
+			waiting:                       1,
+		},
+		3,
+		2,
+	)
+	assert.Zero(t, slots)
+	assert.Equal(t, orm.TaskMapStateFailed, state)
+
+	slots, state = mapPlan(map[string]int{completed: 2}, 2, 1)
+	assert.Zero(t, slots)
+	assert.Equal(t, orm.TaskMapStateCompleted, state)
+
+	_, state = mapPlan(map[string]int{}, 0, 1)
+	assert.Equal(t, orm.TaskMapStateCompleted, state, "empty maps complete")
+}
+
+func TestCollectResults(t *testing.T) {
+	t.Parallel()
+	elements := elementsInStates(
+		orm.TaskMapElementStateCompleted,
+		orm.TaskMapElementStateCompleted,
+	)
+	for i := range elements {
+		result, err := proto.Marshal(&pb.Val{
+			Value: &pb.Val_S64Val{S64Val: int64(i * 10)},
+		})
+		require.NoError(t, err)
+		elements[i].Result = result
+	}
+
+	result, err := collectResults(elements)
+	require.NoError(t, err)
+
+	var list pb.Val
+	require.NoError(t, proto.Unmarshal(result, &list))
+	values := list.GetListVal().GetValues()
+	require.Len(t, values, 2)
+	assert.Equal(t, int64(0), values[0].GetS64Val())
+	assert.Equal(t, int64(10), values[1].GetS64Val())
+}
+
+func TestListParameter(t *testing.T) {
+	t.Parallel()
+	task := &pb.Task{Parameters: []*pb.Val{
+		{Value: &pb.Val_StringVal{StringVal: "prefix"}},
+		{Value: &pb.Val_ListVal{ListVal: &pb.ListVal{Values: []*pb.Val{
+			{Value: &pb.Val_StringVal{StringVal: "a"}},
+			{Value: &pb.Val_StringVal{StringVal: "b"}},
+		}}}},
+	}}
+
+	list, err := ListParameter(task, 1)
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+
+	_, err = ListParameter(task, 0)
+	require.ErrorIs(t, err, ErrMapParameterNotList)
+
+	_, err = ListParameter(task, 2)
+	require.ErrorIs(t, err, ErrMapParameterMissing)
+}